@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 )
 
@@ -11,3 +12,11 @@ import (
 func (c *Client) CallView(hContract iscp.Hname, functionName string, args dict.Dict, optimisticReadTimeout ...time.Duration) (dict.Dict, error) {
 	return c.WaspClient.CallView(c.ChainID, hContract, functionName, args, optimisticReadTimeout...)
 }
+
+// CallViewPaged calls a view function that returns its results page by page until all
+// pages have been retrieved, and calls f with the result of each page
+func (c *Client) CallViewPaged(hContract iscp.Hname, functionName string, args dict.Dict, f func(page dict.Dict) error) error {
+	return coreutil.CallPaged(args, func(args dict.Dict) (dict.Dict, error) {
+		return c.CallView(hContract, functionName, args)
+	}, f)
+}
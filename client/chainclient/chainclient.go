@@ -13,6 +13,7 @@ import (
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/transaction"
 	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/iotaledger/wasp/packages/webapi/model"
 )
 
 // Client allows to interact with a specific chain in the node, for example to send on-ledger or off-ledger requests
@@ -74,6 +75,16 @@ func (c *Client) PostOffLedgerRequest(
 	entrypoint iscp.Hname,
 	params ...PostRequestParams,
 ) (*request.OffLedger, error) {
+	offledgerReq := c.NewOffLedgerRequest(contractHname, entrypoint, params...)
+	return offledgerReq, c.WaspClient.PostOffLedgerRequest(c.ChainID, offledgerReq)
+}
+
+// NewOffLedgerRequest creates an off-ledger request signed by the client, without sending it
+func (c *Client) NewOffLedgerRequest(
+	contractHname iscp.Hname,
+	entrypoint iscp.Hname,
+	params ...PostRequestParams,
+) *request.OffLedger {
 	par := PostRequestParams{}
 	if len(params) > 0 {
 		par = params[0]
@@ -85,7 +96,13 @@ func (c *Client) PostOffLedgerRequest(
 	offledgerReq := request.NewOffLedger(contractHname, entrypoint, par.Args).WithTransfer(par.Transfer)
 	offledgerReq.WithNonce(par.Nonce)
 	offledgerReq.Sign(c.KeyPair)
-	return offledgerReq, c.WaspClient.PostOffLedgerRequest(c.ChainID, offledgerReq)
+	return offledgerReq
+}
+
+// PostOffLedgerRequestBatch sends many off-ledger requests in one call to the wasp node web api.
+// The requests are accepted or rejected one by one, the results are in the order of the requests.
+func (c *Client) PostOffLedgerRequestBatch(reqs []*request.OffLedger) ([]model.OffLedgerRequestResult, error) {
+	return c.WaspClient.PostOffLedgerRequestBatch(c.ChainID, reqs)
 }
 
 func (c *Client) DepositFunds(n uint64) (*ledgerstate.Transaction, error) {
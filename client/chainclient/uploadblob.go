@@ -3,9 +3,12 @@ package chainclient
 import (
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/request"
 	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/blob"
 )
@@ -28,3 +31,65 @@ func (c *Client) UploadBlob(fields dict.Dict) (hashing.HashValue, *request.OffLe
 	err = c.WaspClient.WaitUntilRequestProcessed(c.ChainID, req.ID(), 2*time.Minute)
 	return blobHash, req, err
 }
+
+// UploadBlobChunked uploads the blob through a sequence of off-ledger requests to the blob contract,
+// each carrying at most chunkSize bytes of a field.
+// Chunks which are already part of an interrupted upload session of this client are skipped.
+func (c *Client) UploadBlobChunked(fields dict.Dict, chunkSize int) (hashing.HashValue, error) {
+	blobHash := blob.MustGetBlobHash(fields)
+
+	uploader := iscp.NewAgentID(ledgerstate.NewED25519Address(c.KeyPair.PublicKey), 0)
+	status, err := c.CallView(blob.Contract.Hname(), blob.FuncGetBlobUploadStatus.Name, dict.Dict{
+		blob.ParamHash:  codec.EncodeHashValue(blobHash),
+		blob.ParamOwner: codec.EncodeAgentID(uploader),
+	})
+	if err != nil {
+		return hashing.NilHash, err
+	}
+	uploaded, err := blob.DecodeSizesMap(status)
+	if err != nil {
+		return hashing.NilHash, err
+	}
+
+	for _, field := range fields.KeysSorted() {
+		for i, chunk := range blob.SplitChunks(fields.MustGet(field), chunkSize) {
+			if uint32(i) < uploaded[string(field)] {
+				continue
+			}
+			err = c.postBlobRequest(blob.FuncStoreBlobChunk.Hname(), codec.MakeDict(map[string]interface{}{
+				blob.ParamHash:  blobHash,
+				blob.ParamField: []byte(field),
+				blob.ParamIndex: uint32(i),
+				blob.ParamBytes: chunk,
+			}))
+			if err != nil {
+				return hashing.NilHash, err
+			}
+		}
+	}
+	err = c.postBlobRequest(blob.FuncFinishBlobUpload.Hname(), codec.MakeDict(map[string]interface{}{
+		blob.ParamHash: blobHash,
+	}))
+	return blobHash, err
+}
+
+// DeleteBlob sends an off-ledger request to remove the blob from the chain
+func (c *Client) DeleteBlob(blobHash hashing.HashValue) error {
+	return c.postBlobRequest(blob.FuncDeleteBlob.Hname(), codec.MakeDict(map[string]interface{}{
+		blob.ParamHash: blobHash,
+	}))
+}
+
+func (c *Client) postBlobRequest(entryPoint iscp.Hname, params dict.Dict) error {
+	req, err := c.PostOffLedgerRequest(blob.Contract.Hname(), entryPoint, PostRequestParams{
+		Args: requestargs.New().AddEncodeSimpleMany(params),
+	})
+	if err != nil {
+		return err
+	}
+	err = c.WaspClient.WaitUntilRequestProcessed(c.ChainID, req.ID(), 2*time.Minute)
+	if err != nil {
+		return err
+	}
+	return c.CheckRequestResult(req.ID())
+}
@@ -13,3 +13,18 @@ func (c *WaspClient) PostOffLedgerRequest(chainID *iscp.ChainID, req *request.Of
 	}
 	return c.do("POST", routes.NewRequest(chainID.Base58()), data, nil)
 }
+
+// PostOffLedgerRequestBatch submits the requests in one call. The results are in the order of the requests.
+func (c *WaspClient) PostOffLedgerRequestBatch(chainID *iscp.ChainID, reqs []*request.OffLedger) ([]model.OffLedgerRequestResult, error) {
+	data := model.OffLedgerRequestBatchBody{
+		Requests: make([]model.Bytes, len(reqs)),
+	}
+	for i, req := range reqs {
+		data.Requests[i] = model.NewBytes(req.Bytes())
+	}
+	res := &model.OffLedgerRequestBatchResponse{}
+	if err := c.do("POST", routes.NewRequestBatch(chainID.Base58()), data, res); err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
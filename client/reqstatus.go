@@ -33,13 +33,53 @@ func (c *WaspClient) WaitUntilRequestProcessed(chainID *iscp.ChainID, reqID iscp
 	)
 }
 
+// RequestsStatus fetches the processing status of several requests.
+func (c *WaspClient) RequestsStatus(chainID *iscp.ChainID, reqIDs []iscp.RequestID) (*model.RequestsStatusResponse, error) {
+	return c.requestsStatus(chainID, &model.RequestsStatusParams{RequestIDs: requestIDsBase58(reqIDs)})
+}
+
+// TransactionRequestsStatus fetches the processing status of the requests in the given transaction.
+// Fails with a not found error while the node has not received the transaction.
+func (c *WaspClient) TransactionRequestsStatus(chainID *iscp.ChainID, txID ledgerstate.TransactionID) (*model.RequestsStatusResponse, error) {
+	return c.requestsStatus(chainID, &model.RequestsStatusParams{TransactionID: txID.Base58()})
+}
+
+// WaitUntilRequestsProcessed blocks until all requests have been processed by the node, and returns
+// their status
+func (c *WaspClient) WaitUntilRequestsProcessed(chainID *iscp.ChainID, reqIDs []iscp.RequestID, timeout time.Duration) (*model.RequestsStatusResponse, error) {
+	if timeout == 0 {
+		timeout = model.WaitRequestProcessedDefaultTimeout
+	}
+	return c.requestsStatus(chainID, &model.RequestsStatusParams{
+		RequestIDs: requestIDsBase58(reqIDs),
+		Wait:       true,
+		Timeout:    timeout,
+	})
+}
+
 // WaitUntilAllRequestsProcessed blocks until all requests in the given transaction have been processed
 // by the node
 func (c *WaspClient) WaitUntilAllRequestsProcessed(chainID *iscp.ChainID, tx *ledgerstate.Transaction, timeout time.Duration) error {
-	for _, reqID := range request.RequestsInTransaction(chainID, tx) {
-		if err := c.WaitUntilRequestProcessed(chainID, reqID, timeout); err != nil {
-			return err
-		}
+	reqIDs := request.RequestsInTransaction(chainID, tx)
+	if len(reqIDs) == 0 {
+		return nil
+	}
+	_, err := c.WaitUntilRequestsProcessed(chainID, reqIDs, timeout)
+	return err
+}
+
+func (c *WaspClient) requestsStatus(chainID *iscp.ChainID, params *model.RequestsStatusParams) (*model.RequestsStatusResponse, error) {
+	res := &model.RequestsStatusResponse{}
+	if err := c.do(http.MethodPost, routes.RequestsStatus(chainID.Base58()), params, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func requestIDsBase58(reqIDs []iscp.RequestID) []string {
+	ret := make([]string, len(reqIDs))
+	for i, reqID := range reqIDs {
+		ret[i] = reqID.Base58()
 	}
-	return nil
+	return ret
 }
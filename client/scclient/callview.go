@@ -9,3 +9,7 @@ import (
 func (c *SCClient) CallView(functionName string, args dict.Dict, optimisticReadTimeout ...time.Duration) (dict.Dict, error) {
 	return c.ChainClient.CallView(c.ContractHname, functionName, args, optimisticReadTimeout...)
 }
+
+func (c *SCClient) CallViewPaged(functionName string, args dict.Dict, f func(page dict.Dict) error) error {
+	return c.ChainClient.CallViewPaged(c.ContractHname, functionName, args, f)
+}
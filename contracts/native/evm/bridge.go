@@ -0,0 +1,97 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"golang.org/x/xerrors"
+)
+
+// The bridge moves tokens between the ISCP accounts and the EVM balances.
+//
+// Deposit: post a FuncBridgeDeposit request to the EVM contract with the tokens
+// attached, and FieldAddress set to the receiving EVM address. IOTAs are credited
+// to the balance of the address, colored tokens to its balance in the ERC20
+// contract representing the color (see ERC20Address).
+//
+// Withdraw: send an EVM transaction to BridgeAddress. The value of the transaction
+// is the amount of IOTAs to withdraw (in wei), and its data is the target AgentID
+// and the colored tokens to withdraw, as encoded by EncodeBridgeWithdrawal.
+// Only transactions sent directly to BridgeAddress are honored: funds reaching it
+// by other means (e.g. from a contract) are lost.
+var (
+	FuncBridgeDeposit   = coreutil.Func("bridgeDeposit")
+	FuncGetERC20Address = coreutil.ViewFunc("getERC20Address")
+)
+
+const FieldColor = "col"
+
+// BridgeAddress is the arbitrary address receiving the withdrawal transactions
+var BridgeAddress = common.HexToAddress("0x1076")
+
+// WeiPerIota is the conversion rate between IOTAs and the EVM balances.
+// With 10^12, one million IOTAs (1 Mi) amount to 10^18 wei, the unit shown by
+// most Ethereum tools.
+var WeiPerIota = big.NewInt(1_000_000_000_000)
+
+func IotasToWei(iotas uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(iotas), WeiPerIota)
+}
+
+// WeiToIotas converts the amount, which must be a multiple of WeiPerIota
+func WeiToIotas(wei *big.Int) (uint64, error) {
+	iotas, rem := new(big.Int).QuoRem(wei, WeiPerIota, new(big.Int))
+	if rem.Sign() != 0 {
+		return 0, xerrors.Errorf("%s wei is not a multiple of %s", wei, WeiPerIota)
+	}
+	if !iotas.IsUint64() {
+		return 0, xerrors.Errorf("%s wei is out of range", wei)
+	}
+	return iotas.Uint64(), nil
+}
+
+// ERC20Address is the address of the ERC20 contract representing the colored
+// tokens of the color in the EVM. The contract is created with the first deposit
+// of the color. One token is represented by the smallest unit of the ERC20 token.
+func ERC20Address(color colored.Color) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("iscp-color"), color.Bytes())[12:])
+}
+
+// EncodeBridgeWithdrawal encodes the data of a withdrawal transaction.
+// tokens must not contain IOTAs, which are withdrawn with the value of the transaction.
+func EncodeBridgeWithdrawal(target *iscp.AgentID, tokens colored.Balances) []byte {
+	mu := marshalutil.New().WriteBytes(target.Bytes())
+	if len(tokens) > 0 {
+		mu.WriteBytes(tokens.Bytes())
+	}
+	return mu.Bytes()
+}
+
+func DecodeBridgeWithdrawal(data []byte) (*iscp.AgentID, colored.Balances, error) {
+	mu := marshalutil.New(data)
+	target, err := iscp.AgentIDFromMarshalUtil(mu)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokens := colored.NewBalances()
+	if mu.ReadOffset() < len(data) {
+		if tokens, err = colored.BalancesFromMarshalUtil(mu); err != nil {
+			return nil, nil, err
+		}
+		if mu.ReadOffset() != len(data) {
+			return nil, nil, xerrors.New("unexpected data after the withdrawn tokens")
+		}
+	}
+	if tokens.Get(colored.IOTA) > 0 {
+		return nil, nil, xerrors.New("IOTAs are withdrawn with the value of the transaction")
+	}
+	return target, tokens, nil
+}
@@ -46,6 +46,8 @@ type pending struct {
 	txs      []*types.Transaction
 	receipts []*types.Receipt
 	gasPool  *core.GasPool
+	// stateUpdated is set when the state is modified outside of the transactions
+	stateUpdated bool
 }
 
 type EVMEmulator struct {
@@ -136,15 +138,37 @@ func (e *EVMEmulator) Close() error {
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (e *EVMEmulator) Commit() {
-	if len(e.pending.txs) == 0 {
+	if len(e.pending.txs) == 0 && !e.pending.stateUpdated {
 		return
 	}
-	if _, err := e.blockchain.InsertChain([]*types.Block{e.finalizeBlock()}); err != nil {
+	block := e.finalizeBlock()
+	if e.pending.stateUpdated {
+		// the block cannot be verified by executing its transactions, so it is written as is
+		var logs []*types.Log
+		for _, receipt := range e.pending.receipts {
+			logs = append(logs, receipt.Logs...)
+		}
+		if _, err := e.blockchain.WriteBlockWithState(block, e.pending.receipts, logs, e.pending.state, false); err != nil {
+			panic(err)
+		}
+	} else if _, err := e.blockchain.InsertChain([]*types.Block{block}); err != nil {
 		panic(err)
 	}
 	e.Rollback(e.pending.header.Time + timeDelta)
 }
 
+// PendingState returns the pending state, for reading only. See UpdatePendingState.
+func (e *EVMEmulator) PendingState() vm.StateDB {
+	return e.pending.state
+}
+
+// UpdatePendingState allows to modify the pending state outside of a transaction.
+// The changes are committed with the pending block, even if it has no transactions.
+func (e *EVMEmulator) UpdatePendingState(f func(stateDB vm.StateDB)) {
+	f(e.pending.state)
+	e.pending.stateUpdated = true
+}
+
 func (e *EVMEmulator) finalizeBlock() *types.Block {
 	block, err := e.engine.FinalizeAndAssemble(
 		e.blockchain,
@@ -203,7 +227,11 @@ func (e *EVMEmulator) stateByBlockNumber(blockNumber *big.Int) (*state.StateDB,
 	if err != nil {
 		return nil, err
 	}
-	return e.blockchain.StateAt(block.Root())
+	ret, err := e.blockchain.StateAt(block.Root())
+	if err != nil {
+		return nil, xerrors.Errorf("state of block %s is not available: %w", blockNumber, err)
+	}
+	return ret, nil
 }
 
 // CodeAt returns the code associated with a certain account in the blockchain.
@@ -297,7 +325,11 @@ func (e *EVMEmulator) HeaderByNumber(block *big.Int) (*types.Header, error) {
 	if block == nil {
 		return e.blockchain.CurrentHeader(), nil
 	}
-	return e.blockchain.GetHeaderByNumber(uint64(block.Int64())), nil
+	header := e.blockchain.GetHeaderByNumber(uint64(block.Int64()))
+	if header == nil {
+		return nil, ErrBlockDoesNotExist
+	}
+	return header, nil
 }
 
 // TransactionCount returns the number of transactions in a given block.
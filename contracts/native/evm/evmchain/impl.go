@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/iotaledger/wasp/contracts/native/evm"
 	"github.com/iotaledger/wasp/contracts/native/evm/evmchain/emulator"
 	"github.com/iotaledger/wasp/contracts/native/evm/evminternal"
@@ -37,6 +38,9 @@ var Processor = Contract.Processor(initialize, append(
 	evm.FuncGetTransactionCountByBlockNumber.WithHandler(getTransactionCountByBlockNumber),
 	evm.FuncGetStorage.WithHandler(getStorage),
 	evm.FuncGetLogs.WithHandler(getLogs),
+
+	evm.FuncBridgeDeposit.WithHandler(bridgeDeposit),
+	evm.FuncGetERC20Address.WithHandler(evminternal.GetERC20Address),
 )...)
 
 func initialize(ctx iscp.Sandbox) (dict.Dict, error) {
@@ -65,12 +69,25 @@ func applyTransaction(ctx iscp.Sandbox) (dict.Dict, error) {
 
 	return evminternal.RequireGasFee(ctx, tx.Gas(), func() uint64 {
 		emu := getOrCreateEmulator(ctx)
+		withdrawal := evminternal.RequireBridgeWithdrawal(ctx, emu.PendingState(), emu.Signer(), tx)
 		receipt, err := emu.SendTransaction(tx)
 		a.RequireNoError(err)
+		if withdrawal != nil && receipt.Status == types.ReceiptStatusSuccessful {
+			emu.UpdatePendingState(func(stateDB vm.StateDB) {
+				withdrawal.Execute(ctx, stateDB)
+			})
+		}
 		return receipt.GasUsed
 	}), nil
 }
 
+func bridgeDeposit(ctx iscp.Sandbox) (dict.Dict, error) {
+	getOrCreateEmulator(ctx).UpdatePendingState(func(stateDB vm.StateDB) {
+		evminternal.BridgeDeposit(ctx, stateDB)
+	})
+	return nil, nil
+}
+
 func getBalance(ctx iscp.SandboxView) (dict.Dict, error) {
 	a := assert.NewAssert(ctx.Log())
 	addr := common.BytesToAddress(ctx.Params().MustGet(evm.FieldAddress))
@@ -0,0 +1,73 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+contract ERC20Basic {
+    string private _name;
+    string private _symbol;
+
+	uint8 public constant decimals = 18;
+
+	event Approval(address indexed tokenOwner, address indexed spender, uint tokens);
+	event Transfer(address indexed from, address indexed to, uint tokens);
+
+	mapping(address => uint256) balances;
+	mapping(address => mapping (address => uint256)) allowed;
+	uint256 totalSupply_;
+
+    constructor(string memory name, string memory symbol) {
+        _name = name;
+        _symbol = symbol;
+        totalSupply_ = 100 * 10 ** uint(decimals);
+		balances[msg.sender] = totalSupply_;
+        emit Transfer(address(0), msg.sender, totalSupply_);
+    }
+
+	function totalSupply() public view returns (uint256) {
+		return totalSupply_;
+	}
+
+	function balanceOf(address tokenOwner) public view returns (uint256) {
+		return balances[tokenOwner];
+	}
+
+	function transfer(address receiver, uint256 numTokens) public returns (bool) {
+		require(numTokens <= balances[msg.sender]);
+		balances[msg.sender] = sub(balances[msg.sender], numTokens);
+		balances[receiver] = add(balances[receiver], numTokens);
+		emit Transfer(msg.sender, receiver, numTokens);
+		return true;
+	}
+
+	function approve(address delegate, uint256 numTokens) public returns (bool) {
+		allowed[msg.sender][delegate] = numTokens;
+		emit Approval(msg.sender, delegate, numTokens);
+		return true;
+	}
+
+	function allowance(address owner, address delegate) public view returns (uint) {
+		return allowed[owner][delegate];
+	}
+
+	function transferFrom(address owner, address buyer, uint256 numTokens) public returns (bool) {
+		require(numTokens <= balances[owner]);
+		require(numTokens <= allowed[owner][msg.sender]);
+		balances[owner] = sub(balances[owner], numTokens);
+		allowed[owner][msg.sender] = sub(allowed[owner][msg.sender], numTokens);
+		balances[buyer] = add(balances[buyer], numTokens);
+		emit Transfer(owner, buyer, numTokens);
+		return true;
+	}
+
+	function sub(uint256 a, uint256 b) internal pure returns (uint256) {
+		assert(b <= a);
+		return a - b;
+	}
+
+	function add(uint256 a, uint256 b) internal pure returns (uint256) {
+		uint256 c = a + b;
+		assert(c >= a);
+		return c;
+	}
+}
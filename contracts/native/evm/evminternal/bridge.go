@@ -0,0 +1,265 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evminternal
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iotaledger/wasp/contracts/native/evm"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+)
+
+// The ERC20 contracts representing the colored tokens run this code. Their
+// balances are minted and burned by the bridge by writing directly to their storage.
+//
+//go:generate solc --abi --bin-runtime --overwrite ERC20Basic.sol -o .
+var (
+	//go:embed ERC20Basic.bin-runtime
+	erc20RuntimeBytecodeHex string
+	erc20RuntimeBytecode    = common.FromHex(strings.TrimSpace(erc20RuntimeBytecodeHex))
+)
+
+// storage layout of ERC20Basic.sol
+var (
+	erc20BalancesSlot    = common.BigToHash(big.NewInt(2))
+	erc20TotalSupplySlot = common.BigToHash(big.NewInt(4))
+)
+
+// keyBridgeLocked holds the tokens deposited through the bridge and not withdrawn yet, which
+// are owned by the contract but cannot be withdrawn as gas fees. They back the EVM balances as a
+// whole: wei and ERC20 tokens are fungible, so any holder can withdraw them as long as enough
+// tokens are locked. The wei of the genesis allocation are not backed by any deposit.
+const keyBridgeLocked = "L"
+
+func lockedBalances(ctx iscp.Sandbox) colored.Balances {
+	a := assert.NewAssert(ctx.Log())
+	data := ctx.State().MustGet(keyBridgeLocked)
+	if data == nil {
+		return colored.NewBalances()
+	}
+	ret, err := colored.BalancesFromBytes(data)
+	a.RequireNoError(err)
+	return ret
+}
+
+func setLockedBalances(ctx iscp.Sandbox, locked colored.Balances) {
+	nonZero := colored.NewBalances()
+	locked.ForEachRandomly(func(col colored.Color, bal uint64) bool {
+		if bal > 0 {
+			nonZero.Set(col, bal)
+		}
+		return true
+	})
+	ctx.State().Set(keyBridgeLocked, nonZero.Bytes())
+}
+
+// gasFeeBalances are the tokens owned by the contract, except the ones deposited through the bridge
+func gasFeeBalances(ctx iscp.Sandbox) colored.Balances {
+	ret := ctx.Balances().Clone()
+	lockedBalances(ctx).ForEachRandomly(func(col colored.Color, bal uint64) bool {
+		ret.SubNoOverflow(col, bal)
+		return true
+	})
+	return ret
+}
+
+func erc20BalanceSlot(addr common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), erc20BalancesSlot.Bytes())
+}
+
+func addERC20Balance(stateDB vm.StateDB, color colored.Color, addr common.Address, delta *big.Int) {
+	erc20 := evm.ERC20Address(color)
+	if stateDB.GetCodeSize(erc20) == 0 {
+		stateDB.CreateAccount(erc20)
+		stateDB.SetCode(erc20, erc20RuntimeBytecode)
+	}
+	slot := erc20BalanceSlot(addr)
+	balance := stateDB.GetState(erc20, slot).Big()
+	stateDB.SetState(erc20, slot, common.BigToHash(balance.Add(balance, delta)))
+	supply := stateDB.GetState(erc20, erc20TotalSupplySlot).Big()
+	stateDB.SetState(erc20, erc20TotalSupplySlot, common.BigToHash(supply.Add(supply, delta)))
+}
+
+func getERC20Balance(stateDB vm.StateDB, color colored.Color, addr common.Address) *big.Int {
+	return stateDB.GetState(evm.ERC20Address(color), erc20BalanceSlot(addr)).Big()
+}
+
+// BridgeDeposit credits the tokens attached to the request to the EVM address given in
+// the parameters. The tokens stay in the account of the contract, locked until withdrawn.
+func BridgeDeposit(ctx iscp.Sandbox, stateDB vm.StateDB) {
+	a := assert.NewAssert(ctx.Log())
+	par := kvdecoder.New(ctx.Params(), ctx.Log())
+	a.Require(ctx.Params().MustHas(evm.FieldAddress), "missing parameter %s", evm.FieldAddress)
+	addr := common.BytesToAddress(par.MustGetBytes(evm.FieldAddress))
+	tokens := ctx.IncomingTransfer()
+	a.Require(tokens != nil && !tokens.IsEmpty(), "no tokens to deposit")
+
+	locked := lockedBalances(ctx)
+	tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		if bal == 0 {
+			return true
+		}
+		if col == colored.IOTA {
+			stateDB.AddBalance(addr, evm.IotasToWei(bal))
+		} else {
+			addERC20Balance(stateDB, col, addr, new(big.Int).SetUint64(bal))
+		}
+		locked.Add(col, bal)
+		return true
+	})
+	setLockedBalances(ctx, locked)
+	ctx.Event(fmt.Sprintf("[evm bridge] deposit %s to %s", tokens, addr))
+}
+
+// BridgeWithdrawal is a validated withdrawal transaction
+type BridgeWithdrawal struct {
+	sender common.Address
+	target *iscp.AgentID
+	iotas  uint64
+	tokens colored.Balances
+}
+
+// RequireBridgeWithdrawal returns the withdrawal requested by the transaction, or nil if the
+// transaction is not sent to the bridge. It must be called before running the transaction,
+// so that an invalid withdrawal is rejected before changing the EVM state.
+func RequireBridgeWithdrawal(ctx iscp.Sandbox, stateDB vm.StateDB, signer types.Signer, tx *types.Transaction) *BridgeWithdrawal {
+	if tx.To() == nil || *tx.To() != evm.BridgeAddress {
+		return nil
+	}
+	a := assert.NewAssert(ctx.Log())
+	sender, err := types.Sender(signer, tx)
+	a.RequireNoError(err)
+	target, tokens, err := evm.DecodeBridgeWithdrawal(tx.Data())
+	a.RequireNoError(err)
+	iotas, err := evm.WeiToIotas(tx.Value())
+	a.RequireNoError(err)
+
+	a.Require(stateDB.GetBalance(sender).Cmp(tx.Value()) >= 0, "bridge: not enough IOTAs: balance is %s wei", stateDB.GetBalance(sender))
+	locked := lockedBalances(ctx)
+	a.Require(locked.Get(colored.IOTA) >= iotas, "bridge: cannot withdraw %d IOTAs, only %d are backed by deposits", iotas, locked.Get(colored.IOTA))
+	tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		a.Require(getERC20Balance(stateDB, col, sender).Cmp(new(big.Int).SetUint64(bal)) >= 0,
+			"bridge: not enough tokens of color %s", col.String())
+		a.Require(locked.Get(col) >= bal, "bridge: inconsistency: not enough locked tokens of color %s", col.String())
+		return true
+	})
+	return &BridgeWithdrawal{
+		sender: sender,
+		target: target,
+		iotas:  iotas,
+		tokens: tokens,
+	}
+}
+
+// Execute burns the withdrawn tokens in the EVM and sends them to the target.
+// It must be called after the transaction is applied successfully.
+func (w *BridgeWithdrawal) Execute(ctx iscp.Sandbox, stateDB vm.StateDB) {
+	stateDB.SubBalance(evm.BridgeAddress, evm.IotasToWei(w.iotas))
+	w.tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		addERC20Balance(stateDB, col, w.sender, new(big.Int).Neg(new(big.Int).SetUint64(bal)))
+		return true
+	})
+
+	withdrawn := w.tokens.Clone()
+	if w.iotas > 0 {
+		withdrawn.Set(colored.IOTA, w.iotas)
+	}
+	if withdrawn.IsEmpty() {
+		return
+	}
+	locked := lockedBalances(ctx)
+	withdrawn.ForEachRandomly(func(col colored.Color, bal uint64) bool {
+		locked.SubNoOverflow(col, bal)
+		return true
+	})
+	setLockedBalances(ctx, locked)
+	assert.NewAssert(ctx.Log()).RequireNoError(SendTo(ctx, w.target, withdrawn))
+	ctx.Event(fmt.Sprintf("[evm bridge] withdraw %s from %s to %s", withdrawn, w.sender, w.target))
+}
+
+// RequireEVMBalances returns an error if the EVM address does not own the tokens,
+// either as its balance (IOTAs) or in the ERC20 contracts representing the colors
+func RequireEVMBalances(stateDB vm.StateDB, owner common.Address, tokens colored.Balances) error {
+	var err error
+	tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		var owned *big.Int
+		if col == colored.IOTA {
+			owned = stateDB.GetBalance(owner)
+			if owned.Cmp(evm.IotasToWei(bal)) < 0 {
+				err = fmt.Errorf("not enough IOTAs: %d requested, balance is %s wei", bal, owned)
+			}
+		} else if owned = getERC20Balance(stateDB, col, owner); owned.Cmp(new(big.Int).SetUint64(bal)) < 0 {
+			err = fmt.Errorf("not enough tokens of color %s: %d requested, balance is %s", col.String(), bal, owned)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// UnlockTokens burns the tokens owned by the EVM address and releases the corresponding
+// tokens locked by the bridge, so that they can be sent or transferred by the contract.
+// The returned function locks the released tokens again.
+func UnlockTokens(ctx iscp.Sandbox, stateDB vm.StateDB, owner common.Address, tokens colored.Balances) (func(), error) {
+	if err := RequireEVMBalances(stateDB, owner, tokens); err != nil {
+		return nil, err
+	}
+	locked := lockedBalances(ctx)
+	var err error
+	tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		if locked.Get(col) < bal {
+			err = fmt.Errorf("cannot unlock %d tokens of color %s, only %d are backed by deposits", bal, col.String(), locked.Get(col))
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	prevLocked := locked.Clone()
+	tokens.ForEachSorted(func(col colored.Color, bal uint64) bool {
+		if col == colored.IOTA {
+			stateDB.SubBalance(owner, evm.IotasToWei(bal))
+		} else {
+			addERC20Balance(stateDB, col, owner, new(big.Int).Neg(new(big.Int).SetUint64(bal)))
+		}
+		locked.SubNoOverflow(col, bal)
+		return true
+	})
+	setLockedBalances(ctx, locked)
+	return func() { setLockedBalances(ctx, prevLocked) }, nil
+}
+
+// SendTo sends the tokens owned by the contract to the on-chain account or the L1 address of the agent
+func SendTo(ctx iscp.Sandbox, target *iscp.AgentID, tokens colored.Balances) error {
+	if target.Address().Equals(ctx.ChainID().AsAddress()) {
+		params := codec.MakeDict(map[string]interface{}{
+			accounts.ParamAgentID: target,
+		})
+		_, err := ctx.Call(accounts.Contract.Hname(), accounts.FuncDeposit.Hname(), params, tokens)
+		return err
+	}
+	if !ctx.Send(target.Address(), tokens, &iscp.SendMetadata{
+		TargetContract: target.Hname(),
+	}) {
+		return fmt.Errorf("failed sending tokens to %s", target)
+	}
+	return nil
+}
+
+func GetERC20Address(ctx iscp.SandboxView) (dict.Dict, error) {
+	par := kvdecoder.New(ctx.Params(), ctx.Log())
+	return Result(evm.ERC20Address(par.MustGetColor(evm.FieldColor)).Bytes()), nil
+}
@@ -78,27 +78,13 @@ func getGasPerIota(ctx iscp.SandboxView) (dict.Dict, error) {
 }
 
 func withdrawGasFees(ctx iscp.Sandbox) (dict.Dict, error) {
-	a := assert.NewAssert(ctx.Log())
 	requireOwner(ctx)
 
 	paramsDecoder := kvdecoder.New(ctx.Params(), ctx.Log())
 	targetAgentID := paramsDecoder.MustGetAgentID(evm.FieldAgentID, ctx.Caller())
 
-	isOnChain := targetAgentID.Address().Equals(ctx.ChainID().AsAddress())
-
-	if isOnChain {
-		params := codec.MakeDict(map[string]interface{}{
-			accounts.ParamAgentID: targetAgentID,
-		})
-		_, err := ctx.Call(accounts.Contract.Hname(), accounts.FuncDeposit.Hname(), params, ctx.Balances())
-		a.RequireNoError(err)
-		return nil, nil
-	}
-
-	a.Require(ctx.Send(targetAgentID.Address(), ctx.Balances(), &iscp.SendMetadata{
-		TargetContract: targetAgentID.Hname(),
-	}), "withdraw.inconsistency: failed sending tokens ")
-
+	a := assert.NewAssert(ctx.Log())
+	a.RequireNoError(SendTo(ctx, targetAgentID, gasFeeBalances(ctx)))
 	return nil, nil
 }
 
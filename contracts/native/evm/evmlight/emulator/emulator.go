@@ -30,6 +30,9 @@ type EVMEmulator struct {
 	chainConfig *params.ChainConfig
 	kv          kv.KVStore
 	IEVMBackend vm.ISCPBackend
+	// Precompiles are the contracts run by the EVM code instead of the code at their
+	// addresses, which must be registered with RegisterPrecompile
+	Precompiles map[common.Address]PrecompiledContract
 }
 
 func makeConfig(chainID int) *params.ChainConfig {
@@ -256,14 +259,14 @@ func (e *EVMEmulator) callContract(call ethereum.CallMsg) (*core.ExecutionResult
 	return e.applyMessage(msg, statedb)
 }
 
-func (e *EVMEmulator) applyMessage(msg core.Message, statedb vm.StateDB) (*core.ExecutionResult, error) {
-	pendingHeader := e.PendingHeader()
-	blockContext := core.NewEVMBlockContext(pendingHeader, e.ChainContext(), nil)
-	txContext := core.NewEVMTxContext(msg)
-	vmEnv := vm.NewEVM(blockContext, txContext, statedb, e.chainConfig, e.vmConfig())
-	gasPool := core.GasPool(msg.Gas())
-	vmEnv.Reset(txContext, statedb)
-	return core.ApplyMessage(vmEnv, msg, &gasPool)
+func (e *EVMEmulator) applyMessage(msg core.Message, statedb *StateDB) (*core.ExecutionResult, error) {
+	return e.runEVM(statedb, func(blockContext vm.BlockContext) (*core.ExecutionResult, error) {
+		txContext := core.NewEVMTxContext(msg)
+		vmEnv := vm.NewEVM(blockContext, txContext, statedb, e.chainConfig, e.vmConfig())
+		gasPool := core.GasPool(msg.Gas())
+		vmEnv.Reset(txContext, statedb)
+		return core.ApplyMessage(vmEnv, msg, &gasPool)
+	})
 }
 
 func (e *EVMEmulator) vmConfig() vm.Config {
@@ -170,6 +170,34 @@ func TestBlockchainPersistence(t *testing.T) {
 	}
 }
 
+func TestStateDBSnapshot(t *testing.T) {
+	state := NewStateDB(dict.Dict{})
+	addr := common.HexToAddress("0x1234")
+	key := common.HexToHash("0x01")
+
+	state.CreateAccount(addr)
+	state.AddBalance(addr, big.NewInt(100))
+	state.SetState(addr, key, common.HexToHash("0x02"))
+
+	snapshot := state.Snapshot()
+	state.SubBalance(addr, big.NewInt(30))
+	state.SetState(addr, key, common.HexToHash("0x03"))
+	state.SetCode(addr, []byte{0x00})
+	state.AddRefund(10)
+	state.AddLog(&types.Log{Address: addr})
+	reverted := false
+	state.AddJournalEntry(func() { reverted = true })
+
+	state.RevertToSnapshot(snapshot)
+	require.EqualValues(t, big.NewInt(100), state.GetBalance(addr))
+	require.Equal(t, common.HexToHash("0x02"), state.GetState(addr, key))
+	require.Empty(t, state.GetCode(addr))
+	require.Zero(t, state.GetRefund())
+	require.Empty(t, state.GetLogs(common.Hash{}))
+	require.True(t, reverted)
+	require.Equal(t, snapshot, state.Snapshot())
+}
+
 type contractFnCaller func(sender *ecdsa.PrivateKey, name string, args ...interface{}) *types.Receipt
 
 func deployEVMContract(t testing.TB, emu *EVMEmulator, creator *ecdsa.PrivateKey, contractABI abi.ABI, contractBytecode []byte, args ...interface{}) (common.Address, contractFnCaller) {
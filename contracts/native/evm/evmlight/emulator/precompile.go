@@ -0,0 +1,135 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package emulator
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"golang.org/x/xerrors"
+)
+
+// PrecompiledContract is a precompiled contract that is given the context of the
+// EVM that calls it
+type PrecompiledContract interface {
+	// RequiredGas returns the gas charged for the call, before running it
+	RequiredGas(input []byte) uint64
+	Run(call *PrecompileCall) ([]byte, error)
+}
+
+// PrecompileCall is a call made by the EVM to a PrecompiledContract
+type PrecompileCall struct {
+	Input   []byte
+	StateDB *StateDB
+	// Caller and Value are the address that called the contract with CALL and the
+	// value it transferred. When the contract is called with STATICCALL,
+	// DELEGATECALL or CALLCODE they are unknown and ReadOnly is set.
+	Caller   common.Address
+	Value    *big.Int
+	ReadOnly bool
+}
+
+// The precompiled contracts of go-ethereum are global, and they are not given the
+// context of the EVM that runs them. So the emulators that have precompiled
+// contracts run their EVM code one at a time, and the dispatcher registered at the
+// address of each PrecompiledContract (see RegisterPrecompile) runs the contract of
+// the EVM being run.
+var (
+	evmMutex   sync.Mutex
+	runningEVM *evmRun
+)
+
+// evmRun is the context of the EVM being run by an emulator
+type evmRun struct {
+	precompiles map[common.Address]PrecompiledContract
+	stateDB     *StateDB
+	// transfer is set by the value transfer that precedes each CALL, and taken by
+	// the next call to a precompiled contract
+	transfer *PrecompileCall
+	call     *PrecompileCall
+}
+
+// RegisterPrecompile registers the address of a PrecompiledContract in go-ethereum.
+// It must be called at initialization, before any EVM code is run.
+func RegisterPrecompile(addr common.Address) {
+	if _, ok := vm.PrecompiledContractsBerlin[addr]; ok {
+		panic(xerrors.Errorf("address %s is already a precompiled contract", addr))
+	}
+	vm.PrecompiledContractsBerlin[addr] = &precompileDispatcher{addr: addr}
+	vm.PrecompiledAddressesBerlin = append(vm.PrecompiledAddressesBerlin, addr)
+}
+
+// runEVM runs f as the running EVM, providing the precompiled contracts of the emulator
+func (e *EVMEmulator) runEVM(stateDB *StateDB, f func(blockContext vm.BlockContext) (*core.ExecutionResult, error)) (*core.ExecutionResult, error) {
+	blockContext := core.NewEVMBlockContext(e.PendingHeader(), e.ChainContext(), nil)
+	if len(e.Precompiles) == 0 {
+		return f(blockContext)
+	}
+
+	run := &evmRun{precompiles: e.Precompiles, stateDB: stateDB}
+	blockContext.Transfer = func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+		core.Transfer(db, sender, recipient, amount)
+		if _, ok := run.precompiles[recipient]; ok {
+			run.transfer = &PrecompileCall{Caller: sender, Value: amount}
+		}
+	}
+
+	evmMutex.Lock()
+	defer evmMutex.Unlock()
+	runningEVM = run
+	defer func() { runningEVM = nil }()
+	return f(blockContext)
+}
+
+// Suspend runs f while the EVM that made the call is suspended, so that f can run
+// other EVM code
+func (c *PrecompileCall) Suspend(f func()) {
+	run := runningEVM
+	runningEVM = nil
+	evmMutex.Unlock()
+	defer func() {
+		evmMutex.Lock()
+		runningEVM = run
+	}()
+	f()
+}
+
+type precompileDispatcher struct {
+	addr common.Address
+}
+
+var _ vm.PrecompiledContract = &precompileDispatcher{}
+
+// RequiredGas is called right before each call to Run
+func (d *precompileDispatcher) RequiredGas(input []byte) uint64 {
+	run := runningEVM
+	if run == nil {
+		return 0
+	}
+	contract, ok := run.precompiles[d.addr]
+	if !ok {
+		return 0
+	}
+	run.call = run.transfer
+	run.transfer = nil
+	if run.call == nil {
+		run.call = &PrecompileCall{Value: new(big.Int), ReadOnly: true}
+	}
+	run.call.Input = input
+	run.call.StateDB = run.stateDB
+	return contract.RequiredGas(input)
+}
+
+func (d *precompileDispatcher) Run(input []byte) ([]byte, error) {
+	run := runningEVM
+	if run == nil || run.call == nil {
+		return nil, xerrors.Errorf("precompiled contract %s is not available", d.addr)
+	}
+	call := run.call
+	run.call = nil
+	return run.precompiles[d.addr].Run(call)
+}
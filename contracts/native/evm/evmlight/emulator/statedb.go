@@ -48,6 +48,9 @@ type StateDB struct {
 	kv     kv.KVStore
 	logs   []*types.Log
 	refund uint64
+	// journal holds the functions that undo the changes made to the StateDB, so
+	// that they can be reverted with RevertToSnapshot
+	journal []func()
 }
 
 var _ vm.StateDB = &StateDB{}
@@ -61,8 +64,31 @@ func (s *StateDB) CreateAccount(addr common.Address) {
 	s.SetNonce(addr, 0)
 }
 
+// set sets the value of the key, recording the change in the journal
+func (s *StateDB) set(key kv.Key, value []byte) {
+	prev := s.kv.MustGet(key)
+	s.AddJournalEntry(func() {
+		if prev == nil {
+			s.kv.Del(key)
+		} else {
+			s.kv.Set(key, prev)
+		}
+	})
+	s.kv.Set(key, value)
+}
+
+// del deletes the key, recording the change in the journal
+func (s *StateDB) del(key kv.Key) {
+	prev := s.kv.MustGet(key)
+	if prev == nil {
+		return
+	}
+	s.AddJournalEntry(func() { s.kv.Set(key, prev) })
+	s.kv.Del(key)
+}
+
 func (s *StateDB) setAccountBalance(addr common.Address, amount *big.Int) {
-	s.kv.Set(accountBalanceKey(addr), amount.Bytes())
+	s.set(accountBalanceKey(addr), amount.Bytes())
 }
 
 func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
@@ -94,7 +120,7 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 }
 
 func (s *StateDB) SetNonce(addr common.Address, n uint64) {
-	s.kv.Set(accountNonceKey(addr), codec.EncodeUint64(n))
+	s.set(accountNonceKey(addr), codec.EncodeUint64(n))
 }
 
 func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
@@ -108,9 +134,9 @@ func (s *StateDB) GetCode(addr common.Address) []byte {
 
 func (s *StateDB) SetCode(addr common.Address, code []byte) {
 	if code == nil {
-		s.kv.Del(accountCodeKey(addr))
+		s.del(accountCodeKey(addr))
 	} else {
-		s.kv.Set(accountCodeKey(addr), code)
+		s.set(accountCodeKey(addr), code)
 	}
 }
 
@@ -119,15 +145,21 @@ func (s *StateDB) GetCodeSize(addr common.Address) int {
 	return len(s.GetCode(addr))
 }
 
+func (s *StateDB) setRefund(n uint64) {
+	prev := s.refund
+	s.AddJournalEntry(func() { s.refund = prev })
+	s.refund = n
+}
+
 func (s *StateDB) AddRefund(n uint64) {
-	s.refund += n
+	s.setRefund(s.refund + n)
 }
 
 func (s *StateDB) SubRefund(n uint64) {
 	if n > s.refund {
 		panic(fmt.Sprintf("Refund counter below zero (gas: %d > refund: %d)", n, s.refund))
 	}
-	s.refund -= n
+	s.setRefund(s.refund - n)
 }
 
 func (s *StateDB) GetRefund() uint64 {
@@ -143,7 +175,7 @@ func (s *StateDB) GetState(addr common.Address, key common.Hash) common.Hash {
 }
 
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
-	s.kv.Set(accountStateKey(addr, key), value.Bytes())
+	s.set(accountStateKey(addr, key), value.Bytes())
 }
 
 func (s *StateDB) Suicide(addr common.Address) bool {
@@ -151,9 +183,9 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 		return false
 	}
 
-	s.kv.Del(accountBalanceKey(addr))
-	s.kv.Del(accountNonceKey(addr))
-	s.kv.Del(accountCodeKey(addr))
+	s.del(accountBalanceKey(addr))
+	s.del(accountNonceKey(addr))
+	s.del(accountCodeKey(addr))
 
 	keys := make([]kv.Key, 0)
 	s.kv.MustIterateKeys(accountKey(keyAccountState, addr), func(key kv.Key) bool {
@@ -161,7 +193,7 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 		return true
 	})
 	for _, k := range keys {
-		s.kv.Del(k)
+		s.del(k)
 	}
 
 	return true
@@ -201,11 +233,28 @@ func (s *StateDB) AddAddressToAccessList(addr common.Address) {}
 func (s *StateDB) AddSlotToAccessList(addr common.Address, slot common.Hash) {
 }
 
-func (s *StateDB) RevertToSnapshot(int) {}
-func (s *StateDB) Snapshot() int        { return 0 }
+// AddJournalEntry records a change made during the execution of the EVM, which is
+// undone by revert if the EVM reverts the call frame that made it
+func (s *StateDB) AddJournalEntry(revert func()) {
+	s.journal = append(s.journal, revert)
+}
+
+// RevertToSnapshot undoes the changes recorded in the journal after the snapshot was taken
+func (s *StateDB) RevertToSnapshot(snapshot int) {
+	for i := len(s.journal) - 1; i >= snapshot; i-- {
+		s.journal[i]()
+	}
+	s.journal = s.journal[:snapshot]
+}
+
+func (s *StateDB) Snapshot() int {
+	return len(s.journal)
+}
 
 func (s *StateDB) AddLog(log *types.Log) {
-	log.Index = uint(len(s.logs))
+	n := len(s.logs)
+	s.AddJournalEntry(func() { s.logs = s.logs[:n] })
+	log.Index = uint(n)
 	s.logs = append(s.logs, log)
 }
 
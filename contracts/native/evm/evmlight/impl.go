@@ -37,6 +37,9 @@ var Processor = Contract.Processor(initialize, append(
 	evm.FuncGetTransactionCountByBlockNumber.WithHandler(getTransactionCountByBlockNumber),
 	evm.FuncGetStorage.WithHandler(getStorage),
 	evm.FuncGetLogs.WithHandler(getLogs),
+
+	evm.FuncBridgeDeposit.WithHandler(bridgeDeposit),
+	evm.FuncGetERC20Address.WithHandler(evminternal.GetERC20Address),
 )...)
 
 func initialize(ctx iscp.Sandbox) (dict.Dict, error) {
@@ -67,14 +70,32 @@ func applyTransaction(ctx iscp.Sandbox) (dict.Dict, error) {
 	err := tx.UnmarshalBinary(ctx.Params().MustGet(evm.FieldTransactionData))
 	a.RequireNoError(err)
 
+	requireNotExecuting(ctx)
+	ctx.State().Set(keyExecuting, []byte{1})
+	defer ctx.State().Del(keyExecuting)
+
 	return evminternal.RequireGasFee(ctx, tx.Gas(), func() uint64 {
 		emu := createEmulator(ctx)
+		sandbox := newISCPSandbox(ctx)
+		emu.Precompiles = sandbox.precompiles()
+		withdrawal := evminternal.RequireBridgeWithdrawal(ctx, emu.StateDB(), emu.Signer(), tx)
 		receipt, err := emu.SendTransaction(tx)
 		a.RequireNoError(err)
+		a.Require(!sandbox.revertedEffects, "the EVM transaction reverted a call to an ISCP contract, which cannot be undone")
+		a.RequireNoError(sandbox.applyEffects())
+		if withdrawal != nil && receipt.Status == types.ReceiptStatusSuccessful {
+			withdrawal.Execute(ctx, emu.StateDB())
+		}
 		return receipt.GasUsed
 	}), nil
 }
 
+func bridgeDeposit(ctx iscp.Sandbox) (dict.Dict, error) {
+	requireNotExecuting(ctx)
+	evminternal.BridgeDeposit(ctx, createEmulator(ctx).StateDB())
+	return nil, nil
+}
+
 func getBalance(ctx iscp.SandboxView) (dict.Dict, error) {
 	addr := common.BytesToAddress(ctx.Params().MustGet(evm.FieldAddress))
 	emu := createEmulatorR(ctx)
@@ -36,7 +36,9 @@ func createEmulator(ctx iscp.Sandbox) *emulator.EVMEmulator {
 }
 
 func createEmulatorR(ctx iscp.SandboxView) *emulator.EVMEmulator {
-	return emulator.NewEVMEmulator(evmStateSubrealm(buffered.NewBufferedKVStoreAccess(ctx.State())), timestamp(ctx), &iscpBackendR{ctx})
+	emu := emulator.NewEVMEmulator(evmStateSubrealm(buffered.NewBufferedKVStoreAccess(ctx.State())), timestamp(ctx), &iscpBackendR{ctx})
+	emu.Precompiles = newISCPSandboxR(ctx).precompiles()
+	return emu
 }
 
 // timestamp returns the current timestamp in seconds since epoch
@@ -129,12 +131,17 @@ func transactionByBlockNumberAndIndex(ctx iscp.SandboxView) (*emulator.EVMEmulat
 	return emu, emu.BlockchainDB().GetTransactionByBlockNumber(blockNumber)
 }
 
+// requireLatestBlock fails if allowPrevious is false and the block is not the latest one,
+// since evmlight does not keep the state of past blocks
 func requireLatestBlock(ctx iscp.SandboxView, emu *emulator.EVMEmulator, allowPrevious bool, blockNumber *big.Int) *big.Int {
 	current := emu.BlockchainDB().GetNumber()
-	if blockNumber.Cmp(current) != 0 {
-		assert.NewAssert(ctx.Log()).Require(allowPrevious, "unsupported operation")
+	if allowPrevious || blockNumber.Cmp(current) == 0 {
+		return blockNumber
 	}
-	return blockNumber
+	a := assert.NewAssert(ctx.Log())
+	a.Require(blockNumber.Cmp(current) < 0, "block %s not found, latest block is %s", blockNumber, current)
+	a.Require(false, "historical state is not available in evmlight: block %s requested, latest block is %s", blockNumber, current)
+	return nil
 }
 
 func paramBlockNumber(ctx iscp.SandboxView, emu *emulator.EVMEmulator, allowPrevious bool) *big.Int {
@@ -12,6 +12,7 @@ struct ISCPAddress {
 
 address constant ISCP_CONTRACT_ADDRESS = 0x0000000000000000000000000000000000001074;
 address constant ISCP_YUL_ADDRESS      = 0x0000000000000000000000000000000000001075;
+address constant ISCP_SANDBOX_ADDRESS  = 0x0000000000000000000000000000000000001077;
 
 // The standard ISCP contract present in all EVM ISCP chains at ISCP_CONTRACT_ADDRESS
 contract ISCP {
@@ -34,3 +35,46 @@ function iscpEntropy() returns (bytes32) {
 	assert(success);
     return abi.decode(result, (bytes32));
 }
+
+// The ISCP sandbox, a precompiled contract present in all EVM ISCP chains at
+// ISCP_SANDBOX_ADDRESS.
+//
+// ISCP types are passed in their binary encoding: AgentIDs (37 bytes), the
+// ChainID (33 bytes), colored balances and the parameters and results of
+// ISCP calls (dicts).
+//
+// The functions charge gas (see iscpcontract/sandbox.go) and revert with a
+// reason when the operation fails. The functions changing the state must be
+// called with CALL. Events and sent tokens are reverted along with the call
+// frame; the calls to ISCP contracts cannot be undone, so if the EVM
+// transaction reverts them, the whole ISCP request fails.
+//
+// When called from eth_call or during gas estimation, the functions changing
+// the state are simulated without effects, getCaller returns empty bytes and
+// getEntropy is not random.
+interface ISCPSandbox {
+	// The ChainID of the underlying ISCP chain
+	function getChainID() external view returns (bytes memory);
+
+	// The AgentID of the sender of the ISCP request
+	function getCaller() external view returns (bytes memory);
+
+	// 32 bytes of deterministic and unpredictable random data
+	function getEntropy() external view returns (bytes32);
+
+	// The balance of the color in the on-chain account of the agent
+	function getBalance(bytes calldata agentID, bytes32 color) external view returns (uint64);
+
+	// Triggers an ISCP event
+	function triggerEvent(string calldata s) external;
+
+	// Sends tokens owned by the caller in the EVM (IOTAs and ERC20 tokens created
+	// by the bridge) to the on-chain account or the L1 address of the agent
+	function sendTokens(bytes calldata targetAgentID, bytes calldata tokens) external;
+
+	// Calls an entry point of an ISCP contract, transferring tokens owned by the
+	// caller in the EVM. The EVM contract itself cannot be called.
+	function callContract(uint32 contractHname, uint32 entryPoint, bytes calldata params, bytes calldata transfer) external returns (bytes memory);
+}
+
+ISCPSandbox constant ISCP_SANDBOX = ISCPSandbox(ISCP_SANDBOX_ADDRESS);
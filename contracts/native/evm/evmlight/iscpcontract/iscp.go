@@ -90,4 +90,6 @@ func DeployOnGenesis(genesisAlloc core.GenesisAlloc, chainID *iscp.ChainID) {
 		Code:    common.FromHex(strings.TrimSpace(yulBytecodeHex)),
 		Balance: &big.Int{},
 	}
+
+	deploySandboxOnGenesis(genesisAlloc)
 }
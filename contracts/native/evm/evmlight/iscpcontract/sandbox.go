@@ -0,0 +1,52 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package iscpcontract
+
+import (
+	_ "embed"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// The ISCP sandbox contract gives EVM code access to the ISCP sandbox (see the
+// ISCPSandbox interface in ISCP.sol).
+//
+// It is a precompiled contract implemented by the evmlight contract. The code
+// deployed at SandboxAddress is never run: it only makes the address look like a
+// contract to the callers (e.g. to the extcodesize check of Solidity), and
+// reverts every call if the precompiled contract is not available.
+var (
+	SandboxAddress = common.HexToAddress("0x1077")
+	//go:embed ISCPSandbox.abi
+	SandboxABI string
+
+	sandboxBytecode = []byte{
+		0x60, 0x00, // PUSH1 0
+		0x80, // DUP1
+		0xfd, // REVERT
+	}
+)
+
+// Gas charged by the sandbox functions, on top of the gas of the call itself
+const (
+	// SandboxGasView is charged by the functions that only read the state
+	SandboxGasView = 700
+	// SandboxGasEvent is charged by triggerEvent
+	SandboxGasEvent = 2000
+	// SandboxGasSend is charged by sendTokens, and by callContract when tokens are transferred
+	SandboxGasSend = 10000
+	// SandboxGasCall is charged by callContract
+	SandboxGasCall = 10000
+	// SandboxGasPerWord is charged for each 32-byte word of the call data
+	SandboxGasPerWord = 3
+)
+
+func deploySandboxOnGenesis(genesisAlloc core.GenesisAlloc) {
+	genesisAlloc[SandboxAddress] = core.GenesisAccount{
+		Code:    sandboxBytecode,
+		Balance: &big.Int{},
+	}
+}
@@ -14,7 +14,7 @@ import (
 // the `solc` binary installed in your system. Then, simply run `go generate`
 // in this directory.
 
-//go:generate sh -c "solc --abi --bin --overwrite @iscpcontract=`realpath ../iscpcontract` ISCPTest.sol -o . && rm ISCP.*"
+//go:generate sh -c "solc --abi --bin --overwrite @iscpcontract=`realpath ../iscpcontract` ISCPTest.sol -o . && rm ISCP.* ISCPSandbox.*"
 var (
 	//go:embed ISCPTest.abi
 	ISCPTestContractABI string
@@ -0,0 +1,337 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evmlight
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iotaledger/wasp/contracts/native/evm/evminternal"
+	"github.com/iotaledger/wasp/contracts/native/evm/evmlight/emulator"
+	"github.com/iotaledger/wasp/contracts/native/evm/evmlight/iscpcontract"
+	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"golang.org/x/xerrors"
+)
+
+var sandboxABI = func() abi.ABI {
+	ret, err := abi.JSON(strings.NewReader(iscpcontract.SandboxABI))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}()
+
+// keyExecuting is set while an EVM transaction is executed, so that it cannot be
+// re-entered through the calls made by the ISCP sandbox contract
+const keyExecuting = "x"
+
+func requireNotExecuting(ctx iscp.Sandbox) {
+	a := assert.NewAssert(ctx.Log())
+	a.Require(!ctx.State().MustHas(keyExecuting), "reentrant call to the EVM contract")
+}
+
+func init() {
+	emulator.RegisterPrecompile(iscpcontract.SandboxAddress)
+}
+
+// iscpSandbox implements the ISCP sandbox contract (see iscpcontract.SandboxAddress)
+// as a precompiled contract of the emulator.
+//
+// The events and the tokens sent by the EVM code are effects on the ISCP side that
+// are applied with applyEffects after the EVM transaction, so that they are dropped
+// along with the call frames reverted by the EVM. callContract applies them right
+// away instead, since the ISCP contract has to be called during the execution.
+type iscpSandbox struct {
+	base iscp.SandboxBase
+	// ctx is nil when the EVM code is run from a view (eth_call or gas estimation)
+	ctx     iscp.Sandbox
+	ctxView iscp.SandboxView
+
+	effects []func() error
+	// applied is the number of effects that were already applied
+	applied int
+	// revertedEffects is set when the EVM reverts a call frame with effects that were
+	// already applied, which cannot be undone
+	revertedEffects bool
+}
+
+var _ emulator.PrecompiledContract = &iscpSandbox{}
+
+func newISCPSandbox(ctx iscp.Sandbox) *iscpSandbox {
+	return &iscpSandbox{base: ctx, ctx: ctx}
+}
+
+func newISCPSandboxR(ctx iscp.SandboxView) *iscpSandbox {
+	return &iscpSandbox{base: ctx, ctxView: ctx}
+}
+
+func (s *iscpSandbox) precompiles() map[common.Address]emulator.PrecompiledContract {
+	return map[common.Address]emulator.PrecompiledContract{iscpcontract.SandboxAddress: s}
+}
+
+// addEffect records an effect on the ISCP side, which is dropped if the EVM reverts
+// the call frame
+func (s *iscpSandbox) addEffect(call *emulator.PrecompileCall, effect func() error) {
+	n := len(s.effects)
+	s.effects = append(s.effects, effect)
+	call.StateDB.AddJournalEntry(func() {
+		if n < s.applied {
+			s.revertedEffects = true
+			return
+		}
+		s.effects = s.effects[:n]
+	})
+}
+
+// applyEffects applies the effects that were not applied yet
+func (s *iscpSandbox) applyEffects() error {
+	for s.applied < len(s.effects) {
+		effect := s.effects[s.applied]
+		s.applied++
+		if err := effect(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sandboxWordsGas(size int) uint64 {
+	return uint64(size+31) / 32 * iscpcontract.SandboxGasPerWord
+}
+
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// encodeRevertReason encodes the error as Solidity does with revert("reason")
+func encodeRevertReason(err error) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	data, _ := abi.Arguments{{Type: stringType}}.Pack(err.Error())
+	return append(append([]byte{}, revertSelector...), data...)
+}
+
+func (s *iscpSandbox) RequiredGas(input []byte) uint64 {
+	gas := sandboxWordsGas(len(input))
+	if len(input) < 4 {
+		return gas
+	}
+	method, err := sandboxABI.MethodById(input[:4])
+	if err != nil {
+		return gas
+	}
+	switch method.Name {
+	case "triggerEvent":
+		return gas + iscpcontract.SandboxGasEvent
+	case "sendTokens":
+		return gas + iscpcontract.SandboxGasSend
+	case "callContract":
+		gas += iscpcontract.SandboxGasCall
+		if args, err := method.Inputs.Unpack(input[4:]); err == nil && len(args[3].([]byte)) > 0 {
+			gas += iscpcontract.SandboxGasSend
+		}
+		return gas
+	}
+	return gas + iscpcontract.SandboxGasView
+}
+
+func (s *iscpSandbox) Run(call *emulator.PrecompileCall) ([]byte, error) {
+	ret, err := s.call(call)
+	if err != nil {
+		return encodeRevertReason(err), vm.ErrExecutionReverted
+	}
+	return ret, nil
+}
+
+func (s *iscpSandbox) call(call *emulator.PrecompileCall) ([]byte, error) {
+	if call.Value.Sign() != 0 {
+		return nil, xerrors.New("the ISCP sandbox does not accept value transfers")
+	}
+	if len(call.Input) < 4 {
+		return nil, xerrors.New("missing function selector")
+	}
+	method, err := sandboxABI.MethodById(call.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+	if !method.IsConstant() && call.ReadOnly {
+		return nil, xerrors.Errorf("%s can only be called with CALL", method.Name)
+	}
+
+	var ret []interface{}
+	switch method.Name {
+	case "getChainID":
+		ret = []interface{}{s.base.ChainID().Bytes()}
+	case "getCaller":
+		ret = s.getCaller()
+	case "getEntropy":
+		ret = s.getEntropy()
+	case "getBalance":
+		ret, err = s.getBalance(args[0].([]byte), args[1].([32]byte))
+	case "triggerEvent":
+		s.triggerEvent(call, args[0].(string))
+	case "sendTokens":
+		err = s.sendTokens(call, args[0].([]byte), args[1].([]byte))
+	case "callContract":
+		ret, err = s.callContract(call, iscp.Hname(args[0].(uint32)), iscp.Hname(args[1].(uint32)), args[2].([]byte), args[3].([]byte))
+	default:
+		err = xerrors.Errorf("%s is not implemented", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(ret...)
+}
+
+func (s *iscpSandbox) getCaller() []interface{} {
+	if s.ctx == nil {
+		return []interface{}{[]byte{}}
+	}
+	return []interface{}{s.ctx.Caller().Bytes()}
+}
+
+func (s *iscpSandbox) getEntropy() []interface{} {
+	var entropy hashing.HashValue
+	if s.ctx != nil {
+		entropy = s.ctx.GetEntropy()
+	} else {
+		entropy = hashing.HashData(codec.EncodeInt64(s.base.GetTimestamp()))
+	}
+	return []interface{}{[32]byte(entropy)}
+}
+
+func (s *iscpSandbox) getBalance(agentIDBytes []byte, colorBytes [32]byte) ([]interface{}, error) {
+	agentID, err := iscp.AgentIDFromBytes(agentIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	col, err := colored.ColorFromBytes(colorBytes[:])
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.callView(accounts.Contract.Hname(), accounts.FuncViewBalance.Hname(), codec.MakeDict(map[string]interface{}{
+		accounts.ParamAgentID: agentID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	balances, err := colored.BalancesFromDict(res)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{balances.Get(col)}, nil
+}
+
+func (s *iscpSandbox) callView(contract, entryPoint iscp.Hname, params dict.Dict) (dict.Dict, error) {
+	if s.ctx != nil {
+		return s.ctx.Call(contract, entryPoint, params, nil)
+	}
+	return s.ctxView.Call(contract, entryPoint, params)
+}
+
+func (s *iscpSandbox) triggerEvent(call *emulator.PrecompileCall, msg string) {
+	if s.ctx == nil {
+		return
+	}
+	s.addEffect(call, func() error {
+		s.ctx.Event(msg)
+		return nil
+	})
+}
+
+// unlockTokens takes the tokens from the EVM balances of the caller, so that they can
+// be sent or transferred by the contract
+func (s *iscpSandbox) unlockTokens(call *emulator.PrecompileCall, tokens colored.Balances) error {
+	if s.ctx == nil {
+		return evminternal.RequireEVMBalances(call.StateDB, call.Caller, tokens)
+	}
+	relock, err := evminternal.UnlockTokens(s.ctx, call.StateDB, call.Caller, tokens)
+	if err != nil {
+		return err
+	}
+	call.StateDB.AddJournalEntry(relock)
+	return nil
+}
+
+func (s *iscpSandbox) sendTokens(call *emulator.PrecompileCall, targetBytes, tokensBytes []byte) error {
+	target, err := iscp.AgentIDFromBytes(targetBytes)
+	if err != nil {
+		return err
+	}
+	tokens, err := colored.BalancesFromBytes(tokensBytes)
+	if err != nil {
+		return err
+	}
+	if tokens.IsEmpty() {
+		return xerrors.New("no tokens to send")
+	}
+	if err := s.unlockTokens(call, tokens); err != nil {
+		return err
+	}
+	if s.ctx == nil {
+		return nil
+	}
+	s.addEffect(call, func() error {
+		return evminternal.SendTo(s.ctx, target, tokens)
+	})
+	return nil
+}
+
+func (s *iscpSandbox) callContract(
+	call *emulator.PrecompileCall,
+	target, entryPoint iscp.Hname,
+	paramsBytes, transferBytes []byte,
+) ([]interface{}, error) {
+	if target == s.base.Contract() {
+		return nil, xerrors.New("the EVM contract cannot be called from the EVM")
+	}
+	params := dict.New()
+	var err error
+	if len(paramsBytes) > 0 {
+		if params, err = dict.FromBytes(paramsBytes); err != nil {
+			return nil, err
+		}
+	}
+	var transfer colored.Balances
+	if len(transferBytes) > 0 {
+		if transfer, err = colored.BalancesFromBytes(transferBytes); err != nil {
+			return nil, err
+		}
+	}
+	if len(transfer) > 0 {
+		if err := s.unlockTokens(call, transfer); err != nil {
+			return nil, err
+		}
+	}
+
+	var res dict.Dict
+	if s.ctx == nil {
+		call.Suspend(func() {
+			res, err = s.ctxView.Call(target, entryPoint, params)
+		})
+	} else {
+		// the previous effects are applied first, and the call itself cannot be undone
+		s.addEffect(call, func() error { return nil })
+		if err := s.applyEffects(); err != nil {
+			return nil, err
+		}
+		call.Suspend(func() {
+			res, err = s.ctx.Call(target, entryPoint, params, transfer)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{res.Bytes()}, nil
+}
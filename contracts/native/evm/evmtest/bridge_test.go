@@ -0,0 +1,143 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evmtest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iotaledger/wasp/contracts/native/evm"
+	"github.com/iotaledger/wasp/packages/evm/evmtest"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeDepositWithdraw(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		evmChain := initEVMChain(t, evmFlavor)
+		soloChain := evmChain.soloChain
+
+		wallet, address := evmChain.solo.NewKeyPairWithFunds()
+		agentID := iscp.NewAgentID(address, 0)
+		color, err := evmChain.solo.MintTokens(wallet, 100)
+		require.NoError(t, err)
+
+		ethKey, ethAddress := generateEthereumKey(t)
+		require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000).Set(color, 50)))
+
+		require.Zero(t, evm.IotasToWei(1000).Cmp(evmChain.getBalance(ethAddress)))
+		erc20 := evmChain.erc20ForColor(color)
+		require.Equal(t, evm.ERC20Address(color), erc20.address)
+		require.EqualValues(t, evmtest.ERC20ContractRuntimeBytecode, evmChain.getCode(erc20.address))
+		require.EqualValues(t, 50, erc20.balanceOf(ethAddress).Uint64())
+		require.EqualValues(t, 50, erc20.totalSupply().Uint64())
+
+		// the colored tokens behave as a regular ERC20 token in the EVM
+		_, otherEthAddress := generateEthereumKey(t)
+		res, err := erc20.transfer(otherEthAddress, big.NewInt(10), ethCallOptions{sender: ethKey})
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+		require.EqualValues(t, 40, erc20.balanceOf(ethAddress).Uint64())
+		require.EqualValues(t, 10, erc20.balanceOf(otherEthAddress).Uint64())
+
+		// withdraw to the L1 address
+		l1Balances := evmChain.solo.GetAddressBalances(address)
+		require.NoError(t, evmChain.bridgeWithdraw(ethKey, agentID, 400, colored.NewBalancesForColor(color, 30)))
+		require.Zero(t, evm.IotasToWei(600).Cmp(evmChain.getBalance(ethAddress)))
+		require.Zero(t, evmChain.getBalance(evm.BridgeAddress).Sign())
+		require.EqualValues(t, 10, erc20.balanceOf(ethAddress).Uint64())
+		require.EqualValues(t, 20, erc20.totalSupply().Uint64())
+		require.Equal(t, l1Balances.Get(color)+30, evmChain.solo.GetAddressBalance(address, color))
+		require.Equal(t, l1Balances.Get(colored.IOTA)+400, evmChain.solo.GetAddressBalance(address, colored.IOTA))
+
+		// withdraw to the on-chain account of a contract
+		contractAgentID := iscp.NewAgentID(soloChain.ChainID.AsAddress(), iscp.Hn("someContract"))
+		require.NoError(t, evmChain.bridgeWithdraw(ethKey, contractAgentID, 100, colored.NewBalancesForColor(color, 10)))
+		require.Zero(t, evm.IotasToWei(500).Cmp(evmChain.getBalance(ethAddress)))
+		require.Zero(t, erc20.balanceOf(ethAddress).Sign())
+		require.EqualValues(t, 100, soloChain.GetAccountBalance(contractAgentID).Get(colored.IOTA))
+		require.EqualValues(t, 10, soloChain.GetAccountBalance(contractAgentID).Get(color))
+	})
+}
+
+func TestBridgeWithdrawRejected(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		evmChain := initEVMChain(t, evmFlavor)
+
+		wallet, address := evmChain.solo.NewKeyPairWithFunds()
+		agentID := iscp.NewAgentID(address, 0)
+		color, err := evmChain.solo.MintTokens(wallet, 100)
+		require.NoError(t, err)
+		ethKey, ethAddress := generateEthereumKey(t)
+		require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000).Set(color, 50)))
+
+		// more colored tokens than owned
+		err = evmChain.bridgeWithdraw(ethKey, agentID, 0, colored.NewBalancesForColor(color, 51))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not enough tokens")
+
+		// the deposits back at most 1000 IOTAs, whoever holds the wei
+		err = evmChain.bridgeWithdraw(evmChain.faucetKey, agentID, 1001, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "only 1000 are backed by deposits")
+
+		// more IOTAs than the balance of the address
+		err = evmChain.bridgeWithdraw(ethKey, agentID, 1001, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not enough IOTAs")
+
+		// nothing changed
+		require.Zero(t, evm.IotasToWei(1000).Cmp(evmChain.getBalance(ethAddress)))
+		require.EqualValues(t, 50, evmChain.erc20ForColor(color).balanceOf(ethAddress).Uint64())
+	})
+}
+
+func TestBridgeBackingIsFungible(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		evmChain := initEVMChain(t, evmFlavor)
+
+		wallet, address := evmChain.solo.NewKeyPairWithFunds()
+		agentID := iscp.NewAgentID(address, 0)
+		ethKey, ethAddress := generateEthereumKey(t)
+		otherEthKey, otherEthAddress := generateEthereumKey(t)
+		require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000)))
+
+		// wei transferred inside the EVM can be withdrawn by the recipient
+		require.NoError(t, evmChain.transferWei(ethKey, otherEthAddress, evm.IotasToWei(300)))
+		l1Balance := evmChain.solo.GetAddressBalance(address, colored.IOTA)
+		require.NoError(t, evmChain.bridgeWithdraw(otherEthKey, agentID, 300, nil))
+		require.Equal(t, l1Balance+300, evmChain.solo.GetAddressBalance(address, colored.IOTA))
+		require.Zero(t, evmChain.getBalance(otherEthAddress).Sign())
+
+		// the wei of the genesis allocation can be withdrawn while the deposits cover them
+		require.NoError(t, evmChain.bridgeWithdraw(evmChain.faucetKey, agentID, 500, nil))
+		require.Equal(t, l1Balance+800, evmChain.solo.GetAddressBalance(address, colored.IOTA))
+
+		// only 200 backed IOTAs are left
+		err := evmChain.bridgeWithdraw(ethKey, agentID, 700, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "only 200 are backed by deposits")
+		require.NoError(t, evmChain.bridgeWithdraw(ethKey, agentID, 200, nil))
+		require.Zero(t, evm.IotasToWei(500).Cmp(evmChain.getBalance(ethAddress)))
+	})
+}
+
+func TestBridgeLockedFundsAreNotGasFees(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		evmChain := initEVMChain(t, evmFlavor)
+
+		wallet, address := evmChain.solo.NewKeyPairWithFunds()
+		ethKey, ethAddress := generateEthereumKey(t)
+		require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000)))
+
+		ownerBalance := evmChain.solo.GetAddressBalance(evmChain.soloChain.OriginatorAddress, colored.IOTA)
+		require.NoError(t, evmChain.withdrawGasFees(evmChain.soloChain.OriginatorKeyPair))
+		require.Less(t, evmChain.solo.GetAddressBalance(evmChain.soloChain.OriginatorAddress, colored.IOTA), ownerBalance+1000)
+
+		require.NoError(t, evmChain.bridgeWithdraw(ethKey, iscp.NewAgentID(address, 0), 1000, nil))
+	})
+}
@@ -0,0 +1,232 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evmtest
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iotaledger/wasp/contracts/native/evm"
+	"github.com/iotaledger/wasp/contracts/native/evm/evmlight"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/solo"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/stretchr/testify/require"
+)
+
+// deployCode returns the init code that deploys the runtime bytecode
+func deployCode(runtime []byte) []byte {
+	return append([]byte{
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, 0x0c, // PUSH1 0x0c (length of the init code)
+		0x60, 0x00, // PUSH1 0
+		0x39,                     // CODECOPY
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	}, runtime...)
+}
+
+// sandboxForwarder forwards the call data to the ISCP sandbox, and returns or
+// reverts with its result
+var sandboxForwarder = deployCode([]byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+	0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x61, 0x10, 0x77, 0x5a, 0xf1, // CALL(GAS, 0x1077, 0, 0, CALLDATASIZE, 0, 0)
+	0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+	0x60, 0x21, 0x57, // JUMPI(0x21, success)
+	0x3d, 0x60, 0x00, 0xfd, // REVERT(0, RETURNDATASIZE)
+	0x5b, 0x3d, 0x60, 0x00, 0xf3, // 0x21: RETURN(0, RETURNDATASIZE)
+})
+
+// sandboxReverter forwards the call data to the ISCP sandbox, and then reverts
+var sandboxReverter = deployCode([]byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+	0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, 0x61, 0x10, 0x77, 0x5a, 0xf1, // CALL(GAS, 0x1077, 0, 0, CALLDATASIZE, 0, 0)
+	0x50,                         // POP
+	0x60, 0x00, 0x60, 0x00, 0xfd, // REVERT(0, 0)
+})
+
+// sandboxStaticForwarder forwards the call data to the ISCP sandbox with STATICCALL,
+// and returns or reverts with its result
+var sandboxStaticForwarder = deployCode([]byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+	0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x61, 0x10, 0x77, 0x5a, 0xfa, // STATICCALL(GAS, 0x1077, 0, CALLDATASIZE, 0, 0)
+	0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+	0x60, 0x1f, 0x57, // JUMPI(0x1f, success)
+	0x3d, 0x60, 0x00, 0xfd, // REVERT(0, RETURNDATASIZE)
+	0x5b, 0x3d, 0x60, 0x00, 0xf3, // 0x1f: RETURN(0, RETURNDATASIZE)
+})
+
+// sandboxCatcher forwards the call data to the target contract, and succeeds even if
+// the call reverts
+func sandboxCatcher(target common.Address) []byte {
+	code := []byte{
+		0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+		0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, // CALL(..., 0, 0, CALLDATASIZE, 0, 0)
+		0x73, // PUSH20 target
+	}
+	code = append(code, target.Bytes()...)
+	code = append(code,
+		0x5a, 0xf1, // GAS, CALL
+		0x00, // STOP
+	)
+	return deployCode(code)
+}
+
+func TestISCPSandboxViews(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+	sandbox := evmChain.iscpSandbox()
+
+	require.Equal(t, evmChain.soloChain.ChainID.Bytes(), sandbox.getChainID())
+	// the caller is only known when the EVM code runs in a request
+	require.Empty(t, sandbox.getCaller())
+
+	wallet, address := evmChain.solo.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(address, 0)
+	require.Zero(t, sandbox.getBalance(agentID, colored.IOTA))
+	_, err := evmChain.soloChain.PostRequestSync(
+		solo.NewCallParams(accounts.Contract.Name, accounts.FuncDeposit.Name).WithIotas(42),
+		wallet,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, sandbox.getBalance(agentID, colored.IOTA))
+}
+
+func TestISCPSandboxTriggerEvent(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+
+	res, err := evmChain.iscpSandbox().triggerEvent("Hi from the sandbox!")
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	ev, err := evmChain.soloChain.GetEventsForBlock(evmChain.soloChain.GetLatestBlockInfo().BlockIndex)
+	require.NoError(t, err)
+	require.Len(t, ev, 1)
+	require.Contains(t, ev[0], "Hi from the sandbox!")
+}
+
+func TestISCPSandboxSendTokens(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+	wallet, address := evmChain.solo.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(address, 0)
+
+	// an EOA sends its own IOTAs
+	ethKey, ethAddress := generateEthereumKey(t)
+	require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000)))
+	l1Iotas := evmChain.solo.GetAddressBalance(address, colored.IOTA)
+	res, err := evmChain.iscpSandbox().sendTokens(agentID, colored.NewBalancesForIotas(300), ethCallOptions{sender: ethKey})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	require.Zero(t, evm.IotasToWei(700).Cmp(evmChain.getBalance(ethAddress)))
+	require.Equal(t, l1Iotas+300, evmChain.solo.GetAddressBalance(address, colored.IOTA))
+
+	// a contract sends its own IOTAs
+	forwarder := evmChain.deployISCPSandboxCaller(evmChain.faucetKey, sandboxForwarder)
+	require.NoError(t, evmChain.bridgeDeposit(wallet, forwarder.address, colored.NewBalancesForIotas(500)))
+	res, err = forwarder.sendTokens(agentID, colored.NewBalancesForIotas(200))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	require.Zero(t, evm.IotasToWei(300).Cmp(evmChain.getBalance(forwarder.address)))
+	require.Zero(t, evm.IotasToWei(700).Cmp(evmChain.getBalance(ethAddress)))
+
+	// not enough funds: the call reverts
+	res, err = forwarder.sendTokens(agentID, colored.NewBalancesForIotas(1000), ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusFailed, res.receipt.Status)
+	require.Zero(t, evm.IotasToWei(300).Cmp(evmChain.getBalance(forwarder.address)))
+}
+
+func TestISCPSandboxCallContract(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+	wallet, address := evmChain.solo.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(address, 0)
+	sandbox := evmChain.iscpSandbox()
+
+	ethKey, ethAddress := generateEthereumKey(t)
+	require.NoError(t, evmChain.bridgeDeposit(wallet, ethAddress, colored.NewBalancesForIotas(1000)))
+
+	// deposit the IOTAs of the EOA to the on-chain account of the agent
+	params := codec.MakeDict(map[string]interface{}{accounts.ParamAgentID: agentID})
+	res, err := sandbox.callContract(accounts.Contract.Hname(), accounts.FuncDeposit.Hname(), params,
+		colored.NewBalancesForIotas(100), ethCallOptions{sender: ethKey})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	require.EqualValues(t, 100, sandbox.getBalance(agentID, colored.IOTA))
+	require.Zero(t, evm.IotasToWei(900).Cmp(evmChain.getBalance(ethAddress)))
+
+	// the EVM contract cannot be called
+	res, err = sandbox.callContract(evmlight.Contract.Hname(), evm.FuncGetGasPerIota.Hname(), dict.New(), nil,
+		ethCallOptions{sender: ethKey, gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusFailed, res.receipt.Status)
+}
+
+func TestISCPSandboxStaticCall(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+	forwarder := evmChain.deployISCPSandboxCaller(evmChain.faucetKey, sandboxStaticForwarder)
+
+	require.Equal(t, evmChain.soloChain.ChainID.Bytes(), forwarder.getChainID())
+
+	// the functions that change the state can only be called with CALL
+	res, err := forwarder.triggerEvent("static", ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusFailed, res.receipt.Status)
+	ev, err := evmChain.soloChain.GetEventsForBlock(evmChain.soloChain.GetLatestBlockInfo().BlockIndex)
+	require.NoError(t, err)
+	require.Empty(t, ev)
+}
+
+func TestISCPSandboxRevertedEffects(t *testing.T) {
+	evmChain := initEVMChain(t, evmlight.Contract)
+	wallet, address := evmChain.solo.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(address, 0)
+	reverter := evmChain.deployISCPSandboxCaller(evmChain.faucetKey, sandboxReverter)
+	catcher := evmChain.deployISCPSandboxCaller(evmChain.faucetKey, sandboxCatcher(reverter.address))
+	require.NoError(t, evmChain.bridgeDeposit(wallet, reverter.address, colored.NewBalancesForIotas(500)))
+	l1Iotas := evmChain.solo.GetAddressBalance(address, colored.IOTA)
+
+	requireNoEvents := func() {
+		ev, err := evmChain.soloChain.GetEventsForBlock(evmChain.soloChain.GetLatestBlockInfo().BlockIndex)
+		require.NoError(t, err)
+		require.Empty(t, ev)
+	}
+	requireTokensNotSent := func() {
+		require.Zero(t, evm.IotasToWei(500).Cmp(evmChain.getBalance(reverter.address)))
+		require.Equal(t, l1Iotas, evmChain.solo.GetAddressBalance(address, colored.IOTA))
+	}
+
+	// the effects are reverted along with the transaction
+	res, err := reverter.triggerEvent("reverted", ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusFailed, res.receipt.Status)
+	requireNoEvents()
+
+	res, err = reverter.sendTokens(agentID, colored.NewBalancesForIotas(200), ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusFailed, res.receipt.Status)
+	requireTokensNotSent()
+
+	// the effects are reverted along with the call frame, while the transaction succeeds
+	res, err = catcher.triggerEvent("reverted", ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	requireNoEvents()
+
+	res, err = catcher.sendTokens(agentID, colored.NewBalancesForIotas(200), ethCallOptions{gasLimit: 100_000})
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, res.receipt.Status)
+	requireTokensNotSent()
+
+	// the call to an ISCP contract cannot be undone, so the whole request fails
+	nonce := evmChain.getNonce(evmChain.faucetAddress())
+	params := codec.MakeDict(map[string]interface{}{accounts.ParamAgentID: agentID})
+	_, err = reverter.callContract(accounts.Contract.Hname(), accounts.FuncViewBalance.Hname(), params, nil,
+		ethCallOptions{gasLimit: 100_000})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be undone")
+	require.Equal(t, nonce, evmChain.getNonce(evmChain.faucetAddress()))
+}
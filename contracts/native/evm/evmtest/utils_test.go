@@ -23,6 +23,7 @@ import (
 	"github.com/iotaledger/wasp/packages/evm/evmtest"
 	"github.com/iotaledger/wasp/packages/evm/evmtypes"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
@@ -57,6 +58,10 @@ type iscpTestContractInstance struct {
 	*evmContractInstance
 }
 
+type iscpSandboxContractInstance struct {
+	*evmContractInstance
+}
+
 type storageContractInstance struct {
 	*evmContractInstance
 }
@@ -179,6 +184,71 @@ func (e *evmChainInstance) withdrawGasFees(wallet *ed25519.KeyPair, agentID ...*
 	return err
 }
 
+func (e *evmChainInstance) bridgeDeposit(wallet *ed25519.KeyPair, addr common.Address, tokens colored.Balances) error {
+	_, err := e.soloChain.PostRequestSync(
+		solo.NewCallParams(e.evmFlavor.Name, evm.FuncBridgeDeposit.Name, evm.FieldAddress, addr.Bytes()).WithTransfers(tokens),
+		wallet,
+	)
+	return err
+}
+
+// bridgeWithdraw sends a withdrawal transaction to the bridge address
+func (e *evmChainInstance) bridgeWithdraw(sender *ecdsa.PrivateKey, target *iscp.AgentID, iotas uint64, tokens colored.Balances) error {
+	senderAddress := crypto.PubkeyToAddress(sender.PublicKey)
+	value := evm.IotasToWei(iotas)
+	data := evm.EncodeBridgeWithdrawal(target, tokens)
+	gas := e.estimateGas(ethereum.CallMsg{
+		From:  senderAddress,
+		To:    &evm.BridgeAddress,
+		Value: value,
+		Data:  data,
+	})
+	tx, err := types.SignTx(
+		types.NewTransaction(e.getNonce(senderAddress), evm.BridgeAddress, value, gas, evm.GasPrice, data),
+		e.signer(),
+		sender,
+	)
+	require.NoError(e.t, err)
+	txdata, err := tx.MarshalBinary()
+	require.NoError(e.t, err)
+	_, err = e.postRequest([]iotaCallOptions{{transfer: gas/e.getGasPerIotas() + 1}}, evm.FuncSendTransaction.Name, evm.FieldTransactionData, txdata)
+	return err
+}
+
+// transferWei sends a plain value transfer between EVM addresses
+func (e *evmChainInstance) transferWei(sender *ecdsa.PrivateKey, to common.Address, value *big.Int) error {
+	senderAddress := crypto.PubkeyToAddress(sender.PublicKey)
+	gas := e.estimateGas(ethereum.CallMsg{
+		From:  senderAddress,
+		To:    &to,
+		Value: value,
+	})
+	tx, err := types.SignTx(
+		types.NewTransaction(e.getNonce(senderAddress), to, value, gas, evm.GasPrice, nil),
+		e.signer(),
+		sender,
+	)
+	require.NoError(e.t, err)
+	txdata, err := tx.MarshalBinary()
+	require.NoError(e.t, err)
+	_, err = e.postRequest([]iotaCallOptions{{transfer: gas/e.getGasPerIotas() + 1}}, evm.FuncSendTransaction.Name, evm.FieldTransactionData, txdata)
+	return err
+}
+
+// erc20ForColor returns the ERC20 contract representing the color, created with the first deposit
+func (e *evmChainInstance) erc20ForColor(color colored.Color) *erc20ContractInstance {
+	ret, err := e.callView(evm.FuncGetERC20Address.Name, evm.FieldColor, color)
+	require.NoError(e.t, err)
+	contractABI, err := abi.JSON(strings.NewReader(evmtest.ERC20ContractABI))
+	require.NoError(e.t, err)
+	return &erc20ContractInstance{&evmContractInstance{
+		chain:   e,
+		creator: e.faucetKey,
+		address: common.BytesToAddress(ret.MustGet(evm.FieldResult)),
+		abi:     contractABI,
+	}}
+}
+
 func (e *evmChainInstance) faucetAddress() common.Address {
 	return crypto.PubkeyToAddress(e.faucetKey.PublicKey)
 }
@@ -211,6 +281,23 @@ func (e *evmChainInstance) deployISCPTestContract(creator *ecdsa.PrivateKey) *is
 	return &iscpTestContractInstance{e.deployContract(creator, iscptest.ISCPTestContractABI, iscptest.ISCPTestContractBytecode)}
 }
 
+// iscpSandbox returns the ISCP sandbox contract, called directly by the sender of the transactions
+func (e *evmChainInstance) iscpSandbox() *iscpSandboxContractInstance {
+	contractABI, err := abi.JSON(strings.NewReader(iscpcontract.SandboxABI))
+	require.NoError(e.t, err)
+	return &iscpSandboxContractInstance{&evmContractInstance{
+		chain:   e,
+		creator: e.faucetKey,
+		address: iscpcontract.SandboxAddress,
+		abi:     contractABI,
+	}}
+}
+
+// deployISCPSandboxCaller deploys a contract with the given bytecode, which calls the ISCP sandbox
+func (e *evmChainInstance) deployISCPSandboxCaller(creator *ecdsa.PrivateKey, bytecode []byte) *iscpSandboxContractInstance {
+	return &iscpSandboxContractInstance{e.deployContract(creator, iscpcontract.SandboxABI, bytecode)}
+}
+
 func (e *evmChainInstance) deployStorageContract(creator *ecdsa.PrivateKey, n uint32) *storageContractInstance { // nolint:unparam
 	return &storageContractInstance{e.deployContract(creator, evmtest.StorageContractABI, evmtest.StorageContractBytecode, n)}
 }
@@ -411,6 +498,41 @@ func (i *iscpTestContractInstance) emitEntropy() (res callFnResult, err error) {
 	return i.callFn(nil, "emitEntropy")
 }
 
+func (i *iscpSandboxContractInstance) getChainID() []byte {
+	var v []byte
+	i.callView(nil, "getChainID", nil, &v)
+	return v
+}
+
+func (i *iscpSandboxContractInstance) getCaller() []byte {
+	var v []byte
+	i.callView(nil, "getCaller", nil, &v)
+	return v
+}
+
+func (i *iscpSandboxContractInstance) getBalance(agentID *iscp.AgentID, color colored.Color) uint64 {
+	var v uint64
+	i.callView(nil, "getBalance", []interface{}{agentID.Bytes(), [32]byte(color)}, &v)
+	return v
+}
+
+func (i *iscpSandboxContractInstance) triggerEvent(s string, opts ...ethCallOptions) (res callFnResult, err error) {
+	return i.callFn(opts, "triggerEvent", s)
+}
+
+func (i *iscpSandboxContractInstance) sendTokens(target *iscp.AgentID, tokens colored.Balances, opts ...ethCallOptions) (res callFnResult, err error) {
+	return i.callFn(opts, "sendTokens", target.Bytes(), tokens.Bytes())
+}
+
+func (i *iscpSandboxContractInstance) callContract(
+	contract, entryPoint iscp.Hname,
+	params dict.Dict,
+	transfer colored.Balances,
+	opts ...ethCallOptions,
+) (res callFnResult, err error) {
+	return i.callFn(opts, "callContract", uint32(contract), uint32(entryPoint), params.Bytes(), transfer.Bytes())
+}
+
 func (s *storageContractInstance) retrieve() uint32 {
 	var v uint32
 	s.callView(nil, "retrieve", nil, &v)
@@ -14,6 +14,7 @@ use wasmlib::host::*;
 use crate::*;
 use crate::keys::*;
 use crate::structs::*;
+use crate::typedefs::*;
 
 #[derive(Clone, Copy)]
 pub struct ImmutableGetInfoResults {
@@ -199,6 +199,36 @@ func TestLoop(t *testing.T) {
 	checkStateCounter(t, ctx, 1)
 }
 
+func TestTraceCallIncrement(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.StartTrace()
+	callIncrement := inccounter.ScFuncs.CallIncrement(ctx)
+	callIncrement.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+	tracer := ctx.StopTrace()
+
+	depth := -1
+	counterSet := false
+	for _, event := range tracer.Events() {
+		switch event.Kind {
+		case wasmhost.TraceEnter:
+			if event.Function == inccounter.FuncCallIncrement && event.Depth > 0 {
+				depth = event.Depth
+			}
+		case wasmhost.TraceSet:
+			if event.Key == string(inccounter.StateCounter) && depth > 0 && event.Depth > depth {
+				counterSet = true
+			}
+		}
+	}
+	require.True(t, depth > 0, "nested call was not traced")
+	require.True(t, counterSet, "counter update was not traced")
+	require.NotEmpty(t, tracer.String())
+
+	checkStateCounter(t, ctx, 2)
+}
+
 func checkStateCounter(t *testing.T, ctx *wasmsolo.SoloContext, expected interface{}) {
 	getCounter := inccounter.ScFuncs.GetCounter(ctx)
 	getCounter.Func.Call()
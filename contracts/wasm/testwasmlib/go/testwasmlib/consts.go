@@ -18,53 +18,124 @@ const (
 const (
 	ParamAddress     = wasmlib.Key("address")
 	ParamAgentID     = wasmlib.Key("agentID")
+	ParamBigInt      = wasmlib.Key("bigInt")
 	ParamBlockIndex  = wasmlib.Key("blockIndex")
+	ParamBool        = wasmlib.Key("bool")
 	ParamBytes       = wasmlib.Key("bytes")
 	ParamChainID     = wasmlib.Key("chainID")
+	ParamCode        = wasmlib.Key("code")
 	ParamColor       = wasmlib.Key("color")
+	ParamDelta       = wasmlib.Key("delta")
+	ParamDetail      = wasmlib.Key("detail")
+	ParamEnabled     = wasmlib.Key("enabled")
+	ParamFail        = wasmlib.Key("fail")
+	ParamFrom        = wasmlib.Key("from")
 	ParamHash        = wasmlib.Key("hash")
 	ParamHname       = wasmlib.Key("hname")
 	ParamIndex       = wasmlib.Key("index")
 	ParamInt16       = wasmlib.Key("int16")
 	ParamInt32       = wasmlib.Key("int32")
 	ParamInt64       = wasmlib.Key("int64")
+	ParamInt8        = wasmlib.Key("int8")
+	ParamKey         = wasmlib.Key("key")
+	ParamLimit       = wasmlib.Key("limit")
+	ParamMessage     = wasmlib.Key("message")
 	ParamName        = wasmlib.Key("name")
+	ParamPosition    = wasmlib.Key("position")
 	ParamRecordIndex = wasmlib.Key("recordIndex")
 	ParamRequestID   = wasmlib.Key("requestID")
+	ParamReverse     = wasmlib.Key("reverse")
 	ParamString      = wasmlib.Key("string")
+	ParamTag         = wasmlib.Key("tag")
+	ParamTimeout     = wasmlib.Key("timeout")
+	ParamTo          = wasmlib.Key("to")
+	ParamUint16      = wasmlib.Key("uint16")
+	ParamUint32      = wasmlib.Key("uint32")
+	ParamUint64      = wasmlib.Key("uint64")
+	ParamUint8       = wasmlib.Key("uint8")
 	ParamValue       = wasmlib.Key("value")
+	ParamValueIndex  = wasmlib.Key("valueIndex")
 )
 
 const (
-	ResultCount  = wasmlib.Key("count")
-	ResultIotas  = wasmlib.Key("iotas")
-	ResultLength = wasmlib.Key("length")
-	ResultRecord = wasmlib.Key("record")
-	ResultValue  = wasmlib.Key("value")
+	ResultCallID    = wasmlib.Key("callID")
+	ResultCount     = wasmlib.Key("count")
+	ResultCounter   = wasmlib.Key("counter")
+	ResultCursor    = wasmlib.Key("cursor")
+	ResultEntries   = wasmlib.Key("entries")
+	ResultIotas     = wasmlib.Key("iotas")
+	ResultLength    = wasmlib.Key("length")
+	ResultPositions = wasmlib.Key("positions")
+	ResultRecord    = wasmlib.Key("record")
+	ResultStatus    = wasmlib.Key("status")
+	ResultValue     = wasmlib.Key("value")
+	ResultValues    = wasmlib.Key("values")
 )
 
-const StateArrays = wasmlib.Key("arrays")
+const (
+	StateAdmins        = wasmlib.Key("admins")
+	StateArrayOfArrays = wasmlib.Key("arrayOfArrays")
+	StateArrays        = wasmlib.Key("arrays")
+	StateCounter       = wasmlib.Key("counter")
+	StateMapOfMaps     = wasmlib.Key("mapOfMaps")
+	StateRemoteStatus  = wasmlib.Key("remoteStatus")
+	StateTaggedValues  = wasmlib.Key("taggedValues")
+)
 
 const (
-	FuncArrayClear   = "arrayClear"
-	FuncArrayCreate  = "arrayCreate"
-	FuncArraySet     = "arraySet"
-	FuncParamTypes   = "paramTypes"
-	ViewArrayLength  = "arrayLength"
-	ViewArrayValue   = "arrayValue"
-	ViewBlockRecord  = "blockRecord"
-	ViewBlockRecords = "blockRecords"
-	ViewIotaBalance  = "iotaBalance"
+	FuncAdminSet            = "adminSet"
+	FuncArrayClear          = "arrayClear"
+	FuncArrayCreate         = "arrayCreate"
+	FuncArrayOfArraysAppend = "arrayOfArraysAppend"
+	FuncArraySet            = "arraySet"
+	FuncCounterAdd          = "counterAdd"
+	FuncMapOfMapsSet        = "mapOfMapsSet"
+	FuncOrderedDelete       = "orderedDelete"
+	FuncOrderedSet          = "orderedSet"
+	FuncParamTypes          = "paramTypes"
+	FuncRaiseError          = "raiseError"
+	FuncRemoteCall          = "remoteCall"
+	FuncRemoteResult        = "remoteResult"
+	FuncRemoteTarget        = "remoteTarget"
+	FuncTaggedValueAdd      = "taggedValueAdd"
+	ViewArrayLength         = "arrayLength"
+	ViewArrayOfArraysValue  = "arrayOfArraysValue"
+	ViewArrayValue          = "arrayValue"
+	ViewBlockRecord         = "blockRecord"
+	ViewBlockRecords        = "blockRecords"
+	ViewCounterValue        = "counterValue"
+	ViewIotaBalance         = "iotaBalance"
+	ViewMapOfMapsValue      = "mapOfMapsValue"
+	ViewOrderedPage         = "orderedPage"
+	ViewRemoteStatus        = "remoteStatus"
+	ViewTaggedValues        = "taggedValues"
 )
 
 const (
-	HFuncArrayClear   = wasmlib.ScHname(0x88021821)
-	HFuncArrayCreate  = wasmlib.ScHname(0x1ed5b23b)
-	HFuncArraySet     = wasmlib.ScHname(0x2c4150b3)
-	HFuncParamTypes   = wasmlib.ScHname(0x6921c4cd)
-	HViewArrayLength  = wasmlib.ScHname(0x3a831021)
-	HViewArrayValue   = wasmlib.ScHname(0x662dbd81)
-	HViewBlockRecord  = wasmlib.ScHname(0xad13b2f8)
-	HViewBlockRecords = wasmlib.ScHname(0x16e249ea)
-	HViewIotaBalance  = wasmlib.ScHname(0x9d3920bd)
+	HFuncAdminSet            = wasmlib.ScHname(0x260fdd12)
+	HFuncArrayClear          = wasmlib.ScHname(0x88021821)
+	HFuncArrayCreate         = wasmlib.ScHname(0x1ed5b23b)
+	HFuncArrayOfArraysAppend = wasmlib.ScHname(0x23f3a17e)
+	HFuncArraySet            = wasmlib.ScHname(0x2c4150b3)
+	HFuncCounterAdd          = wasmlib.ScHname(0x8b4f54b4)
+	HFuncMapOfMapsSet        = wasmlib.ScHname(0x353d577f)
+	HFuncOrderedDelete       = wasmlib.ScHname(0x7852a6c2)
+	HFuncOrderedSet          = wasmlib.ScHname(0x707c892d)
+	HFuncParamTypes          = wasmlib.ScHname(0x6921c4cd)
+	HFuncRaiseError          = wasmlib.ScHname(0x2f7b2e65)
+	HFuncRemoteCall          = wasmlib.ScHname(0x78b5dce9)
+	HFuncRemoteResult        = wasmlib.ScHname(0x5d2ce831)
+	HFuncRemoteTarget        = wasmlib.ScHname(0x4f105e06)
+	HFuncTaggedValueAdd      = wasmlib.ScHname(0x6c63fbdd)
+	HViewArrayLength         = wasmlib.ScHname(0x3a831021)
+	HViewArrayOfArraysValue  = wasmlib.ScHname(0x41d5f686)
+	HViewArrayValue          = wasmlib.ScHname(0x662dbd81)
+	HViewBlockRecord         = wasmlib.ScHname(0xad13b2f8)
+	HViewBlockRecords        = wasmlib.ScHname(0x16e249ea)
+	HViewCounterValue        = wasmlib.ScHname(0x13c43065)
+	HViewIotaBalance         = wasmlib.ScHname(0x9d3920bd)
+	HViewMapOfMapsValue      = wasmlib.ScHname(0x476c56e4)
+	HViewOrderedPage         = wasmlib.ScHname(0xe932effa)
+	HViewRemoteStatus        = wasmlib.ScHname(0xb338b7a2)
+	HViewTaggedValues        = wasmlib.ScHname(0x1d470801)
 )
@@ -9,6 +9,11 @@ package testwasmlib
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type AdminSetCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableAdminSetParams
+}
+
 type ArrayClearCall struct {
 	Func   *wasmlib.ScFunc
 	Params MutableArrayClearParams
@@ -19,22 +24,79 @@ type ArrayCreateCall struct {
 	Params MutableArrayCreateParams
 }
 
+type ArrayOfArraysAppendCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableArrayOfArraysAppendParams
+}
+
 type ArraySetCall struct {
 	Func   *wasmlib.ScFunc
 	Params MutableArraySetParams
 }
 
+type CounterAddCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableCounterAddParams
+}
+
+type MapOfMapsSetCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableMapOfMapsSetParams
+}
+
+type OrderedDeleteCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableOrderedDeleteParams
+}
+
+type OrderedSetCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableOrderedSetParams
+}
+
 type ParamTypesCall struct {
 	Func   *wasmlib.ScFunc
 	Params MutableParamTypesParams
 }
 
+type RaiseErrorCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableRaiseErrorParams
+}
+
+type RemoteCallCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableRemoteCallParams
+	Results ImmutableRemoteCallResults
+}
+
+type RemoteResultCall struct {
+	Func *wasmlib.ScFunc
+}
+
+type RemoteTargetCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableRemoteTargetParams
+	Results ImmutableRemoteTargetResults
+}
+
+type TaggedValueAddCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableTaggedValueAddParams
+}
+
 type ArrayLengthCall struct {
 	Func    *wasmlib.ScView
 	Params  MutableArrayLengthParams
 	Results ImmutableArrayLengthResults
 }
 
+type ArrayOfArraysValueCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableArrayOfArraysValueParams
+	Results ImmutableArrayOfArraysValueResults
+}
+
 type ArrayValueCall struct {
 	Func    *wasmlib.ScView
 	Params  MutableArrayValueParams
@@ -53,15 +115,49 @@ type BlockRecordsCall struct {
 	Results ImmutableBlockRecordsResults
 }
 
+type CounterValueCall struct {
+	Func    *wasmlib.ScView
+	Results ImmutableCounterValueResults
+}
+
 type IotaBalanceCall struct {
 	Func    *wasmlib.ScView
 	Results ImmutableIotaBalanceResults
 }
 
+type MapOfMapsValueCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableMapOfMapsValueParams
+	Results ImmutableMapOfMapsValueResults
+}
+
+type OrderedPageCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableOrderedPageParams
+	Results ImmutableOrderedPageResults
+}
+
+type RemoteStatusCall struct {
+	Func    *wasmlib.ScView
+	Results ImmutableRemoteStatusResults
+}
+
+type TaggedValuesCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableTaggedValuesParams
+	Results ImmutableTaggedValuesResults
+}
+
 type Funcs struct{}
 
 var ScFuncs Funcs
 
+func (sc Funcs) AdminSet(ctx wasmlib.ScFuncCallContext) *AdminSetCall {
+	f := &AdminSetCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncAdminSet)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) ArrayClear(ctx wasmlib.ScFuncCallContext) *ArrayClearCall {
 	f := &ArrayClearCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncArrayClear)}
 	f.Func.SetPtrs(&f.Params.id, nil)
@@ -74,24 +170,88 @@ func (sc Funcs) ArrayCreate(ctx wasmlib.ScFuncCallContext) *ArrayCreateCall {
 	return f
 }
 
+func (sc Funcs) ArrayOfArraysAppend(ctx wasmlib.ScFuncCallContext) *ArrayOfArraysAppendCall {
+	f := &ArrayOfArraysAppendCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncArrayOfArraysAppend)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) ArraySet(ctx wasmlib.ScFuncCallContext) *ArraySetCall {
 	f := &ArraySetCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncArraySet)}
 	f.Func.SetPtrs(&f.Params.id, nil)
 	return f
 }
 
+func (sc Funcs) CounterAdd(ctx wasmlib.ScFuncCallContext) *CounterAddCall {
+	f := &CounterAddCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncCounterAdd)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) MapOfMapsSet(ctx wasmlib.ScFuncCallContext) *MapOfMapsSetCall {
+	f := &MapOfMapsSetCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMapOfMapsSet)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) OrderedDelete(ctx wasmlib.ScFuncCallContext) *OrderedDeleteCall {
+	f := &OrderedDeleteCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncOrderedDelete)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) OrderedSet(ctx wasmlib.ScFuncCallContext) *OrderedSetCall {
+	f := &OrderedSetCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncOrderedSet)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) ParamTypes(ctx wasmlib.ScFuncCallContext) *ParamTypesCall {
 	f := &ParamTypesCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncParamTypes)}
 	f.Func.SetPtrs(&f.Params.id, nil)
 	return f
 }
 
+func (sc Funcs) RaiseError(ctx wasmlib.ScFuncCallContext) *RaiseErrorCall {
+	f := &RaiseErrorCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRaiseError)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) RemoteCall(ctx wasmlib.ScFuncCallContext) *RemoteCallCall {
+	f := &RemoteCallCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRemoteCall)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) RemoteResult(ctx wasmlib.ScFuncCallContext) *RemoteResultCall {
+	return &RemoteResultCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRemoteResult)}
+}
+
+func (sc Funcs) RemoteTarget(ctx wasmlib.ScFuncCallContext) *RemoteTargetCall {
+	f := &RemoteTargetCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRemoteTarget)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) TaggedValueAdd(ctx wasmlib.ScFuncCallContext) *TaggedValueAddCall {
+	f := &TaggedValueAddCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncTaggedValueAdd)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) ArrayLength(ctx wasmlib.ScViewCallContext) *ArrayLengthCall {
 	f := &ArrayLengthCall{Func: wasmlib.NewScView(ctx, HScName, HViewArrayLength)}
 	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
+func (sc Funcs) ArrayOfArraysValue(ctx wasmlib.ScViewCallContext) *ArrayOfArraysValueCall {
+	f := &ArrayOfArraysValueCall{Func: wasmlib.NewScView(ctx, HScName, HViewArrayOfArraysValue)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) ArrayValue(ctx wasmlib.ScViewCallContext) *ArrayValueCall {
 	f := &ArrayValueCall{Func: wasmlib.NewScView(ctx, HScName, HViewArrayValue)}
 	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
@@ -110,8 +270,38 @@ func (sc Funcs) BlockRecords(ctx wasmlib.ScViewCallContext) *BlockRecordsCall {
 	return f
 }
 
+func (sc Funcs) CounterValue(ctx wasmlib.ScViewCallContext) *CounterValueCall {
+	f := &CounterValueCall{Func: wasmlib.NewScView(ctx, HScName, HViewCounterValue)}
+	f.Func.SetPtrs(nil, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) IotaBalance(ctx wasmlib.ScViewCallContext) *IotaBalanceCall {
 	f := &IotaBalanceCall{Func: wasmlib.NewScView(ctx, HScName, HViewIotaBalance)}
 	f.Func.SetPtrs(nil, &f.Results.id)
 	return f
 }
+
+func (sc Funcs) MapOfMapsValue(ctx wasmlib.ScViewCallContext) *MapOfMapsValueCall {
+	f := &MapOfMapsValueCall{Func: wasmlib.NewScView(ctx, HScName, HViewMapOfMapsValue)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) OrderedPage(ctx wasmlib.ScViewCallContext) *OrderedPageCall {
+	f := &OrderedPageCall{Func: wasmlib.NewScView(ctx, HScName, HViewOrderedPage)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) RemoteStatus(ctx wasmlib.ScViewCallContext) *RemoteStatusCall {
+	f := &RemoteStatusCall{Func: wasmlib.NewScView(ctx, HScName, HViewRemoteStatus)}
+	f.Func.SetPtrs(nil, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) TaggedValues(ctx wasmlib.ScViewCallContext) *TaggedValuesCall {
+	f := &TaggedValuesCall{Func: wasmlib.NewScView(ctx, HScName, HViewTaggedValues)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
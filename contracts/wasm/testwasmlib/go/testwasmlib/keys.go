@@ -10,57 +10,127 @@ package testwasmlib
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
 const (
-	IdxParamAddress     = 0
-	IdxParamAgentID     = 1
-	IdxParamBlockIndex  = 2
-	IdxParamBytes       = 3
-	IdxParamChainID     = 4
-	IdxParamColor       = 5
-	IdxParamHash        = 6
-	IdxParamHname       = 7
-	IdxParamIndex       = 8
-	IdxParamInt16       = 9
-	IdxParamInt32       = 10
-	IdxParamInt64       = 11
-	IdxParamName        = 12
-	IdxParamRecordIndex = 13
-	IdxParamRequestID   = 14
-	IdxParamString      = 15
-	IdxParamValue       = 16
-	IdxResultCount      = 17
-	IdxResultIotas      = 18
-	IdxResultLength     = 19
-	IdxResultRecord     = 20
-	IdxResultValue      = 21
-	IdxStateArrays      = 22
+	IdxParamAddress       = 0
+	IdxParamAgentID       = 1
+	IdxParamBigInt        = 2
+	IdxParamBlockIndex    = 3
+	IdxParamBool          = 4
+	IdxParamBytes         = 5
+	IdxParamChainID       = 6
+	IdxParamCode          = 7
+	IdxParamColor         = 8
+	IdxParamDelta         = 9
+	IdxParamDetail        = 10
+	IdxParamEnabled       = 11
+	IdxParamFail          = 12
+	IdxParamFrom          = 13
+	IdxParamHash          = 14
+	IdxParamHname         = 15
+	IdxParamIndex         = 16
+	IdxParamInt16         = 17
+	IdxParamInt32         = 18
+	IdxParamInt64         = 19
+	IdxParamInt8          = 20
+	IdxParamKey           = 21
+	IdxParamLimit         = 22
+	IdxParamMessage       = 23
+	IdxParamName          = 24
+	IdxParamPosition      = 25
+	IdxParamRecordIndex   = 26
+	IdxParamRequestID     = 27
+	IdxParamReverse       = 28
+	IdxParamString        = 29
+	IdxParamTag           = 30
+	IdxParamTimeout       = 31
+	IdxParamTo            = 32
+	IdxParamUint16        = 33
+	IdxParamUint32        = 34
+	IdxParamUint64        = 35
+	IdxParamUint8         = 36
+	IdxParamValue         = 37
+	IdxParamValueIndex    = 38
+	IdxResultCallID       = 39
+	IdxResultCount        = 40
+	IdxResultCounter      = 41
+	IdxResultCursor       = 42
+	IdxResultEntries      = 43
+	IdxResultIotas        = 44
+	IdxResultLength       = 45
+	IdxResultPositions    = 46
+	IdxResultRecord       = 47
+	IdxResultStatus       = 48
+	IdxResultValue        = 49
+	IdxResultValues       = 50
+	IdxStateAdmins        = 51
+	IdxStateArrayOfArrays = 52
+	IdxStateArrays        = 53
+	IdxStateCounter       = 54
+	IdxStateMapOfMaps     = 55
+	IdxStateRemoteStatus  = 56
+	IdxStateTaggedValues  = 57
 )
 
-const keyMapLen = 23
+const keyMapLen = 58
 
 var keyMap = [keyMapLen]wasmlib.Key{
 	ParamAddress,
 	ParamAgentID,
+	ParamBigInt,
 	ParamBlockIndex,
+	ParamBool,
 	ParamBytes,
 	ParamChainID,
+	ParamCode,
 	ParamColor,
+	ParamDelta,
+	ParamDetail,
+	ParamEnabled,
+	ParamFail,
+	ParamFrom,
 	ParamHash,
 	ParamHname,
 	ParamIndex,
 	ParamInt16,
 	ParamInt32,
 	ParamInt64,
+	ParamInt8,
+	ParamKey,
+	ParamLimit,
+	ParamMessage,
 	ParamName,
+	ParamPosition,
 	ParamRecordIndex,
 	ParamRequestID,
+	ParamReverse,
 	ParamString,
+	ParamTag,
+	ParamTimeout,
+	ParamTo,
+	ParamUint16,
+	ParamUint32,
+	ParamUint64,
+	ParamUint8,
 	ParamValue,
+	ParamValueIndex,
+	ResultCallID,
 	ResultCount,
+	ResultCounter,
+	ResultCursor,
+	ResultEntries,
 	ResultIotas,
 	ResultLength,
+	ResultPositions,
 	ResultRecord,
+	ResultStatus,
 	ResultValue,
+	ResultValues,
+	StateAdmins,
+	StateArrayOfArrays,
 	StateArrays,
+	StateCounter,
+	StateMapOfMaps,
+	StateRemoteStatus,
+	StateTaggedValues,
 }
 
 var idxMap [keyMapLen]wasmlib.Key32
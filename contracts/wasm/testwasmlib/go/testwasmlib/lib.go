@@ -11,21 +11,65 @@ import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
 func OnLoad() {
 	exports := wasmlib.NewScExports()
+	exports.AddFunc(FuncAdminSet, funcAdminSetThunk)
 	exports.AddFunc(FuncArrayClear, funcArrayClearThunk)
 	exports.AddFunc(FuncArrayCreate, funcArrayCreateThunk)
+	exports.AddFunc(FuncArrayOfArraysAppend, funcArrayOfArraysAppendThunk)
 	exports.AddFunc(FuncArraySet, funcArraySetThunk)
+	exports.AddFunc(FuncCounterAdd, funcCounterAddThunk)
+	exports.AddFunc(FuncMapOfMapsSet, funcMapOfMapsSetThunk)
+	exports.AddFunc(FuncOrderedDelete, funcOrderedDeleteThunk)
+	exports.AddFunc(FuncOrderedSet, funcOrderedSetThunk)
 	exports.AddFunc(FuncParamTypes, funcParamTypesThunk)
+	exports.AddFunc(FuncRaiseError, funcRaiseErrorThunk)
+	exports.AddFunc(FuncRemoteCall, funcRemoteCallThunk)
+	exports.AddFunc(FuncRemoteResult, funcRemoteResultThunk)
+	exports.AddFunc(FuncRemoteTarget, funcRemoteTargetThunk)
+	exports.AddFunc(FuncTaggedValueAdd, funcTaggedValueAddThunk)
 	exports.AddView(ViewArrayLength, viewArrayLengthThunk)
+	exports.AddView(ViewArrayOfArraysValue, viewArrayOfArraysValueThunk)
 	exports.AddView(ViewArrayValue, viewArrayValueThunk)
 	exports.AddView(ViewBlockRecord, viewBlockRecordThunk)
 	exports.AddView(ViewBlockRecords, viewBlockRecordsThunk)
+	exports.AddView(ViewCounterValue, viewCounterValueThunk)
 	exports.AddView(ViewIotaBalance, viewIotaBalanceThunk)
+	exports.AddView(ViewMapOfMapsValue, viewMapOfMapsValueThunk)
+	exports.AddView(ViewOrderedPage, viewOrderedPageThunk)
+	exports.AddView(ViewRemoteStatus, viewRemoteStatusThunk)
+	exports.AddView(ViewTaggedValues, viewTaggedValuesThunk)
 
 	for i, key := range keyMap {
 		idxMap[i] = key.KeyID()
 	}
 }
 
+type AdminSetContext struct {
+	Params ImmutableAdminSetParams
+	State  MutableTestWasmLibState
+}
+
+func funcAdminSetThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcAdminSet")
+	// only SC creator can appoint admins
+	ctx.Require(ctx.Caller() == ctx.ContractCreator(), "no permission")
+
+	f := &AdminSetContext{
+		Params: ImmutableAdminSetParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.AgentID().Exists(), "missing mandatory agentID")
+	ctx.Require(f.Params.Enabled().Exists(), "missing mandatory enabled")
+	funcAdminSet(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcAdminSet ok")
+}
+
 type ArrayClearContext struct {
 	Params ImmutableArrayClearParams
 	State  MutableTestWasmLibState
@@ -43,6 +87,9 @@ func funcArrayClearThunk(ctx wasmlib.ScFuncContext) {
 	}
 	ctx.Require(f.Params.Name().Exists(), "missing mandatory name")
 	funcArrayClear(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
 	ctx.Log("testwasmlib.funcArrayClear ok")
 }
 
@@ -63,9 +110,36 @@ func funcArrayCreateThunk(ctx wasmlib.ScFuncContext) {
 	}
 	ctx.Require(f.Params.Name().Exists(), "missing mandatory name")
 	funcArrayCreate(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
 	ctx.Log("testwasmlib.funcArrayCreate ok")
 }
 
+type ArrayOfArraysAppendContext struct {
+	Params ImmutableArrayOfArraysAppendParams
+	State  MutableTestWasmLibState
+}
+
+func funcArrayOfArraysAppendThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcArrayOfArraysAppend")
+	f := &ArrayOfArraysAppendContext{
+		Params: ImmutableArrayOfArraysAppendParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Index().Exists(), "missing mandatory index")
+	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
+	funcArrayOfArraysAppend(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcArrayOfArraysAppend ok")
+}
+
 type ArraySetContext struct {
 	Params ImmutableArraySetParams
 	State  MutableTestWasmLibState
@@ -84,10 +158,118 @@ func funcArraySetThunk(ctx wasmlib.ScFuncContext) {
 	ctx.Require(f.Params.Index().Exists(), "missing mandatory index")
 	ctx.Require(f.Params.Name().Exists(), "missing mandatory name")
 	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
+	ctx.Require(f.Params.Index().Value() >= 0, "invalid index: below minimum")
+	ctx.Require(len(f.Params.Name().Value()) >= 1, "invalid name: too short")
+	ctx.Require(len(f.Params.Name().Value()) <= 32, "invalid name: too long")
 	funcArraySet(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
 	ctx.Log("testwasmlib.funcArraySet ok")
 }
 
+type CounterAddContext struct {
+	Params ImmutableCounterAddParams
+	State  MutableTestWasmLibState
+}
+
+func funcCounterAddThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcCounterAdd")
+	ctx.Require(ctx.Caller() == ctx.ContractCreator() ||
+		ctx.State().GetMap(wasmlib.Key("admins")).GetBool(ctx.Caller()).Value(),
+		"no permission")
+
+	f := &CounterAddContext{
+		Params: ImmutableCounterAddParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	if f.Params.Delta().Exists() {
+		ctx.Require(f.Params.Delta().Value() >= -5, "invalid delta: below minimum")
+		ctx.Require(f.Params.Delta().Value() <= 5, "invalid delta: above maximum")
+	}
+	funcCounterAdd(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcCounterAdd ok")
+}
+
+type MapOfMapsSetContext struct {
+	Params ImmutableMapOfMapsSetParams
+	State  MutableTestWasmLibState
+}
+
+func funcMapOfMapsSetThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcMapOfMapsSet")
+	f := &MapOfMapsSetContext{
+		Params: ImmutableMapOfMapsSetParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Key().Exists(), "missing mandatory key")
+	ctx.Require(f.Params.Name().Exists(), "missing mandatory name")
+	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
+	funcMapOfMapsSet(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcMapOfMapsSet ok")
+}
+
+type OrderedDeleteContext struct {
+	Params ImmutableOrderedDeleteParams
+	State  MutableTestWasmLibState
+}
+
+func funcOrderedDeleteThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcOrderedDelete")
+	f := &OrderedDeleteContext{
+		Params: ImmutableOrderedDeleteParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Position().Exists(), "missing mandatory position")
+	funcOrderedDelete(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcOrderedDelete ok")
+}
+
+type OrderedSetContext struct {
+	Params ImmutableOrderedSetParams
+	State  MutableTestWasmLibState
+}
+
+func funcOrderedSetThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcOrderedSet")
+	f := &OrderedSetContext{
+		Params: ImmutableOrderedSetParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Position().Exists(), "missing mandatory position")
+	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
+	funcOrderedSet(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcOrderedSet ok")
+}
+
 type ParamTypesContext struct {
 	Params ImmutableParamTypesParams
 	State  MutableTestWasmLibState
@@ -104,9 +286,130 @@ func funcParamTypesThunk(ctx wasmlib.ScFuncContext) {
 		},
 	}
 	funcParamTypes(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
 	ctx.Log("testwasmlib.funcParamTypes ok")
 }
 
+type RaiseErrorContext struct {
+	Params ImmutableRaiseErrorParams
+	State  MutableTestWasmLibState
+}
+
+func funcRaiseErrorThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcRaiseError")
+	f := &RaiseErrorContext{
+		Params: ImmutableRaiseErrorParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Code().Exists(), "missing mandatory code")
+	ctx.Require(f.Params.Message().Exists(), "missing mandatory message")
+	funcRaiseError(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcRaiseError ok")
+}
+
+type RemoteCallContext struct {
+	Params  ImmutableRemoteCallParams
+	Results MutableRemoteCallResults
+	State   MutableTestWasmLibState
+}
+
+func funcRemoteCallThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcRemoteCall")
+	f := &RemoteCallContext{
+		Params: ImmutableRemoteCallParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableRemoteCallResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	funcRemoteCall(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcRemoteCall ok")
+}
+
+type RemoteResultContext struct {
+	State MutableTestWasmLibState
+}
+
+func funcRemoteResultThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcRemoteResult")
+	f := &RemoteResultContext{
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	funcRemoteResult(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcRemoteResult ok")
+}
+
+type RemoteTargetContext struct {
+	Params  ImmutableRemoteTargetParams
+	Results MutableRemoteTargetResults
+	State   MutableTestWasmLibState
+}
+
+func funcRemoteTargetThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcRemoteTarget")
+	f := &RemoteTargetContext{
+		Params: ImmutableRemoteTargetParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableRemoteTargetResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	funcRemoteTarget(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcRemoteTarget ok")
+}
+
+type TaggedValueAddContext struct {
+	Params ImmutableTaggedValueAddParams
+	State  MutableTestWasmLibState
+}
+
+func funcTaggedValueAddThunk(ctx wasmlib.ScFuncContext) {
+	ctx.Log("testwasmlib.funcTaggedValueAdd")
+	f := &TaggedValueAddContext{
+		Params: ImmutableTaggedValueAddParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		State: MutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Tag().Exists(), "missing mandatory tag")
+	ctx.Require(f.Params.Value().Exists(), "missing mandatory value")
+	funcTaggedValueAdd(ctx, f)
+	if f.State.Counter().Exists() {
+		ctx.Require(f.State.Counter().Value() >= 0, "invariant violated: counter: below minimum")
+	}
+	ctx.Log("testwasmlib.funcTaggedValueAdd ok")
+}
+
 type ArrayLengthContext struct {
 	Params  ImmutableArrayLengthParams
 	Results MutableArrayLengthResults
@@ -131,6 +434,31 @@ func viewArrayLengthThunk(ctx wasmlib.ScViewContext) {
 	ctx.Log("testwasmlib.viewArrayLength ok")
 }
 
+type ArrayOfArraysValueContext struct {
+	Params  ImmutableArrayOfArraysValueParams
+	Results MutableArrayOfArraysValueResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewArrayOfArraysValueThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewArrayOfArraysValue")
+	f := &ArrayOfArraysValueContext{
+		Params: ImmutableArrayOfArraysValueParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableArrayOfArraysValueResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Index().Exists(), "missing mandatory index")
+	ctx.Require(f.Params.ValueIndex().Exists(), "missing mandatory valueIndex")
+	viewArrayOfArraysValue(ctx, f)
+	ctx.Log("testwasmlib.viewArrayOfArraysValue ok")
+}
+
 type ArrayValueContext struct {
 	Params  ImmutableArrayValueParams
 	Results MutableArrayValueResults
@@ -205,6 +533,25 @@ func viewBlockRecordsThunk(ctx wasmlib.ScViewContext) {
 	ctx.Log("testwasmlib.viewBlockRecords ok")
 }
 
+type CounterValueContext struct {
+	Results MutableCounterValueResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewCounterValueThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewCounterValue")
+	f := &CounterValueContext{
+		Results: MutableCounterValueResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	viewCounterValue(ctx, f)
+	ctx.Log("testwasmlib.viewCounterValue ok")
+}
+
 type IotaBalanceContext struct {
 	Results MutableIotaBalanceResults
 	State   ImmutableTestWasmLibState
@@ -223,3 +570,97 @@ func viewIotaBalanceThunk(ctx wasmlib.ScViewContext) {
 	viewIotaBalance(ctx, f)
 	ctx.Log("testwasmlib.viewIotaBalance ok")
 }
+
+type MapOfMapsValueContext struct {
+	Params  ImmutableMapOfMapsValueParams
+	Results MutableMapOfMapsValueResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewMapOfMapsValueThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewMapOfMapsValue")
+	f := &MapOfMapsValueContext{
+		Params: ImmutableMapOfMapsValueParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableMapOfMapsValueResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Key().Exists(), "missing mandatory key")
+	ctx.Require(f.Params.Name().Exists(), "missing mandatory name")
+	viewMapOfMapsValue(ctx, f)
+	ctx.Log("testwasmlib.viewMapOfMapsValue ok")
+}
+
+type OrderedPageContext struct {
+	Params  ImmutableOrderedPageParams
+	Results MutableOrderedPageResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewOrderedPageThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewOrderedPage")
+	f := &OrderedPageContext{
+		Params: ImmutableOrderedPageParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableOrderedPageResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.Limit().Exists(), "missing mandatory limit")
+	ctx.Require(f.Params.Limit().Value() >= 1, "invalid limit: below minimum")
+	ctx.Require(f.Params.Limit().Value() <= 100, "invalid limit: above maximum")
+	viewOrderedPage(ctx, f)
+	ctx.Log("testwasmlib.viewOrderedPage ok")
+}
+
+type RemoteStatusContext struct {
+	Results MutableRemoteStatusResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewRemoteStatusThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewRemoteStatus")
+	f := &RemoteStatusContext{
+		Results: MutableRemoteStatusResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	viewRemoteStatus(ctx, f)
+	ctx.Log("testwasmlib.viewRemoteStatus ok")
+}
+
+type TaggedValuesContext struct {
+	Params  ImmutableTaggedValuesParams
+	Results MutableTaggedValuesResults
+	State   ImmutableTestWasmLibState
+}
+
+func viewTaggedValuesThunk(ctx wasmlib.ScViewContext) {
+	ctx.Log("testwasmlib.viewTaggedValues")
+	f := &TaggedValuesContext{
+		Params: ImmutableTaggedValuesParams{
+			id: wasmlib.OBJ_ID_PARAMS,
+		},
+		Results: MutableTaggedValuesResults{
+			id: wasmlib.OBJ_ID_RESULTS,
+		},
+		State: ImmutableTestWasmLibState{
+			id: wasmlib.OBJ_ID_STATE,
+		},
+	}
+	ctx.Require(f.Params.AgentID().Exists(), "missing mandatory agentID")
+	viewTaggedValues(ctx, f)
+	ctx.Log("testwasmlib.viewTaggedValues ok")
+}
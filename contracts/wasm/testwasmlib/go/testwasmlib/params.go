@@ -9,6 +9,30 @@ package testwasmlib
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ImmutableAdminSetParams struct {
+	id int32
+}
+
+func (s ImmutableAdminSetParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, idxMap[IdxParamAgentID])
+}
+
+func (s ImmutableAdminSetParams) Enabled() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, idxMap[IdxParamEnabled])
+}
+
+type MutableAdminSetParams struct {
+	id int32
+}
+
+func (s MutableAdminSetParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, idxMap[IdxParamAgentID])
+}
+
+func (s MutableAdminSetParams) Enabled() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, idxMap[IdxParamEnabled])
+}
+
 type ImmutableArrayClearParams struct {
 	id int32
 }
@@ -41,6 +65,30 @@ func (s MutableArrayCreateParams) Name() wasmlib.ScMutableString {
 	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamName])
 }
 
+type ImmutableArrayOfArraysAppendParams struct {
+	id int32
+}
+
+func (s ImmutableArrayOfArraysAppendParams) Index() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, idxMap[IdxParamIndex])
+}
+
+func (s ImmutableArrayOfArraysAppendParams) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamValue])
+}
+
+type MutableArrayOfArraysAppendParams struct {
+	id int32
+}
+
+func (s MutableArrayOfArraysAppendParams) Index() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamIndex])
+}
+
+func (s MutableArrayOfArraysAppendParams) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamValue])
+}
+
 type ImmutableArraySetParams struct {
 	id int32
 }
@@ -73,6 +121,94 @@ func (s MutableArraySetParams) Value() wasmlib.ScMutableString {
 	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamValue])
 }
 
+type ImmutableCounterAddParams struct {
+	id int32
+}
+
+func (s ImmutableCounterAddParams) Delta() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamDelta])
+}
+
+type MutableCounterAddParams struct {
+	id int32
+}
+
+func (s MutableCounterAddParams) Delta() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamDelta])
+}
+
+type ImmutableMapOfMapsSetParams struct {
+	id int32
+}
+
+func (s ImmutableMapOfMapsSetParams) Key() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamKey])
+}
+
+func (s ImmutableMapOfMapsSetParams) Name() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamName])
+}
+
+func (s ImmutableMapOfMapsSetParams) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamValue])
+}
+
+type MutableMapOfMapsSetParams struct {
+	id int32
+}
+
+func (s MutableMapOfMapsSetParams) Key() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamKey])
+}
+
+func (s MutableMapOfMapsSetParams) Name() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamName])
+}
+
+func (s MutableMapOfMapsSetParams) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamValue])
+}
+
+type ImmutableOrderedDeleteParams struct {
+	id int32
+}
+
+func (s ImmutableOrderedDeleteParams) Position() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamPosition])
+}
+
+type MutableOrderedDeleteParams struct {
+	id int32
+}
+
+func (s MutableOrderedDeleteParams) Position() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamPosition])
+}
+
+type ImmutableOrderedSetParams struct {
+	id int32
+}
+
+func (s ImmutableOrderedSetParams) Position() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamPosition])
+}
+
+func (s ImmutableOrderedSetParams) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamValue])
+}
+
+type MutableOrderedSetParams struct {
+	id int32
+}
+
+func (s MutableOrderedSetParams) Position() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamPosition])
+}
+
+func (s MutableOrderedSetParams) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamValue])
+}
+
 type MapStringToImmutableBytes struct {
 	objID int32
 }
@@ -93,6 +229,14 @@ func (s ImmutableParamTypesParams) AgentID() wasmlib.ScImmutableAgentID {
 	return wasmlib.NewScImmutableAgentID(s.id, idxMap[IdxParamAgentID])
 }
 
+func (s ImmutableParamTypesParams) BigInt() wasmlib.ScImmutableBigInt {
+	return wasmlib.NewScImmutableBigInt(s.id, idxMap[IdxParamBigInt])
+}
+
+func (s ImmutableParamTypesParams) Bool() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, idxMap[IdxParamBool])
+}
+
 func (s ImmutableParamTypesParams) Bytes() wasmlib.ScImmutableBytes {
 	return wasmlib.NewScImmutableBytes(s.id, idxMap[IdxParamBytes])
 }
@@ -125,6 +269,10 @@ func (s ImmutableParamTypesParams) Int64() wasmlib.ScImmutableInt64 {
 	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamInt64])
 }
 
+func (s ImmutableParamTypesParams) Int8() wasmlib.ScImmutableInt8 {
+	return wasmlib.NewScImmutableInt8(s.id, idxMap[IdxParamInt8])
+}
+
 func (s ImmutableParamTypesParams) Param() MapStringToImmutableBytes {
 	return MapStringToImmutableBytes{objID: s.id}
 }
@@ -137,6 +285,22 @@ func (s ImmutableParamTypesParams) String() wasmlib.ScImmutableString {
 	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamString])
 }
 
+func (s ImmutableParamTypesParams) Uint16() wasmlib.ScImmutableUint16 {
+	return wasmlib.NewScImmutableUint16(s.id, idxMap[IdxParamUint16])
+}
+
+func (s ImmutableParamTypesParams) Uint32() wasmlib.ScImmutableUint32 {
+	return wasmlib.NewScImmutableUint32(s.id, idxMap[IdxParamUint32])
+}
+
+func (s ImmutableParamTypesParams) Uint64() wasmlib.ScImmutableUint64 {
+	return wasmlib.NewScImmutableUint64(s.id, idxMap[IdxParamUint64])
+}
+
+func (s ImmutableParamTypesParams) Uint8() wasmlib.ScImmutableUint8 {
+	return wasmlib.NewScImmutableUint8(s.id, idxMap[IdxParamUint8])
+}
+
 type MapStringToMutableBytes struct {
 	objID int32
 }
@@ -161,6 +325,14 @@ func (s MutableParamTypesParams) AgentID() wasmlib.ScMutableAgentID {
 	return wasmlib.NewScMutableAgentID(s.id, idxMap[IdxParamAgentID])
 }
 
+func (s MutableParamTypesParams) BigInt() wasmlib.ScMutableBigInt {
+	return wasmlib.NewScMutableBigInt(s.id, idxMap[IdxParamBigInt])
+}
+
+func (s MutableParamTypesParams) Bool() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, idxMap[IdxParamBool])
+}
+
 func (s MutableParamTypesParams) Bytes() wasmlib.ScMutableBytes {
 	return wasmlib.NewScMutableBytes(s.id, idxMap[IdxParamBytes])
 }
@@ -193,6 +365,10 @@ func (s MutableParamTypesParams) Int64() wasmlib.ScMutableInt64 {
 	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamInt64])
 }
 
+func (s MutableParamTypesParams) Int8() wasmlib.ScMutableInt8 {
+	return wasmlib.NewScMutableInt8(s.id, idxMap[IdxParamInt8])
+}
+
 func (s MutableParamTypesParams) Param() MapStringToMutableBytes {
 	return MapStringToMutableBytes{objID: s.id}
 }
@@ -205,6 +381,126 @@ func (s MutableParamTypesParams) String() wasmlib.ScMutableString {
 	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamString])
 }
 
+func (s MutableParamTypesParams) Uint16() wasmlib.ScMutableUint16 {
+	return wasmlib.NewScMutableUint16(s.id, idxMap[IdxParamUint16])
+}
+
+func (s MutableParamTypesParams) Uint32() wasmlib.ScMutableUint32 {
+	return wasmlib.NewScMutableUint32(s.id, idxMap[IdxParamUint32])
+}
+
+func (s MutableParamTypesParams) Uint64() wasmlib.ScMutableUint64 {
+	return wasmlib.NewScMutableUint64(s.id, idxMap[IdxParamUint64])
+}
+
+func (s MutableParamTypesParams) Uint8() wasmlib.ScMutableUint8 {
+	return wasmlib.NewScMutableUint8(s.id, idxMap[IdxParamUint8])
+}
+
+type ImmutableRaiseErrorParams struct {
+	id int32
+}
+
+func (s ImmutableRaiseErrorParams) Code() wasmlib.ScImmutableUint16 {
+	return wasmlib.NewScImmutableUint16(s.id, idxMap[IdxParamCode])
+}
+
+func (s ImmutableRaiseErrorParams) Detail() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamDetail])
+}
+
+func (s ImmutableRaiseErrorParams) Message() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamMessage])
+}
+
+type MutableRaiseErrorParams struct {
+	id int32
+}
+
+func (s MutableRaiseErrorParams) Code() wasmlib.ScMutableUint16 {
+	return wasmlib.NewScMutableUint16(s.id, idxMap[IdxParamCode])
+}
+
+func (s MutableRaiseErrorParams) Detail() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamDetail])
+}
+
+func (s MutableRaiseErrorParams) Message() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamMessage])
+}
+
+type ImmutableRemoteCallParams struct {
+	id int32
+}
+
+func (s ImmutableRemoteCallParams) ChainID() wasmlib.ScImmutableChainID {
+	return wasmlib.NewScImmutableChainID(s.id, idxMap[IdxParamChainID])
+}
+
+func (s ImmutableRemoteCallParams) Fail() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, idxMap[IdxParamFail])
+}
+
+func (s ImmutableRemoteCallParams) Timeout() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, idxMap[IdxParamTimeout])
+}
+
+type MutableRemoteCallParams struct {
+	id int32
+}
+
+func (s MutableRemoteCallParams) ChainID() wasmlib.ScMutableChainID {
+	return wasmlib.NewScMutableChainID(s.id, idxMap[IdxParamChainID])
+}
+
+func (s MutableRemoteCallParams) Fail() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, idxMap[IdxParamFail])
+}
+
+func (s MutableRemoteCallParams) Timeout() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamTimeout])
+}
+
+type ImmutableRemoteTargetParams struct {
+	id int32
+}
+
+func (s ImmutableRemoteTargetParams) Fail() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, idxMap[IdxParamFail])
+}
+
+type MutableRemoteTargetParams struct {
+	id int32
+}
+
+func (s MutableRemoteTargetParams) Fail() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, idxMap[IdxParamFail])
+}
+
+type ImmutableTaggedValueAddParams struct {
+	id int32
+}
+
+func (s ImmutableTaggedValueAddParams) Tag() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamTag])
+}
+
+func (s ImmutableTaggedValueAddParams) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamValue])
+}
+
+type MutableTaggedValueAddParams struct {
+	id int32
+}
+
+func (s MutableTaggedValueAddParams) Tag() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamTag])
+}
+
+func (s MutableTaggedValueAddParams) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamValue])
+}
+
 type ImmutableArrayLengthParams struct {
 	id int32
 }
@@ -221,6 +517,30 @@ func (s MutableArrayLengthParams) Name() wasmlib.ScMutableString {
 	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamName])
 }
 
+type ImmutableArrayOfArraysValueParams struct {
+	id int32
+}
+
+func (s ImmutableArrayOfArraysValueParams) Index() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, idxMap[IdxParamIndex])
+}
+
+func (s ImmutableArrayOfArraysValueParams) ValueIndex() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, idxMap[IdxParamValueIndex])
+}
+
+type MutableArrayOfArraysValueParams struct {
+	id int32
+}
+
+func (s MutableArrayOfArraysValueParams) Index() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamIndex])
+}
+
+func (s MutableArrayOfArraysValueParams) ValueIndex() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamValueIndex])
+}
+
 type ImmutableArrayValueParams struct {
 	id int32
 }
@@ -284,3 +604,83 @@ type MutableBlockRecordsParams struct {
 func (s MutableBlockRecordsParams) BlockIndex() wasmlib.ScMutableInt32 {
 	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamBlockIndex])
 }
+
+type ImmutableMapOfMapsValueParams struct {
+	id int32
+}
+
+func (s ImmutableMapOfMapsValueParams) Key() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamKey])
+}
+
+func (s ImmutableMapOfMapsValueParams) Name() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxParamName])
+}
+
+type MutableMapOfMapsValueParams struct {
+	id int32
+}
+
+func (s MutableMapOfMapsValueParams) Key() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamKey])
+}
+
+func (s MutableMapOfMapsValueParams) Name() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxParamName])
+}
+
+type ImmutableOrderedPageParams struct {
+	id int32
+}
+
+func (s ImmutableOrderedPageParams) From() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamFrom])
+}
+
+func (s ImmutableOrderedPageParams) Limit() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, idxMap[IdxParamLimit])
+}
+
+func (s ImmutableOrderedPageParams) Reverse() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, idxMap[IdxParamReverse])
+}
+
+func (s ImmutableOrderedPageParams) To() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxParamTo])
+}
+
+type MutableOrderedPageParams struct {
+	id int32
+}
+
+func (s MutableOrderedPageParams) From() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamFrom])
+}
+
+func (s MutableOrderedPageParams) Limit() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxParamLimit])
+}
+
+func (s MutableOrderedPageParams) Reverse() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, idxMap[IdxParamReverse])
+}
+
+func (s MutableOrderedPageParams) To() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxParamTo])
+}
+
+type ImmutableTaggedValuesParams struct {
+	id int32
+}
+
+func (s ImmutableTaggedValuesParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, idxMap[IdxParamAgentID])
+}
+
+type MutableTaggedValuesParams struct {
+	id int32
+}
+
+func (s MutableTaggedValuesParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, idxMap[IdxParamAgentID])
+}
@@ -9,6 +9,38 @@ package testwasmlib
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ImmutableRemoteCallResults struct {
+	id int32
+}
+
+func (s ImmutableRemoteCallResults) CallID() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxResultCallID])
+}
+
+type MutableRemoteCallResults struct {
+	id int32
+}
+
+func (s MutableRemoteCallResults) CallID() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxResultCallID])
+}
+
+type ImmutableRemoteTargetResults struct {
+	id int32
+}
+
+func (s ImmutableRemoteTargetResults) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxResultValue])
+}
+
+type MutableRemoteTargetResults struct {
+	id int32
+}
+
+func (s MutableRemoteTargetResults) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxResultValue])
+}
+
 type ImmutableArrayLengthResults struct {
 	id int32
 }
@@ -25,6 +57,22 @@ func (s MutableArrayLengthResults) Length() wasmlib.ScMutableInt32 {
 	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxResultLength])
 }
 
+type ImmutableArrayOfArraysValueResults struct {
+	id int32
+}
+
+func (s ImmutableArrayOfArraysValueResults) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxResultValue])
+}
+
+type MutableArrayOfArraysValueResults struct {
+	id int32
+}
+
+func (s MutableArrayOfArraysValueResults) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxResultValue])
+}
+
 type ImmutableArrayValueResults struct {
 	id int32
 }
@@ -73,6 +121,22 @@ func (s MutableBlockRecordsResults) Count() wasmlib.ScMutableInt32 {
 	return wasmlib.NewScMutableInt32(s.id, idxMap[IdxResultCount])
 }
 
+type ImmutableCounterValueResults struct {
+	id int32
+}
+
+func (s ImmutableCounterValueResults) Counter() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxResultCounter])
+}
+
+type MutableCounterValueResults struct {
+	id int32
+}
+
+func (s MutableCounterValueResults) Counter() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxResultCounter])
+}
+
 type ImmutableIotaBalanceResults struct {
 	id int32
 }
@@ -88,3 +152,117 @@ type MutableIotaBalanceResults struct {
 func (s MutableIotaBalanceResults) Iotas() wasmlib.ScMutableInt64 {
 	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxResultIotas])
 }
+
+type ImmutableMapOfMapsValueResults struct {
+	id int32
+}
+
+func (s ImmutableMapOfMapsValueResults) Value() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxResultValue])
+}
+
+type MutableMapOfMapsValueResults struct {
+	id int32
+}
+
+func (s MutableMapOfMapsValueResults) Value() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxResultValue])
+}
+
+type ArrayOfImmutableInt64 struct {
+	objID int32
+}
+
+func (a ArrayOfImmutableInt64) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfImmutableInt64) GetInt64(index int32) wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(a.objID, wasmlib.Key32(index))
+}
+
+type ImmutableOrderedPageResults struct {
+	id int32
+}
+
+func (s ImmutableOrderedPageResults) Cursor() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxResultCursor])
+}
+
+func (s ImmutableOrderedPageResults) Entries() ArrayOfImmutableString {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultEntries], wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING)
+	return ArrayOfImmutableString{objID: arrID}
+}
+
+func (s ImmutableOrderedPageResults) Positions() ArrayOfImmutableInt64 {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultPositions], wasmlib.TYPE_ARRAY|wasmlib.TYPE_INT64)
+	return ArrayOfImmutableInt64{objID: arrID}
+}
+
+type ArrayOfMutableInt64 struct {
+	objID int32
+}
+
+func (a ArrayOfMutableInt64) Clear() {
+	wasmlib.Clear(a.objID)
+}
+
+func (a ArrayOfMutableInt64) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfMutableInt64) GetInt64(index int32) wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(a.objID, wasmlib.Key32(index))
+}
+
+type MutableOrderedPageResults struct {
+	id int32
+}
+
+func (s MutableOrderedPageResults) Cursor() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxResultCursor])
+}
+
+func (s MutableOrderedPageResults) Entries() ArrayOfMutableString {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultEntries], wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING)
+	return ArrayOfMutableString{objID: arrID}
+}
+
+func (s MutableOrderedPageResults) Positions() ArrayOfMutableInt64 {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultPositions], wasmlib.TYPE_ARRAY|wasmlib.TYPE_INT64)
+	return ArrayOfMutableInt64{objID: arrID}
+}
+
+type ImmutableRemoteStatusResults struct {
+	id int32
+}
+
+func (s ImmutableRemoteStatusResults) Status() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxResultStatus])
+}
+
+type MutableRemoteStatusResults struct {
+	id int32
+}
+
+func (s MutableRemoteStatusResults) Status() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxResultStatus])
+}
+
+type ImmutableTaggedValuesResults struct {
+	id int32
+}
+
+func (s ImmutableTaggedValuesResults) Values() ArrayOfImmutableTaggedValue {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultValues], wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES)
+	return ArrayOfImmutableTaggedValue{objID: arrID}
+}
+
+type MutableTaggedValuesResults struct {
+	id int32
+}
+
+func (s MutableTaggedValuesResults) Values() ArrayOfMutableTaggedValue {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxResultValues], wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES)
+	return ArrayOfMutableTaggedValue{objID: arrID}
+}
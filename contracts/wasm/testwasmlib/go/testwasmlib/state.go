@@ -9,6 +9,27 @@ package testwasmlib
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type MapAgentIDToImmutableBool struct {
+	objID int32
+}
+
+func (m MapAgentIDToImmutableBool) GetBool(key wasmlib.ScAgentID) wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(m.objID, key.KeyID())
+}
+
+type ArrayOfImmutableStringArray struct {
+	objID int32
+}
+
+func (a ArrayOfImmutableStringArray) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfImmutableStringArray) GetStringArray(index int32) ImmutableStringArray {
+	subID := wasmlib.GetObjectID(a.objID, wasmlib.Key32(index), wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING)
+	return ImmutableStringArray{objID: subID}
+}
+
 type MapStringToImmutableStringArray struct {
 	objID int32
 }
@@ -18,15 +39,90 @@ func (m MapStringToImmutableStringArray) GetStringArray(key string) ImmutableStr
 	return ImmutableStringArray{objID: subID}
 }
 
+type MapStringToImmutableMapStringToString struct {
+	objID int32
+}
+
+func (m MapStringToImmutableMapStringToString) GetMapStringToString(key string) ImmutableMapStringToString {
+	subID := wasmlib.GetObjectID(m.objID, wasmlib.Key(key).KeyID(), wasmlib.TYPE_MAP)
+	return ImmutableMapStringToString{objID: subID}
+}
+
+type MapAgentIDToImmutableTaggedValueArray struct {
+	objID int32
+}
+
+func (m MapAgentIDToImmutableTaggedValueArray) GetTaggedValueArray(key wasmlib.ScAgentID) ImmutableTaggedValueArray {
+	subID := wasmlib.GetObjectID(m.objID, key.KeyID(), wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES)
+	return ImmutableTaggedValueArray{objID: subID}
+}
+
 type ImmutableTestWasmLibState struct {
 	id int32
 }
 
+func (s ImmutableTestWasmLibState) Admins() MapAgentIDToImmutableBool {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateAdmins], wasmlib.TYPE_MAP)
+	return MapAgentIDToImmutableBool{objID: mapID}
+}
+
+func (s ImmutableTestWasmLibState) ArrayOfArrays() ArrayOfImmutableStringArray {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxStateArrayOfArrays], wasmlib.TYPE_ARRAY|wasmlib.TYPE_MAP)
+	return ArrayOfImmutableStringArray{objID: arrID}
+}
+
 func (s ImmutableTestWasmLibState) Arrays() MapStringToImmutableStringArray {
 	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateArrays], wasmlib.TYPE_MAP)
 	return MapStringToImmutableStringArray{objID: mapID}
 }
 
+func (s ImmutableTestWasmLibState) Counter() wasmlib.ScImmutableInt64 {
+	return wasmlib.NewScImmutableInt64(s.id, idxMap[IdxStateCounter])
+}
+
+func (s ImmutableTestWasmLibState) MapOfMaps() MapStringToImmutableMapStringToString {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateMapOfMaps], wasmlib.TYPE_MAP)
+	return MapStringToImmutableMapStringToString{objID: mapID}
+}
+
+func (s ImmutableTestWasmLibState) RemoteStatus() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, idxMap[IdxStateRemoteStatus])
+}
+
+func (s ImmutableTestWasmLibState) TaggedValues() MapAgentIDToImmutableTaggedValueArray {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateTaggedValues], wasmlib.TYPE_MAP)
+	return MapAgentIDToImmutableTaggedValueArray{objID: mapID}
+}
+
+type MapAgentIDToMutableBool struct {
+	objID int32
+}
+
+func (m MapAgentIDToMutableBool) Clear() {
+	wasmlib.Clear(m.objID)
+}
+
+func (m MapAgentIDToMutableBool) GetBool(key wasmlib.ScAgentID) wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(m.objID, key.KeyID())
+}
+
+type ArrayOfMutableStringArray struct {
+	objID int32
+}
+
+func (a ArrayOfMutableStringArray) Clear() {
+	wasmlib.Clear(a.objID)
+}
+
+func (a ArrayOfMutableStringArray) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfMutableStringArray) GetStringArray(index int32) MutableStringArray {
+	subID := wasmlib.GetObjectID(a.objID, wasmlib.Key32(index), wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING)
+	return MutableStringArray{objID: subID}
+}
+
 type MapStringToMutableStringArray struct {
 	objID int32
 }
@@ -40,11 +136,65 @@ func (m MapStringToMutableStringArray) GetStringArray(key string) MutableStringA
 	return MutableStringArray{objID: subID}
 }
 
+type MapStringToMutableMapStringToString struct {
+	objID int32
+}
+
+func (m MapStringToMutableMapStringToString) Clear() {
+	wasmlib.Clear(m.objID)
+}
+
+func (m MapStringToMutableMapStringToString) GetMapStringToString(key string) MutableMapStringToString {
+	subID := wasmlib.GetObjectID(m.objID, wasmlib.Key(key).KeyID(), wasmlib.TYPE_MAP)
+	return MutableMapStringToString{objID: subID}
+}
+
+type MapAgentIDToMutableTaggedValueArray struct {
+	objID int32
+}
+
+func (m MapAgentIDToMutableTaggedValueArray) Clear() {
+	wasmlib.Clear(m.objID)
+}
+
+func (m MapAgentIDToMutableTaggedValueArray) GetTaggedValueArray(key wasmlib.ScAgentID) MutableTaggedValueArray {
+	subID := wasmlib.GetObjectID(m.objID, key.KeyID(), wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES)
+	return MutableTaggedValueArray{objID: subID}
+}
+
 type MutableTestWasmLibState struct {
 	id int32
 }
 
+func (s MutableTestWasmLibState) Admins() MapAgentIDToMutableBool {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateAdmins], wasmlib.TYPE_MAP)
+	return MapAgentIDToMutableBool{objID: mapID}
+}
+
+func (s MutableTestWasmLibState) ArrayOfArrays() ArrayOfMutableStringArray {
+	arrID := wasmlib.GetObjectID(s.id, idxMap[IdxStateArrayOfArrays], wasmlib.TYPE_ARRAY|wasmlib.TYPE_MAP)
+	return ArrayOfMutableStringArray{objID: arrID}
+}
+
 func (s MutableTestWasmLibState) Arrays() MapStringToMutableStringArray {
 	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateArrays], wasmlib.TYPE_MAP)
 	return MapStringToMutableStringArray{objID: mapID}
 }
+
+func (s MutableTestWasmLibState) Counter() wasmlib.ScMutableInt64 {
+	return wasmlib.NewScMutableInt64(s.id, idxMap[IdxStateCounter])
+}
+
+func (s MutableTestWasmLibState) MapOfMaps() MapStringToMutableMapStringToString {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateMapOfMaps], wasmlib.TYPE_MAP)
+	return MapStringToMutableMapStringToString{objID: mapID}
+}
+
+func (s MutableTestWasmLibState) RemoteStatus() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, idxMap[IdxStateRemoteStatus])
+}
+
+func (s MutableTestWasmLibState) TaggedValues() MapAgentIDToMutableTaggedValueArray {
+	mapID := wasmlib.GetObjectID(s.id, idxMap[IdxStateTaggedValues], wasmlib.TYPE_MAP)
+	return MapAgentIDToMutableTaggedValueArray{objID: mapID}
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+package testwasmlib
+
+import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
+
+type TaggedValue struct {
+	Tags  []string
+	Value string
+}
+
+func NewTaggedValueFromBytes(bytes []byte) *TaggedValue {
+	decode := wasmlib.NewBytesDecoder(bytes)
+	data := &TaggedValue{}
+	data.Tags = make([]string, decode.Uint32())
+	for i := range data.Tags {
+		data.Tags[i] = decode.String()
+	}
+	data.Value = decode.String()
+	decode.Close()
+	return data
+}
+
+func (o *TaggedValue) Bytes() []byte {
+	encode := wasmlib.NewBytesEncoder()
+	encode.Uint32(uint32(len(o.Tags)))
+	for _, item := range o.Tags {
+		encode.String(item)
+	}
+	encode.String(o.Value)
+	return encode.Data()
+}
+
+type ImmutableTaggedValue struct {
+	objID int32
+	keyID wasmlib.Key32
+}
+
+func (o ImmutableTaggedValue) Exists() bool {
+	return wasmlib.Exists(o.objID, o.keyID, wasmlib.TYPE_BYTES)
+}
+
+func (o ImmutableTaggedValue) Value() *TaggedValue {
+	return NewTaggedValueFromBytes(wasmlib.GetBytes(o.objID, o.keyID, wasmlib.TYPE_BYTES))
+}
+
+type MutableTaggedValue struct {
+	objID int32
+	keyID wasmlib.Key32
+}
+
+func (o MutableTaggedValue) Exists() bool {
+	return wasmlib.Exists(o.objID, o.keyID, wasmlib.TYPE_BYTES)
+}
+
+func (o MutableTaggedValue) SetValue(value *TaggedValue) {
+	wasmlib.SetBytes(o.objID, o.keyID, wasmlib.TYPE_BYTES, value.Bytes())
+}
+
+func (o MutableTaggedValue) Value() *TaggedValue {
+	return NewTaggedValueFromBytes(wasmlib.GetBytes(o.objID, o.keyID, wasmlib.TYPE_BYTES))
+}
@@ -17,6 +17,13 @@ func funcParamTypes(ctx wasmlib.ScFuncContext, f *ParamTypesContext) {
 	if f.Params.AgentID().Exists() {
 		ctx.Require(f.Params.AgentID().Value() == ctx.AccountID(), "mismatch: AgentID")
 	}
+	if f.Params.BigInt().Exists() {
+		bigInt := wasmlib.NewScBigInt(1234567890123456789).Mul(wasmlib.NewScBigInt(1234567890123456789))
+		ctx.Require(f.Params.BigInt().Value().Cmp(bigInt) == 0, "mismatch: BigInt")
+	}
+	if f.Params.Bool().Exists() {
+		ctx.Require(f.Params.Bool().Value(), "mismatch: Bool")
+	}
 	if f.Params.Bytes().Exists() {
 		byteData := []byte("these are bytes")
 		ctx.Require(bytes.Equal(f.Params.Bytes().Value(), byteData), "mismatch: Bytes")
@@ -35,6 +42,9 @@ func funcParamTypes(ctx wasmlib.ScFuncContext, f *ParamTypesContext) {
 	if f.Params.Hname().Exists() {
 		ctx.Require(f.Params.Hname().Value() == ctx.AccountID().Hname(), "mismatch: Hname")
 	}
+	if f.Params.Int8().Exists() {
+		ctx.Require(f.Params.Int8().Value() == -123, "mismatch: Int8")
+	}
 	if f.Params.Int16().Exists() {
 		ctx.Require(f.Params.Int16().Value() == 12345, "mismatch: Int16")
 	}
@@ -51,6 +61,18 @@ func funcParamTypes(ctx wasmlib.ScFuncContext, f *ParamTypesContext) {
 	if f.Params.String().Exists() {
 		ctx.Require(f.Params.String().Value() == "this is a string", "mismatch: String")
 	}
+	if f.Params.Uint8().Exists() {
+		ctx.Require(f.Params.Uint8().Value() == 123, "mismatch: Uint8")
+	}
+	if f.Params.Uint16().Exists() {
+		ctx.Require(f.Params.Uint16().Value() == 54321, "mismatch: Uint16")
+	}
+	if f.Params.Uint32().Exists() {
+		ctx.Require(f.Params.Uint32().Value() == 3456789012, "mismatch: Uint32")
+	}
+	if f.Params.Uint64().Exists() {
+		ctx.Require(f.Params.Uint64().Value() == 12345678901234567890, "mismatch: Uint64")
+	}
 }
 
 func viewBlockRecord(ctx wasmlib.ScViewContext, f *BlockRecordContext) {
@@ -107,3 +129,166 @@ func viewArrayValue(ctx wasmlib.ScViewContext, f *ArrayValueContext) {
 func viewIotaBalance(ctx wasmlib.ScViewContext, f *IotaBalanceContext) {
 	f.Results.Iotas().SetValue(ctx.Balances().Balance(wasmlib.IOTA))
 }
+
+func funcArrayOfArraysAppend(ctx wasmlib.ScFuncContext, f *ArrayOfArraysAppendContext) {
+	// appending to the array at index == length creates a new nested array
+	index := f.Params.Index().Value()
+	array := f.State.ArrayOfArrays().GetStringArray(index)
+	value := f.Params.Value().Value()
+	array.GetString(array.Length()).SetValue(value)
+}
+
+func funcMapOfMapsSet(ctx wasmlib.ScFuncContext, f *MapOfMapsSetContext) {
+	name := f.Params.Name().Value()
+	mapOfStrings := f.State.MapOfMaps().GetMapStringToString(name)
+	key := f.Params.Key().Value()
+	value := f.Params.Value().Value()
+	mapOfStrings.GetString(key).SetValue(value)
+}
+
+func funcTaggedValueAdd(ctx wasmlib.ScFuncContext, f *TaggedValueAddContext) {
+	values := f.State.TaggedValues().GetTaggedValueArray(ctx.Caller())
+	tag := f.Params.Tag().Value()
+	value := f.Params.Value().Value()
+
+	// add the tag to the last value when it has the same value
+	length := values.Length()
+	if length != 0 {
+		last := values.GetTaggedValue(length - 1)
+		taggedValue := last.Value()
+		if taggedValue.Value == value {
+			taggedValue.Tags = append(taggedValue.Tags, tag)
+			last.SetValue(taggedValue)
+			return
+		}
+	}
+	taggedValue := &TaggedValue{Tags: []string{tag}, Value: value}
+	values.GetTaggedValue(length).SetValue(taggedValue)
+}
+
+func viewArrayOfArraysValue(ctx wasmlib.ScViewContext, f *ArrayOfArraysValueContext) {
+	arrays := f.State.ArrayOfArrays()
+	index := f.Params.Index().Value()
+	ctx.Require(index >= 0 && index < arrays.Length(), "invalid index")
+	array := arrays.GetStringArray(index)
+	valueIndex := f.Params.ValueIndex().Value()
+	ctx.Require(valueIndex >= 0 && valueIndex < array.Length(), "invalid valueIndex")
+	f.Results.Value().SetValue(array.GetString(valueIndex).Value())
+}
+
+func viewMapOfMapsValue(ctx wasmlib.ScViewContext, f *MapOfMapsValueContext) {
+	name := f.Params.Name().Value()
+	mapOfStrings := f.State.MapOfMaps().GetMapStringToString(name)
+	key := f.Params.Key().Value()
+	f.Results.Value().SetValue(mapOfStrings.GetString(key).Value())
+}
+
+func viewTaggedValues(ctx wasmlib.ScViewContext, f *TaggedValuesContext) {
+	values := f.State.TaggedValues().GetTaggedValueArray(f.Params.AgentID().Value())
+	results := f.Results.Values()
+	length := values.Length()
+	for i := int32(0); i < length; i++ {
+		results.GetTaggedValue(i).SetValue(values.GetTaggedValue(i).Value())
+	}
+}
+
+func funcAdminSet(ctx wasmlib.ScFuncContext, f *AdminSetContext) {
+	agentID := f.Params.AgentID().Value()
+	enabled := f.Params.Enabled().Value()
+	f.State.Admins().GetBool(agentID).SetValue(enabled)
+}
+
+func funcCounterAdd(ctx wasmlib.ScFuncContext, f *CounterAddContext) {
+	delta := int64(1)
+	if f.Params.Delta().Exists() {
+		delta = f.Params.Delta().Value()
+	}
+	counter := f.State.Counter()
+	counter.SetValue(counter.Value() + delta)
+}
+
+func viewCounterValue(ctx wasmlib.ScViewContext, f *CounterValueContext) {
+	f.Results.Counter().SetValue(f.State.Counter().Value())
+}
+
+func funcRemoteCall(ctx wasmlib.ScFuncContext, f *RemoteCallContext) {
+	chainID := ctx.ChainID()
+	if f.Params.ChainID().Exists() {
+		chainID = f.Params.ChainID().Value()
+	}
+	params := wasmlib.NewScMutableMap()
+	params.GetBool(ParamFail).SetValue(f.Params.Fail().Value())
+	// one iota for the remote function, and one to send back the outcome
+	transfer := wasmlib.NewScTransferIotas(2)
+	callID := ctx.PostWithCallback(chainID, ctx.Contract(), HFuncRemoteTarget, params, transfer,
+		HFuncRemoteResult, f.Params.Timeout().Value())
+	f.Results.CallID().SetValue(callID)
+	f.State.RemoteStatus().SetValue("pending")
+}
+
+func funcRemoteResult(ctx wasmlib.ScFuncContext, f *RemoteResultContext) {
+	result, ok := ctx.CallResult()
+	if !ok {
+		// unexpected or duplicate outcome
+		return
+	}
+	prefix := ""
+	if result.Late {
+		prefix = "late "
+	}
+	if !result.Success {
+		f.State.RemoteStatus().SetValue(prefix + "failed: " + result.Error)
+		return
+	}
+	f.State.RemoteStatus().SetValue(prefix + "ok: " + result.Results.GetString(ResultValue).Value())
+}
+
+func funcRemoteTarget(ctx wasmlib.ScFuncContext, f *RemoteTargetContext) {
+	ctx.Require(!f.Params.Fail().Value(), "remote failure")
+	f.Results.Value().SetValue("remote value")
+}
+
+func viewRemoteStatus(ctx wasmlib.ScViewContext, f *RemoteStatusContext) {
+	f.Results.Status().SetValue(f.State.RemoteStatus().Value())
+}
+
+func funcOrderedDelete(ctx wasmlib.ScFuncContext, f *OrderedDeleteContext) {
+	ordered := ctx.State().GetOrderedMap(wasmlib.Key("ordered"))
+	ordered.Delete(wasmlib.EncodeSortableInt64(f.Params.Position().Value()))
+}
+
+func funcOrderedSet(ctx wasmlib.ScFuncContext, f *OrderedSetContext) {
+	ordered := ctx.State().GetOrderedMap(wasmlib.Key("ordered"))
+	key := wasmlib.EncodeSortableInt64(f.Params.Position().Value())
+	ordered.SetBytes(key, []byte(f.Params.Value().Value()))
+}
+
+func viewOrderedPage(ctx wasmlib.ScViewContext, f *OrderedPageContext) {
+	var from, to []byte
+	if f.Params.From().Exists() {
+		from = wasmlib.EncodeSortableInt64(f.Params.From().Value())
+	}
+	if f.Params.To().Exists() {
+		to = wasmlib.EncodeSortableInt64(f.Params.To().Value())
+	}
+	ordered := ctx.State().GetOrderedMap(wasmlib.Key("ordered"))
+	page := ordered.Page(from, to, f.Params.Limit().Value(), f.Params.Reverse().Value())
+	positions := f.Results.Positions()
+	entries := f.Results.Entries()
+	for i, key := range page.Keys {
+		positions.GetInt64(int32(i)).SetValue(wasmlib.DecodeSortableInt64(key))
+		entries.GetString(int32(i)).SetValue(string(page.Values[i]))
+	}
+	if page.Cursor != nil {
+		f.Results.Cursor().SetValue(wasmlib.DecodeSortableInt64(page.Cursor))
+	}
+}
+
+func funcRaiseError(ctx wasmlib.ScFuncContext, f *RaiseErrorContext) {
+	code := f.Params.Code().Value()
+	message := f.Params.Message().Value()
+	if f.Params.Detail().Exists() {
+		ctx.PanicWithError(code, message, f.Params.Detail().Value())
+	}
+	ctx.PanicWithError(code, message)
+}
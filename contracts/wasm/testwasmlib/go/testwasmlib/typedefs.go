@@ -40,3 +40,59 @@ func (a ArrayOfMutableString) GetString(index int32) wasmlib.ScMutableString {
 }
 
 type MutableStringArray = ArrayOfMutableString
+
+type MapStringToImmutableString struct {
+	objID int32
+}
+
+func (m MapStringToImmutableString) GetString(key string) wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(m.objID, wasmlib.Key(key).KeyID())
+}
+
+type ImmutableMapStringToString = MapStringToImmutableString
+
+type MapStringToMutableString struct {
+	objID int32
+}
+
+func (m MapStringToMutableString) Clear() {
+	wasmlib.Clear(m.objID)
+}
+
+func (m MapStringToMutableString) GetString(key string) wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(m.objID, wasmlib.Key(key).KeyID())
+}
+
+type MutableMapStringToString = MapStringToMutableString
+
+type ArrayOfImmutableTaggedValue struct {
+	objID int32
+}
+
+func (a ArrayOfImmutableTaggedValue) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfImmutableTaggedValue) GetTaggedValue(index int32) ImmutableTaggedValue {
+	return ImmutableTaggedValue{objID: a.objID, keyID: wasmlib.Key32(index)}
+}
+
+type ImmutableTaggedValueArray = ArrayOfImmutableTaggedValue
+
+type ArrayOfMutableTaggedValue struct {
+	objID int32
+}
+
+func (a ArrayOfMutableTaggedValue) Clear() {
+	wasmlib.Clear(a.objID)
+}
+
+func (a ArrayOfMutableTaggedValue) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfMutableTaggedValue) GetTaggedValue(index int32) MutableTaggedValue {
+	return MutableTaggedValue{objID: a.objID, keyID: wasmlib.Key32(index)}
+}
+
+type MutableTaggedValueArray = ArrayOfMutableTaggedValue
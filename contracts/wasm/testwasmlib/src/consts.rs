@@ -17,48 +17,117 @@ pub const HSC_NAME:       ScHname = ScHname(0x89703a45);
 
 pub const PARAM_ADDRESS:      &str = "address";
 pub const PARAM_AGENT_ID:     &str = "agentID";
+pub const PARAM_BIG_INT:      &str = "bigInt";
 pub const PARAM_BLOCK_INDEX:  &str = "blockIndex";
+pub const PARAM_BOOL:         &str = "bool";
 pub const PARAM_BYTES:        &str = "bytes";
 pub const PARAM_CHAIN_ID:     &str = "chainID";
+pub const PARAM_CODE:         &str = "code";
 pub const PARAM_COLOR:        &str = "color";
+pub const PARAM_DELTA:        &str = "delta";
+pub const PARAM_DETAIL:       &str = "detail";
+pub const PARAM_ENABLED:      &str = "enabled";
+pub const PARAM_FAIL:         &str = "fail";
+pub const PARAM_FROM:         &str = "from";
 pub const PARAM_HASH:         &str = "hash";
 pub const PARAM_HNAME:        &str = "hname";
 pub const PARAM_INDEX:        &str = "index";
 pub const PARAM_INT16:        &str = "int16";
 pub const PARAM_INT32:        &str = "int32";
 pub const PARAM_INT64:        &str = "int64";
+pub const PARAM_INT8:         &str = "int8";
+pub const PARAM_KEY:          &str = "key";
+pub const PARAM_LIMIT:        &str = "limit";
+pub const PARAM_MESSAGE:      &str = "message";
 pub const PARAM_NAME:         &str = "name";
+pub const PARAM_POSITION:     &str = "position";
 pub const PARAM_RECORD_INDEX: &str = "recordIndex";
 pub const PARAM_REQUEST_ID:   &str = "requestID";
+pub const PARAM_REVERSE:      &str = "reverse";
 pub const PARAM_STRING:       &str = "string";
+pub const PARAM_TAG:          &str = "tag";
+pub const PARAM_TIMEOUT:      &str = "timeout";
+pub const PARAM_TO:           &str = "to";
+pub const PARAM_UINT16:       &str = "uint16";
+pub const PARAM_UINT32:       &str = "uint32";
+pub const PARAM_UINT64:       &str = "uint64";
+pub const PARAM_UINT8:        &str = "uint8";
 pub const PARAM_VALUE:        &str = "value";
+pub const PARAM_VALUE_INDEX:  &str = "valueIndex";
 
-pub const RESULT_COUNT:  &str = "count";
-pub const RESULT_IOTAS:  &str = "iotas";
-pub const RESULT_LENGTH: &str = "length";
-pub const RESULT_RECORD: &str = "record";
-pub const RESULT_VALUE:  &str = "value";
+pub const RESULT_CALL_ID:   &str = "callID";
+pub const RESULT_COUNT:     &str = "count";
+pub const RESULT_COUNTER:   &str = "counter";
+pub const RESULT_CURSOR:    &str = "cursor";
+pub const RESULT_ENTRIES:   &str = "entries";
+pub const RESULT_IOTAS:     &str = "iotas";
+pub const RESULT_LENGTH:    &str = "length";
+pub const RESULT_POSITIONS: &str = "positions";
+pub const RESULT_RECORD:    &str = "record";
+pub const RESULT_STATUS:    &str = "status";
+pub const RESULT_VALUE:     &str = "value";
+pub const RESULT_VALUES:    &str = "values";
 
-pub const STATE_ARRAYS: &str = "arrays";
+pub const STATE_ADMINS:          &str = "admins";
+pub const STATE_ARRAY_OF_ARRAYS: &str = "arrayOfArrays";
+pub const STATE_ARRAYS:          &str = "arrays";
+pub const STATE_COUNTER:         &str = "counter";
+pub const STATE_MAP_OF_MAPS:     &str = "mapOfMaps";
+pub const STATE_REMOTE_STATUS:   &str = "remoteStatus";
+pub const STATE_TAGGED_VALUES:   &str = "taggedValues";
 
-pub const FUNC_ARRAY_CLEAR:   &str = "arrayClear";
-pub const FUNC_ARRAY_CREATE:  &str = "arrayCreate";
-pub const FUNC_ARRAY_SET:     &str = "arraySet";
-pub const FUNC_PARAM_TYPES:   &str = "paramTypes";
-pub const VIEW_ARRAY_LENGTH:  &str = "arrayLength";
-pub const VIEW_ARRAY_VALUE:   &str = "arrayValue";
-pub const VIEW_BLOCK_RECORD:  &str = "blockRecord";
-pub const VIEW_BLOCK_RECORDS: &str = "blockRecords";
-pub const VIEW_IOTA_BALANCE:  &str = "iotaBalance";
+pub const FUNC_ADMIN_SET:              &str = "adminSet";
+pub const FUNC_ARRAY_CLEAR:            &str = "arrayClear";
+pub const FUNC_ARRAY_CREATE:           &str = "arrayCreate";
+pub const FUNC_ARRAY_OF_ARRAYS_APPEND: &str = "arrayOfArraysAppend";
+pub const FUNC_ARRAY_SET:              &str = "arraySet";
+pub const FUNC_COUNTER_ADD:            &str = "counterAdd";
+pub const FUNC_MAP_OF_MAPS_SET:        &str = "mapOfMapsSet";
+pub const FUNC_ORDERED_DELETE:         &str = "orderedDelete";
+pub const FUNC_ORDERED_SET:            &str = "orderedSet";
+pub const FUNC_PARAM_TYPES:            &str = "paramTypes";
+pub const FUNC_RAISE_ERROR:            &str = "raiseError";
+pub const FUNC_REMOTE_CALL:            &str = "remoteCall";
+pub const FUNC_REMOTE_RESULT:          &str = "remoteResult";
+pub const FUNC_REMOTE_TARGET:          &str = "remoteTarget";
+pub const FUNC_TAGGED_VALUE_ADD:       &str = "taggedValueAdd";
+pub const VIEW_ARRAY_LENGTH:           &str = "arrayLength";
+pub const VIEW_ARRAY_OF_ARRAYS_VALUE:  &str = "arrayOfArraysValue";
+pub const VIEW_ARRAY_VALUE:            &str = "arrayValue";
+pub const VIEW_BLOCK_RECORD:           &str = "blockRecord";
+pub const VIEW_BLOCK_RECORDS:          &str = "blockRecords";
+pub const VIEW_COUNTER_VALUE:          &str = "counterValue";
+pub const VIEW_IOTA_BALANCE:           &str = "iotaBalance";
+pub const VIEW_MAP_OF_MAPS_VALUE:      &str = "mapOfMapsValue";
+pub const VIEW_ORDERED_PAGE:           &str = "orderedPage";
+pub const VIEW_REMOTE_STATUS:          &str = "remoteStatus";
+pub const VIEW_TAGGED_VALUES:          &str = "taggedValues";
 
-pub const HFUNC_ARRAY_CLEAR:   ScHname = ScHname(0x88021821);
-pub const HFUNC_ARRAY_CREATE:  ScHname = ScHname(0x1ed5b23b);
-pub const HFUNC_ARRAY_SET:     ScHname = ScHname(0x2c4150b3);
-pub const HFUNC_PARAM_TYPES:   ScHname = ScHname(0x6921c4cd);
-pub const HVIEW_ARRAY_LENGTH:  ScHname = ScHname(0x3a831021);
-pub const HVIEW_ARRAY_VALUE:   ScHname = ScHname(0x662dbd81);
-pub const HVIEW_BLOCK_RECORD:  ScHname = ScHname(0xad13b2f8);
-pub const HVIEW_BLOCK_RECORDS: ScHname = ScHname(0x16e249ea);
-pub const HVIEW_IOTA_BALANCE:  ScHname = ScHname(0x9d3920bd);
+pub const HFUNC_ADMIN_SET:              ScHname = ScHname(0x260fdd12);
+pub const HFUNC_ARRAY_CLEAR:            ScHname = ScHname(0x88021821);
+pub const HFUNC_ARRAY_CREATE:           ScHname = ScHname(0x1ed5b23b);
+pub const HFUNC_ARRAY_OF_ARRAYS_APPEND: ScHname = ScHname(0x23f3a17e);
+pub const HFUNC_ARRAY_SET:              ScHname = ScHname(0x2c4150b3);
+pub const HFUNC_COUNTER_ADD:            ScHname = ScHname(0x8b4f54b4);
+pub const HFUNC_MAP_OF_MAPS_SET:        ScHname = ScHname(0x353d577f);
+pub const HFUNC_ORDERED_DELETE:         ScHname = ScHname(0x7852a6c2);
+pub const HFUNC_ORDERED_SET:            ScHname = ScHname(0x707c892d);
+pub const HFUNC_PARAM_TYPES:            ScHname = ScHname(0x6921c4cd);
+pub const HFUNC_RAISE_ERROR:            ScHname = ScHname(0x2f7b2e65);
+pub const HFUNC_REMOTE_CALL:            ScHname = ScHname(0x78b5dce9);
+pub const HFUNC_REMOTE_RESULT:          ScHname = ScHname(0x5d2ce831);
+pub const HFUNC_REMOTE_TARGET:          ScHname = ScHname(0x4f105e06);
+pub const HFUNC_TAGGED_VALUE_ADD:       ScHname = ScHname(0x6c63fbdd);
+pub const HVIEW_ARRAY_LENGTH:           ScHname = ScHname(0x3a831021);
+pub const HVIEW_ARRAY_OF_ARRAYS_VALUE:  ScHname = ScHname(0x41d5f686);
+pub const HVIEW_ARRAY_VALUE:            ScHname = ScHname(0x662dbd81);
+pub const HVIEW_BLOCK_RECORD:           ScHname = ScHname(0xad13b2f8);
+pub const HVIEW_BLOCK_RECORDS:          ScHname = ScHname(0x16e249ea);
+pub const HVIEW_COUNTER_VALUE:          ScHname = ScHname(0x13c43065);
+pub const HVIEW_IOTA_BALANCE:           ScHname = ScHname(0x9d3920bd);
+pub const HVIEW_MAP_OF_MAPS_VALUE:      ScHname = ScHname(0x476c56e4);
+pub const HVIEW_ORDERED_PAGE:           ScHname = ScHname(0xe932effa);
+pub const HVIEW_REMOTE_STATUS:          ScHname = ScHname(0xb338b7a2);
+pub const HVIEW_TAGGED_VALUES:          ScHname = ScHname(0x1d470801);
 
 // @formatter:on
@@ -17,6 +17,11 @@ use crate::consts::*;
 use crate::params::*;
 use crate::results::*;
 
+pub struct AdminSetCall {
+    pub func:   ScFunc,
+    pub params: MutableAdminSetParams,
+}
+
 pub struct ArrayClearCall {
     pub func:   ScFunc,
     pub params: MutableArrayClearParams,
@@ -27,22 +32,79 @@ pub struct ArrayCreateCall {
     pub params: MutableArrayCreateParams,
 }
 
+pub struct ArrayOfArraysAppendCall {
+    pub func:   ScFunc,
+    pub params: MutableArrayOfArraysAppendParams,
+}
+
 pub struct ArraySetCall {
     pub func:   ScFunc,
     pub params: MutableArraySetParams,
 }
 
+pub struct CounterAddCall {
+    pub func:   ScFunc,
+    pub params: MutableCounterAddParams,
+}
+
+pub struct MapOfMapsSetCall {
+    pub func:   ScFunc,
+    pub params: MutableMapOfMapsSetParams,
+}
+
+pub struct OrderedDeleteCall {
+    pub func:   ScFunc,
+    pub params: MutableOrderedDeleteParams,
+}
+
+pub struct OrderedSetCall {
+    pub func:   ScFunc,
+    pub params: MutableOrderedSetParams,
+}
+
 pub struct ParamTypesCall {
     pub func:   ScFunc,
     pub params: MutableParamTypesParams,
 }
 
+pub struct RaiseErrorCall {
+    pub func:   ScFunc,
+    pub params: MutableRaiseErrorParams,
+}
+
+pub struct RemoteCallCall {
+    pub func:    ScFunc,
+    pub params:  MutableRemoteCallParams,
+    pub results: ImmutableRemoteCallResults,
+}
+
+pub struct RemoteResultCall {
+    pub func: ScFunc,
+}
+
+pub struct RemoteTargetCall {
+    pub func:    ScFunc,
+    pub params:  MutableRemoteTargetParams,
+    pub results: ImmutableRemoteTargetResults,
+}
+
+pub struct TaggedValueAddCall {
+    pub func:   ScFunc,
+    pub params: MutableTaggedValueAddParams,
+}
+
 pub struct ArrayLengthCall {
     pub func:    ScView,
     pub params:  MutableArrayLengthParams,
     pub results: ImmutableArrayLengthResults,
 }
 
+pub struct ArrayOfArraysValueCall {
+    pub func:    ScView,
+    pub params:  MutableArrayOfArraysValueParams,
+    pub results: ImmutableArrayOfArraysValueResults,
+}
+
 pub struct ArrayValueCall {
     pub func:    ScView,
     pub params:  MutableArrayValueParams,
@@ -61,15 +123,51 @@ pub struct BlockRecordsCall {
     pub results: ImmutableBlockRecordsResults,
 }
 
+pub struct CounterValueCall {
+    pub func:    ScView,
+    pub results: ImmutableCounterValueResults,
+}
+
 pub struct IotaBalanceCall {
     pub func:    ScView,
     pub results: ImmutableIotaBalanceResults,
 }
 
+pub struct MapOfMapsValueCall {
+    pub func:    ScView,
+    pub params:  MutableMapOfMapsValueParams,
+    pub results: ImmutableMapOfMapsValueResults,
+}
+
+pub struct OrderedPageCall {
+    pub func:    ScView,
+    pub params:  MutableOrderedPageParams,
+    pub results: ImmutableOrderedPageResults,
+}
+
+pub struct RemoteStatusCall {
+    pub func:    ScView,
+    pub results: ImmutableRemoteStatusResults,
+}
+
+pub struct TaggedValuesCall {
+    pub func:    ScView,
+    pub params:  MutableTaggedValuesParams,
+    pub results: ImmutableTaggedValuesResults,
+}
+
 pub struct ScFuncs {
 }
 
 impl ScFuncs {
+    pub fn admin_set(_ctx: & dyn ScFuncCallContext) -> AdminSetCall {
+        let mut f = AdminSetCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ADMIN_SET),
+            params: MutableAdminSetParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn array_clear(_ctx: & dyn ScFuncCallContext) -> ArrayClearCall {
         let mut f = ArrayClearCall {
             func:   ScFunc::new(HSC_NAME, HFUNC_ARRAY_CLEAR),
@@ -86,6 +184,14 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn array_of_arrays_append(_ctx: & dyn ScFuncCallContext) -> ArrayOfArraysAppendCall {
+        let mut f = ArrayOfArraysAppendCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ARRAY_OF_ARRAYS_APPEND),
+            params: MutableArrayOfArraysAppendParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn array_set(_ctx: & dyn ScFuncCallContext) -> ArraySetCall {
         let mut f = ArraySetCall {
             func:   ScFunc::new(HSC_NAME, HFUNC_ARRAY_SET),
@@ -94,6 +200,38 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn counter_add(_ctx: & dyn ScFuncCallContext) -> CounterAddCall {
+        let mut f = CounterAddCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_COUNTER_ADD),
+            params: MutableCounterAddParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn map_of_maps_set(_ctx: & dyn ScFuncCallContext) -> MapOfMapsSetCall {
+        let mut f = MapOfMapsSetCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_MAP_OF_MAPS_SET),
+            params: MutableMapOfMapsSetParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn ordered_delete(_ctx: & dyn ScFuncCallContext) -> OrderedDeleteCall {
+        let mut f = OrderedDeleteCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ORDERED_DELETE),
+            params: MutableOrderedDeleteParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn ordered_set(_ctx: & dyn ScFuncCallContext) -> OrderedSetCall {
+        let mut f = OrderedSetCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ORDERED_SET),
+            params: MutableOrderedSetParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn param_types(_ctx: & dyn ScFuncCallContext) -> ParamTypesCall {
         let mut f = ParamTypesCall {
             func:   ScFunc::new(HSC_NAME, HFUNC_PARAM_TYPES),
@@ -102,6 +240,45 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn raise_error(_ctx: & dyn ScFuncCallContext) -> RaiseErrorCall {
+        let mut f = RaiseErrorCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_RAISE_ERROR),
+            params: MutableRaiseErrorParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn remote_call(_ctx: & dyn ScFuncCallContext) -> RemoteCallCall {
+        let mut f = RemoteCallCall {
+            func:    ScFunc::new(HSC_NAME, HFUNC_REMOTE_CALL),
+            params:  MutableRemoteCallParams { id: 0 },
+            results: ImmutableRemoteCallResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn remote_result(_ctx: & dyn ScFuncCallContext) -> RemoteResultCall {
+        RemoteResultCall {
+            func: ScFunc::new(HSC_NAME, HFUNC_REMOTE_RESULT),
+        }
+    }
+    pub fn remote_target(_ctx: & dyn ScFuncCallContext) -> RemoteTargetCall {
+        let mut f = RemoteTargetCall {
+            func:    ScFunc::new(HSC_NAME, HFUNC_REMOTE_TARGET),
+            params:  MutableRemoteTargetParams { id: 0 },
+            results: ImmutableRemoteTargetResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn tagged_value_add(_ctx: & dyn ScFuncCallContext) -> TaggedValueAddCall {
+        let mut f = TaggedValueAddCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_TAGGED_VALUE_ADD),
+            params: MutableTaggedValueAddParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn array_length(_ctx: & dyn ScViewCallContext) -> ArrayLengthCall {
         let mut f = ArrayLengthCall {
             func:    ScView::new(HSC_NAME, HVIEW_ARRAY_LENGTH),
@@ -111,6 +288,15 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn array_of_arrays_value(_ctx: & dyn ScViewCallContext) -> ArrayOfArraysValueCall {
+        let mut f = ArrayOfArraysValueCall {
+            func:    ScView::new(HSC_NAME, HVIEW_ARRAY_OF_ARRAYS_VALUE),
+            params:  MutableArrayOfArraysValueParams { id: 0 },
+            results: ImmutableArrayOfArraysValueResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn array_value(_ctx: & dyn ScViewCallContext) -> ArrayValueCall {
         let mut f = ArrayValueCall {
             func:    ScView::new(HSC_NAME, HVIEW_ARRAY_VALUE),
@@ -138,6 +324,14 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn counter_value(_ctx: & dyn ScViewCallContext) -> CounterValueCall {
+        let mut f = CounterValueCall {
+            func:    ScView::new(HSC_NAME, HVIEW_COUNTER_VALUE),
+            results: ImmutableCounterValueResults { id: 0 },
+        };
+        f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
+        f
+    }
     pub fn iota_balance(_ctx: & dyn ScViewCallContext) -> IotaBalanceCall {
         let mut f = IotaBalanceCall {
             func:    ScView::new(HSC_NAME, HVIEW_IOTA_BALANCE),
@@ -146,6 +340,41 @@ impl ScFuncs {
         f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
         f
     }
+    pub fn map_of_maps_value(_ctx: & dyn ScViewCallContext) -> MapOfMapsValueCall {
+        let mut f = MapOfMapsValueCall {
+            func:    ScView::new(HSC_NAME, HVIEW_MAP_OF_MAPS_VALUE),
+            params:  MutableMapOfMapsValueParams { id: 0 },
+            results: ImmutableMapOfMapsValueResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn ordered_page(_ctx: & dyn ScViewCallContext) -> OrderedPageCall {
+        let mut f = OrderedPageCall {
+            func:    ScView::new(HSC_NAME, HVIEW_ORDERED_PAGE),
+            params:  MutableOrderedPageParams { id: 0 },
+            results: ImmutableOrderedPageResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn remote_status(_ctx: & dyn ScViewCallContext) -> RemoteStatusCall {
+        let mut f = RemoteStatusCall {
+            func:    ScView::new(HSC_NAME, HVIEW_REMOTE_STATUS),
+            results: ImmutableRemoteStatusResults { id: 0 },
+        };
+        f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
+        f
+    }
+    pub fn tagged_values(_ctx: & dyn ScViewCallContext) -> TaggedValuesCall {
+        let mut f = TaggedValuesCall {
+            func:    ScView::new(HSC_NAME, HVIEW_TAGGED_VALUES),
+            params:  MutableTaggedValuesParams { id: 0 },
+            results: ImmutableTaggedValuesResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
 }
 
 // @formatter:on
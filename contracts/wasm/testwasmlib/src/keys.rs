@@ -13,56 +13,126 @@ use wasmlib::*;
 
 use crate::*;
 
-pub(crate) const IDX_PARAM_ADDRESS:      usize = 0;
-pub(crate) const IDX_PARAM_AGENT_ID:     usize = 1;
-pub(crate) const IDX_PARAM_BLOCK_INDEX:  usize = 2;
-pub(crate) const IDX_PARAM_BYTES:        usize = 3;
-pub(crate) const IDX_PARAM_CHAIN_ID:     usize = 4;
-pub(crate) const IDX_PARAM_COLOR:        usize = 5;
-pub(crate) const IDX_PARAM_HASH:         usize = 6;
-pub(crate) const IDX_PARAM_HNAME:        usize = 7;
-pub(crate) const IDX_PARAM_INDEX:        usize = 8;
-pub(crate) const IDX_PARAM_INT16:        usize = 9;
-pub(crate) const IDX_PARAM_INT32:        usize = 10;
-pub(crate) const IDX_PARAM_INT64:        usize = 11;
-pub(crate) const IDX_PARAM_NAME:         usize = 12;
-pub(crate) const IDX_PARAM_RECORD_INDEX: usize = 13;
-pub(crate) const IDX_PARAM_REQUEST_ID:   usize = 14;
-pub(crate) const IDX_PARAM_STRING:       usize = 15;
-pub(crate) const IDX_PARAM_VALUE:        usize = 16;
-pub(crate) const IDX_RESULT_COUNT:       usize = 17;
-pub(crate) const IDX_RESULT_IOTAS:       usize = 18;
-pub(crate) const IDX_RESULT_LENGTH:      usize = 19;
-pub(crate) const IDX_RESULT_RECORD:      usize = 20;
-pub(crate) const IDX_RESULT_VALUE:       usize = 21;
-pub(crate) const IDX_STATE_ARRAYS:       usize = 22;
+pub(crate) const IDX_PARAM_ADDRESS:         usize = 0;
+pub(crate) const IDX_PARAM_AGENT_ID:        usize = 1;
+pub(crate) const IDX_PARAM_BIG_INT:         usize = 2;
+pub(crate) const IDX_PARAM_BLOCK_INDEX:     usize = 3;
+pub(crate) const IDX_PARAM_BOOL:            usize = 4;
+pub(crate) const IDX_PARAM_BYTES:           usize = 5;
+pub(crate) const IDX_PARAM_CHAIN_ID:        usize = 6;
+pub(crate) const IDX_PARAM_CODE:            usize = 7;
+pub(crate) const IDX_PARAM_COLOR:           usize = 8;
+pub(crate) const IDX_PARAM_DELTA:           usize = 9;
+pub(crate) const IDX_PARAM_DETAIL:          usize = 10;
+pub(crate) const IDX_PARAM_ENABLED:         usize = 11;
+pub(crate) const IDX_PARAM_FAIL:            usize = 12;
+pub(crate) const IDX_PARAM_FROM:            usize = 13;
+pub(crate) const IDX_PARAM_HASH:            usize = 14;
+pub(crate) const IDX_PARAM_HNAME:           usize = 15;
+pub(crate) const IDX_PARAM_INDEX:           usize = 16;
+pub(crate) const IDX_PARAM_INT16:           usize = 17;
+pub(crate) const IDX_PARAM_INT32:           usize = 18;
+pub(crate) const IDX_PARAM_INT64:           usize = 19;
+pub(crate) const IDX_PARAM_INT8:            usize = 20;
+pub(crate) const IDX_PARAM_KEY:             usize = 21;
+pub(crate) const IDX_PARAM_LIMIT:           usize = 22;
+pub(crate) const IDX_PARAM_MESSAGE:         usize = 23;
+pub(crate) const IDX_PARAM_NAME:            usize = 24;
+pub(crate) const IDX_PARAM_POSITION:        usize = 25;
+pub(crate) const IDX_PARAM_RECORD_INDEX:    usize = 26;
+pub(crate) const IDX_PARAM_REQUEST_ID:      usize = 27;
+pub(crate) const IDX_PARAM_REVERSE:         usize = 28;
+pub(crate) const IDX_PARAM_STRING:          usize = 29;
+pub(crate) const IDX_PARAM_TAG:             usize = 30;
+pub(crate) const IDX_PARAM_TIMEOUT:         usize = 31;
+pub(crate) const IDX_PARAM_TO:              usize = 32;
+pub(crate) const IDX_PARAM_UINT16:          usize = 33;
+pub(crate) const IDX_PARAM_UINT32:          usize = 34;
+pub(crate) const IDX_PARAM_UINT64:          usize = 35;
+pub(crate) const IDX_PARAM_UINT8:           usize = 36;
+pub(crate) const IDX_PARAM_VALUE:           usize = 37;
+pub(crate) const IDX_PARAM_VALUE_INDEX:     usize = 38;
+pub(crate) const IDX_RESULT_CALL_ID:        usize = 39;
+pub(crate) const IDX_RESULT_COUNT:          usize = 40;
+pub(crate) const IDX_RESULT_COUNTER:        usize = 41;
+pub(crate) const IDX_RESULT_CURSOR:         usize = 42;
+pub(crate) const IDX_RESULT_ENTRIES:        usize = 43;
+pub(crate) const IDX_RESULT_IOTAS:          usize = 44;
+pub(crate) const IDX_RESULT_LENGTH:         usize = 45;
+pub(crate) const IDX_RESULT_POSITIONS:      usize = 46;
+pub(crate) const IDX_RESULT_RECORD:         usize = 47;
+pub(crate) const IDX_RESULT_STATUS:         usize = 48;
+pub(crate) const IDX_RESULT_VALUE:          usize = 49;
+pub(crate) const IDX_RESULT_VALUES:         usize = 50;
+pub(crate) const IDX_STATE_ADMINS:          usize = 51;
+pub(crate) const IDX_STATE_ARRAY_OF_ARRAYS: usize = 52;
+pub(crate) const IDX_STATE_ARRAYS:          usize = 53;
+pub(crate) const IDX_STATE_COUNTER:         usize = 54;
+pub(crate) const IDX_STATE_MAP_OF_MAPS:     usize = 55;
+pub(crate) const IDX_STATE_REMOTE_STATUS:   usize = 56;
+pub(crate) const IDX_STATE_TAGGED_VALUES:   usize = 57;
 
-pub const KEY_MAP_LEN: usize = 23;
+pub const KEY_MAP_LEN: usize = 58;
 
 pub const KEY_MAP: [&str; KEY_MAP_LEN] = [
     PARAM_ADDRESS,
     PARAM_AGENT_ID,
+    PARAM_BIG_INT,
     PARAM_BLOCK_INDEX,
+    PARAM_BOOL,
     PARAM_BYTES,
     PARAM_CHAIN_ID,
+    PARAM_CODE,
     PARAM_COLOR,
+    PARAM_DELTA,
+    PARAM_DETAIL,
+    PARAM_ENABLED,
+    PARAM_FAIL,
+    PARAM_FROM,
     PARAM_HASH,
     PARAM_HNAME,
     PARAM_INDEX,
     PARAM_INT16,
     PARAM_INT32,
     PARAM_INT64,
+    PARAM_INT8,
+    PARAM_KEY,
+    PARAM_LIMIT,
+    PARAM_MESSAGE,
     PARAM_NAME,
+    PARAM_POSITION,
     PARAM_RECORD_INDEX,
     PARAM_REQUEST_ID,
+    PARAM_REVERSE,
     PARAM_STRING,
+    PARAM_TAG,
+    PARAM_TIMEOUT,
+    PARAM_TO,
+    PARAM_UINT16,
+    PARAM_UINT32,
+    PARAM_UINT64,
+    PARAM_UINT8,
     PARAM_VALUE,
+    PARAM_VALUE_INDEX,
+    RESULT_CALL_ID,
     RESULT_COUNT,
+    RESULT_COUNTER,
+    RESULT_CURSOR,
+    RESULT_ENTRIES,
     RESULT_IOTAS,
     RESULT_LENGTH,
+    RESULT_POSITIONS,
     RESULT_RECORD,
+    RESULT_STATUS,
     RESULT_VALUE,
+    RESULT_VALUES,
+    STATE_ADMINS,
+    STATE_ARRAY_OF_ARRAYS,
     STATE_ARRAYS,
+    STATE_COUNTER,
+    STATE_MAP_OF_MAPS,
+    STATE_REMOTE_STATUS,
+    STATE_TAGGED_VALUES,
 ];
 
 pub static mut IDX_MAP: [Key32; KEY_MAP_LEN] = [Key32(0); KEY_MAP_LEN];
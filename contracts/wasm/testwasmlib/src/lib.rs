@@ -26,21 +26,39 @@ mod keys;
 mod params;
 mod results;
 mod state;
+mod structs;
 mod typedefs;
 mod testwasmlib;
 
 #[no_mangle]
 fn on_load() {
     let exports = ScExports::new();
+    exports.add_func(FUNC_ADMIN_SET, func_admin_set_thunk);
     exports.add_func(FUNC_ARRAY_CLEAR, func_array_clear_thunk);
     exports.add_func(FUNC_ARRAY_CREATE, func_array_create_thunk);
+    exports.add_func(FUNC_ARRAY_OF_ARRAYS_APPEND, func_array_of_arrays_append_thunk);
     exports.add_func(FUNC_ARRAY_SET, func_array_set_thunk);
+    exports.add_func(FUNC_COUNTER_ADD, func_counter_add_thunk);
+    exports.add_func(FUNC_MAP_OF_MAPS_SET, func_map_of_maps_set_thunk);
+    exports.add_func(FUNC_ORDERED_DELETE, func_ordered_delete_thunk);
+    exports.add_func(FUNC_ORDERED_SET, func_ordered_set_thunk);
     exports.add_func(FUNC_PARAM_TYPES, func_param_types_thunk);
+    exports.add_func(FUNC_RAISE_ERROR, func_raise_error_thunk);
+    exports.add_func(FUNC_REMOTE_CALL, func_remote_call_thunk);
+    exports.add_func(FUNC_REMOTE_RESULT, func_remote_result_thunk);
+    exports.add_func(FUNC_REMOTE_TARGET, func_remote_target_thunk);
+    exports.add_func(FUNC_TAGGED_VALUE_ADD, func_tagged_value_add_thunk);
     exports.add_view(VIEW_ARRAY_LENGTH, view_array_length_thunk);
+    exports.add_view(VIEW_ARRAY_OF_ARRAYS_VALUE, view_array_of_arrays_value_thunk);
     exports.add_view(VIEW_ARRAY_VALUE, view_array_value_thunk);
     exports.add_view(VIEW_BLOCK_RECORD, view_block_record_thunk);
     exports.add_view(VIEW_BLOCK_RECORDS, view_block_records_thunk);
+    exports.add_view(VIEW_COUNTER_VALUE, view_counter_value_thunk);
     exports.add_view(VIEW_IOTA_BALANCE, view_iota_balance_thunk);
+    exports.add_view(VIEW_MAP_OF_MAPS_VALUE, view_map_of_maps_value_thunk);
+    exports.add_view(VIEW_ORDERED_PAGE, view_ordered_page_thunk);
+    exports.add_view(VIEW_REMOTE_STATUS, view_remote_status_thunk);
+    exports.add_view(VIEW_TAGGED_VALUES, view_tagged_values_thunk);
 
     unsafe {
         for i in 0..KEY_MAP_LEN {
@@ -49,6 +67,33 @@ fn on_load() {
     }
 }
 
+pub struct AdminSetContext {
+    params: ImmutableAdminSetParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_admin_set_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcAdminSet");
+    // only SC creator can appoint admins
+    ctx.require(ctx.caller() == ctx.contract_creator(), "no permission");
+
+    let f = AdminSetContext {
+        params: ImmutableAdminSetParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.agent_id().exists(), "missing mandatory agentID");
+    ctx.require(f.params.enabled().exists(), "missing mandatory enabled");
+    func_admin_set(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcAdminSet ok");
+}
+
 pub struct ArrayClearContext {
     params: ImmutableArrayClearParams,
     state:  MutableTestWasmLibState,
@@ -66,6 +111,9 @@ fn func_array_clear_thunk(ctx: &ScFuncContext) {
     };
     ctx.require(f.params.name().exists(), "missing mandatory name");
     func_array_clear(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArrayClear ok");
 }
 
@@ -86,9 +134,36 @@ fn func_array_create_thunk(ctx: &ScFuncContext) {
     };
     ctx.require(f.params.name().exists(), "missing mandatory name");
     func_array_create(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArrayCreate ok");
 }
 
+pub struct ArrayOfArraysAppendContext {
+    params: ImmutableArrayOfArraysAppendParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_array_of_arrays_append_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcArrayOfArraysAppend");
+    let f = ArrayOfArraysAppendContext {
+        params: ImmutableArrayOfArraysAppendParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.index().exists(), "missing mandatory index");
+    ctx.require(f.params.value().exists(), "missing mandatory value");
+    func_array_of_arrays_append(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcArrayOfArraysAppend ok");
+}
+
 pub struct ArraySetContext {
     params: ImmutableArraySetParams,
     state:  MutableTestWasmLibState,
@@ -107,10 +182,118 @@ fn func_array_set_thunk(ctx: &ScFuncContext) {
     ctx.require(f.params.index().exists(), "missing mandatory index");
     ctx.require(f.params.name().exists(), "missing mandatory name");
     ctx.require(f.params.value().exists(), "missing mandatory value");
+    ctx.require(f.params.index().value() >= 0, "invalid index: below minimum");
+    ctx.require(f.params.name().value().len() >= 1, "invalid name: too short");
+    ctx.require(f.params.name().value().len() <= 32, "invalid name: too long");
     func_array_set(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArraySet ok");
 }
 
+pub struct CounterAddContext {
+    params: ImmutableCounterAddParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_counter_add_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcCounterAdd");
+    ctx.require(ctx.caller() == ctx.contract_creator() ||
+        ctx.state().get_map("admins").get_bool(&ctx.caller()).value(),
+        "no permission");
+
+    let f = CounterAddContext {
+        params: ImmutableCounterAddParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    if f.params.delta().exists() {
+        ctx.require(f.params.delta().value() >= -5, "invalid delta: below minimum");
+        ctx.require(f.params.delta().value() <= 5, "invalid delta: above maximum");
+    }
+    func_counter_add(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcCounterAdd ok");
+}
+
+pub struct MapOfMapsSetContext {
+    params: ImmutableMapOfMapsSetParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_map_of_maps_set_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcMapOfMapsSet");
+    let f = MapOfMapsSetContext {
+        params: ImmutableMapOfMapsSetParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.key().exists(), "missing mandatory key");
+    ctx.require(f.params.name().exists(), "missing mandatory name");
+    ctx.require(f.params.value().exists(), "missing mandatory value");
+    func_map_of_maps_set(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcMapOfMapsSet ok");
+}
+
+pub struct OrderedDeleteContext {
+    params: ImmutableOrderedDeleteParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_ordered_delete_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcOrderedDelete");
+    let f = OrderedDeleteContext {
+        params: ImmutableOrderedDeleteParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.position().exists(), "missing mandatory position");
+    func_ordered_delete(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcOrderedDelete ok");
+}
+
+pub struct OrderedSetContext {
+    params: ImmutableOrderedSetParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_ordered_set_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcOrderedSet");
+    let f = OrderedSetContext {
+        params: ImmutableOrderedSetParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.position().exists(), "missing mandatory position");
+    ctx.require(f.params.value().exists(), "missing mandatory value");
+    func_ordered_set(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcOrderedSet ok");
+}
+
 pub struct ParamTypesContext {
     params: ImmutableParamTypesParams,
     state:  MutableTestWasmLibState,
@@ -127,9 +310,130 @@ fn func_param_types_thunk(ctx: &ScFuncContext) {
         },
     };
     func_param_types(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcParamTypes ok");
 }
 
+pub struct RaiseErrorContext {
+    params: ImmutableRaiseErrorParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_raise_error_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcRaiseError");
+    let f = RaiseErrorContext {
+        params: ImmutableRaiseErrorParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.code().exists(), "missing mandatory code");
+    ctx.require(f.params.message().exists(), "missing mandatory message");
+    func_raise_error(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRaiseError ok");
+}
+
+pub struct RemoteCallContext {
+    params:  ImmutableRemoteCallParams,
+    results: MutableRemoteCallResults,
+    state:   MutableTestWasmLibState,
+}
+
+fn func_remote_call_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcRemoteCall");
+    let f = RemoteCallContext {
+        params: ImmutableRemoteCallParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableRemoteCallResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    func_remote_call(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteCall ok");
+}
+
+pub struct RemoteResultContext {
+    state: MutableTestWasmLibState,
+}
+
+fn func_remote_result_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcRemoteResult");
+    let f = RemoteResultContext {
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    func_remote_result(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteResult ok");
+}
+
+pub struct RemoteTargetContext {
+    params:  ImmutableRemoteTargetParams,
+    results: MutableRemoteTargetResults,
+    state:   MutableTestWasmLibState,
+}
+
+fn func_remote_target_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcRemoteTarget");
+    let f = RemoteTargetContext {
+        params: ImmutableRemoteTargetParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableRemoteTargetResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    func_remote_target(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteTarget ok");
+}
+
+pub struct TaggedValueAddContext {
+    params: ImmutableTaggedValueAddParams,
+    state:  MutableTestWasmLibState,
+}
+
+fn func_tagged_value_add_thunk(ctx: &ScFuncContext) {
+    ctx.log("testwasmlib.funcTaggedValueAdd");
+    let f = TaggedValueAddContext {
+        params: ImmutableTaggedValueAddParams {
+            id: OBJ_ID_PARAMS,
+        },
+        state: MutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.tag().exists(), "missing mandatory tag");
+    ctx.require(f.params.value().exists(), "missing mandatory value");
+    func_tagged_value_add(ctx, &f);
+    if f.state.counter().exists() {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcTaggedValueAdd ok");
+}
+
 pub struct ArrayLengthContext {
     params:  ImmutableArrayLengthParams,
     results: MutableArrayLengthResults,
@@ -154,6 +458,31 @@ fn view_array_length_thunk(ctx: &ScViewContext) {
     ctx.log("testwasmlib.viewArrayLength ok");
 }
 
+pub struct ArrayOfArraysValueContext {
+    params:  ImmutableArrayOfArraysValueParams,
+    results: MutableArrayOfArraysValueResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_array_of_arrays_value_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewArrayOfArraysValue");
+    let f = ArrayOfArraysValueContext {
+        params: ImmutableArrayOfArraysValueParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableArrayOfArraysValueResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.index().exists(), "missing mandatory index");
+    ctx.require(f.params.value_index().exists(), "missing mandatory valueIndex");
+    view_array_of_arrays_value(ctx, &f);
+    ctx.log("testwasmlib.viewArrayOfArraysValue ok");
+}
+
 pub struct ArrayValueContext {
     params:  ImmutableArrayValueParams,
     results: MutableArrayValueResults,
@@ -228,6 +557,25 @@ fn view_block_records_thunk(ctx: &ScViewContext) {
     ctx.log("testwasmlib.viewBlockRecords ok");
 }
 
+pub struct CounterValueContext {
+    results: MutableCounterValueResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_counter_value_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewCounterValue");
+    let f = CounterValueContext {
+        results: MutableCounterValueResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    view_counter_value(ctx, &f);
+    ctx.log("testwasmlib.viewCounterValue ok");
+}
+
 pub struct IotaBalanceContext {
     results: MutableIotaBalanceResults,
     state:   ImmutableTestWasmLibState,
@@ -247,4 +595,98 @@ fn view_iota_balance_thunk(ctx: &ScViewContext) {
     ctx.log("testwasmlib.viewIotaBalance ok");
 }
 
+pub struct MapOfMapsValueContext {
+    params:  ImmutableMapOfMapsValueParams,
+    results: MutableMapOfMapsValueResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_map_of_maps_value_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewMapOfMapsValue");
+    let f = MapOfMapsValueContext {
+        params: ImmutableMapOfMapsValueParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableMapOfMapsValueResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.key().exists(), "missing mandatory key");
+    ctx.require(f.params.name().exists(), "missing mandatory name");
+    view_map_of_maps_value(ctx, &f);
+    ctx.log("testwasmlib.viewMapOfMapsValue ok");
+}
+
+pub struct OrderedPageContext {
+    params:  ImmutableOrderedPageParams,
+    results: MutableOrderedPageResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_ordered_page_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewOrderedPage");
+    let f = OrderedPageContext {
+        params: ImmutableOrderedPageParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableOrderedPageResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.limit().exists(), "missing mandatory limit");
+    ctx.require(f.params.limit().value() >= 1, "invalid limit: below minimum");
+    ctx.require(f.params.limit().value() <= 100, "invalid limit: above maximum");
+    view_ordered_page(ctx, &f);
+    ctx.log("testwasmlib.viewOrderedPage ok");
+}
+
+pub struct RemoteStatusContext {
+    results: MutableRemoteStatusResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_remote_status_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewRemoteStatus");
+    let f = RemoteStatusContext {
+        results: MutableRemoteStatusResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    view_remote_status(ctx, &f);
+    ctx.log("testwasmlib.viewRemoteStatus ok");
+}
+
+pub struct TaggedValuesContext {
+    params:  ImmutableTaggedValuesParams,
+    results: MutableTaggedValuesResults,
+    state:   ImmutableTestWasmLibState,
+}
+
+fn view_tagged_values_thunk(ctx: &ScViewContext) {
+    ctx.log("testwasmlib.viewTaggedValues");
+    let f = TaggedValuesContext {
+        params: ImmutableTaggedValuesParams {
+            id: OBJ_ID_PARAMS,
+        },
+        results: MutableTaggedValuesResults {
+            id: OBJ_ID_RESULTS,
+        },
+        state: ImmutableTestWasmLibState {
+            id: OBJ_ID_STATE,
+        },
+    };
+    ctx.require(f.params.agent_id().exists(), "missing mandatory agentID");
+    view_tagged_values(ctx, &f);
+    ctx.log("testwasmlib.viewTaggedValues ok");
+}
+
 // @formatter:on
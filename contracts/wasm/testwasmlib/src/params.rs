@@ -14,6 +14,36 @@ use wasmlib::host::*;
 use crate::*;
 use crate::keys::*;
 
+#[derive(Clone, Copy)]
+pub struct ImmutableAdminSetParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableAdminSetParams {
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
+    }
+
+    pub fn enabled(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, idx_map(IDX_PARAM_ENABLED))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableAdminSetParams {
+    pub(crate) id: i32,
+}
+
+impl MutableAdminSetParams {
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
+    }
+
+    pub fn enabled(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, idx_map(IDX_PARAM_ENABLED))
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableArrayClearParams {
     pub(crate) id: i32,
@@ -58,6 +88,36 @@ impl MutableArrayCreateParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableArrayOfArraysAppendParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableArrayOfArraysAppendParams {
+    pub fn index(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, idx_map(IDX_PARAM_INDEX))
+    }
+
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableArrayOfArraysAppendParams {
+    pub(crate) id: i32,
+}
+
+impl MutableArrayOfArraysAppendParams {
+    pub fn index(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, idx_map(IDX_PARAM_INDEX))
+    }
+
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableArraySetParams {
     pub(crate) id: i32,
@@ -96,6 +156,118 @@ impl MutableArraySetParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableCounterAddParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableCounterAddParams {
+    pub fn delta(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_DELTA))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableCounterAddParams {
+    pub(crate) id: i32,
+}
+
+impl MutableCounterAddParams {
+    pub fn delta(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_PARAM_DELTA))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableMapOfMapsSetParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableMapOfMapsSetParams {
+    pub fn key(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_KEY))
+    }
+
+    pub fn name(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_NAME))
+    }
+
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableMapOfMapsSetParams {
+    pub(crate) id: i32,
+}
+
+impl MutableMapOfMapsSetParams {
+    pub fn key(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_KEY))
+    }
+
+    pub fn name(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_NAME))
+    }
+
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableOrderedDeleteParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableOrderedDeleteParams {
+    pub fn position(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_POSITION))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableOrderedDeleteParams {
+    pub(crate) id: i32,
+}
+
+impl MutableOrderedDeleteParams {
+    pub fn position(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_PARAM_POSITION))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableOrderedSetParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableOrderedSetParams {
+    pub fn position(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_POSITION))
+    }
+
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableOrderedSetParams {
+    pub(crate) id: i32,
+}
+
+impl MutableOrderedSetParams {
+    pub fn position(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_PARAM_POSITION))
+    }
+
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
 pub struct MapStringToImmutableBytes {
     pub(crate) obj_id: i32,
 }
@@ -120,6 +292,14 @@ impl ImmutableParamTypesParams {
         ScImmutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
     }
 
+    pub fn big_int(&self) -> ScImmutableBigInt {
+        ScImmutableBigInt::new(self.id, idx_map(IDX_PARAM_BIG_INT))
+    }
+
+    pub fn bool(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, idx_map(IDX_PARAM_BOOL))
+    }
+
     pub fn bytes(&self) -> ScImmutableBytes {
         ScImmutableBytes::new(self.id, idx_map(IDX_PARAM_BYTES))
     }
@@ -152,6 +332,10 @@ impl ImmutableParamTypesParams {
         ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_INT64))
     }
 
+    pub fn int8(&self) -> ScImmutableInt8 {
+        ScImmutableInt8::new(self.id, idx_map(IDX_PARAM_INT8))
+    }
+
     pub fn param(&self) -> MapStringToImmutableBytes {
         MapStringToImmutableBytes { obj_id: self.id }
     }
@@ -163,6 +347,22 @@ impl ImmutableParamTypesParams {
     pub fn string(&self) -> ScImmutableString {
         ScImmutableString::new(self.id, idx_map(IDX_PARAM_STRING))
     }
+
+    pub fn uint16(&self) -> ScImmutableUint16 {
+        ScImmutableUint16::new(self.id, idx_map(IDX_PARAM_UINT16))
+    }
+
+    pub fn uint32(&self) -> ScImmutableUint32 {
+        ScImmutableUint32::new(self.id, idx_map(IDX_PARAM_UINT32))
+    }
+
+    pub fn uint64(&self) -> ScImmutableUint64 {
+        ScImmutableUint64::new(self.id, idx_map(IDX_PARAM_UINT64))
+    }
+
+    pub fn uint8(&self) -> ScImmutableUint8 {
+        ScImmutableUint8::new(self.id, idx_map(IDX_PARAM_UINT8))
+    }
 }
 
 pub struct MapStringToMutableBytes {
@@ -193,6 +393,14 @@ impl MutableParamTypesParams {
         ScMutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
     }
 
+    pub fn big_int(&self) -> ScMutableBigInt {
+        ScMutableBigInt::new(self.id, idx_map(IDX_PARAM_BIG_INT))
+    }
+
+    pub fn bool(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, idx_map(IDX_PARAM_BOOL))
+    }
+
     pub fn bytes(&self) -> ScMutableBytes {
         ScMutableBytes::new(self.id, idx_map(IDX_PARAM_BYTES))
     }
@@ -225,6 +433,10 @@ impl MutableParamTypesParams {
         ScMutableInt64::new(self.id, idx_map(IDX_PARAM_INT64))
     }
 
+    pub fn int8(&self) -> ScMutableInt8 {
+        ScMutableInt8::new(self.id, idx_map(IDX_PARAM_INT8))
+    }
+
     pub fn param(&self) -> MapStringToMutableBytes {
         MapStringToMutableBytes { obj_id: self.id }
     }
@@ -236,6 +448,150 @@ impl MutableParamTypesParams {
     pub fn string(&self) -> ScMutableString {
         ScMutableString::new(self.id, idx_map(IDX_PARAM_STRING))
     }
+
+    pub fn uint16(&self) -> ScMutableUint16 {
+        ScMutableUint16::new(self.id, idx_map(IDX_PARAM_UINT16))
+    }
+
+    pub fn uint32(&self) -> ScMutableUint32 {
+        ScMutableUint32::new(self.id, idx_map(IDX_PARAM_UINT32))
+    }
+
+    pub fn uint64(&self) -> ScMutableUint64 {
+        ScMutableUint64::new(self.id, idx_map(IDX_PARAM_UINT64))
+    }
+
+    pub fn uint8(&self) -> ScMutableUint8 {
+        ScMutableUint8::new(self.id, idx_map(IDX_PARAM_UINT8))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRaiseErrorParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRaiseErrorParams {
+    pub fn code(&self) -> ScImmutableUint16 {
+        ScImmutableUint16::new(self.id, idx_map(IDX_PARAM_CODE))
+    }
+
+    pub fn detail(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_DETAIL))
+    }
+
+    pub fn message(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_MESSAGE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRaiseErrorParams {
+    pub(crate) id: i32,
+}
+
+impl MutableRaiseErrorParams {
+    pub fn code(&self) -> ScMutableUint16 {
+        ScMutableUint16::new(self.id, idx_map(IDX_PARAM_CODE))
+    }
+
+    pub fn detail(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_DETAIL))
+    }
+
+    pub fn message(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_MESSAGE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoteCallParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoteCallParams {
+    pub fn chain_id(&self) -> ScImmutableChainID {
+        ScImmutableChainID::new(self.id, idx_map(IDX_PARAM_CHAIN_ID))
+    }
+
+    pub fn fail(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, idx_map(IDX_PARAM_FAIL))
+    }
+
+    pub fn timeout(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, idx_map(IDX_PARAM_TIMEOUT))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoteCallParams {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoteCallParams {
+    pub fn chain_id(&self) -> ScMutableChainID {
+        ScMutableChainID::new(self.id, idx_map(IDX_PARAM_CHAIN_ID))
+    }
+
+    pub fn fail(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, idx_map(IDX_PARAM_FAIL))
+    }
+
+    pub fn timeout(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, idx_map(IDX_PARAM_TIMEOUT))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoteTargetParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoteTargetParams {
+    pub fn fail(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, idx_map(IDX_PARAM_FAIL))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoteTargetParams {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoteTargetParams {
+    pub fn fail(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, idx_map(IDX_PARAM_FAIL))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableTaggedValueAddParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableTaggedValueAddParams {
+    pub fn tag(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_TAG))
+    }
+
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableTaggedValueAddParams {
+    pub(crate) id: i32,
+}
+
+impl MutableTaggedValueAddParams {
+    pub fn tag(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_TAG))
+    }
+
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_VALUE))
+    }
 }
 
 #[derive(Clone, Copy)]
@@ -260,6 +616,36 @@ impl MutableArrayLengthParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableArrayOfArraysValueParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableArrayOfArraysValueParams {
+    pub fn index(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, idx_map(IDX_PARAM_INDEX))
+    }
+
+    pub fn value_index(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, idx_map(IDX_PARAM_VALUE_INDEX))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableArrayOfArraysValueParams {
+    pub(crate) id: i32,
+}
+
+impl MutableArrayOfArraysValueParams {
+    pub fn index(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, idx_map(IDX_PARAM_INDEX))
+    }
+
+    pub fn value_index(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, idx_map(IDX_PARAM_VALUE_INDEX))
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableArrayValueParams {
     pub(crate) id: i32,
@@ -341,3 +727,101 @@ impl MutableBlockRecordsParams {
         ScMutableInt32::new(self.id, idx_map(IDX_PARAM_BLOCK_INDEX))
     }
 }
+
+#[derive(Clone, Copy)]
+pub struct ImmutableMapOfMapsValueParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableMapOfMapsValueParams {
+    pub fn key(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_KEY))
+    }
+
+    pub fn name(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_PARAM_NAME))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableMapOfMapsValueParams {
+    pub(crate) id: i32,
+}
+
+impl MutableMapOfMapsValueParams {
+    pub fn key(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_KEY))
+    }
+
+    pub fn name(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_PARAM_NAME))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableOrderedPageParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableOrderedPageParams {
+    pub fn from(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_FROM))
+    }
+
+    pub fn limit(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, idx_map(IDX_PARAM_LIMIT))
+    }
+
+    pub fn reverse(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, idx_map(IDX_PARAM_REVERSE))
+    }
+
+    pub fn to(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_PARAM_TO))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableOrderedPageParams {
+    pub(crate) id: i32,
+}
+
+impl MutableOrderedPageParams {
+    pub fn from(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_PARAM_FROM))
+    }
+
+    pub fn limit(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, idx_map(IDX_PARAM_LIMIT))
+    }
+
+    pub fn reverse(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, idx_map(IDX_PARAM_REVERSE))
+    }
+
+    pub fn to(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_PARAM_TO))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableTaggedValuesParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableTaggedValuesParams {
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableTaggedValuesParams {
+    pub(crate) id: i32,
+}
+
+impl MutableTaggedValuesParams {
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, idx_map(IDX_PARAM_AGENT_ID))
+    }
+}
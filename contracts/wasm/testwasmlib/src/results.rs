@@ -13,6 +13,52 @@ use wasmlib::host::*;
 
 use crate::*;
 use crate::keys::*;
+use crate::structs::*;
+use crate::typedefs::*;
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoteCallResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoteCallResults {
+    pub fn call_id(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_RESULT_CALL_ID))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoteCallResults {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoteCallResults {
+    pub fn call_id(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_RESULT_CALL_ID))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoteTargetResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoteTargetResults {
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoteTargetResults {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoteTargetResults {
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
 
 #[derive(Clone, Copy)]
 pub struct ImmutableArrayLengthResults {
@@ -36,6 +82,28 @@ impl MutableArrayLengthResults {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableArrayOfArraysValueResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableArrayOfArraysValueResults {
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableArrayOfArraysValueResults {
+    pub(crate) id: i32,
+}
+
+impl MutableArrayOfArraysValueResults {
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableArrayValueResults {
     pub(crate) id: i32,
@@ -102,6 +170,28 @@ impl MutableBlockRecordsResults {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableCounterValueResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableCounterValueResults {
+    pub fn counter(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_RESULT_COUNTER))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableCounterValueResults {
+    pub(crate) id: i32,
+}
+
+impl MutableCounterValueResults {
+    pub fn counter(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_RESULT_COUNTER))
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableIotaBalanceResults {
     pub(crate) id: i32,
@@ -123,3 +213,145 @@ impl MutableIotaBalanceResults {
         ScMutableInt64::new(self.id, idx_map(IDX_RESULT_IOTAS))
     }
 }
+
+#[derive(Clone, Copy)]
+pub struct ImmutableMapOfMapsValueResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableMapOfMapsValueResults {
+    pub fn value(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableMapOfMapsValueResults {
+    pub(crate) id: i32,
+}
+
+impl MutableMapOfMapsValueResults {
+    pub fn value(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_RESULT_VALUE))
+    }
+}
+
+pub struct ArrayOfImmutableInt64 {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfImmutableInt64 {
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_int64(&self, index: i32) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.obj_id, Key32(index))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableOrderedPageResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableOrderedPageResults {
+    pub fn cursor(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_RESULT_CURSOR))
+    }
+
+    pub fn entries(&self) -> ArrayOfImmutableString {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_ENTRIES), TYPE_ARRAY | TYPE_STRING);
+        ArrayOfImmutableString { obj_id: arr_id }
+    }
+
+    pub fn positions(&self) -> ArrayOfImmutableInt64 {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_POSITIONS), TYPE_ARRAY | TYPE_INT64);
+        ArrayOfImmutableInt64 { obj_id: arr_id }
+    }
+}
+
+pub struct ArrayOfMutableInt64 {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfMutableInt64 {
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_int64(&self, index: i32) -> ScMutableInt64 {
+        ScMutableInt64::new(self.obj_id, Key32(index))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableOrderedPageResults {
+    pub(crate) id: i32,
+}
+
+impl MutableOrderedPageResults {
+    pub fn cursor(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_RESULT_CURSOR))
+    }
+
+    pub fn entries(&self) -> ArrayOfMutableString {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_ENTRIES), TYPE_ARRAY | TYPE_STRING);
+        ArrayOfMutableString { obj_id: arr_id }
+    }
+
+    pub fn positions(&self) -> ArrayOfMutableInt64 {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_POSITIONS), TYPE_ARRAY | TYPE_INT64);
+        ArrayOfMutableInt64 { obj_id: arr_id }
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoteStatusResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoteStatusResults {
+    pub fn status(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_RESULT_STATUS))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoteStatusResults {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoteStatusResults {
+    pub fn status(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_RESULT_STATUS))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableTaggedValuesResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableTaggedValuesResults {
+    pub fn values(&self) -> ArrayOfImmutableTaggedValue {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_VALUES), TYPE_ARRAY | TYPE_BYTES);
+        ArrayOfImmutableTaggedValue { obj_id: arr_id }
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableTaggedValuesResults {
+    pub(crate) id: i32,
+}
+
+impl MutableTaggedValuesResults {
+    pub fn values(&self) -> ArrayOfMutableTaggedValue {
+        let arr_id = get_object_id(self.id, idx_map(IDX_RESULT_VALUES), TYPE_ARRAY | TYPE_BYTES);
+        ArrayOfMutableTaggedValue { obj_id: arr_id }
+    }
+}
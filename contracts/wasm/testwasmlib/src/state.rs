@@ -13,8 +13,34 @@ use wasmlib::host::*;
 
 use crate::*;
 use crate::keys::*;
+use crate::structs::*;
 use crate::typedefs::*;
 
+pub struct MapAgentIDToImmutableBool {
+    pub(crate) obj_id: i32,
+}
+
+impl MapAgentIDToImmutableBool {
+    pub fn get_bool(&self, key: &ScAgentID) -> ScImmutableBool {
+        ScImmutableBool::new(self.obj_id, key.get_key_id())
+    }
+}
+
+pub struct ArrayOfImmutableStringArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfImmutableStringArray {
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_string_array(&self, index: i32) -> ImmutableStringArray {
+        let sub_id = get_object_id(self.obj_id, Key32(index), TYPE_ARRAY | TYPE_STRING);
+        ImmutableStringArray { obj_id: sub_id }
+    }
+}
+
 pub struct MapStringToImmutableStringArray {
     pub(crate) obj_id: i32,
 }
@@ -26,16 +52,99 @@ impl MapStringToImmutableStringArray {
     }
 }
 
+pub struct MapStringToImmutableMapStringToString {
+    pub(crate) obj_id: i32,
+}
+
+impl MapStringToImmutableMapStringToString {
+    pub fn get_map_string_to_string(&self, key: &str) -> ImmutableMapStringToString {
+        let sub_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_MAP);
+        ImmutableMapStringToString { obj_id: sub_id }
+    }
+}
+
+pub struct MapAgentIDToImmutableTaggedValueArray {
+    pub(crate) obj_id: i32,
+}
+
+impl MapAgentIDToImmutableTaggedValueArray {
+    pub fn get_tagged_value_array(&self, key: &ScAgentID) -> ImmutableTaggedValueArray {
+        let sub_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_ARRAY | TYPE_BYTES);
+        ImmutableTaggedValueArray { obj_id: sub_id }
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableTestWasmLibState {
     pub(crate) id: i32,
 }
 
 impl ImmutableTestWasmLibState {
+    pub fn admins(&self) -> MapAgentIDToImmutableBool {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_ADMINS), TYPE_MAP);
+        MapAgentIDToImmutableBool { obj_id: map_id }
+    }
+
+    pub fn array_of_arrays(&self) -> ArrayOfImmutableStringArray {
+        let arr_id = get_object_id(self.id, idx_map(IDX_STATE_ARRAY_OF_ARRAYS), TYPE_ARRAY | TYPE_MAP);
+        ArrayOfImmutableStringArray { obj_id: arr_id }
+    }
+
     pub fn arrays(&self) -> MapStringToImmutableStringArray {
         let map_id = get_object_id(self.id, idx_map(IDX_STATE_ARRAYS), TYPE_MAP);
         MapStringToImmutableStringArray { obj_id: map_id }
     }
+
+    pub fn counter(&self) -> ScImmutableInt64 {
+        ScImmutableInt64::new(self.id, idx_map(IDX_STATE_COUNTER))
+    }
+
+    pub fn map_of_maps(&self) -> MapStringToImmutableMapStringToString {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_MAP_OF_MAPS), TYPE_MAP);
+        MapStringToImmutableMapStringToString { obj_id: map_id }
+    }
+
+    pub fn remote_status(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, idx_map(IDX_STATE_REMOTE_STATUS))
+    }
+
+    pub fn tagged_values(&self) -> MapAgentIDToImmutableTaggedValueArray {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_TAGGED_VALUES), TYPE_MAP);
+        MapAgentIDToImmutableTaggedValueArray { obj_id: map_id }
+    }
+}
+
+pub struct MapAgentIDToMutableBool {
+    pub(crate) obj_id: i32,
+}
+
+impl MapAgentIDToMutableBool {
+    pub fn clear(&self) {
+        clear(self.obj_id)
+    }
+
+    pub fn get_bool(&self, key: &ScAgentID) -> ScMutableBool {
+        ScMutableBool::new(self.obj_id, key.get_key_id())
+    }
+}
+
+pub struct ArrayOfMutableStringArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfMutableStringArray {
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_string_array(&self, index: i32) -> MutableStringArray {
+        let sub_id = get_object_id(self.obj_id, Key32(index), TYPE_ARRAY | TYPE_STRING);
+        MutableStringArray { obj_id: sub_id }
+    }
 }
 
 pub struct MapStringToMutableStringArray {
@@ -53,14 +162,72 @@ impl MapStringToMutableStringArray {
     }
 }
 
+pub struct MapStringToMutableMapStringToString {
+    pub(crate) obj_id: i32,
+}
+
+impl MapStringToMutableMapStringToString {
+    pub fn clear(&self) {
+        clear(self.obj_id)
+    }
+
+    pub fn get_map_string_to_string(&self, key: &str) -> MutableMapStringToString {
+        let sub_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_MAP);
+        MutableMapStringToString { obj_id: sub_id }
+    }
+}
+
+pub struct MapAgentIDToMutableTaggedValueArray {
+    pub(crate) obj_id: i32,
+}
+
+impl MapAgentIDToMutableTaggedValueArray {
+    pub fn clear(&self) {
+        clear(self.obj_id)
+    }
+
+    pub fn get_tagged_value_array(&self, key: &ScAgentID) -> MutableTaggedValueArray {
+        let sub_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_ARRAY | TYPE_BYTES);
+        MutableTaggedValueArray { obj_id: sub_id }
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct MutableTestWasmLibState {
     pub(crate) id: i32,
 }
 
 impl MutableTestWasmLibState {
+    pub fn admins(&self) -> MapAgentIDToMutableBool {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_ADMINS), TYPE_MAP);
+        MapAgentIDToMutableBool { obj_id: map_id }
+    }
+
+    pub fn array_of_arrays(&self) -> ArrayOfMutableStringArray {
+        let arr_id = get_object_id(self.id, idx_map(IDX_STATE_ARRAY_OF_ARRAYS), TYPE_ARRAY | TYPE_MAP);
+        ArrayOfMutableStringArray { obj_id: arr_id }
+    }
+
     pub fn arrays(&self) -> MapStringToMutableStringArray {
         let map_id = get_object_id(self.id, idx_map(IDX_STATE_ARRAYS), TYPE_MAP);
         MapStringToMutableStringArray { obj_id: map_id }
     }
+
+    pub fn counter(&self) -> ScMutableInt64 {
+        ScMutableInt64::new(self.id, idx_map(IDX_STATE_COUNTER))
+    }
+
+    pub fn map_of_maps(&self) -> MapStringToMutableMapStringToString {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_MAP_OF_MAPS), TYPE_MAP);
+        MapStringToMutableMapStringToString { obj_id: map_id }
+    }
+
+    pub fn remote_status(&self) -> ScMutableString {
+        ScMutableString::new(self.id, idx_map(IDX_STATE_REMOTE_STATUS))
+    }
+
+    pub fn tagged_values(&self) -> MapAgentIDToMutableTaggedValueArray {
+        let map_id = get_object_id(self.id, idx_map(IDX_STATE_TAGGED_VALUES), TYPE_MAP);
+        MapAgentIDToMutableTaggedValueArray { obj_id: map_id }
+    }
 }
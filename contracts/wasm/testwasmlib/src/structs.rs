@@ -0,0 +1,74 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+// @formatter:off
+
+#![allow(dead_code)]
+
+use wasmlib::*;
+use wasmlib::host::*;
+
+pub struct TaggedValue {
+    pub tags:  Vec<String>,
+    pub value: String,
+}
+
+impl TaggedValue {
+    pub fn from_bytes(bytes: &[u8]) -> TaggedValue {
+        let mut decode = BytesDecoder::new(bytes);
+        TaggedValue {
+            tags: (0..decode.uint32()).map(|_| decode.string()).collect(),
+            value: decode.string(),
+        }
+    }
+
+    pub fn to_bytes(&self) -> Vec<u8> {
+        let mut encode = BytesEncoder::new();
+        encode.uint32(self.tags.len() as u32);
+        for item in &self.tags {
+            encode.string(item);
+        }
+        encode.string(&self.value);
+        return encode.data();
+    }
+}
+
+pub struct ImmutableTaggedValue {
+    pub(crate) obj_id: i32,
+    pub(crate) key_id: Key32,
+}
+
+impl ImmutableTaggedValue {
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BYTES)
+    }
+
+    pub fn value(&self) -> TaggedValue {
+        TaggedValue::from_bytes(&get_bytes(self.obj_id, self.key_id, TYPE_BYTES))
+    }
+}
+
+pub struct MutableTaggedValue {
+    pub(crate) obj_id: i32,
+    pub(crate) key_id: Key32,
+}
+
+impl MutableTaggedValue {
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BYTES)
+    }
+
+    pub fn set_value(&self, value: &TaggedValue) {
+        set_bytes(self.obj_id, self.key_id, TYPE_BYTES, &value.to_bytes());
+    }
+
+    pub fn value(&self) -> TaggedValue {
+        TaggedValue::from_bytes(&get_bytes(self.obj_id, self.key_id, TYPE_BYTES))
+    }
+}
+
+// @formatter:on
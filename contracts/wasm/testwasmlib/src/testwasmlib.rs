@@ -4,6 +4,7 @@
 use wasmlib::*;
 
 use crate::*;
+use crate::structs::*;
 
 pub fn func_array_clear(_ctx: &ScFuncContext, f: &ArrayClearContext) {
     let name = f.params.name().value();
@@ -32,6 +33,13 @@ pub fn func_param_types(ctx: &ScFuncContext, f: &ParamTypesContext) {
     if f.params.agent_id().exists() {
         ctx.require(f.params.agent_id().value() == ctx.account_id(), "mismatch: AgentID");
     }
+    if f.params.big_int().exists() {
+        let big_int = ScBigInt::new(1234567890123456789).mul(&ScBigInt::new(1234567890123456789));
+        ctx.require(f.params.big_int().value() == big_int, "mismatch: BigInt");
+    }
+    if f.params.bool().exists() {
+        ctx.require(f.params.bool().value(), "mismatch: Bool");
+    }
     if f.params.bytes().exists() {
         let byte_data = "these are bytes".as_bytes();
         ctx.require(f.params.bytes().value() == byte_data, "mismatch: Bytes");
@@ -50,6 +58,9 @@ pub fn func_param_types(ctx: &ScFuncContext, f: &ParamTypesContext) {
     if f.params.hname().exists() {
         ctx.require(f.params.hname().value() == ctx.account_id().hname(), "mismatch: Hname");
     }
+    if f.params.int8().exists() {
+        ctx.require(f.params.int8().value() == -123, "mismatch: Int8");
+    }
     if f.params.int16().exists() {
         ctx.require(f.params.int16().value() == 12345, "mismatch: Int16");
     }
@@ -66,6 +77,18 @@ pub fn func_param_types(ctx: &ScFuncContext, f: &ParamTypesContext) {
     if f.params.string().exists() {
         ctx.require(f.params.string().value() == "this is a string", "mismatch: String");
     }
+    if f.params.uint8().exists() {
+        ctx.require(f.params.uint8().value() == 123, "mismatch: Uint8");
+    }
+    if f.params.uint16().exists() {
+        ctx.require(f.params.uint16().value() == 54321, "mismatch: Uint16");
+    }
+    if f.params.uint32().exists() {
+        ctx.require(f.params.uint32().value() == 3456789012, "mismatch: Uint32");
+    }
+    if f.params.uint64().exists() {
+        ctx.require(f.params.uint64().value() == 12345678901234567890, "mismatch: Uint64");
+    }
 }
 
 pub fn view_array_length(_ctx: &ScViewContext, f: &ArrayLengthContext) {
@@ -102,3 +125,166 @@ pub fn view_block_records(ctx: &ScViewContext, f: &BlockRecordsContext) {
 pub fn view_iota_balance(ctx: &ScViewContext, f: &IotaBalanceContext) {
     f.results.iotas().set_value(ctx.balances().balance(&ScColor::IOTA));
 }
+
+pub fn func_array_of_arrays_append(_ctx: &ScFuncContext, f: &ArrayOfArraysAppendContext) {
+    // appending to the array at index == length creates a new nested array
+    let index = f.params.index().value();
+    let array = f.state.array_of_arrays().get_string_array(index);
+    let value = f.params.value().value();
+    array.get_string(array.length()).set_value(&value);
+}
+
+pub fn func_map_of_maps_set(_ctx: &ScFuncContext, f: &MapOfMapsSetContext) {
+    let name = f.params.name().value();
+    let map_of_strings = f.state.map_of_maps().get_map_string_to_string(&name);
+    let key = f.params.key().value();
+    let value = f.params.value().value();
+    map_of_strings.get_string(&key).set_value(&value);
+}
+
+pub fn func_tagged_value_add(ctx: &ScFuncContext, f: &TaggedValueAddContext) {
+    let values = f.state.tagged_values().get_tagged_value_array(&ctx.caller());
+    let tag = f.params.tag().value();
+    let value = f.params.value().value();
+
+    // add the tag to the last value when it has the same value
+    let length = values.length();
+    if length != 0 {
+        let last = values.get_tagged_value(length - 1);
+        let mut tagged_value = last.value();
+        if tagged_value.value == value {
+            tagged_value.tags.push(tag);
+            last.set_value(&tagged_value);
+            return;
+        }
+    }
+    let tagged_value = TaggedValue { tags: vec![tag], value: value };
+    values.get_tagged_value(length).set_value(&tagged_value);
+}
+
+pub fn view_array_of_arrays_value(ctx: &ScViewContext, f: &ArrayOfArraysValueContext) {
+    let arrays = f.state.array_of_arrays();
+    let index = f.params.index().value();
+    ctx.require(index >= 0 && index < arrays.length(), "invalid index");
+    let array = arrays.get_string_array(index);
+    let value_index = f.params.value_index().value();
+    ctx.require(value_index >= 0 && value_index < array.length(), "invalid valueIndex");
+    f.results.value().set_value(&array.get_string(value_index).value());
+}
+
+pub fn view_map_of_maps_value(_ctx: &ScViewContext, f: &MapOfMapsValueContext) {
+    let name = f.params.name().value();
+    let map_of_strings = f.state.map_of_maps().get_map_string_to_string(&name);
+    let key = f.params.key().value();
+    f.results.value().set_value(&map_of_strings.get_string(&key).value());
+}
+
+pub fn view_tagged_values(_ctx: &ScViewContext, f: &TaggedValuesContext) {
+    let values = f.state.tagged_values().get_tagged_value_array(&f.params.agent_id().value());
+    let results = f.results.values();
+    let length = values.length();
+    for i in 0..length {
+        results.get_tagged_value(i).set_value(&values.get_tagged_value(i).value());
+    }
+}
+
+pub fn func_admin_set(_ctx: &ScFuncContext, f: &AdminSetContext) {
+    let agent_id = f.params.agent_id().value();
+    let enabled = f.params.enabled().value();
+    f.state.admins().get_bool(&agent_id).set_value(enabled);
+}
+
+pub fn func_counter_add(_ctx: &ScFuncContext, f: &CounterAddContext) {
+    let mut delta: i64 = 1;
+    if f.params.delta().exists() {
+        delta = f.params.delta().value();
+    }
+    let counter = f.state.counter();
+    counter.set_value(counter.value() + delta);
+}
+
+pub fn view_counter_value(_ctx: &ScViewContext, f: &CounterValueContext) {
+    f.results.counter().set_value(f.state.counter().value());
+}
+
+pub fn func_remote_call(ctx: &ScFuncContext, f: &RemoteCallContext) {
+    let mut chain_id = ctx.chain_id();
+    if f.params.chain_id().exists() {
+        chain_id = f.params.chain_id().value();
+    }
+    let params = ScMutableMap::new();
+    params.get_bool(PARAM_FAIL).set_value(f.params.fail().value());
+    // one iota for the remote function, and one to send back the outcome
+    let transfer = ScTransfers::iotas(2);
+    let call_id = ctx.post_with_callback(&chain_id, ctx.contract(), HFUNC_REMOTE_TARGET, Some(params), transfer,
+                                         HFUNC_REMOTE_RESULT, f.params.timeout().value());
+    f.results.call_id().set_value(call_id);
+    f.state.remote_status().set_value("pending");
+}
+
+pub fn func_remote_result(ctx: &ScFuncContext, f: &RemoteResultContext) {
+    let result = ctx.call_result();
+    if result.is_none() {
+        // unexpected or duplicate outcome
+        return;
+    }
+    let result = result.unwrap();
+    let prefix = if result.late { "late " } else { "" };
+    if !result.success {
+        f.state.remote_status().set_value(&(prefix.to_string() + "failed: " + &result.error));
+        return;
+    }
+    let value = result.results.get_string(RESULT_VALUE).value();
+    f.state.remote_status().set_value(&(prefix.to_string() + "ok: " + &value));
+}
+
+pub fn func_remote_target(ctx: &ScFuncContext, f: &RemoteTargetContext) {
+    ctx.require(!f.params.fail().value(), "remote failure");
+    f.results.value().set_value("remote value");
+}
+
+pub fn view_remote_status(_ctx: &ScViewContext, f: &RemoteStatusContext) {
+    f.results.status().set_value(&f.state.remote_status().value());
+}
+
+pub fn func_ordered_delete(ctx: &ScFuncContext, f: &OrderedDeleteContext) {
+    let ordered = ctx.state().get_ordered_map("ordered");
+    ordered.delete(&encode_sortable_int64(f.params.position().value()));
+}
+
+pub fn func_ordered_set(ctx: &ScFuncContext, f: &OrderedSetContext) {
+    let ordered = ctx.state().get_ordered_map("ordered");
+    let key = encode_sortable_int64(f.params.position().value());
+    ordered.set_bytes(&key, f.params.value().value().as_bytes());
+}
+
+pub fn view_ordered_page(ctx: &ScViewContext, f: &OrderedPageContext) {
+    let mut from = None;
+    if f.params.from().exists() {
+        from = Some(encode_sortable_int64(f.params.from().value()));
+    }
+    let mut to = None;
+    if f.params.to().exists() {
+        to = Some(encode_sortable_int64(f.params.to().value()));
+    }
+    let ordered = ctx.state().get_ordered_map("ordered");
+    let page = ordered.page(from.as_deref(), to.as_deref(), f.params.limit().value(), f.params.reverse().value());
+    let positions = f.results.positions();
+    let entries = f.results.entries();
+    for i in 0..page.keys.len() {
+        positions.get_int64(i as i32).set_value(decode_sortable_int64(&page.keys[i]));
+        entries.get_string(i as i32).set_value(&String::from_utf8_lossy(&page.values[i]));
+    }
+    if let Some(cursor) = page.cursor {
+        f.results.cursor().set_value(decode_sortable_int64(&cursor));
+    }
+}
+
+pub fn func_raise_error(ctx: &ScFuncContext, f: &RaiseErrorContext) {
+    let code = f.params.code().value();
+    let message = f.params.message().value();
+    if f.params.detail().exists() {
+        ctx.panic_with_error(code, &message, &[&f.params.detail().value()]);
+    }
+    ctx.panic_with_error(code, &message, &[]);
+}
@@ -12,6 +12,8 @@
 use wasmlib::*;
 use wasmlib::host::*;
 
+use crate::structs::*;
+
 pub struct ArrayOfImmutableString {
     pub(crate) obj_id: i32,
 }
@@ -48,4 +50,68 @@ impl ArrayOfMutableString {
 
 pub type MutableStringArray = ArrayOfMutableString;
 
+pub struct MapStringToImmutableString {
+    pub(crate) obj_id: i32,
+}
+
+impl MapStringToImmutableString {
+    pub fn get_string(&self, key: &str) -> ScImmutableString {
+        ScImmutableString::new(self.obj_id, key.get_key_id())
+    }
+}
+
+pub type ImmutableMapStringToString = MapStringToImmutableString;
+
+pub struct MapStringToMutableString {
+    pub(crate) obj_id: i32,
+}
+
+impl MapStringToMutableString {
+    pub fn clear(&self) {
+        clear(self.obj_id)
+    }
+
+    pub fn get_string(&self, key: &str) -> ScMutableString {
+        ScMutableString::new(self.obj_id, key.get_key_id())
+    }
+}
+
+pub type MutableMapStringToString = MapStringToMutableString;
+
+pub struct ArrayOfImmutableTaggedValue {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfImmutableTaggedValue {
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_tagged_value(&self, index: i32) -> ImmutableTaggedValue {
+        ImmutableTaggedValue { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+}
+
+pub type ImmutableTaggedValueArray = ArrayOfImmutableTaggedValue;
+
+pub struct ArrayOfMutableTaggedValue {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfMutableTaggedValue {
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_tagged_value(&self, index: i32) -> MutableTaggedValue {
+        MutableTaggedValue { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+}
+
+pub type MutableTaggedValueArray = ArrayOfMutableTaggedValue;
+
 // @formatter:on
@@ -4,34 +4,57 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/iotaledger/wasp/contracts/wasm/testwasmlib/go/testwasmlib"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/subrealm"
 	"github.com/iotaledger/wasp/packages/solo"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
 	"github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 	"github.com/iotaledger/wasp/packages/vm/wasmsolo"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
 )
 
 var (
 	allParams = []string{
 		string(testwasmlib.ParamAddress),
 		string(testwasmlib.ParamAgentID),
+		string(testwasmlib.ParamBool),
 		string(testwasmlib.ParamChainID),
 		string(testwasmlib.ParamColor),
 		string(testwasmlib.ParamHash),
 		string(testwasmlib.ParamHname),
+		string(testwasmlib.ParamInt8),
 		string(testwasmlib.ParamInt16),
 		string(testwasmlib.ParamInt32),
 		string(testwasmlib.ParamInt64),
 		string(testwasmlib.ParamRequestID),
+		string(testwasmlib.ParamUint8),
+		string(testwasmlib.ParamUint16),
+		string(testwasmlib.ParamUint32),
+		string(testwasmlib.ParamUint64),
 	}
-	allLengths    = []int{33, 37, 33, 32, 32, 4, 2, 4, 8, 34}
+	allLengths    = []int{33, 37, 1, 33, 32, 32, 4, 1, 2, 4, 8, 34, 1, 2, 4, 8}
 	invalidValues = map[wasmlib.Key][][]byte{
 		testwasmlib.ParamAddress: {
 			append([]byte{3}, zeroHash...),
 			append([]byte{4}, zeroHash...),
 			append([]byte{255}, zeroHash...),
 		},
+		testwasmlib.ParamBigInt: {
+			{},
+			{0, 0},
+			{1, 2, 0},
+		},
+		testwasmlib.ParamBool: {
+			{2},
+			{255},
+		},
 		testwasmlib.ParamChainID: {
 			append([]byte{0}, zeroHash...),
 			append([]byte{1}, zeroHash...),
@@ -77,16 +100,23 @@ func testValidParams(t *testing.T) *wasmsolo.SoloContext {
 	pt := testwasmlib.ScFuncs.ParamTypes(ctx)
 	pt.Params.Address().SetValue(ctx.ChainID().Address())
 	pt.Params.AgentID().SetValue(ctx.AccountID())
+	pt.Params.BigInt().SetValue(wasmlib.NewScBigInt(1234567890123456789).Mul(wasmlib.NewScBigInt(1234567890123456789)))
+	pt.Params.Bool().SetValue(true)
 	pt.Params.Bytes().SetValue([]byte("these are bytes"))
 	pt.Params.ChainID().SetValue(ctx.ChainID())
 	pt.Params.Color().SetValue(wasmlib.NewScColorFromBytes([]byte("RedGreenBlueYellowCyanBlackWhite")))
 	pt.Params.Hash().SetValue(wasmlib.NewScHashFromBytes([]byte("0123456789abcdeffedcba9876543210")))
 	pt.Params.Hname().SetValue(testwasmlib.HScName)
+	pt.Params.Int8().SetValue(-123)
 	pt.Params.Int16().SetValue(12345)
 	pt.Params.Int32().SetValue(1234567890)
 	pt.Params.Int64().SetValue(1234567890123456789)
 	pt.Params.RequestID().SetValue(wasmlib.NewScRequestIDFromBytes([]byte("abcdefghijklmnopqrstuvwxyz123456\x00\x00")))
 	pt.Params.String().SetValue("this is a string")
+	pt.Params.Uint8().SetValue(123)
+	pt.Params.Uint16().SetValue(54321)
+	pt.Params.Uint32().SetValue(3456789012)
+	pt.Params.Uint64().SetValue(12345678901234567890)
 	pt.Func.TransferIotas(1).Post()
 	require.NoError(t, ctx.Err)
 	return ctx
@@ -231,6 +261,211 @@ func TestClearArray(t *testing.T) {
 	require.Error(t, ctx.Err)
 }
 
+func TestArrayOfArrays(t *testing.T) {
+	ctx := setupTest(t)
+
+	appendValue := func(index int32, value string) {
+		f := testwasmlib.ScFuncs.ArrayOfArraysAppend(ctx)
+		f.Params.Index().SetValue(index)
+		f.Params.Value().SetValue(value)
+		f.Func.TransferIotas(1).Post()
+	}
+
+	appendValue(0, "Simple Minds")
+	require.NoError(t, ctx.Err)
+	appendValue(0, "Dire Straits")
+	require.NoError(t, ctx.Err)
+	appendValue(1, "ELO")
+	require.NoError(t, ctx.Err)
+
+	// cannot skip an index
+	appendValue(3, "Queen")
+	require.Error(t, ctx.Err)
+
+	v := testwasmlib.ScFuncs.ArrayOfArraysValue(ctx)
+	v.Params.Index().SetValue(0)
+	v.Params.ValueIndex().SetValue(1)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, "Dire Straits", v.Results.Value().Value())
+
+	v = testwasmlib.ScFuncs.ArrayOfArraysValue(ctx)
+	v.Params.Index().SetValue(1)
+	v.Params.ValueIndex().SetValue(0)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, "ELO", v.Results.Value().Value())
+
+	v = testwasmlib.ScFuncs.ArrayOfArraysValue(ctx)
+	v.Params.Index().SetValue(1)
+	v.Params.ValueIndex().SetValue(1)
+	v.Func.Call()
+	require.Error(t, ctx.Err)
+
+	// the nested arrays can be read natively from the contract state
+	arrays := collections.NewNestedArrayReadOnly(contractState(ctx), string(testwasmlib.StateArrayOfArrays))
+	require.EqualValues(t, 2, arrays.MustLen())
+	require.EqualValues(t, 2, arrays.MustArray(0).MustLen())
+	require.EqualValues(t, "Dire Straits", string(arrays.MustArray(0).MustGetAt(1)))
+	require.EqualValues(t, 1, arrays.MustArray(1).MustLen())
+	require.EqualValues(t, "ELO", string(arrays.MustArray(1).MustGetAt(0)))
+	_, err := arrays.Array(2)
+	require.Error(t, err)
+}
+
+func TestMapOfMaps(t *testing.T) {
+	ctx := setupTest(t)
+
+	setValue := func(name, key, value string) {
+		f := testwasmlib.ScFuncs.MapOfMapsSet(ctx)
+		f.Params.Name().SetValue(name)
+		f.Params.Key().SetValue(key)
+		f.Params.Value().SetValue(value)
+		f.Func.TransferIotas(1).Post()
+		require.NoError(t, ctx.Err)
+	}
+
+	setValue("bands", "uk", "Simple Minds")
+	setValue("bands", "us", "Toto")
+	setValue("albums", "uk", "Sparkle in the Rain")
+
+	getValue := func(name, key string) string {
+		v := testwasmlib.ScFuncs.MapOfMapsValue(ctx)
+		v.Params.Name().SetValue(name)
+		v.Params.Key().SetValue(key)
+		v.Func.Call()
+		require.NoError(t, ctx.Err)
+		return v.Results.Value().Value()
+	}
+
+	require.EqualValues(t, "Simple Minds", getValue("bands", "uk"))
+	require.EqualValues(t, "Toto", getValue("bands", "us"))
+	require.EqualValues(t, "Sparkle in the Rain", getValue("albums", "uk"))
+	require.EqualValues(t, "", getValue("albums", "us"))
+
+	// the nested maps can be read natively from the contract state
+	maps := collections.NewNestedMapReadOnly(contractState(ctx), string(testwasmlib.StateMapOfMaps))
+	require.EqualValues(t, "Toto", string(maps.Map([]byte("bands")).MustGetAt([]byte("us"))))
+	require.EqualValues(t, "Sparkle in the Rain", string(maps.Map([]byte("albums")).MustGetAt([]byte("uk"))))
+	require.False(t, maps.Map([]byte("albums")).MustHasAt([]byte("us")))
+}
+
+func TestTaggedValues(t *testing.T) {
+	ctx := setupTest(t)
+
+	user := ctx.NewSoloAgent()
+	addValue := func(tag, value string) {
+		f := testwasmlib.ScFuncs.TaggedValueAdd(ctx.Sign(user))
+		f.Params.Tag().SetValue(tag)
+		f.Params.Value().SetValue(value)
+		f.Func.TransferIotas(1).Post()
+		require.NoError(t, ctx.Err)
+	}
+
+	addValue("band", "Simple Minds")
+	addValue("scottish", "Simple Minds")
+	addValue("band", "Dire Straits")
+
+	v := testwasmlib.ScFuncs.TaggedValues(ctx)
+	v.Params.AgentID().SetValue(user.ScAgentID())
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	values := v.Results.Values()
+	require.EqualValues(t, 2, values.Length())
+	value := values.GetTaggedValue(0).Value()
+	require.EqualValues(t, []string{"band", "scottish"}, value.Tags)
+	require.EqualValues(t, "Simple Minds", value.Value)
+	value = values.GetTaggedValue(1).Value()
+	require.EqualValues(t, []string{"band"}, value.Tags)
+	require.EqualValues(t, "Dire Straits", value.Value)
+
+	// the result array can be read natively in the nested layout
+	res, err := ctx.Chain.CallView(testwasmlib.ScName, testwasmlib.ViewTaggedValues,
+		string(testwasmlib.ParamAgentID), user.ScAgentID().Bytes())
+	require.NoError(t, err)
+	array := collections.NewNestedArrayReadOnly(res, string(testwasmlib.ResultValues))
+	require.EqualValues(t, 2, array.MustLen())
+	value = testwasmlib.NewTaggedValueFromBytes(array.MustGetAt(1))
+	require.EqualValues(t, "Dire Straits", value.Value)
+
+	// and so can the map of struct arrays in the contract state
+	tagged := collections.NewNestedMapReadOnly(contractState(ctx), string(testwasmlib.StateTaggedValues))
+	array = tagged.Array(user.ScAgentID().Bytes())
+	require.EqualValues(t, 2, array.MustLen())
+	value = testwasmlib.NewTaggedValueFromBytes(array.MustGetAt(0))
+	require.EqualValues(t, []string{"band", "scottish"}, value.Tags)
+
+	// other agents have no tagged values
+	v = testwasmlib.ScFuncs.TaggedValues(ctx)
+	v.Params.AgentID().SetValue(ctx.Originator().ScAgentID())
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 0, v.Results.Values().Length())
+}
+
+func TestOrderedMap(t *testing.T) {
+	ctx := setupTest(t)
+
+	for i := int64(-5); i < 5; i++ {
+		f := testwasmlib.ScFuncs.OrderedSet(ctx)
+		f.Params.Position().SetValue(i * 10)
+		f.Params.Value().SetValue("value" + strconv.FormatInt(i*10, 10))
+		f.Func.TransferIotas(1).Post()
+		require.NoError(t, ctx.Err)
+	}
+
+	f := testwasmlib.ScFuncs.OrderedDelete(ctx)
+	f.Params.Position().SetValue(20)
+	f.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+
+	getPage := func(from, to *int64, reverse bool) ([]int64, *int64) {
+		v := testwasmlib.ScFuncs.OrderedPage(ctx)
+		if from != nil {
+			v.Params.From().SetValue(*from)
+		}
+		if to != nil {
+			v.Params.To().SetValue(*to)
+		}
+		v.Params.Limit().SetValue(4)
+		v.Params.Reverse().SetValue(reverse)
+		v.Func.Call()
+		require.NoError(t, ctx.Err)
+		positions := make([]int64, 0)
+		length := v.Results.Positions().Length()
+		require.EqualValues(t, length, v.Results.Entries().Length())
+		for i := int32(0); i < length; i++ {
+			position := v.Results.Positions().GetInt64(i).Value()
+			require.EqualValues(t, "value"+strconv.FormatInt(position, 10), v.Results.Entries().GetString(i).Value())
+			positions = append(positions, position)
+		}
+		if !v.Results.Cursor().Exists() {
+			return positions, nil
+		}
+		cursor := v.Results.Cursor().Value()
+		return positions, &cursor
+	}
+
+	from := int64(-30)
+	positions, cursor := getPage(&from, nil, false)
+	require.EqualValues(t, []int64{-30, -20, -10, 0}, positions)
+	require.NotNil(t, cursor)
+	require.EqualValues(t, 10, *cursor)
+	positions, cursor = getPage(cursor, nil, false)
+	require.EqualValues(t, []int64{10, 30, 40}, positions)
+	require.Nil(t, cursor)
+
+	positions, cursor = getPage(nil, nil, true)
+	require.EqualValues(t, []int64{40, 30, 10, 0}, positions)
+	require.NotNil(t, cursor)
+	positions, cursor = getPage(nil, cursor, true)
+	require.EqualValues(t, []int64{-10, -20, -30, -40}, positions)
+	require.NotNil(t, cursor)
+	positions, cursor = getPage(nil, cursor, true)
+	require.EqualValues(t, []int64{-50}, positions)
+	require.Nil(t, cursor)
+}
+
 func TestViewBalance(t *testing.T) {
 	ctx := setupTest(t)
 
@@ -255,3 +490,277 @@ func TestViewBalanceWithTokens(t *testing.T) {
 	require.True(t, v.Results.Iotas().Exists())
 	require.EqualValues(t, 42, v.Results.Iotas().Value())
 }
+
+func TestConstraints(t *testing.T) {
+	ctx := setupTest(t)
+	ctx.FuzzConstraints("../schema.yaml", 10)
+}
+
+func TestCounterInvariant(t *testing.T) {
+	ctx := setupTest(t)
+
+	f := testwasmlib.ScFuncs.CounterAdd(ctx)
+	f.Params.Delta().SetValue(3)
+	f.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+
+	f = testwasmlib.ScFuncs.CounterAdd(ctx)
+	f.Params.Delta().SetValue(6)
+	f.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "invalid delta: above maximum")
+
+	f = testwasmlib.ScFuncs.CounterAdd(ctx)
+	f.Params.Delta().SetValue(-5)
+	f.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "invariant violated: counter")
+
+	v := testwasmlib.ScFuncs.CounterValue(ctx)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 3, v.Results.Counter().Value())
+}
+
+func TestAdmins(t *testing.T) {
+	ctx := setupTest(t)
+	admin := ctx.NewSoloAgent()
+
+	f := testwasmlib.ScFuncs.CounterAdd(ctx.Sign(admin))
+	f.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "no permission")
+
+	a := testwasmlib.ScFuncs.AdminSet(ctx.Sign(admin))
+	a.Params.AgentID().SetValue(admin.ScAgentID())
+	a.Params.Enabled().SetValue(true)
+	a.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	require.Contains(t, ctx.Err.Error(), "no permission")
+
+	a = testwasmlib.ScFuncs.AdminSet(ctx.Sign(ctx.Creator()))
+	a.Params.AgentID().SetValue(admin.ScAgentID())
+	a.Params.Enabled().SetValue(true)
+	a.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+
+	f = testwasmlib.ScFuncs.CounterAdd(ctx.Sign(admin))
+	f.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+
+	v := testwasmlib.ScFuncs.CounterValue(ctx)
+	v.Func.Call()
+	require.NoError(t, ctx.Err)
+	require.EqualValues(t, 1, v.Results.Counter().Value())
+}
+
+func setupRemoteTest(t *testing.T) (*wasmsolo.SoloContext, *wasmsolo.SoloContext) {
+	chain1 := wasmsolo.StartChain(t, "chain1")
+	chain2 := wasmsolo.StartChain(t, "chain2", chain1.Env)
+	ctx1 := wasmsolo.NewSoloContextForChain(t, chain1, nil, testwasmlib.ScName, testwasmlib.OnLoad)
+	require.NoError(t, ctx1.Err)
+	ctx2 := wasmsolo.NewSoloContextForChain(t, chain2, nil, testwasmlib.ScName, testwasmlib.OnLoad)
+	require.NoError(t, ctx2.Err)
+	return ctx1, ctx2
+}
+
+func remoteCall(ctx *wasmsolo.SoloContext, chainID wasmlib.ScChainID, fail bool, timeout int32) int64 {
+	f := testwasmlib.ScFuncs.RemoteCall(ctx)
+	f.Params.ChainID().SetValue(chainID)
+	f.Params.Fail().SetValue(fail)
+	f.Params.Timeout().SetValue(timeout)
+	f.Func.TransferIotas(10).Post()
+	require.NoError(ctx.Chain.Env.T, ctx.Err)
+	return f.Results.CallID().Value()
+}
+
+func remoteStatus(ctx *wasmsolo.SoloContext) string {
+	v := testwasmlib.ScFuncs.RemoteStatus(ctx)
+	v.Func.Call()
+	require.NoError(ctx.Chain.Env.T, ctx.Err)
+	return v.Results.Status().Value()
+}
+
+func TestRemoteCall(t *testing.T) {
+	ctx1, ctx2 := setupRemoteTest(t)
+
+	callID := remoteCall(ctx1, ctx2.ChainID(), false, 0)
+	require.EqualValues(t, 1, callID)
+	require.EqualValues(t, "pending", remoteStatus(ctx1))
+	require.EqualValues(t, 10-2, ctx1.Balance(ctx1.Account()))
+
+	require.True(t, ctx2.WaitForPendingRequests(1))
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	require.EqualValues(t, "ok: remote value", remoteStatus(ctx1))
+
+	// the remote function keeps one iota, the other one carried the outcome back
+	require.EqualValues(t, 10-2+1, ctx1.Balance(ctx1.Account()))
+	require.EqualValues(t, 1, ctx2.Balance(ctx2.Account()))
+	require.EqualValues(t, 0, pendingCallbacks(ctx1))
+}
+
+func TestRemoteCallFailure(t *testing.T) {
+	ctx1, ctx2 := setupRemoteTest(t)
+
+	callID := remoteCall(ctx1, ctx2.ChainID(), true, 0)
+	require.EqualValues(t, 1, callID)
+
+	require.True(t, ctx2.WaitForPendingRequests(1))
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	status := remoteStatus(ctx1)
+	require.True(t, strings.HasPrefix(status, "failed: "))
+	require.Contains(t, status, "remote failure")
+
+	// the outcome carries back the refund
+	require.EqualValues(t, 10, ctx1.Balance(ctx1.Account()))
+	require.EqualValues(t, 0, ctx2.Balance(ctx2.Account()))
+}
+
+func TestRemoteCallTimeout(t *testing.T) {
+	ctx := setupTest(t)
+
+	// requests to an unknown chain are never processed
+	unknownChain := ctx.Convertor.ScChainID(iscp.RandomChainID())
+	callID := remoteCall(ctx, unknownChain, false, 60)
+	require.EqualValues(t, 1, callID)
+	require.EqualValues(t, 10-2-1, ctx.Balance(ctx.Account()))
+
+	require.False(t, ctx.WaitForPendingRequests(1, 100*time.Millisecond))
+	require.EqualValues(t, "pending", remoteStatus(ctx))
+
+	ctx.AdvanceClockBy(61 * time.Second)
+	require.True(t, ctx.WaitForPendingRequests(1))
+	require.EqualValues(t, "failed: timeout", remoteStatus(ctx))
+	require.EqualValues(t, 10-2-1+1, ctx.Balance(ctx.Account()))
+}
+
+func TestRemoteCallLateResult(t *testing.T) {
+	ctx1, ctx2 := setupRemoteTest(t)
+
+	remoteCall(ctx1, ctx2.ChainID(), false, 60)
+	require.True(t, ctx2.WaitForPendingRequests(1))
+
+	// the pending timeout request keeps the mempool of chain1 from
+	// draining, so poll for the outcome instead
+	for i := 0; i < 500 && remoteStatus(ctx1) == "pending"; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.EqualValues(t, "ok: remote value", remoteStatus(ctx1))
+
+	require.EqualValues(t, 0, pendingCallbacks(ctx1))
+
+	// the timeout fires after the outcome arrived and is ignored
+	ctx1.AdvanceClockBy(61 * time.Second)
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	require.EqualValues(t, "ok: remote value", remoteStatus(ctx1))
+	require.EqualValues(t, 0, pendingCallbacks(ctx1))
+}
+
+func TestRemoteCallTimeoutThenLateResult(t *testing.T) {
+	ctx1, ctx2 := setupRemoteTest(t)
+
+	// keep chain2 from admitting the call until the timeout has fired
+	ctx2.Chain.GlobalSync.InvalidateSolidIndex()
+	remoteCall(ctx1, ctx2.ChainID(), false, 60)
+	ctx1.AdvanceClockBy(61 * time.Second)
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	require.EqualValues(t, "failed: timeout", remoteStatus(ctx1))
+	require.EqualValues(t, 10-2-1+1, ctx1.Balance(ctx1.Account()))
+	require.EqualValues(t, 1, pendingCallbacks(ctx1))
+
+	// chain2 does not run the call after its deadline, the late
+	// outcome carries back the refund of the whole transfer
+	ctx2.Chain.GlobalSync.SetSolidIndex(ctx2.Chain.State.BlockIndex())
+	require.True(t, ctx2.WaitForPendingRequests(1))
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	status := remoteStatus(ctx1)
+	require.True(t, strings.HasPrefix(status, "late failed: "))
+	require.Contains(t, status, "deadline passed")
+	require.EqualValues(t, 10-1+1, ctx1.Balance(ctx1.Account()))
+	require.EqualValues(t, 0, ctx2.Balance(ctx2.Account()))
+	require.EqualValues(t, 0, pendingCallbacks(ctx1))
+}
+
+// contractState returns the state partition of the test contract
+func contractState(ctx *wasmsolo.SoloContext) kv.KVStoreReader {
+	return subrealm.NewReadOnly(ctx.Chain.State.KVStoreReader(), kv.Key(iscp.Hn(testwasmlib.ScName).Bytes()))
+}
+
+// pendingCallbacks returns the number of calls that still wait for their outcome
+func pendingCallbacks(ctx *wasmsolo.SoloContext) uint32 {
+	state := contractState(ctx)
+	timeouts := collections.NewOrderedMapReadOnly(state, "$callbacks.timeout").MustLen()
+	pending := collections.NewOrderedMapReadOnly(state, "$callbacks").MustLen()
+	require.LessOrEqual(ctx.Chain.Env.T, timeouts, pending)
+	return pending
+}
+
+func TestRemoteCallMissingTarget(t *testing.T) {
+	ctx1 := setupTest(t)
+	chain2 := wasmsolo.StartChain(t, "chain2", ctx1.Chain.Env)
+
+	// chain2 does not have the target contract
+	callID := remoteCall(ctx1, ctx1.Convertor.ScChainID(chain2.ChainID), false, 0)
+	require.EqualValues(t, 1, callID)
+
+	require.True(t, chain2.WaitForRequestsThrough(1+chain2.MempoolInfo().OutPoolCounter))
+	require.True(t, ctx1.WaitForPendingRequests(1))
+	status := remoteStatus(ctx1)
+	require.True(t, strings.HasPrefix(status, "failed: "))
+	require.Contains(t, status, "contract not found")
+
+	// the outcome carries back the refund
+	require.EqualValues(t, 10, ctx1.Balance(ctx1.Account()))
+}
+
+func TestRaiseError(t *testing.T) {
+	ctx := setupTest(t)
+
+	f := testwasmlib.ScFuncs.RaiseError(ctx)
+	f.Params.Code().SetValue(1234)
+	f.Params.Message().SetValue("something went wrong")
+	f.Params.Detail().SetValue("42")
+	f.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	var vmErr *iscp.VMError
+	require.True(t, xerrors.As(ctx.Err, &vmErr))
+	require.EqualValues(t, 1234, vmErr.Code)
+	require.EqualValues(t, iscp.Hn(testwasmlib.ScName), vmErr.Contract)
+	require.EqualValues(t, "something went wrong", vmErr.Message)
+	require.EqualValues(t, []string{"42"}, vmErr.Params)
+
+	receipt := getReceipt(t, ctx)
+	require.EqualValues(t, vmErr, receipt.Error)
+	require.Nil(t, receipt.Results)
+
+	// codes below VMErrorCodeUser are reserved for the VM
+	f.Params.Code().SetValue(iscp.VMErrorCodeNotAllowed)
+	f.Func.TransferIotas(1).Post()
+	require.Error(t, ctx.Err)
+	require.False(t, xerrors.As(ctx.Err, &vmErr))
+	require.Contains(t, ctx.Err.Error(), "invalid error code")
+}
+
+func TestReceiptResults(t *testing.T) {
+	ctx := setupTest(t)
+
+	f := testwasmlib.ScFuncs.RemoteTarget(ctx)
+	f.Func.TransferIotas(1).Post()
+	require.NoError(t, ctx.Err)
+
+	receipt := getReceipt(t, ctx)
+	require.Nil(t, receipt.Error)
+	require.NotNil(t, receipt.Results)
+	value, err := codec.DecodeString(receipt.Results.MustGet(kv.Key(testwasmlib.ResultValue)))
+	require.NoError(t, err)
+	require.EqualValues(t, f.Results.Value().Value(), value)
+}
+
+func getReceipt(t *testing.T, ctx *wasmsolo.SoloContext) *blocklog.RequestReceipt {
+	reqs, err := ctx.Chain.Env.RequestsForChain(ctx.Tx, ctx.Chain.ChainID)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	receipt, _, _, ok := ctx.Chain.GetRequestReceipt(reqs[0].ID())
+	require.True(t, ok)
+	return receipt
+}
@@ -13,46 +13,115 @@ export const HScName       = new wasmlib.ScHname(0x89703a45);
 
 export const ParamAddress     = "address";
 export const ParamAgentID     = "agentID";
+export const ParamBigInt      = "bigInt";
 export const ParamBlockIndex  = "blockIndex";
+export const ParamBool        = "bool";
 export const ParamBytes       = "bytes";
 export const ParamChainID     = "chainID";
+export const ParamCode        = "code";
 export const ParamColor       = "color";
+export const ParamDelta       = "delta";
+export const ParamDetail      = "detail";
+export const ParamEnabled     = "enabled";
+export const ParamFail        = "fail";
+export const ParamFrom        = "from";
 export const ParamHash        = "hash";
 export const ParamHname       = "hname";
 export const ParamIndex       = "index";
 export const ParamInt16       = "int16";
 export const ParamInt32       = "int32";
 export const ParamInt64       = "int64";
+export const ParamInt8        = "int8";
+export const ParamKey         = "key";
+export const ParamLimit       = "limit";
+export const ParamMessage     = "message";
 export const ParamName        = "name";
+export const ParamPosition    = "position";
 export const ParamRecordIndex = "recordIndex";
 export const ParamRequestID   = "requestID";
+export const ParamReverse     = "reverse";
 export const ParamString      = "string";
+export const ParamTag         = "tag";
+export const ParamTimeout     = "timeout";
+export const ParamTo          = "to";
+export const ParamUint16      = "uint16";
+export const ParamUint32      = "uint32";
+export const ParamUint64      = "uint64";
+export const ParamUint8       = "uint8";
 export const ParamValue       = "value";
+export const ParamValueIndex  = "valueIndex";
 
-export const ResultCount  = "count";
-export const ResultIotas  = "iotas";
-export const ResultLength = "length";
-export const ResultRecord = "record";
-export const ResultValue  = "value";
-
-export const StateArrays = "arrays";
-
-export const FuncArrayClear   = "arrayClear";
-export const FuncArrayCreate  = "arrayCreate";
-export const FuncArraySet     = "arraySet";
-export const FuncParamTypes   = "paramTypes";
-export const ViewArrayLength  = "arrayLength";
-export const ViewArrayValue   = "arrayValue";
-export const ViewBlockRecord  = "blockRecord";
-export const ViewBlockRecords = "blockRecords";
-export const ViewIotaBalance  = "iotaBalance";
-
-export const HFuncArrayClear   = new wasmlib.ScHname(0x88021821);
-export const HFuncArrayCreate  = new wasmlib.ScHname(0x1ed5b23b);
-export const HFuncArraySet     = new wasmlib.ScHname(0x2c4150b3);
-export const HFuncParamTypes   = new wasmlib.ScHname(0x6921c4cd);
-export const HViewArrayLength  = new wasmlib.ScHname(0x3a831021);
-export const HViewArrayValue   = new wasmlib.ScHname(0x662dbd81);
-export const HViewBlockRecord  = new wasmlib.ScHname(0xad13b2f8);
-export const HViewBlockRecords = new wasmlib.ScHname(0x16e249ea);
-export const HViewIotaBalance  = new wasmlib.ScHname(0x9d3920bd);
+export const ResultCallID    = "callID";
+export const ResultCount     = "count";
+export const ResultCounter   = "counter";
+export const ResultCursor    = "cursor";
+export const ResultEntries   = "entries";
+export const ResultIotas     = "iotas";
+export const ResultLength    = "length";
+export const ResultPositions = "positions";
+export const ResultRecord    = "record";
+export const ResultStatus    = "status";
+export const ResultValue     = "value";
+export const ResultValues    = "values";
+
+export const StateAdmins        = "admins";
+export const StateArrayOfArrays = "arrayOfArrays";
+export const StateArrays        = "arrays";
+export const StateCounter       = "counter";
+export const StateMapOfMaps     = "mapOfMaps";
+export const StateRemoteStatus  = "remoteStatus";
+export const StateTaggedValues  = "taggedValues";
+
+export const FuncAdminSet            = "adminSet";
+export const FuncArrayClear          = "arrayClear";
+export const FuncArrayCreate         = "arrayCreate";
+export const FuncArrayOfArraysAppend = "arrayOfArraysAppend";
+export const FuncArraySet            = "arraySet";
+export const FuncCounterAdd          = "counterAdd";
+export const FuncMapOfMapsSet        = "mapOfMapsSet";
+export const FuncOrderedDelete       = "orderedDelete";
+export const FuncOrderedSet          = "orderedSet";
+export const FuncParamTypes          = "paramTypes";
+export const FuncRaiseError          = "raiseError";
+export const FuncRemoteCall          = "remoteCall";
+export const FuncRemoteResult        = "remoteResult";
+export const FuncRemoteTarget        = "remoteTarget";
+export const FuncTaggedValueAdd      = "taggedValueAdd";
+export const ViewArrayLength         = "arrayLength";
+export const ViewArrayOfArraysValue  = "arrayOfArraysValue";
+export const ViewArrayValue          = "arrayValue";
+export const ViewBlockRecord         = "blockRecord";
+export const ViewBlockRecords        = "blockRecords";
+export const ViewCounterValue        = "counterValue";
+export const ViewIotaBalance         = "iotaBalance";
+export const ViewMapOfMapsValue      = "mapOfMapsValue";
+export const ViewOrderedPage         = "orderedPage";
+export const ViewRemoteStatus        = "remoteStatus";
+export const ViewTaggedValues        = "taggedValues";
+
+export const HFuncAdminSet            = new wasmlib.ScHname(0x260fdd12);
+export const HFuncArrayClear          = new wasmlib.ScHname(0x88021821);
+export const HFuncArrayCreate         = new wasmlib.ScHname(0x1ed5b23b);
+export const HFuncArrayOfArraysAppend = new wasmlib.ScHname(0x23f3a17e);
+export const HFuncArraySet            = new wasmlib.ScHname(0x2c4150b3);
+export const HFuncCounterAdd          = new wasmlib.ScHname(0x8b4f54b4);
+export const HFuncMapOfMapsSet        = new wasmlib.ScHname(0x353d577f);
+export const HFuncOrderedDelete       = new wasmlib.ScHname(0x7852a6c2);
+export const HFuncOrderedSet          = new wasmlib.ScHname(0x707c892d);
+export const HFuncParamTypes          = new wasmlib.ScHname(0x6921c4cd);
+export const HFuncRaiseError          = new wasmlib.ScHname(0x2f7b2e65);
+export const HFuncRemoteCall          = new wasmlib.ScHname(0x78b5dce9);
+export const HFuncRemoteResult        = new wasmlib.ScHname(0x5d2ce831);
+export const HFuncRemoteTarget        = new wasmlib.ScHname(0x4f105e06);
+export const HFuncTaggedValueAdd      = new wasmlib.ScHname(0x6c63fbdd);
+export const HViewArrayLength         = new wasmlib.ScHname(0x3a831021);
+export const HViewArrayOfArraysValue  = new wasmlib.ScHname(0x41d5f686);
+export const HViewArrayValue          = new wasmlib.ScHname(0x662dbd81);
+export const HViewBlockRecord         = new wasmlib.ScHname(0xad13b2f8);
+export const HViewBlockRecords        = new wasmlib.ScHname(0x16e249ea);
+export const HViewCounterValue        = new wasmlib.ScHname(0x13c43065);
+export const HViewIotaBalance         = new wasmlib.ScHname(0x9d3920bd);
+export const HViewMapOfMapsValue      = new wasmlib.ScHname(0x476c56e4);
+export const HViewOrderedPage         = new wasmlib.ScHname(0xe932effa);
+export const HViewRemoteStatus        = new wasmlib.ScHname(0xb338b7a2);
+export const HViewTaggedValues        = new wasmlib.ScHname(0x1d470801);
@@ -8,6 +8,16 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class AdminSetCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncAdminSet);
+    params: sc.MutableAdminSetParams = new sc.MutableAdminSetParams();
+}
+
+export class AdminSetContext {
+    params: sc.ImmutableAdminSetParams = new sc.ImmutableAdminSetParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
 export class ArrayClearCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncArrayClear);
     params: sc.MutableArrayClearParams = new sc.MutableArrayClearParams();
@@ -28,6 +38,16 @@ export class ArrayCreateContext {
     state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
 }
 
+export class ArrayOfArraysAppendCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncArrayOfArraysAppend);
+    params: sc.MutableArrayOfArraysAppendParams = new sc.MutableArrayOfArraysAppendParams();
+}
+
+export class ArrayOfArraysAppendContext {
+    params: sc.ImmutableArrayOfArraysAppendParams = new sc.ImmutableArrayOfArraysAppendParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
 export class ArraySetCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncArraySet);
     params: sc.MutableArraySetParams = new sc.MutableArraySetParams();
@@ -38,6 +58,46 @@ export class ArraySetContext {
     state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
 }
 
+export class CounterAddCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncCounterAdd);
+    params: sc.MutableCounterAddParams = new sc.MutableCounterAddParams();
+}
+
+export class CounterAddContext {
+    params: sc.ImmutableCounterAddParams = new sc.ImmutableCounterAddParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class MapOfMapsSetCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncMapOfMapsSet);
+    params: sc.MutableMapOfMapsSetParams = new sc.MutableMapOfMapsSetParams();
+}
+
+export class MapOfMapsSetContext {
+    params: sc.ImmutableMapOfMapsSetParams = new sc.ImmutableMapOfMapsSetParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class OrderedDeleteCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncOrderedDelete);
+    params: sc.MutableOrderedDeleteParams = new sc.MutableOrderedDeleteParams();
+}
+
+export class OrderedDeleteContext {
+    params: sc.ImmutableOrderedDeleteParams = new sc.ImmutableOrderedDeleteParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class OrderedSetCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncOrderedSet);
+    params: sc.MutableOrderedSetParams = new sc.MutableOrderedSetParams();
+}
+
+export class OrderedSetContext {
+    params: sc.ImmutableOrderedSetParams = new sc.ImmutableOrderedSetParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
 export class ParamTypesCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncParamTypes);
     params: sc.MutableParamTypesParams = new sc.MutableParamTypesParams();
@@ -48,6 +108,58 @@ export class ParamTypesContext {
     state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
 }
 
+export class RaiseErrorCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRaiseError);
+    params: sc.MutableRaiseErrorParams = new sc.MutableRaiseErrorParams();
+}
+
+export class RaiseErrorContext {
+    params: sc.ImmutableRaiseErrorParams = new sc.ImmutableRaiseErrorParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class RemoteCallCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRemoteCall);
+    params: sc.MutableRemoteCallParams = new sc.MutableRemoteCallParams();
+    results: sc.ImmutableRemoteCallResults = new sc.ImmutableRemoteCallResults();
+}
+
+export class RemoteCallContext {
+    params: sc.ImmutableRemoteCallParams = new sc.ImmutableRemoteCallParams();
+    results: sc.MutableRemoteCallResults = new sc.MutableRemoteCallResults();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class RemoteResultCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRemoteResult);
+}
+
+export class RemoteResultContext {
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class RemoteTargetCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRemoteTarget);
+    params: sc.MutableRemoteTargetParams = new sc.MutableRemoteTargetParams();
+    results: sc.ImmutableRemoteTargetResults = new sc.ImmutableRemoteTargetResults();
+}
+
+export class RemoteTargetContext {
+    params: sc.ImmutableRemoteTargetParams = new sc.ImmutableRemoteTargetParams();
+    results: sc.MutableRemoteTargetResults = new sc.MutableRemoteTargetResults();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
+export class TaggedValueAddCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncTaggedValueAdd);
+    params: sc.MutableTaggedValueAddParams = new sc.MutableTaggedValueAddParams();
+}
+
+export class TaggedValueAddContext {
+    params: sc.ImmutableTaggedValueAddParams = new sc.ImmutableTaggedValueAddParams();
+    state: sc.MutableTestWasmLibState = new sc.MutableTestWasmLibState();
+}
+
 export class ArrayLengthCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewArrayLength);
     params: sc.MutableArrayLengthParams = new sc.MutableArrayLengthParams();
@@ -60,6 +172,18 @@ export class ArrayLengthContext {
     state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
 }
 
+export class ArrayOfArraysValueCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewArrayOfArraysValue);
+    params: sc.MutableArrayOfArraysValueParams = new sc.MutableArrayOfArraysValueParams();
+    results: sc.ImmutableArrayOfArraysValueResults = new sc.ImmutableArrayOfArraysValueResults();
+}
+
+export class ArrayOfArraysValueContext {
+    params: sc.ImmutableArrayOfArraysValueParams = new sc.ImmutableArrayOfArraysValueParams();
+    results: sc.MutableArrayOfArraysValueResults = new sc.MutableArrayOfArraysValueResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
 export class ArrayValueCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewArrayValue);
     params: sc.MutableArrayValueParams = new sc.MutableArrayValueParams();
@@ -96,6 +220,16 @@ export class BlockRecordsContext {
     state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
 }
 
+export class CounterValueCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewCounterValue);
+    results: sc.ImmutableCounterValueResults = new sc.ImmutableCounterValueResults();
+}
+
+export class CounterValueContext {
+    results: sc.MutableCounterValueResults = new sc.MutableCounterValueResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
 export class IotaBalanceCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewIotaBalance);
     results: sc.ImmutableIotaBalanceResults = new sc.ImmutableIotaBalanceResults();
@@ -106,8 +240,60 @@ export class IotaBalanceContext {
     state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
 }
 
+export class MapOfMapsValueCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewMapOfMapsValue);
+    params: sc.MutableMapOfMapsValueParams = new sc.MutableMapOfMapsValueParams();
+    results: sc.ImmutableMapOfMapsValueResults = new sc.ImmutableMapOfMapsValueResults();
+}
+
+export class MapOfMapsValueContext {
+    params: sc.ImmutableMapOfMapsValueParams = new sc.ImmutableMapOfMapsValueParams();
+    results: sc.MutableMapOfMapsValueResults = new sc.MutableMapOfMapsValueResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
+export class OrderedPageCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewOrderedPage);
+    params: sc.MutableOrderedPageParams = new sc.MutableOrderedPageParams();
+    results: sc.ImmutableOrderedPageResults = new sc.ImmutableOrderedPageResults();
+}
+
+export class OrderedPageContext {
+    params: sc.ImmutableOrderedPageParams = new sc.ImmutableOrderedPageParams();
+    results: sc.MutableOrderedPageResults = new sc.MutableOrderedPageResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
+export class RemoteStatusCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewRemoteStatus);
+    results: sc.ImmutableRemoteStatusResults = new sc.ImmutableRemoteStatusResults();
+}
+
+export class RemoteStatusContext {
+    results: sc.MutableRemoteStatusResults = new sc.MutableRemoteStatusResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
+export class TaggedValuesCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewTaggedValues);
+    params: sc.MutableTaggedValuesParams = new sc.MutableTaggedValuesParams();
+    results: sc.ImmutableTaggedValuesResults = new sc.ImmutableTaggedValuesResults();
+}
+
+export class TaggedValuesContext {
+    params: sc.ImmutableTaggedValuesParams = new sc.ImmutableTaggedValuesParams();
+    results: sc.MutableTaggedValuesResults = new sc.MutableTaggedValuesResults();
+    state: sc.ImmutableTestWasmLibState = new sc.ImmutableTestWasmLibState();
+}
+
 export class ScFuncs {
 
+    static adminSet(ctx: wasmlib.ScFuncCallContext): AdminSetCall {
+        let f = new AdminSetCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static arrayClear(ctx: wasmlib.ScFuncCallContext): ArrayClearCall {
         let f = new ArrayClearCall();
         f.func.setPtrs(f.params, null);
@@ -120,24 +306,89 @@ export class ScFuncs {
         return f;
     }
 
+    static arrayOfArraysAppend(ctx: wasmlib.ScFuncCallContext): ArrayOfArraysAppendCall {
+        let f = new ArrayOfArraysAppendCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static arraySet(ctx: wasmlib.ScFuncCallContext): ArraySetCall {
         let f = new ArraySetCall();
         f.func.setPtrs(f.params, null);
         return f;
     }
 
+    static counterAdd(ctx: wasmlib.ScFuncCallContext): CounterAddCall {
+        let f = new CounterAddCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static mapOfMapsSet(ctx: wasmlib.ScFuncCallContext): MapOfMapsSetCall {
+        let f = new MapOfMapsSetCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static orderedDelete(ctx: wasmlib.ScFuncCallContext): OrderedDeleteCall {
+        let f = new OrderedDeleteCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static orderedSet(ctx: wasmlib.ScFuncCallContext): OrderedSetCall {
+        let f = new OrderedSetCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static paramTypes(ctx: wasmlib.ScFuncCallContext): ParamTypesCall {
         let f = new ParamTypesCall();
         f.func.setPtrs(f.params, null);
         return f;
     }
 
+    static raiseError(ctx: wasmlib.ScFuncCallContext): RaiseErrorCall {
+        let f = new RaiseErrorCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static remoteCall(ctx: wasmlib.ScFuncCallContext): RemoteCallCall {
+        let f = new RemoteCallCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static remoteResult(ctx: wasmlib.ScFuncCallContext): RemoteResultCall {
+        let f = new RemoteResultCall();
+        return f;
+    }
+
+    static remoteTarget(ctx: wasmlib.ScFuncCallContext): RemoteTargetCall {
+        let f = new RemoteTargetCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static taggedValueAdd(ctx: wasmlib.ScFuncCallContext): TaggedValueAddCall {
+        let f = new TaggedValueAddCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static arrayLength(ctx: wasmlib.ScViewCallContext): ArrayLengthCall {
         let f = new ArrayLengthCall();
         f.func.setPtrs(f.params, f.results);
         return f;
     }
 
+    static arrayOfArraysValue(ctx: wasmlib.ScViewCallContext): ArrayOfArraysValueCall {
+        let f = new ArrayOfArraysValueCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static arrayValue(ctx: wasmlib.ScViewCallContext): ArrayValueCall {
         let f = new ArrayValueCall();
         f.func.setPtrs(f.params, f.results);
@@ -156,9 +407,39 @@ export class ScFuncs {
         return f;
     }
 
+    static counterValue(ctx: wasmlib.ScViewCallContext): CounterValueCall {
+        let f = new CounterValueCall();
+        f.func.setPtrs(null, f.results);
+        return f;
+    }
+
     static iotaBalance(ctx: wasmlib.ScViewCallContext): IotaBalanceCall {
         let f = new IotaBalanceCall();
         f.func.setPtrs(null, f.results);
         return f;
     }
+
+    static mapOfMapsValue(ctx: wasmlib.ScViewCallContext): MapOfMapsValueCall {
+        let f = new MapOfMapsValueCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static orderedPage(ctx: wasmlib.ScViewCallContext): OrderedPageCall {
+        let f = new OrderedPageCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static remoteStatus(ctx: wasmlib.ScViewCallContext): RemoteStatusCall {
+        let f = new RemoteStatusCall();
+        f.func.setPtrs(null, f.results);
+        return f;
+    }
+
+    static taggedValues(ctx: wasmlib.ScViewCallContext): TaggedValuesCall {
+        let f = new TaggedValuesCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
 }
@@ -14,4 +14,5 @@ export * from "./lib";
 export * from "./params";
 export * from "./results";
 export * from "./state";
+export * from "./structs";
 export * from "./typedefs";
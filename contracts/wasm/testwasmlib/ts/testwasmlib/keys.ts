@@ -8,54 +8,124 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
-export const IdxParamAddress     = 0;
-export const IdxParamAgentID     = 1;
-export const IdxParamBlockIndex  = 2;
-export const IdxParamBytes       = 3;
-export const IdxParamChainID     = 4;
-export const IdxParamColor       = 5;
-export const IdxParamHash        = 6;
-export const IdxParamHname       = 7;
-export const IdxParamIndex       = 8;
-export const IdxParamInt16       = 9;
-export const IdxParamInt32       = 10;
-export const IdxParamInt64       = 11;
-export const IdxParamName        = 12;
-export const IdxParamRecordIndex = 13;
-export const IdxParamRequestID   = 14;
-export const IdxParamString      = 15;
-export const IdxParamValue       = 16;
-export const IdxResultCount      = 17;
-export const IdxResultIotas      = 18;
-export const IdxResultLength     = 19;
-export const IdxResultRecord     = 20;
-export const IdxResultValue      = 21;
-export const IdxStateArrays      = 22;
+export const IdxParamAddress       = 0;
+export const IdxParamAgentID       = 1;
+export const IdxParamBigInt        = 2;
+export const IdxParamBlockIndex    = 3;
+export const IdxParamBool          = 4;
+export const IdxParamBytes         = 5;
+export const IdxParamChainID       = 6;
+export const IdxParamCode          = 7;
+export const IdxParamColor         = 8;
+export const IdxParamDelta         = 9;
+export const IdxParamDetail        = 10;
+export const IdxParamEnabled       = 11;
+export const IdxParamFail          = 12;
+export const IdxParamFrom          = 13;
+export const IdxParamHash          = 14;
+export const IdxParamHname         = 15;
+export const IdxParamIndex         = 16;
+export const IdxParamInt16         = 17;
+export const IdxParamInt32         = 18;
+export const IdxParamInt64         = 19;
+export const IdxParamInt8          = 20;
+export const IdxParamKey           = 21;
+export const IdxParamLimit         = 22;
+export const IdxParamMessage       = 23;
+export const IdxParamName          = 24;
+export const IdxParamPosition      = 25;
+export const IdxParamRecordIndex   = 26;
+export const IdxParamRequestID     = 27;
+export const IdxParamReverse       = 28;
+export const IdxParamString        = 29;
+export const IdxParamTag           = 30;
+export const IdxParamTimeout       = 31;
+export const IdxParamTo            = 32;
+export const IdxParamUint16        = 33;
+export const IdxParamUint32        = 34;
+export const IdxParamUint64        = 35;
+export const IdxParamUint8         = 36;
+export const IdxParamValue         = 37;
+export const IdxParamValueIndex    = 38;
+export const IdxResultCallID       = 39;
+export const IdxResultCount        = 40;
+export const IdxResultCounter      = 41;
+export const IdxResultCursor       = 42;
+export const IdxResultEntries      = 43;
+export const IdxResultIotas        = 44;
+export const IdxResultLength       = 45;
+export const IdxResultPositions    = 46;
+export const IdxResultRecord       = 47;
+export const IdxResultStatus       = 48;
+export const IdxResultValue        = 49;
+export const IdxResultValues       = 50;
+export const IdxStateAdmins        = 51;
+export const IdxStateArrayOfArrays = 52;
+export const IdxStateArrays        = 53;
+export const IdxStateCounter       = 54;
+export const IdxStateMapOfMaps     = 55;
+export const IdxStateRemoteStatus  = 56;
+export const IdxStateTaggedValues  = 57;
 
 export let keyMap: string[] = [
     sc.ParamAddress,
     sc.ParamAgentID,
+    sc.ParamBigInt,
     sc.ParamBlockIndex,
+    sc.ParamBool,
     sc.ParamBytes,
     sc.ParamChainID,
+    sc.ParamCode,
     sc.ParamColor,
+    sc.ParamDelta,
+    sc.ParamDetail,
+    sc.ParamEnabled,
+    sc.ParamFail,
+    sc.ParamFrom,
     sc.ParamHash,
     sc.ParamHname,
     sc.ParamIndex,
     sc.ParamInt16,
     sc.ParamInt32,
     sc.ParamInt64,
+    sc.ParamInt8,
+    sc.ParamKey,
+    sc.ParamLimit,
+    sc.ParamMessage,
     sc.ParamName,
+    sc.ParamPosition,
     sc.ParamRecordIndex,
     sc.ParamRequestID,
+    sc.ParamReverse,
     sc.ParamString,
+    sc.ParamTag,
+    sc.ParamTimeout,
+    sc.ParamTo,
+    sc.ParamUint16,
+    sc.ParamUint32,
+    sc.ParamUint64,
+    sc.ParamUint8,
     sc.ParamValue,
+    sc.ParamValueIndex,
+    sc.ResultCallID,
     sc.ResultCount,
+    sc.ResultCounter,
+    sc.ResultCursor,
+    sc.ResultEntries,
     sc.ResultIotas,
     sc.ResultLength,
+    sc.ResultPositions,
     sc.ResultRecord,
+    sc.ResultStatus,
     sc.ResultValue,
+    sc.ResultValues,
+    sc.StateAdmins,
+    sc.StateArrayOfArrays,
     sc.StateArrays,
+    sc.StateCounter,
+    sc.StateMapOfMaps,
+    sc.StateRemoteStatus,
+    sc.StateTaggedValues,
 ];
 
 export let idxMap: wasmlib.Key32[] = new Array(keyMap.length);
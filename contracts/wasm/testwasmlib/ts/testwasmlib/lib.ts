@@ -14,21 +14,55 @@ export function on_call(index: i32): void {
 
 export function on_load(): void {
     let exports = new wasmlib.ScExports();
+    exports.addFunc(sc.FuncAdminSet, funcAdminSetThunk);
     exports.addFunc(sc.FuncArrayClear, funcArrayClearThunk);
     exports.addFunc(sc.FuncArrayCreate, funcArrayCreateThunk);
+    exports.addFunc(sc.FuncArrayOfArraysAppend, funcArrayOfArraysAppendThunk);
     exports.addFunc(sc.FuncArraySet, funcArraySetThunk);
+    exports.addFunc(sc.FuncCounterAdd, funcCounterAddThunk);
+    exports.addFunc(sc.FuncMapOfMapsSet, funcMapOfMapsSetThunk);
+    exports.addFunc(sc.FuncOrderedDelete, funcOrderedDeleteThunk);
+    exports.addFunc(sc.FuncOrderedSet, funcOrderedSetThunk);
     exports.addFunc(sc.FuncParamTypes, funcParamTypesThunk);
+    exports.addFunc(sc.FuncRaiseError, funcRaiseErrorThunk);
+    exports.addFunc(sc.FuncRemoteCall, funcRemoteCallThunk);
+    exports.addFunc(sc.FuncRemoteResult, funcRemoteResultThunk);
+    exports.addFunc(sc.FuncRemoteTarget, funcRemoteTargetThunk);
+    exports.addFunc(sc.FuncTaggedValueAdd, funcTaggedValueAddThunk);
     exports.addView(sc.ViewArrayLength, viewArrayLengthThunk);
+    exports.addView(sc.ViewArrayOfArraysValue, viewArrayOfArraysValueThunk);
     exports.addView(sc.ViewArrayValue, viewArrayValueThunk);
     exports.addView(sc.ViewBlockRecord, viewBlockRecordThunk);
     exports.addView(sc.ViewBlockRecords, viewBlockRecordsThunk);
+    exports.addView(sc.ViewCounterValue, viewCounterValueThunk);
     exports.addView(sc.ViewIotaBalance, viewIotaBalanceThunk);
+    exports.addView(sc.ViewMapOfMapsValue, viewMapOfMapsValueThunk);
+    exports.addView(sc.ViewOrderedPage, viewOrderedPageThunk);
+    exports.addView(sc.ViewRemoteStatus, viewRemoteStatusThunk);
+    exports.addView(sc.ViewTaggedValues, viewTaggedValuesThunk);
 
     for (let i = 0; i < sc.keyMap.length; i++) {
         sc.idxMap[i] = wasmlib.Key32.fromString(sc.keyMap[i]);
     }
 }
 
+function funcAdminSetThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcAdminSet");
+    // only SC creator can appoint admins
+    ctx.require(ctx.caller().equals(ctx.contractCreator()), "no permission");
+
+    let f = new sc.AdminSetContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.agentID().exists(), "missing mandatory agentID")
+    ctx.require(f.params.enabled().exists(), "missing mandatory enabled")
+    sc.funcAdminSet(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcAdminSet ok");
+}
+
 function funcArrayClearThunk(ctx: wasmlib.ScFuncContext): void {
     ctx.log("testwasmlib.funcArrayClear");
     let f = new sc.ArrayClearContext();
@@ -36,6 +70,9 @@ function funcArrayClearThunk(ctx: wasmlib.ScFuncContext): void {
     f.state.mapID = wasmlib.OBJ_ID_STATE;
     ctx.require(f.params.name().exists(), "missing mandatory name")
     sc.funcArrayClear(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArrayClear ok");
 }
 
@@ -46,9 +83,26 @@ function funcArrayCreateThunk(ctx: wasmlib.ScFuncContext): void {
     f.state.mapID = wasmlib.OBJ_ID_STATE;
     ctx.require(f.params.name().exists(), "missing mandatory name")
     sc.funcArrayCreate(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArrayCreate ok");
 }
 
+function funcArrayOfArraysAppendThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcArrayOfArraysAppend");
+    let f = new sc.ArrayOfArraysAppendContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.index().exists(), "missing mandatory index")
+    ctx.require(f.params.value().exists(), "missing mandatory value")
+    sc.funcArrayOfArraysAppend(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcArrayOfArraysAppend ok");
+}
+
 function funcArraySetThunk(ctx: wasmlib.ScFuncContext): void {
     ctx.log("testwasmlib.funcArraySet");
     let f = new sc.ArraySetContext();
@@ -57,19 +111,155 @@ function funcArraySetThunk(ctx: wasmlib.ScFuncContext): void {
     ctx.require(f.params.index().exists(), "missing mandatory index")
     ctx.require(f.params.name().exists(), "missing mandatory name")
     ctx.require(f.params.value().exists(), "missing mandatory value")
+    ctx.require(f.params.index().value() >= 0, "invalid index: below minimum");
+    ctx.require(wasmlib.Convert.fromString(f.params.name().value()).length >= 1, "invalid name: too short");
+    ctx.require(wasmlib.Convert.fromString(f.params.name().value()).length <= 32, "invalid name: too long");
     sc.funcArraySet(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcArraySet ok");
 }
 
+function funcCounterAddThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcCounterAdd");
+    ctx.require(ctx.caller().equals(ctx.contractCreator()) ||
+        ctx.state().getMap(wasmlib.Key32.fromString("admins")).getBool(ctx.caller()).value(),
+        "no permission");
+
+    let f = new sc.CounterAddContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    if (f.params.delta().exists()) {
+        ctx.require(f.params.delta().value() >= -5, "invalid delta: below minimum");
+        ctx.require(f.params.delta().value() <= 5, "invalid delta: above maximum");
+    }
+    sc.funcCounterAdd(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcCounterAdd ok");
+}
+
+function funcMapOfMapsSetThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcMapOfMapsSet");
+    let f = new sc.MapOfMapsSetContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.key().exists(), "missing mandatory key")
+    ctx.require(f.params.name().exists(), "missing mandatory name")
+    ctx.require(f.params.value().exists(), "missing mandatory value")
+    sc.funcMapOfMapsSet(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcMapOfMapsSet ok");
+}
+
+function funcOrderedDeleteThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcOrderedDelete");
+    let f = new sc.OrderedDeleteContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.position().exists(), "missing mandatory position")
+    sc.funcOrderedDelete(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcOrderedDelete ok");
+}
+
+function funcOrderedSetThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcOrderedSet");
+    let f = new sc.OrderedSetContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.position().exists(), "missing mandatory position")
+    ctx.require(f.params.value().exists(), "missing mandatory value")
+    sc.funcOrderedSet(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcOrderedSet ok");
+}
+
 function funcParamTypesThunk(ctx: wasmlib.ScFuncContext): void {
     ctx.log("testwasmlib.funcParamTypes");
     let f = new sc.ParamTypesContext();
     f.params.mapID = wasmlib.OBJ_ID_PARAMS;
     f.state.mapID = wasmlib.OBJ_ID_STATE;
     sc.funcParamTypes(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
     ctx.log("testwasmlib.funcParamTypes ok");
 }
 
+function funcRaiseErrorThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcRaiseError");
+    let f = new sc.RaiseErrorContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.code().exists(), "missing mandatory code")
+    ctx.require(f.params.message().exists(), "missing mandatory message")
+    sc.funcRaiseError(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRaiseError ok");
+}
+
+function funcRemoteCallThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcRemoteCall");
+    let f = new sc.RemoteCallContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    sc.funcRemoteCall(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteCall ok");
+}
+
+function funcRemoteResultThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcRemoteResult");
+    let f = new sc.RemoteResultContext();
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    sc.funcRemoteResult(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteResult ok");
+}
+
+function funcRemoteTargetThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcRemoteTarget");
+    let f = new sc.RemoteTargetContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    sc.funcRemoteTarget(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcRemoteTarget ok");
+}
+
+function funcTaggedValueAddThunk(ctx: wasmlib.ScFuncContext): void {
+    ctx.log("testwasmlib.funcTaggedValueAdd");
+    let f = new sc.TaggedValueAddContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.tag().exists(), "missing mandatory tag")
+    ctx.require(f.params.value().exists(), "missing mandatory value")
+    sc.funcTaggedValueAdd(ctx, f);
+    if (f.state.counter().exists()) {
+        ctx.require(f.state.counter().value() >= 0, "invariant violated: counter: below minimum");
+    }
+    ctx.log("testwasmlib.funcTaggedValueAdd ok");
+}
+
 function viewArrayLengthThunk(ctx: wasmlib.ScViewContext): void {
     ctx.log("testwasmlib.viewArrayLength");
     let f = new sc.ArrayLengthContext();
@@ -81,6 +271,18 @@ function viewArrayLengthThunk(ctx: wasmlib.ScViewContext): void {
     ctx.log("testwasmlib.viewArrayLength ok");
 }
 
+function viewArrayOfArraysValueThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewArrayOfArraysValue");
+    let f = new sc.ArrayOfArraysValueContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.index().exists(), "missing mandatory index")
+    ctx.require(f.params.valueIndex().exists(), "missing mandatory valueIndex")
+    sc.viewArrayOfArraysValue(ctx, f);
+    ctx.log("testwasmlib.viewArrayOfArraysValue ok");
+}
+
 function viewArrayValueThunk(ctx: wasmlib.ScViewContext): void {
     ctx.log("testwasmlib.viewArrayValue");
     let f = new sc.ArrayValueContext();
@@ -116,6 +318,15 @@ function viewBlockRecordsThunk(ctx: wasmlib.ScViewContext): void {
     ctx.log("testwasmlib.viewBlockRecords ok");
 }
 
+function viewCounterValueThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewCounterValue");
+    let f = new sc.CounterValueContext();
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    sc.viewCounterValue(ctx, f);
+    ctx.log("testwasmlib.viewCounterValue ok");
+}
+
 function viewIotaBalanceThunk(ctx: wasmlib.ScViewContext): void {
     ctx.log("testwasmlib.viewIotaBalance");
     let f = new sc.IotaBalanceContext();
@@ -124,3 +335,48 @@ function viewIotaBalanceThunk(ctx: wasmlib.ScViewContext): void {
     sc.viewIotaBalance(ctx, f);
     ctx.log("testwasmlib.viewIotaBalance ok");
 }
+
+function viewMapOfMapsValueThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewMapOfMapsValue");
+    let f = new sc.MapOfMapsValueContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.key().exists(), "missing mandatory key")
+    ctx.require(f.params.name().exists(), "missing mandatory name")
+    sc.viewMapOfMapsValue(ctx, f);
+    ctx.log("testwasmlib.viewMapOfMapsValue ok");
+}
+
+function viewOrderedPageThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewOrderedPage");
+    let f = new sc.OrderedPageContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.limit().exists(), "missing mandatory limit")
+    ctx.require(f.params.limit().value() >= 1, "invalid limit: below minimum");
+    ctx.require(f.params.limit().value() <= 100, "invalid limit: above maximum");
+    sc.viewOrderedPage(ctx, f);
+    ctx.log("testwasmlib.viewOrderedPage ok");
+}
+
+function viewRemoteStatusThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewRemoteStatus");
+    let f = new sc.RemoteStatusContext();
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    sc.viewRemoteStatus(ctx, f);
+    ctx.log("testwasmlib.viewRemoteStatus ok");
+}
+
+function viewTaggedValuesThunk(ctx: wasmlib.ScViewContext): void {
+    ctx.log("testwasmlib.viewTaggedValues");
+    let f = new sc.TaggedValuesContext();
+    f.params.mapID = wasmlib.OBJ_ID_PARAMS;
+    f.results.mapID = wasmlib.OBJ_ID_RESULTS;
+    f.state.mapID = wasmlib.OBJ_ID_STATE;
+    ctx.require(f.params.agentID().exists(), "missing mandatory agentID")
+    sc.viewTaggedValues(ctx, f);
+    ctx.log("testwasmlib.viewTaggedValues ok");
+}
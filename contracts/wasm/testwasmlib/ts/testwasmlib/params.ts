@@ -8,6 +8,28 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ImmutableAdminSetParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
+    }
+
+    enabled(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, sc.idxMap[sc.IdxParamEnabled]);
+    }
+}
+
+export class MutableAdminSetParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
+    }
+
+    enabled(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, sc.idxMap[sc.IdxParamEnabled]);
+    }
+}
+
 export class ImmutableArrayClearParams extends wasmlib.ScMapID {
 
     name(): wasmlib.ScImmutableString {
@@ -36,6 +58,28 @@ export class MutableArrayCreateParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableArrayOfArraysAppendParams extends wasmlib.ScMapID {
+
+    index(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, sc.idxMap[sc.IdxParamIndex]);
+    }
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
+export class MutableArrayOfArraysAppendParams extends wasmlib.ScMapID {
+
+    index(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamIndex]);
+    }
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
 export class ImmutableArraySetParams extends wasmlib.ScMapID {
 
     index(): wasmlib.ScImmutableInt32 {
@@ -66,6 +110,86 @@ export class MutableArraySetParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableCounterAddParams extends wasmlib.ScMapID {
+
+    delta(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamDelta]);
+    }
+}
+
+export class MutableCounterAddParams extends wasmlib.ScMapID {
+
+    delta(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamDelta]);
+    }
+}
+
+export class ImmutableMapOfMapsSetParams extends wasmlib.ScMapID {
+
+    key(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamKey]);
+    }
+
+    name(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamName]);
+    }
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
+export class MutableMapOfMapsSetParams extends wasmlib.ScMapID {
+
+    key(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamKey]);
+    }
+
+    name(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamName]);
+    }
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
+export class ImmutableOrderedDeleteParams extends wasmlib.ScMapID {
+
+    position(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamPosition]);
+    }
+}
+
+export class MutableOrderedDeleteParams extends wasmlib.ScMapID {
+
+    position(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamPosition]);
+    }
+}
+
+export class ImmutableOrderedSetParams extends wasmlib.ScMapID {
+
+    position(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamPosition]);
+    }
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
+export class MutableOrderedSetParams extends wasmlib.ScMapID {
+
+    position(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamPosition]);
+    }
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
 export class MapStringToImmutableBytes {
     objID: i32;
 
@@ -88,6 +212,14 @@ export class ImmutableParamTypesParams extends wasmlib.ScMapID {
         return new wasmlib.ScImmutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
     }
 
+    bigInt(): wasmlib.ScImmutableBigInt {
+        return new wasmlib.ScImmutableBigInt(this.mapID, sc.idxMap[sc.IdxParamBigInt]);
+    }
+
+    bool(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, sc.idxMap[sc.IdxParamBool]);
+    }
+
     bytes(): wasmlib.ScImmutableBytes {
         return new wasmlib.ScImmutableBytes(this.mapID, sc.idxMap[sc.IdxParamBytes]);
     }
@@ -120,6 +252,10 @@ export class ImmutableParamTypesParams extends wasmlib.ScMapID {
         return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamInt64]);
     }
 
+    int8(): wasmlib.ScImmutableInt8 {
+        return new wasmlib.ScImmutableInt8(this.mapID, sc.idxMap[sc.IdxParamInt8]);
+    }
+
     param(): sc.MapStringToImmutableBytes {
         return new sc.MapStringToImmutableBytes(this.mapID);
     }
@@ -131,6 +267,22 @@ export class ImmutableParamTypesParams extends wasmlib.ScMapID {
     string(): wasmlib.ScImmutableString {
         return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamString]);
     }
+
+    uint16(): wasmlib.ScImmutableUint16 {
+        return new wasmlib.ScImmutableUint16(this.mapID, sc.idxMap[sc.IdxParamUint16]);
+    }
+
+    uint32(): wasmlib.ScImmutableUint32 {
+        return new wasmlib.ScImmutableUint32(this.mapID, sc.idxMap[sc.IdxParamUint32]);
+    }
+
+    uint64(): wasmlib.ScImmutableUint64 {
+        return new wasmlib.ScImmutableUint64(this.mapID, sc.idxMap[sc.IdxParamUint64]);
+    }
+
+    uint8(): wasmlib.ScImmutableUint8 {
+        return new wasmlib.ScImmutableUint8(this.mapID, sc.idxMap[sc.IdxParamUint8]);
+    }
 }
 
 export class MapStringToMutableBytes {
@@ -159,6 +311,14 @@ export class MutableParamTypesParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
     }
 
+    bigInt(): wasmlib.ScMutableBigInt {
+        return new wasmlib.ScMutableBigInt(this.mapID, sc.idxMap[sc.IdxParamBigInt]);
+    }
+
+    bool(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, sc.idxMap[sc.IdxParamBool]);
+    }
+
     bytes(): wasmlib.ScMutableBytes {
         return new wasmlib.ScMutableBytes(this.mapID, sc.idxMap[sc.IdxParamBytes]);
     }
@@ -191,6 +351,10 @@ export class MutableParamTypesParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamInt64]);
     }
 
+    int8(): wasmlib.ScMutableInt8 {
+        return new wasmlib.ScMutableInt8(this.mapID, sc.idxMap[sc.IdxParamInt8]);
+    }
+
     param(): sc.MapStringToMutableBytes {
         return new sc.MapStringToMutableBytes(this.mapID);
     }
@@ -202,6 +366,118 @@ export class MutableParamTypesParams extends wasmlib.ScMapID {
     string(): wasmlib.ScMutableString {
         return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamString]);
     }
+
+    uint16(): wasmlib.ScMutableUint16 {
+        return new wasmlib.ScMutableUint16(this.mapID, sc.idxMap[sc.IdxParamUint16]);
+    }
+
+    uint32(): wasmlib.ScMutableUint32 {
+        return new wasmlib.ScMutableUint32(this.mapID, sc.idxMap[sc.IdxParamUint32]);
+    }
+
+    uint64(): wasmlib.ScMutableUint64 {
+        return new wasmlib.ScMutableUint64(this.mapID, sc.idxMap[sc.IdxParamUint64]);
+    }
+
+    uint8(): wasmlib.ScMutableUint8 {
+        return new wasmlib.ScMutableUint8(this.mapID, sc.idxMap[sc.IdxParamUint8]);
+    }
+}
+
+export class ImmutableRaiseErrorParams extends wasmlib.ScMapID {
+
+    code(): wasmlib.ScImmutableUint16 {
+        return new wasmlib.ScImmutableUint16(this.mapID, sc.idxMap[sc.IdxParamCode]);
+    }
+
+    detail(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamDetail]);
+    }
+
+    message(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamMessage]);
+    }
+}
+
+export class MutableRaiseErrorParams extends wasmlib.ScMapID {
+
+    code(): wasmlib.ScMutableUint16 {
+        return new wasmlib.ScMutableUint16(this.mapID, sc.idxMap[sc.IdxParamCode]);
+    }
+
+    detail(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamDetail]);
+    }
+
+    message(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamMessage]);
+    }
+}
+
+export class ImmutableRemoteCallParams extends wasmlib.ScMapID {
+
+    chainID(): wasmlib.ScImmutableChainID {
+        return new wasmlib.ScImmutableChainID(this.mapID, sc.idxMap[sc.IdxParamChainID]);
+    }
+
+    fail(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, sc.idxMap[sc.IdxParamFail]);
+    }
+
+    timeout(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, sc.idxMap[sc.IdxParamTimeout]);
+    }
+}
+
+export class MutableRemoteCallParams extends wasmlib.ScMapID {
+
+    chainID(): wasmlib.ScMutableChainID {
+        return new wasmlib.ScMutableChainID(this.mapID, sc.idxMap[sc.IdxParamChainID]);
+    }
+
+    fail(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, sc.idxMap[sc.IdxParamFail]);
+    }
+
+    timeout(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamTimeout]);
+    }
+}
+
+export class ImmutableRemoteTargetParams extends wasmlib.ScMapID {
+
+    fail(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, sc.idxMap[sc.IdxParamFail]);
+    }
+}
+
+export class MutableRemoteTargetParams extends wasmlib.ScMapID {
+
+    fail(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, sc.idxMap[sc.IdxParamFail]);
+    }
+}
+
+export class ImmutableTaggedValueAddParams extends wasmlib.ScMapID {
+
+    tag(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamTag]);
+    }
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
+}
+
+export class MutableTaggedValueAddParams extends wasmlib.ScMapID {
+
+    tag(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamTag]);
+    }
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamValue]);
+    }
 }
 
 export class ImmutableArrayLengthParams extends wasmlib.ScMapID {
@@ -218,6 +494,28 @@ export class MutableArrayLengthParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableArrayOfArraysValueParams extends wasmlib.ScMapID {
+
+    index(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, sc.idxMap[sc.IdxParamIndex]);
+    }
+
+    valueIndex(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, sc.idxMap[sc.IdxParamValueIndex]);
+    }
+}
+
+export class MutableArrayOfArraysValueParams extends wasmlib.ScMapID {
+
+    index(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamIndex]);
+    }
+
+    valueIndex(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamValueIndex]);
+    }
+}
+
 export class ImmutableArrayValueParams extends wasmlib.ScMapID {
 
     index(): wasmlib.ScImmutableInt32 {
@@ -275,3 +573,77 @@ export class MutableBlockRecordsParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamBlockIndex]);
     }
 }
+
+export class ImmutableMapOfMapsValueParams extends wasmlib.ScMapID {
+
+    key(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamKey]);
+    }
+
+    name(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxParamName]);
+    }
+}
+
+export class MutableMapOfMapsValueParams extends wasmlib.ScMapID {
+
+    key(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamKey]);
+    }
+
+    name(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxParamName]);
+    }
+}
+
+export class ImmutableOrderedPageParams extends wasmlib.ScMapID {
+
+    from(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamFrom]);
+    }
+
+    limit(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, sc.idxMap[sc.IdxParamLimit]);
+    }
+
+    reverse(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, sc.idxMap[sc.IdxParamReverse]);
+    }
+
+    to(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxParamTo]);
+    }
+}
+
+export class MutableOrderedPageParams extends wasmlib.ScMapID {
+
+    from(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamFrom]);
+    }
+
+    limit(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, sc.idxMap[sc.IdxParamLimit]);
+    }
+
+    reverse(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, sc.idxMap[sc.IdxParamReverse]);
+    }
+
+    to(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxParamTo]);
+    }
+}
+
+export class ImmutableTaggedValuesParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
+    }
+}
+
+export class MutableTaggedValuesParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, sc.idxMap[sc.IdxParamAgentID]);
+    }
+}
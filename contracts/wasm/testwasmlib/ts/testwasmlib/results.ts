@@ -8,6 +8,34 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ImmutableRemoteCallResults extends wasmlib.ScMapID {
+
+    callID(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxResultCallID]);
+    }
+}
+
+export class MutableRemoteCallResults extends wasmlib.ScMapID {
+
+    callID(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxResultCallID]);
+    }
+}
+
+export class ImmutableRemoteTargetResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
+export class MutableRemoteTargetResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
 export class ImmutableArrayLengthResults extends wasmlib.ScMapID {
 
     length(): wasmlib.ScImmutableInt32 {
@@ -22,6 +50,20 @@ export class MutableArrayLengthResults extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableArrayOfArraysValueResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
+export class MutableArrayOfArraysValueResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
 export class ImmutableArrayValueResults extends wasmlib.ScMapID {
 
     value(): wasmlib.ScImmutableString {
@@ -64,6 +106,20 @@ export class MutableBlockRecordsResults extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableCounterValueResults extends wasmlib.ScMapID {
+
+    counter(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxResultCounter]);
+    }
+}
+
+export class MutableCounterValueResults extends wasmlib.ScMapID {
+
+    counter(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxResultCounter]);
+    }
+}
+
 export class ImmutableIotaBalanceResults extends wasmlib.ScMapID {
 
     iotas(): wasmlib.ScImmutableInt64 {
@@ -77,3 +133,117 @@ export class MutableIotaBalanceResults extends wasmlib.ScMapID {
         return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxResultIotas]);
     }
 }
+
+export class ImmutableMapOfMapsValueResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
+export class MutableMapOfMapsValueResults extends wasmlib.ScMapID {
+
+    value(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxResultValue]);
+    }
+}
+
+export class ArrayOfImmutableInt64 {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getInt64(index: i32): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class ImmutableOrderedPageResults extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxResultCursor]);
+    }
+
+    entries(): sc.ArrayOfImmutableString {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultEntries], wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING);
+        return new sc.ArrayOfImmutableString(arrID)
+    }
+
+    positions(): sc.ArrayOfImmutableInt64 {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultPositions], wasmlib.TYPE_ARRAY|wasmlib.TYPE_INT64);
+        return new sc.ArrayOfImmutableInt64(arrID)
+    }
+}
+
+export class ArrayOfMutableInt64 {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID);
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getInt64(index: i32): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class MutableOrderedPageResults extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxResultCursor]);
+    }
+
+    entries(): sc.ArrayOfMutableString {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultEntries], wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING);
+        return new sc.ArrayOfMutableString(arrID)
+    }
+
+    positions(): sc.ArrayOfMutableInt64 {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultPositions], wasmlib.TYPE_ARRAY|wasmlib.TYPE_INT64);
+        return new sc.ArrayOfMutableInt64(arrID)
+    }
+}
+
+export class ImmutableRemoteStatusResults extends wasmlib.ScMapID {
+
+    status(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxResultStatus]);
+    }
+}
+
+export class MutableRemoteStatusResults extends wasmlib.ScMapID {
+
+    status(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxResultStatus]);
+    }
+}
+
+export class ImmutableTaggedValuesResults extends wasmlib.ScMapID {
+
+    values(): sc.ArrayOfImmutableTaggedValue {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultValues], wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES);
+        return new sc.ArrayOfImmutableTaggedValue(arrID)
+    }
+}
+
+export class MutableTaggedValuesResults extends wasmlib.ScMapID {
+
+    values(): sc.ArrayOfMutableTaggedValue {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxResultValues], wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES);
+        return new sc.ArrayOfMutableTaggedValue(arrID)
+    }
+}
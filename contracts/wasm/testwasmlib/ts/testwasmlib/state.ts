@@ -8,6 +8,35 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class MapAgentIDToImmutableBool {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    getBool(key: wasmlib.ScAgentID): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.objID, key.getKeyID());
+    }
+}
+
+export class ArrayOfImmutableStringArray {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getStringArray(index: i32): sc.ImmutableStringArray {
+        let subID = wasmlib.getObjectID(this.objID, new wasmlib.Key32(index), wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING);
+        return new sc.ImmutableStringArray(subID);
+    }
+}
+
 export class MapStringToImmutableStringArray {
     objID: i32;
 
@@ -21,12 +50,103 @@ export class MapStringToImmutableStringArray {
     }
 }
 
+export class MapStringToImmutableMapStringToString {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    getMapStringToString(key: string): sc.ImmutableMapStringToString {
+        let subID = wasmlib.getObjectID(this.objID, wasmlib.Key32.fromString(key).getKeyID(), wasmlib.TYPE_MAP);
+        return new sc.ImmutableMapStringToString(subID);
+    }
+}
+
+export class MapAgentIDToImmutableTaggedValueArray {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    getTaggedValueArray(key: wasmlib.ScAgentID): sc.ImmutableTaggedValueArray {
+        let subID = wasmlib.getObjectID(this.objID, key.getKeyID(), wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES);
+        return new sc.ImmutableTaggedValueArray(subID);
+    }
+}
+
 export class ImmutableTestWasmLibState extends wasmlib.ScMapID {
 
+    admins(): sc.MapAgentIDToImmutableBool {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateAdmins], wasmlib.TYPE_MAP);
+        return new sc.MapAgentIDToImmutableBool(mapID);
+    }
+
+    arrayOfArrays(): sc.ArrayOfImmutableStringArray {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateArrayOfArrays], wasmlib.TYPE_ARRAY|wasmlib.TYPE_MAP);
+        return new sc.ArrayOfImmutableStringArray(arrID)
+    }
+
     arrays(): sc.MapStringToImmutableStringArray {
         let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateArrays], wasmlib.TYPE_MAP);
         return new sc.MapStringToImmutableStringArray(mapID);
     }
+
+    counter(): wasmlib.ScImmutableInt64 {
+        return new wasmlib.ScImmutableInt64(this.mapID, sc.idxMap[sc.IdxStateCounter]);
+    }
+
+    mapOfMaps(): sc.MapStringToImmutableMapStringToString {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateMapOfMaps], wasmlib.TYPE_MAP);
+        return new sc.MapStringToImmutableMapStringToString(mapID);
+    }
+
+    remoteStatus(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, sc.idxMap[sc.IdxStateRemoteStatus]);
+    }
+
+    taggedValues(): sc.MapAgentIDToImmutableTaggedValueArray {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateTaggedValues], wasmlib.TYPE_MAP);
+        return new sc.MapAgentIDToImmutableTaggedValueArray(mapID);
+    }
+}
+
+export class MapAgentIDToMutableBool {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID)
+    }
+
+    getBool(key: wasmlib.ScAgentID): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.objID, key.getKeyID());
+    }
+}
+
+export class ArrayOfMutableStringArray {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID);
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getStringArray(index: i32): sc.MutableStringArray {
+        let subID = wasmlib.getObjectID(this.objID, new wasmlib.Key32(index), wasmlib.TYPE_ARRAY|wasmlib.TYPE_STRING);
+        return new sc.MutableStringArray(subID);
+    }
 }
 
 export class MapStringToMutableStringArray {
@@ -46,10 +166,72 @@ export class MapStringToMutableStringArray {
     }
 }
 
+export class MapStringToMutableMapStringToString {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID)
+    }
+
+    getMapStringToString(key: string): sc.MutableMapStringToString {
+        let subID = wasmlib.getObjectID(this.objID, wasmlib.Key32.fromString(key).getKeyID(), wasmlib.TYPE_MAP);
+        return new sc.MutableMapStringToString(subID);
+    }
+}
+
+export class MapAgentIDToMutableTaggedValueArray {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID)
+    }
+
+    getTaggedValueArray(key: wasmlib.ScAgentID): sc.MutableTaggedValueArray {
+        let subID = wasmlib.getObjectID(this.objID, key.getKeyID(), wasmlib.TYPE_ARRAY|wasmlib.TYPE_BYTES);
+        return new sc.MutableTaggedValueArray(subID);
+    }
+}
+
 export class MutableTestWasmLibState extends wasmlib.ScMapID {
 
+    admins(): sc.MapAgentIDToMutableBool {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateAdmins], wasmlib.TYPE_MAP);
+        return new sc.MapAgentIDToMutableBool(mapID);
+    }
+
+    arrayOfArrays(): sc.ArrayOfMutableStringArray {
+        let arrID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateArrayOfArrays], wasmlib.TYPE_ARRAY|wasmlib.TYPE_MAP);
+        return new sc.ArrayOfMutableStringArray(arrID)
+    }
+
     arrays(): sc.MapStringToMutableStringArray {
         let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateArrays], wasmlib.TYPE_MAP);
         return new sc.MapStringToMutableStringArray(mapID);
     }
+
+    counter(): wasmlib.ScMutableInt64 {
+        return new wasmlib.ScMutableInt64(this.mapID, sc.idxMap[sc.IdxStateCounter]);
+    }
+
+    mapOfMaps(): sc.MapStringToMutableMapStringToString {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateMapOfMaps], wasmlib.TYPE_MAP);
+        return new sc.MapStringToMutableMapStringToString(mapID);
+    }
+
+    remoteStatus(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, sc.idxMap[sc.IdxStateRemoteStatus]);
+    }
+
+    taggedValues(): sc.MapAgentIDToMutableTaggedValueArray {
+        let mapID = wasmlib.getObjectID(this.mapID, sc.idxMap[sc.IdxStateTaggedValues], wasmlib.TYPE_MAP);
+        return new sc.MapAgentIDToMutableTaggedValueArray(mapID);
+    }
 }
@@ -0,0 +1,75 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// (Re-)generated by schema tool
+// >>>> DO NOT CHANGE THIS FILE! <<<<
+// Change the json schema instead
+
+import * as wasmlib from "wasmlib"
+
+export class TaggedValue {
+    tags : string[] = [];
+    value: string = "";
+
+    static fromBytes(bytes: u8[]): TaggedValue {
+        let decode = new wasmlib.BytesDecoder(bytes);
+        let data = new TaggedValue();
+        let tagsLen = decode.uint32();
+        for (let i: u32 = 0; i < tagsLen; i++) {
+            data.tags.push(decode.string());
+        }
+        data.value = decode.string();
+        decode.close();
+        return data;
+    }
+
+    bytes(): u8[] {
+        let encode = new wasmlib.BytesEncoder();
+        encode.uint32(this.tags.length as u32);
+        for (let i = 0; i < this.tags.length; i++) {
+            encode.string(this.tags[i]);
+        }
+        encode.string(this.value);
+        return encode.data();
+    }
+}
+
+export class ImmutableTaggedValue {
+    objID: i32;
+    keyID: wasmlib.Key32;
+
+    constructor(objID: i32, keyID: wasmlib.Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    exists(): boolean {
+        return wasmlib.exists(this.objID, this.keyID, wasmlib.TYPE_BYTES);
+    }
+
+    value(): TaggedValue {
+        return TaggedValue.fromBytes(wasmlib.getBytes(this.objID, this.keyID,wasmlib. TYPE_BYTES));
+    }
+}
+
+export class MutableTaggedValue {
+    objID: i32;
+    keyID: wasmlib.Key32;
+
+    constructor(objID: i32, keyID: wasmlib.Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    exists(): boolean {
+        return wasmlib.exists(this.objID, this.keyID, wasmlib.TYPE_BYTES);
+    }
+
+    setValue(value: TaggedValue): void {
+        wasmlib.setBytes(this.objID, this.keyID, wasmlib.TYPE_BYTES, value.bytes());
+    }
+
+    value(): TaggedValue {
+        return TaggedValue.fromBytes(wasmlib.getBytes(this.objID, this.keyID,wasmlib. TYPE_BYTES));
+    }
+}
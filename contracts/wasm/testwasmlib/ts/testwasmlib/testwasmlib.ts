@@ -32,6 +32,13 @@ export function funcParamTypes(ctx: wasmlib.ScFuncContext, f: sc.ParamTypesConte
     if (f.params.agentID().exists()) {
         ctx.require(f.params.agentID().value().equals(ctx.accountID()), "mismatch: AgentID");
     }
+    if (f.params.bigInt().exists()) {
+        let bigInt = wasmlib.ScBigInt.fromU64(1234567890123456789).mul(wasmlib.ScBigInt.fromU64(1234567890123456789));
+        ctx.require(f.params.bigInt().value().cmp(bigInt) == 0, "mismatch: BigInt");
+    }
+    if (f.params.bool().exists()) {
+        ctx.require(f.params.bool().value(), "mismatch: Bool");
+    }
     if (f.params.bytes().exists()) {
         let byteData = wasmlib.Convert.fromString("these are bytes");
         ctx.require(wasmlib.Convert.equals(f.params.bytes().value(), byteData), "mismatch: Bytes");
@@ -50,6 +57,9 @@ export function funcParamTypes(ctx: wasmlib.ScFuncContext, f: sc.ParamTypesConte
     if (f.params.hname().exists()) {
         ctx.require(f.params.hname().value().equals(ctx.accountID().hname()), "mismatch: Hname");
     }
+    if (f.params.int8().exists()) {
+        ctx.require(f.params.int8().value() == -123, "mismatch: Int8");
+    }
     if (f.params.int16().exists()) {
         ctx.require(f.params.int16().value() == 12345, "mismatch: Int16");
     }
@@ -66,6 +76,18 @@ export function funcParamTypes(ctx: wasmlib.ScFuncContext, f: sc.ParamTypesConte
     if (f.params.string().exists()) {
         ctx.require(f.params.string().value() == "this is a string", "mismatch: String");
     }
+    if (f.params.uint8().exists()) {
+        ctx.require(f.params.uint8().value() == 123, "mismatch: Uint8");
+    }
+    if (f.params.uint16().exists()) {
+        ctx.require(f.params.uint16().value() == 54321, "mismatch: Uint16");
+    }
+    if (f.params.uint32().exists()) {
+        ctx.require(f.params.uint32().value() == 3456789012, "mismatch: Uint32");
+    }
+    if (f.params.uint64().exists()) {
+        ctx.require(f.params.uint64().value() == 12345678901234567890, "mismatch: Uint64");
+    }
 }
 
 export function viewArrayLength(ctx: wasmlib.ScViewContext, f: sc.ArrayLengthContext): void {
@@ -102,3 +124,168 @@ export function viewBlockRecords(ctx: wasmlib.ScViewContext, f: sc.BlockRecordsC
 export function viewIotaBalance(ctx: wasmlib.ScViewContext, f: sc.IotaBalanceContext): void {
     f.results.iotas().setValue(ctx.balances().balance(wasmlib.ScColor.IOTA));
 }
+
+export function funcArrayOfArraysAppend(ctx: wasmlib.ScFuncContext, f: sc.ArrayOfArraysAppendContext): void {
+    // appending to the array at index == length creates a new nested array
+    let index = f.params.index().value();
+    let array = f.state.arrayOfArrays().getStringArray(index);
+    let value = f.params.value().value();
+    array.getString(array.length()).setValue(value);
+}
+
+export function funcMapOfMapsSet(ctx: wasmlib.ScFuncContext, f: sc.MapOfMapsSetContext): void {
+    let name = f.params.name().value();
+    let mapOfStrings = f.state.mapOfMaps().getMapStringToString(name);
+    let key = f.params.key().value();
+    let value = f.params.value().value();
+    mapOfStrings.getString(key).setValue(value);
+}
+
+export function funcTaggedValueAdd(ctx: wasmlib.ScFuncContext, f: sc.TaggedValueAddContext): void {
+    let values = f.state.taggedValues().getTaggedValueArray(ctx.caller());
+    let tag = f.params.tag().value();
+    let value = f.params.value().value();
+
+    // add the tag to the last value when it has the same value
+    let length = values.length();
+    if (length != 0) {
+        let last = values.getTaggedValue(length - 1);
+        let taggedValue = last.value();
+        if (taggedValue.value == value) {
+            taggedValue.tags.push(tag);
+            last.setValue(taggedValue);
+            return;
+        }
+    }
+    let taggedValue = new sc.TaggedValue();
+    taggedValue.tags.push(tag);
+    taggedValue.value = value;
+    values.getTaggedValue(length).setValue(taggedValue);
+}
+
+export function viewArrayOfArraysValue(ctx: wasmlib.ScViewContext, f: sc.ArrayOfArraysValueContext): void {
+    let arrays = f.state.arrayOfArrays();
+    let index = f.params.index().value();
+    ctx.require(index >= 0 && index < arrays.length(), "invalid index");
+    let array = arrays.getStringArray(index);
+    let valueIndex = f.params.valueIndex().value();
+    ctx.require(valueIndex >= 0 && valueIndex < array.length(), "invalid valueIndex");
+    f.results.value().setValue(array.getString(valueIndex).value());
+}
+
+export function viewMapOfMapsValue(ctx: wasmlib.ScViewContext, f: sc.MapOfMapsValueContext): void {
+    let name = f.params.name().value();
+    let mapOfStrings = f.state.mapOfMaps().getMapStringToString(name);
+    let key = f.params.key().value();
+    f.results.value().setValue(mapOfStrings.getString(key).value());
+}
+
+export function viewTaggedValues(ctx: wasmlib.ScViewContext, f: sc.TaggedValuesContext): void {
+    let values = f.state.taggedValues().getTaggedValueArray(f.params.agentID().value());
+    let results = f.results.values();
+    let length = values.length();
+    for (let i = 0; i < length; i++) {
+        results.getTaggedValue(i).setValue(values.getTaggedValue(i).value());
+    }
+}
+
+export function funcAdminSet(ctx: wasmlib.ScFuncContext, f: sc.AdminSetContext): void {
+    let agentID = f.params.agentID().value();
+    let enabled = f.params.enabled().value();
+    f.state.admins().getBool(agentID).setValue(enabled);
+}
+
+export function funcCounterAdd(ctx: wasmlib.ScFuncContext, f: sc.CounterAddContext): void {
+    let delta: i64 = 1;
+    if (f.params.delta().exists()) {
+        delta = f.params.delta().value();
+    }
+    let counter = f.state.counter();
+    counter.setValue(counter.value() + delta);
+}
+
+export function viewCounterValue(ctx: wasmlib.ScViewContext, f: sc.CounterValueContext): void {
+    f.results.counter().setValue(f.state.counter().value());
+}
+
+export function funcRemoteCall(ctx: wasmlib.ScFuncContext, f: sc.RemoteCallContext): void {
+    let chainID = ctx.chainID();
+    if (f.params.chainID().exists()) {
+        chainID = f.params.chainID().value();
+    }
+    let params = wasmlib.ScMutableMap.create();
+    params.getBool(wasmlib.Key32.fromString(sc.ParamFail)).setValue(f.params.fail().value());
+    // one iota for the remote function, and one to send back the outcome
+    let transfer = wasmlib.ScTransfers.iotas(2);
+    let callID = ctx.postWithCallback(chainID, ctx.contract(), sc.HFuncRemoteTarget, params, transfer,
+        sc.HFuncRemoteResult, f.params.timeout().value());
+    f.results.callID().setValue(callID);
+    f.state.remoteStatus().setValue("pending");
+}
+
+export function funcRemoteResult(ctx: wasmlib.ScFuncContext, f: sc.RemoteResultContext): void {
+    let result = ctx.callResult();
+    if (result === null) {
+        // unexpected or duplicate outcome
+        return;
+    }
+    let prefix = result.late ? "late " : "";
+    if (!result.success) {
+        f.state.remoteStatus().setValue(prefix + "failed: " + result.error);
+        return;
+    }
+    let value = result.results.getString(wasmlib.Key32.fromString(sc.ResultValue)).value();
+    f.state.remoteStatus().setValue(prefix + "ok: " + value);
+}
+
+export function funcRemoteTarget(ctx: wasmlib.ScFuncContext, f: sc.RemoteTargetContext): void {
+    ctx.require(!f.params.fail().value(), "remote failure");
+    f.results.value().setValue("remote value");
+}
+
+export function viewRemoteStatus(ctx: wasmlib.ScViewContext, f: sc.RemoteStatusContext): void {
+    f.results.status().setValue(f.state.remoteStatus().value());
+}
+
+export function funcOrderedDelete(ctx: wasmlib.ScFuncContext, f: sc.OrderedDeleteContext): void {
+    let ordered = ctx.state().getOrderedMap(wasmlib.Key32.fromString("ordered"));
+    ordered.delete(wasmlib.encodeSortableInt64(f.params.position().value()));
+}
+
+export function funcOrderedSet(ctx: wasmlib.ScFuncContext, f: sc.OrderedSetContext): void {
+    let ordered = ctx.state().getOrderedMap(wasmlib.Key32.fromString("ordered"));
+    let key = wasmlib.encodeSortableInt64(f.params.position().value());
+    ordered.setBytes(key, wasmlib.Convert.fromString(f.params.value().value()));
+}
+
+export function viewOrderedPage(ctx: wasmlib.ScViewContext, f: sc.OrderedPageContext): void {
+    let from: u8[] | null = null;
+    if (f.params.from().exists()) {
+        from = wasmlib.encodeSortableInt64(f.params.from().value());
+    }
+    let to: u8[] | null = null;
+    if (f.params.to().exists()) {
+        to = wasmlib.encodeSortableInt64(f.params.to().value());
+    }
+    let ordered = ctx.state().getOrderedMap(wasmlib.Key32.fromString("ordered"));
+    let page = ordered.page(from, to, f.params.limit().value(), f.params.reverse().value());
+    let positions = f.results.positions();
+    let entries = f.results.entries();
+    for (let i = 0; i < page.keys.length; i++) {
+        positions.getInt64(i).setValue(wasmlib.decodeSortableInt64(page.keys[i]));
+        entries.getString(i).setValue(wasmlib.Convert.toString(page.values[i]));
+    }
+    let cursor = page.cursor;
+    if (cursor !== null) {
+        f.results.cursor().setValue(wasmlib.decodeSortableInt64(cursor!));
+    }
+}
+
+export function funcRaiseError(ctx: wasmlib.ScFuncContext, f: sc.RaiseErrorContext): void {
+    let code = f.params.code().value();
+    let message = f.params.message().value();
+    if (f.params.detail().exists()) {
+        ctx.panicWithError(code, message, [f.params.detail().value()]);
+    }
+    ctx.panicWithError(code, message, []);
+}
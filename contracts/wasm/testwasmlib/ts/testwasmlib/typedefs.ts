@@ -49,3 +49,79 @@ export class ArrayOfMutableString {
 
 export class MutableStringArray extends ArrayOfMutableString {
 };
+
+export class MapStringToImmutableString {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    getString(key: string): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.objID, wasmlib.Key32.fromString(key).getKeyID());
+    }
+}
+
+export class ImmutableMapStringToString extends MapStringToImmutableString {
+};
+
+export class MapStringToMutableString {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID)
+    }
+
+    getString(key: string): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.objID, wasmlib.Key32.fromString(key).getKeyID());
+    }
+}
+
+export class MutableMapStringToString extends MapStringToMutableString {
+};
+
+export class ArrayOfImmutableTaggedValue {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getTaggedValue(index: i32): sc.ImmutableTaggedValue {
+        return new sc.ImmutableTaggedValue(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class ImmutableTaggedValueArray extends ArrayOfImmutableTaggedValue {
+};
+
+export class ArrayOfMutableTaggedValue {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID);
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getTaggedValue(index: i32): sc.MutableTaggedValue {
+        return new sc.MutableTaggedValue(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class MutableTaggedValueArray extends ArrayOfMutableTaggedValue {
+};
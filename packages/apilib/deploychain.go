@@ -179,3 +179,23 @@ func ActivateChainOnAccessNodes(apiHosts, peers []string, chainID *iscp.ChainID)
 	}
 	return nil
 }
+
+// ActivateChainOnObserverNodes puts observer chain records into nodes and activates them.
+// Observer nodes follow the chain by fetching blocks from the committee peers,
+// serve views and forward off-ledger requests, but never participate in consensus.
+func ActivateChainOnObserverNodes(apiHosts, committeePeers []string, chainID *iscp.ChainID) error {
+	nodes := multiclient.New(apiHosts)
+	err := nodes.PutChainRecord(&registry.ChainRecord{
+		ChainID:  chainID,
+		Peers:    committeePeers,
+		Observer: true,
+	})
+	if err != nil {
+		return xerrors.Errorf("ActivateChainOnObserverNodes: %w", err)
+	}
+	err = nodes.ActivateChain(chainID)
+	if err != nil {
+		return xerrors.Errorf("ActivateChainOnObserverNodes: %w", err)
+	}
+	return nil
+}
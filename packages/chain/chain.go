@@ -20,6 +20,7 @@ import (
 	"github.com/iotaledger/wasp/packages/state"
 	"github.com/iotaledger/wasp/packages/tcrypto"
 	"github.com/iotaledger/wasp/packages/util/ready"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
 	"github.com/iotaledger/wasp/packages/vm/processors"
 )
 
@@ -41,6 +42,7 @@ type ChainEntry interface {
 	ReceiveState(stateOutput *ledgerstate.AliasOutput, timestamp time.Time)
 	ReceiveOutput(output ledgerstate.Output)
 	ReceiveOffLedgerRequest(req *request.OffLedger, senderNetID string)
+	ReceiveOffLedgerRequests(reqs []*request.OffLedger)
 
 	Dismiss(reason string)
 	IsDismissed() bool
@@ -49,6 +51,12 @@ type ChainEntry interface {
 // ChainRequests is an interface to query status of the request
 type ChainRequests interface {
 	GetRequestProcessingStatus(id iscp.RequestID) RequestProcessingStatus
+	GetRequestPropagationInfo(id iscp.RequestID) *RequestPropagationInfo
+	// GetRequestReceipt returns the receipt of a processed request, or nil when the request was not processed
+	GetRequestReceipt(id iscp.RequestID) (*blocklog.RequestReceipt, error)
+	// GetTransactionRequestIDs returns the requests to the chain contained in an L1 transaction
+	// received by the node. Returns false when the transaction is not known to the node
+	GetTransactionRequestIDs(txID ledgerstate.TransactionID) ([]iscp.RequestID, bool)
 	EventRequestProcessed() *events.Event
 }
 
@@ -94,7 +102,7 @@ type NodeConnection interface {
 
 type StateManager interface {
 	Ready() *ready.Ready
-	EventGetBlockMsg(msg *messages.GetBlockMsg)
+	EventGetBlocksMsg(msg *messages.GetBlocksMsg)
 	EventBlockMsg(msg *messages.BlockMsg)
 	EventStateMsg(msg *messages.StateMsg)
 	EventOutputMsg(msg ledgerstate.Output)
@@ -156,12 +164,28 @@ type SyncInfo struct {
 	StateOutputID         ledgerstate.OutputID
 	StateOutputHash       hashing.HashValue
 	StateOutputTimestamp  time.Time
+	// catch-up progress: blocks received from peers, but not committed yet, and blocks requested and still awaited
+	BlocksFetched   uint32
+	BlocksRequested uint32
+	SyncPeers       []SyncPeerInfo
+}
+
+// SyncPeerInfo is the responsiveness of a peer, as seen by the state manager while syncing blocks
+type SyncPeerInfo struct {
+	NetID     string
+	Latency   time.Duration
+	Delivered uint32
+	Timeouts  uint32
+	Rejected  uint32
 }
 
 type ConsensusInfo struct {
 	StateIndex uint32
 	Mempool    MempoolInfo
 	TimerTick  int
+	// number of times the consensus workflow was restarted because the state transaction
+	// was rejected or not confirmed in time
+	Recoveries uint32
 }
 
 type ReadyListRecord struct {
@@ -202,6 +226,46 @@ const (
 	RequestProcessingStatusCompleted
 )
 
+// RequestPropagationState tells how far an off-ledger request has propagated through the committee
+type RequestPropagationState int
+
+const (
+	RequestPropagationUnknown = RequestPropagationState(iota)
+	// RequestPropagationReceived the request is in the mempool of this node only
+	RequestPropagationReceived
+	// RequestPropagationInMempools the request has been acknowledged by the mempools of other nodes
+	RequestPropagationInMempools
+	// RequestPropagationInBatch the request has been included in a batch proposal of this node
+	RequestPropagationInBatch
+	// RequestPropagationProcessed the request has been processed and is part of a block
+	RequestPropagationProcessed
+)
+
+var requestPropagationStateNames = []string{"unknown", "received", "in_mempools", "in_batch", "processed"}
+
+func (s RequestPropagationState) String() string {
+	if s < 0 || int(s) >= len(requestPropagationStateNames) {
+		return requestPropagationStateNames[RequestPropagationUnknown]
+	}
+	return requestPropagationStateNames[s]
+}
+
+// RequestPropagationInfo is a snapshot of the propagation of an off-ledger request
+type RequestPropagationInfo struct {
+	State RequestPropagationState
+	// Mempools is the number of mempools known to contain the request, including our own
+	Mempools int
+	// Quorum is the number of mempools that have to acknowledge the request before it is confirmed
+	Quorum int
+	// Attempts is the number of times the request was forwarded to other nodes
+	Attempts int
+}
+
+// Confirmed returns true when a quorum of mempools acknowledged receipt of the request
+func (info *RequestPropagationInfo) Confirmed() bool {
+	return info.State == RequestPropagationProcessed || (info.Quorum > 0 && info.Mempools >= info.Quorum)
+}
+
 const (
 	// TimerTickPeriod time tick for consensus and state manager objects
 	TimerTickPeriod = 100 * time.Millisecond
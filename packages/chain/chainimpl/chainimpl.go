@@ -72,10 +72,15 @@ type chainObj struct {
 	peers                            *peering.PeerDomainProvider
 	offLedgerReqsAcksMutex           sync.RWMutex
 	offLedgerReqsAcks                map[iscp.RequestID][]string
+	offLedgerPropagationMutex        sync.Mutex
+	offLedgerPropagation             map[iscp.RequestID]*propagationEntry
+	txRequestsMutex                  sync.Mutex
+	txRequests                       map[ledgerstate.TransactionID]*txRequestsEntry
 	offledgerBroadcastUpToNPeers     int
 	offledgerBroadcastInterval       time.Duration
 	pullMissingRequestsFromCommittee bool
 	chainMetrics                     metrics.ChainMetrics
+	observer                         bool
 }
 
 type committeeStruct struct {
@@ -98,6 +103,7 @@ func NewChain(
 	offledgerBroadcastInterval time.Duration,
 	pullMissingRequestsFromCommittee bool,
 	chainMetrics metrics.ChainMetrics,
+	observer bool,
 ) chain.Chain {
 	log.Debugf("creating chain object for %s", chainID.String())
 
@@ -125,10 +131,13 @@ func NewChain(
 			handler.(func(_ *chain.ChainTransitionEventData))(params[0].(*chain.ChainTransitionEventData))
 		}),
 		offLedgerReqsAcks:                make(map[iscp.RequestID][]string),
+		offLedgerPropagation:             make(map[iscp.RequestID]*propagationEntry),
+		txRequests:                       make(map[ledgerstate.TransactionID]*txRequestsEntry),
 		offledgerBroadcastUpToNPeers:     offledgerBroadcastUpToNPeers,
 		offledgerBroadcastInterval:       offledgerBroadcastInterval,
 		pullMissingRequestsFromCommittee: pullMissingRequestsFromCommittee,
 		chainMetrics:                     chainMetrics,
+		observer:                         observer,
 	}
 	ret.committee.Store(&committeeStruct{})
 	ret.eventChainTransition.Attach(events.NewClosure(ret.processChainTransition))
@@ -180,6 +189,8 @@ func (c *chainObj) dispatchMessage(msg interface{}) {
 		if c.consensus != nil {
 			c.consensus.EventAsynchronousCommonSubsetMsg(msgt)
 		}
+	case *messages.BatchProposalSentMsg:
+		c.markRequestsInBatch(msgt.RequestIDs)
 	case messages.TimerTick:
 		if msgt%2 == 0 {
 			c.stateMgr.EventTimerMsg(msgt / 2)
@@ -204,8 +215,21 @@ func (c *chainObj) processPeerMessage(msg *peering.PeerMessage) {
 			c.log.Error(err)
 			return
 		}
+		// a single block request is a range of one block
+		c.stateMgr.EventGetBlocksMsg(&messages.GetBlocksMsg{
+			SenderNetID: msg.SenderNetID,
+			FromIndex:   msgt.BlockIndex,
+			ToIndex:     msgt.BlockIndex,
+		})
+
+	case messages.MsgGetBlocks:
+		msgt := &messages.GetBlocksMsg{}
+		if err := msgt.Read(rdr); err != nil {
+			c.log.Error(err)
+			return
+		}
 		msgt.SenderNetID = msg.SenderNetID
-		c.stateMgr.EventGetBlockMsg(msgt)
+		c.stateMgr.EventGetBlocksMsg(msgt)
 
 	case messages.MsgBlock:
 		msgt := &messages.BlockMsg{}
@@ -270,7 +294,7 @@ func (c *chainObj) processPeerMessage(msg *peering.PeerMessage) {
 			c.log.Error(err)
 			return
 		}
-		if c.consensus.ShouldReceiveMissingRequest(msgt.Request) {
+		if c.consensus != nil && c.consensus.ShouldReceiveMissingRequest(msgt.Request) {
 			c.mempool.ReceiveRequest(msgt.Request)
 		}
 	default:
@@ -309,6 +333,7 @@ func (c *chainObj) processChainTransition(msg *chain.ChainTransitionEventData) {
 			for _, reqid := range reqids {
 				c.eventRequestProcessed.Trigger(reqid)
 			}
+			c.markRequestsProcessed(reqids)
 			c.publishNewBlockEvents(stateIndex)
 
 			c.log.Debugf("processChainTransition state %d: state %d cleaned, deleted requests: %+v",
@@ -366,6 +391,11 @@ func (c *chainObj) processStateMessage(msg *messages.StateMsg) {
 		msg.ChainOutput.GetStateIndex(), sh.String(),
 		msg.ChainOutput.GetStateAddress().Base58(), !msg.ChainOutput.GetIsGovernanceUpdated(),
 	)
+	if c.observer {
+		// observer nodes never join the committee, they only follow the state
+		c.stateMgr.EventStateMsg(msg)
+		return
+	}
 	cmt := c.getCommittee()
 
 	if cmt != nil {
@@ -124,20 +124,23 @@ func (c *chainObj) broadcastOffLedgerRequest(req *request.OffLedger) {
 				(*c.peers).SendSimple(peerID, messages.MsgOffLedgerRequest, msgData)
 			}
 		}
+		c.countPropagationAttempt(req.ID())
 	}
 
-	ticker := time.NewTicker(c.offledgerBroadcastInterval)
+	// retries back off exponentially, so that an unreachable peer does not flood the network
+	interval := c.offledgerBroadcastInterval
+	timer := time.NewTimer(interval)
 	stopBroadcast := func() {
 		c.offLedgerReqsAcksMutex.Lock()
 		delete(c.offLedgerReqsAcks, req.ID())
 		c.offLedgerReqsAcksMutex.Unlock()
-		ticker.Stop()
+		timer.Stop()
 	}
 
 	go func() {
 		defer stopBroadcast()
 		for {
-			<-ticker.C
+			<-timer.C
 			// check if processed (request already left the mempool)
 			if !c.mempool.HasRequest(req.ID()) {
 				return
@@ -149,7 +152,15 @@ func (c *chainObj) broadcastOffLedgerRequest(req *request.OffLedger) {
 				// this node is part of the committee and the message has already been received by every other committee node
 				return
 			}
+			if c.pullMissingRequestsFromCommittee && c.isPropagationConfirmed(req.ID()) {
+				// a quorum of mempools has the request, the rest of the committee will pull it when needed
+				return
+			}
 			sendMessage(ackPeers)
+			if interval < maxOffledgerBroadcastBackoff*c.offledgerBroadcastInterval {
+				interval *= 2
+			}
+			timer.Reset(interval)
 		}
 	}()
 }
@@ -160,10 +171,19 @@ func (c *chainObj) ReceiveOffLedgerRequest(req *request.OffLedger, senderNetID s
 	if !c.mempool.ReceiveRequest(req) {
 		return
 	}
+	c.startRequestPropagation(req.ID())
 	c.log.Debugf("ReceiveOffLedgerRequest - added to mempool: reqID: %s, peerID: %s", req.ID().Base58(), senderNetID)
 	c.broadcastOffLedgerRequest(req)
 }
 
+// ReceiveOffLedgerRequests adds a batch of off-ledger requests, received directly from clients, to the mempool
+func (c *chainObj) ReceiveOffLedgerRequests(reqs []*request.OffLedger) {
+	c.log.Debugf("ReceiveOffLedgerRequests: %d requests", len(reqs))
+	for _, req := range reqs {
+		c.ReceiveOffLedgerRequest(req, "")
+	}
+}
+
 func (c *chainObj) sendRequestAcknowledgementMsg(reqID iscp.RequestID, peerID string) {
 	c.log.Debugf("sendRequestAcknowledgementMsg: reqID: %s, peerID: %s", reqID.Base58(), peerID)
 	if peerID == "" {
@@ -177,8 +197,18 @@ func (c *chainObj) ReceiveRequestAckMessage(reqID *iscp.RequestID, peerID string
 	c.log.Debugf("ReceiveRequestAckMessage: reqID: %s, peerID: %s", reqID.Base58(), peerID)
 	c.offLedgerReqsAcksMutex.Lock()
 	defer c.offLedgerReqsAcksMutex.Unlock()
-	c.offLedgerReqsAcks[*reqID] = append(c.offLedgerReqsAcks[*reqID], peerID)
 	c.chainMetrics.CountRequestAckMessages()
+	if !shouldSendToPeer(peerID, c.offLedgerReqsAcks[*reqID]) {
+		// duplicate acknowledgement of a retried broadcast
+		return
+	}
+	c.offLedgerReqsAcks[*reqID] = append(c.offLedgerReqsAcks[*reqID], peerID)
+	c.updateRequestPropagation(*reqID, func(info *chain.RequestPropagationInfo) {
+		info.Mempools++
+		if info.State < chain.RequestPropagationInMempools {
+			info.State = chain.RequestPropagationInMempools
+		}
+	})
 }
 
 // SendMissingRequestsToPeer sends the requested missing requests by a peer
@@ -199,6 +229,7 @@ func (c *chainObj) ReceiveTransaction(tx *ledgerstate.Transaction) {
 		c.log.Warnf("failed to parse transaction %s: %v", tx.ID().Base58(), err)
 		return
 	}
+	c.recordTxRequests(tx.ID(), reqs)
 	for _, req := range reqs {
 		c.ReceiveRequest(req)
 	}
@@ -240,10 +271,10 @@ func (c *chainObj) GetRequestProcessingStatus(reqID iscp.RequestID) chain.Reques
 	if c.IsDismissed() {
 		return chain.RequestProcessingStatusUnknown
 	}
-	if c.consensus != nil {
-		if c.mempool.HasRequest(reqID) {
-			return chain.RequestProcessingStatusBacklog
-		}
+	// observers never process the requests they keep in the mempool,
+	// so they can only tell whether a request has been completed
+	if c.consensus != nil && c.mempool.HasRequest(reqID) {
+		return chain.RequestProcessingStatusBacklog
 	}
 	c.stateReader.SetBaseline()
 	processed, err := blocklog.IsRequestProcessed(c.stateReader.KVStoreReader(), &reqID)
@@ -253,6 +284,14 @@ func (c *chainObj) GetRequestProcessingStatus(reqID iscp.RequestID) chain.Reques
 	return chain.RequestProcessingStatusCompleted
 }
 
+func (c *chainObj) GetRequestReceipt(reqID iscp.RequestID) (*blocklog.RequestReceipt, error) {
+	if c.IsDismissed() {
+		return nil, nil
+	}
+	c.stateReader.SetBaseline()
+	return blocklog.GetRequestReceipt(c.stateReader.KVStoreReader(), &reqID)
+}
+
 func (c *chainObj) Processors() *processors.Cache {
 	return c.procset
 }
@@ -0,0 +1,105 @@
+package chainimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxodb"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxoutil"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/chain/messages"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/peering"
+	"github.com/iotaledger/wasp/packages/state"
+	"github.com/iotaledger/wasp/packages/testutil/testchain"
+	"github.com/iotaledger/wasp/packages/testutil/testlogger"
+	"github.com/iotaledger/wasp/packages/transaction"
+	"github.com/iotaledger/wasp/packages/util/ready"
+	"github.com/stretchr/testify/require"
+)
+
+type mockedStateMgr struct {
+	stateMsgs []*messages.StateMsg
+}
+
+var _ chain.StateManager = &mockedStateMgr{}
+
+func (m *mockedStateMgr) Ready() *ready.Ready                                    { return nil }
+func (m *mockedStateMgr) EventGetBlocksMsg(msg *messages.GetBlocksMsg)           {}
+func (m *mockedStateMgr) EventBlockMsg(msg *messages.BlockMsg)                   {}
+func (m *mockedStateMgr) EventOutputMsg(msg ledgerstate.Output)                  {}
+func (m *mockedStateMgr) EventStateCandidateMsg(msg *messages.StateCandidateMsg) {}
+func (m *mockedStateMgr) EventTimerMsg(msg messages.TimerTick)                   {}
+func (m *mockedStateMgr) GetStatusSnapshot() *chain.SyncInfo                     { return nil }
+func (m *mockedStateMgr) Close()                                                 {}
+
+func (m *mockedStateMgr) EventStateMsg(msg *messages.StateMsg) {
+	m.stateMsgs = append(m.stateMsgs, msg)
+}
+
+type mockedMempool struct {
+	chain.Mempool
+	requests map[iscp.RequestID]bool
+}
+
+func (m *mockedMempool) HasRequest(id iscp.RequestID) bool {
+	return m.requests[id]
+}
+
+func originStateMsg(t *testing.T) *messages.StateMsg {
+	u := utxodb.New()
+	user, addr := u.NewKeyPairByIndex(1)
+	_, err := u.RequestFunds(addr)
+	require.NoError(t, err)
+	_, stateAddr := u.NewKeyPairByIndex(2)
+	tx, _, err := transaction.NewChainOriginTransaction(user, stateAddr, colored.NewBalancesForIotas(100), time.Now(), u.GetAddressOutputs(addr)...)
+	require.NoError(t, err)
+	out, err := utxoutil.GetSingleChainedAliasOutput(tx)
+	require.NoError(t, err)
+	return &messages.StateMsg{ChainOutput: out, Timestamp: tx.Essence().Timestamp()}
+}
+
+func TestObserverSyncsWithoutCommittee(t *testing.T) {
+	const ownNetID = "localhost:4000"
+	netConfig, err := peering.NewStaticPeerNetworkConfigProvider(ownNetID, 4000, "localhost:4001")
+	require.NoError(t, err)
+
+	stateMgr := &mockedStateMgr{}
+	c := &chainObj{
+		log:               testlogger.NewLogger(t),
+		stateMgr:          stateMgr,
+		peerNetworkConfig: netConfig,
+		// the registry would make the node a member of any committee
+		committeeRegistry: testchain.NewMockedCommitteeRegistry([]string{ownNetID}),
+		observer:          true,
+	}
+	c.committee.Store(&committeeStruct{})
+
+	msg := originStateMsg(t)
+	c.processStateMessage(msg)
+
+	// the state is handed to the state manager, which fetches the blocks from the peers
+	require.Equal(t, []*messages.StateMsg{msg}, stateMgr.stateMsgs)
+	require.Nil(t, c.getCommittee())
+	require.Nil(t, c.consensus)
+	require.Nil(t, c.GetCommitteeInfo())
+}
+
+func TestObserverRequestStatusUnknown(t *testing.T) {
+	store := mapdb.NewMapDB()
+	_, err := state.CreateOriginState(store, nil)
+	require.NoError(t, err)
+
+	reqID := iscp.RequestID(ledgerstate.OutputID{1})
+	c := &chainObj{
+		mempool:     &mockedMempool{requests: map[iscp.RequestID]bool{reqID: true}},
+		stateReader: state.NewOptimisticStateReader(store, coreutil.NewChainStateSync().SetSolidIndex(0)),
+		observer:    true,
+	}
+	// the observer forwards the request to the committee but never processes it
+	require.Equal(t, chain.RequestProcessingStatusUnknown, c.GetRequestProcessingStatus(reqID))
+}
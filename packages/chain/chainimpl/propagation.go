@@ -0,0 +1,161 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package chainimpl
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
+)
+
+// maxOffledgerBroadcastBackoff limits the growth of the off-ledger broadcast retry interval,
+// as a multiple of the configured broadcast interval
+const maxOffledgerBroadcastBackoff = 8
+
+const (
+	// offLedgerPropagationTTL is how long the propagation of a request is tracked at most.
+	// Requests which were dropped or rejected by the mempool are never marked as processed,
+	// so their entries are only removed when they expire.
+	offLedgerPropagationTTL = 10 * time.Minute
+	// maxOffLedgerPropagationEntries bounds the number of tracked requests,
+	// the oldest entries are evicted when it is reached
+	maxOffLedgerPropagationEntries = 10000
+)
+
+type propagationEntry struct {
+	info  *chain.RequestPropagationInfo
+	added time.Time
+}
+
+// propagationQuorum is the number of mempools, including our own, which have to
+// acknowledge an off-ledger request before it is considered to be safely propagated
+func (c *chainObj) propagationQuorum() int {
+	if committee := c.getCommittee(); committee != nil {
+		return int(committee.Quorum())
+	}
+	quorum := c.offledgerBroadcastUpToNPeers
+	if peers := len((*c.peers).GetRandomPeers(c.offledgerBroadcastUpToNPeers)); peers < quorum {
+		quorum = peers
+	}
+	// our own mempool counts too
+	return quorum + 1
+}
+
+func (c *chainObj) startRequestPropagation(reqID iscp.RequestID) {
+	info := &chain.RequestPropagationInfo{
+		State:    chain.RequestPropagationReceived,
+		Mempools: 1,
+		Quorum:   c.propagationQuorum(),
+	}
+	now := time.Now()
+	c.offLedgerPropagationMutex.Lock()
+	c.pruneRequestPropagation(now)
+	c.offLedgerPropagation[reqID] = &propagationEntry{info: info, added: now}
+	snapshot := *info
+	c.offLedgerPropagationMutex.Unlock()
+	chain.PublishRequestPropagation(c.chainID, reqID, &snapshot)
+}
+
+// pruneRequestPropagation removes the expired entries and, when the limit is reached,
+// the oldest tenth of the entries. Must be called with offLedgerPropagationMutex locked.
+func (c *chainObj) pruneRequestPropagation(now time.Time) {
+	for reqID, entry := range c.offLedgerPropagation {
+		if now.Sub(entry.added) > offLedgerPropagationTTL {
+			delete(c.offLedgerPropagation, reqID)
+		}
+	}
+	if len(c.offLedgerPropagation) < maxOffLedgerPropagationEntries {
+		return
+	}
+	reqIDs := make([]iscp.RequestID, 0, len(c.offLedgerPropagation))
+	for reqID := range c.offLedgerPropagation {
+		reqIDs = append(reqIDs, reqID)
+	}
+	sort.Slice(reqIDs, func(i, j int) bool {
+		return c.offLedgerPropagation[reqIDs[i]].added.Before(c.offLedgerPropagation[reqIDs[j]].added)
+	})
+	for _, reqID := range reqIDs[:len(reqIDs)-maxOffLedgerPropagationEntries*9/10] {
+		delete(c.offLedgerPropagation, reqID)
+	}
+}
+
+// updateRequestPropagation applies update to the propagation info of the request and publishes the result.
+// Requests which are not tracked by this node are ignored.
+func (c *chainObj) updateRequestPropagation(reqID iscp.RequestID, update func(info *chain.RequestPropagationInfo)) {
+	c.offLedgerPropagationMutex.Lock()
+	entry, ok := c.offLedgerPropagation[reqID]
+	if !ok {
+		c.offLedgerPropagationMutex.Unlock()
+		return
+	}
+	update(entry.info)
+	snapshot := *entry.info
+	c.offLedgerPropagationMutex.Unlock()
+	chain.PublishRequestPropagation(c.chainID, reqID, &snapshot)
+}
+
+func (c *chainObj) countPropagationAttempt(reqID iscp.RequestID) {
+	c.offLedgerPropagationMutex.Lock()
+	defer c.offLedgerPropagationMutex.Unlock()
+	if entry, ok := c.offLedgerPropagation[reqID]; ok {
+		entry.info.Attempts++
+	}
+}
+
+func (c *chainObj) isPropagationConfirmed(reqID iscp.RequestID) bool {
+	c.offLedgerPropagationMutex.Lock()
+	defer c.offLedgerPropagationMutex.Unlock()
+	entry, ok := c.offLedgerPropagation[reqID]
+	return ok && entry.info.Confirmed()
+}
+
+func (c *chainObj) markRequestsInBatch(reqIDs []iscp.RequestID) {
+	for _, reqID := range reqIDs {
+		c.updateRequestPropagation(reqID, func(info *chain.RequestPropagationInfo) {
+			if info.State < chain.RequestPropagationInBatch {
+				info.State = chain.RequestPropagationInBatch
+			}
+		})
+	}
+}
+
+// markRequestsProcessed publishes the final propagation state and stops tracking the requests
+func (c *chainObj) markRequestsProcessed(reqIDs []iscp.RequestID) {
+	for _, reqID := range reqIDs {
+		c.updateRequestPropagation(reqID, func(info *chain.RequestPropagationInfo) {
+			info.State = chain.RequestPropagationProcessed
+		})
+		c.offLedgerPropagationMutex.Lock()
+		delete(c.offLedgerPropagation, reqID)
+		c.offLedgerPropagationMutex.Unlock()
+	}
+}
+
+func (c *chainObj) GetRequestPropagationInfo(reqID iscp.RequestID) *chain.RequestPropagationInfo {
+	if c.IsDismissed() {
+		return &chain.RequestPropagationInfo{State: chain.RequestPropagationUnknown}
+	}
+	c.offLedgerPropagationMutex.Lock()
+	entry, ok := c.offLedgerPropagation[reqID]
+	if ok {
+		snapshot := *entry.info
+		c.offLedgerPropagationMutex.Unlock()
+		return &snapshot
+	}
+	c.offLedgerPropagationMutex.Unlock()
+
+	c.stateReader.SetBaseline()
+	processed, err := blocklog.IsRequestProcessed(c.stateReader.KVStoreReader(), &reqID)
+	if err == nil && processed {
+		return &chain.RequestPropagationInfo{State: chain.RequestPropagationProcessed}
+	}
+	if c.mempool.HasRequest(reqID) {
+		// forwarded to us by another node, or an on-ledger request
+		return &chain.RequestPropagationInfo{State: chain.RequestPropagationReceived, Mempools: 1}
+	}
+	return &chain.RequestPropagationInfo{State: chain.RequestPropagationUnknown}
+}
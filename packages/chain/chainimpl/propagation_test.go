@@ -0,0 +1,64 @@
+package chainimpl
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/stretchr/testify/require"
+)
+
+func testRequestID(i int) iscp.RequestID {
+	var txid ledgerstate.TransactionID
+	binary.LittleEndian.PutUint32(txid[:], uint32(i))
+	return iscp.NewRequestID(txid, 0)
+}
+
+func newPropagationTestChain() *chainObj {
+	return &chainObj{
+		offLedgerPropagation: make(map[iscp.RequestID]*propagationEntry),
+	}
+}
+
+func (c *chainObj) trackTestRequest(reqID iscp.RequestID, added time.Time) {
+	c.offLedgerPropagation[reqID] = &propagationEntry{
+		info:  &chain.RequestPropagationInfo{State: chain.RequestPropagationReceived, Mempools: 1},
+		added: added,
+	}
+}
+
+func TestRequestPropagationExpires(t *testing.T) {
+	c := newPropagationTestChain()
+	now := time.Now()
+	// never processed, e.g. dropped by the mempool
+	c.trackTestRequest(testRequestID(1), now.Add(-offLedgerPropagationTTL-time.Second))
+	c.trackTestRequest(testRequestID(2), now.Add(-time.Second))
+
+	c.pruneRequestPropagation(now)
+	require.Len(t, c.offLedgerPropagation, 1)
+	require.Contains(t, c.offLedgerPropagation, testRequestID(2))
+	require.False(t, c.isPropagationConfirmed(testRequestID(1)))
+}
+
+func TestRequestPropagationLimit(t *testing.T) {
+	c := newPropagationTestChain()
+	now := time.Now()
+	for i := 0; i < maxOffLedgerPropagationEntries; i++ {
+		c.trackTestRequest(testRequestID(i), now.Add(time.Duration(i-maxOffLedgerPropagationEntries)*time.Millisecond))
+	}
+
+	c.pruneRequestPropagation(now)
+	require.Len(t, c.offLedgerPropagation, maxOffLedgerPropagationEntries*9/10)
+	// the oldest entries are evicted first
+	require.NotContains(t, c.offLedgerPropagation, testRequestID(0))
+	require.NotContains(t, c.offLedgerPropagation, testRequestID(maxOffLedgerPropagationEntries/10-1))
+	require.Contains(t, c.offLedgerPropagation, testRequestID(maxOffLedgerPropagationEntries/10))
+	require.Contains(t, c.offLedgerPropagation, testRequestID(maxOffLedgerPropagationEntries-1))
+
+	// below the limit nothing that is still fresh is evicted
+	c.pruneRequestPropagation(now)
+	require.Len(t, c.offLedgerPropagation, maxOffLedgerPropagationEntries*9/10)
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package chainimpl
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+)
+
+// maxTxRequestsEntries bounds the number of transactions whose requests are remembered,
+// the oldest entries are evicted when it is reached
+const maxTxRequestsEntries = 10000
+
+type txRequestsEntry struct {
+	reqIDs []iscp.RequestID
+	added  time.Time
+}
+
+// recordTxRequests remembers the requests to the chain contained in the transaction
+func (c *chainObj) recordTxRequests(txID ledgerstate.TransactionID, reqs []*request.OnLedger) {
+	if len(reqs) == 0 {
+		return
+	}
+	c.txRequestsMutex.Lock()
+	defer c.txRequestsMutex.Unlock()
+	if _, ok := c.txRequests[txID]; ok {
+		return
+	}
+	c.pruneTxRequests()
+	reqIDs := make([]iscp.RequestID, len(reqs))
+	for i, req := range reqs {
+		reqIDs[i] = req.ID()
+	}
+	c.txRequests[txID] = &txRequestsEntry{reqIDs: reqIDs, added: time.Now()}
+}
+
+// pruneTxRequests removes the oldest tenth of the entries when the limit is reached.
+// Must be called with txRequestsMutex locked.
+func (c *chainObj) pruneTxRequests() {
+	if len(c.txRequests) < maxTxRequestsEntries {
+		return
+	}
+	txIDs := make([]ledgerstate.TransactionID, 0, len(c.txRequests))
+	for txID := range c.txRequests {
+		txIDs = append(txIDs, txID)
+	}
+	sort.Slice(txIDs, func(i, j int) bool {
+		return c.txRequests[txIDs[i]].added.Before(c.txRequests[txIDs[j]].added)
+	})
+	for _, txID := range txIDs[:len(txIDs)-maxTxRequestsEntries*9/10] {
+		delete(c.txRequests, txID)
+	}
+}
+
+func (c *chainObj) GetTransactionRequestIDs(txID ledgerstate.TransactionID) ([]iscp.RequestID, bool) {
+	c.txRequestsMutex.Lock()
+	defer c.txRequestsMutex.Unlock()
+	entry, ok := c.txRequests[txID]
+	if !ok {
+		return nil, false
+	}
+	ret := make([]iscp.RequestID, len(entry.reqIDs))
+	copy(ret, entry.reqIDs)
+	return ret, true
+}
@@ -0,0 +1,44 @@
+package chainimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxRequests(t *testing.T) {
+	c := &chainObj{txRequests: make(map[ledgerstate.TransactionID]*txRequestsEntry)}
+	reqID := testRequestID(1)
+	txID := reqID.OutputID().TransactionID()
+
+	_, ok := c.GetTransactionRequestIDs(txID)
+	require.False(t, ok)
+
+	c.txRequests[txID] = &txRequestsEntry{reqIDs: []iscp.RequestID{reqID}, added: time.Now()}
+	reqIDs, ok := c.GetTransactionRequestIDs(txID)
+	require.True(t, ok)
+	require.EqualValues(t, []iscp.RequestID{reqID}, reqIDs)
+}
+
+func TestTxRequestsLimit(t *testing.T) {
+	c := &chainObj{txRequests: make(map[ledgerstate.TransactionID]*txRequestsEntry)}
+	now := time.Now()
+	for i := 0; i < maxTxRequestsEntries; i++ {
+		reqID := testRequestID(i)
+		c.txRequests[reqID.OutputID().TransactionID()] = &txRequestsEntry{
+			reqIDs: []iscp.RequestID{reqID},
+			added:  now.Add(time.Duration(i-maxTxRequestsEntries) * time.Millisecond),
+		}
+	}
+
+	c.pruneTxRequests()
+	require.Len(t, c.txRequests, maxTxRequestsEntries*9/10)
+	// the oldest entries are evicted first
+	_, ok := c.GetTransactionRequestIDs(testRequestID(0).OutputID().TransactionID())
+	require.False(t, ok)
+	_, ok = c.GetTransactionRequestIDs(testRequestID(maxTxRequestsEntries - 1).OutputID().TransactionID())
+	require.True(t, ok)
+}
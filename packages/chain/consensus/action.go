@@ -37,6 +37,7 @@ func (c *Consensus) takeAction() {
 	c.checkQuorum()
 	c.postTransactionIfNeeded()
 	c.pullInclusionStateIfNeeded()
+	c.recoverIfTransactionNotConfirmed()
 }
 
 // proposeBatchIfNeeded when non empty ready batch is available is in mempool propose it as a candidate
@@ -76,6 +77,7 @@ func (c *Consensus) proposeBatchIfNeeded() {
 	c.log.Infof("proposeBatch: proposed batch len = %d, ACS session ID: %d, state index: %d",
 		len(reqs), c.acsSessionID, c.stateOutput.GetStateIndex())
 	c.workflow.batchProposalSent = true
+	c.chain.ReceiveMessage(&messages.BatchProposalSentMsg{RequestIDs: proposal.RequestIDs})
 }
 
 // runVMIfNeeded attempts to extract deterministic batch of requests from ACS.
@@ -359,6 +361,7 @@ func (c *Consensus) checkQuorum() {
 	}
 	c.workflow.transactionFinalized = true
 	c.pullInclusionStateDeadline = time.Now()
+	c.txConfirmationDeadline = time.Now().Add(c.timers.TxConfirmationTimeout)
 }
 
 // postTransactionIfNeeded posts a finalized transaction upon deadline unless it was evidenced on L1 before the deadline.
@@ -391,7 +394,8 @@ func (c *Consensus) postTransactionIfNeeded() {
 }
 
 // pullInclusionStateIfNeeded periodic pull to know the inclusions state of the transaction. Note that pulling
-// starts immediately after finalization of the transaction, not after posting it
+// starts immediately after finalization of the transaction, not after posting it. Pulling stops once the
+// transaction is seen on L1; if it never gets confirmed after that, recoverIfTransactionNotConfirmed restarts the workflow
 func (c *Consensus) pullInclusionStateIfNeeded() {
 	if !c.workflow.transactionFinalized {
 		c.log.Debugf("pullInclusionState not needed: transaction is not finalized")
@@ -410,6 +414,45 @@ func (c *Consensus) pullInclusionStateIfNeeded() {
 	c.log.Debugf("pullInclusionState: request for inclusion state sent")
 }
 
+// recoverIfTransactionNotConfirmed restarts the workflow if the finalized transaction is not confirmed
+// on L1 in time, e.g. because it was never booked, got orphaned or stayed pending
+func (c *Consensus) recoverIfTransactionNotConfirmed() {
+	if !c.workflow.transactionFinalized {
+		c.log.Debugf("recoverIfTransactionNotConfirmed not needed: transaction is not finalized")
+		return
+	}
+	if c.workflow.transactionConfirmed {
+		c.log.Debugf("recoverIfTransactionNotConfirmed not needed: transaction already confirmed")
+		return
+	}
+	if time.Now().Before(c.txConfirmationDeadline) {
+		c.log.Debugf("recoverIfTransactionNotConfirmed not needed: waiting for confirmation till %v", c.txConfirmationDeadline)
+		return
+	}
+	c.recoverWorkflow(fmt.Sprintf("transaction %s was not confirmed in %v", c.finalTx.ID().Base58(), c.timers.TxConfirmationTimeout))
+}
+
+// recoverWorkflow restarts the workflow after the state transaction failed. The latest confirmed
+// state output is pulled, and a fresh batch is proposed on it after a delay, which grows
+// exponentially with each consecutive recovery
+func (c *Consensus) recoverWorkflow(reason string) {
+	c.recoveries++
+	c.consecutiveRecoveries++
+	backoff := c.timers.RecoveryBackoff
+	for i := uint32(1); i < c.consecutiveRecoveries && backoff < c.timers.MaxRecoveryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.timers.MaxRecoveryBackoff {
+		backoff = c.timers.MaxRecoveryBackoff
+	}
+	c.log.Warnf("recoverWorkflow: %s; proposing a new batch in %v (recovery #%d, consecutive: %d)",
+		reason, backoff, c.recoveries, c.consecutiveRecoveries)
+	c.resetWorkflow()
+	c.delayBatchProposalUntil = time.Now().Add(backoff)
+	c.nodeConn.PullState(c.chain.ID().AsAliasAddress())
+	c.refreshConsensusInfo()
+}
+
 // prepareBatchProposal creates a batch proposal structure out of requests
 func (c *Consensus) prepareBatchProposal(reqs []iscp.Request) *BatchProposal {
 	ts := time.Now()
@@ -575,13 +618,14 @@ func (c *Consensus) processInclusionState(msg *messages.InclusionStateMsg) {
 		c.log.Debugf("processInclusionState: transaction id %v is pending.", c.finalTx.ID().Base58())
 	case ledgerstate.Confirmed:
 		c.workflow.transactionSeen = true
+		c.workflow.transactionConfirmed = true
 		c.workflow.inProgress = false
+		c.consecutiveRecoveries = 0
 		c.refreshConsensusInfo()
 		c.log.Debugf("processInclusionState: transaction id %s is confirmed; workflow finished", msg.TxID.Base58())
 	case ledgerstate.Rejected:
 		c.workflow.transactionSeen = true
-		c.log.Infof("processInclusionState: transaction id %s is rejected; restarting consensus.", msg.TxID.Base58())
-		c.resetWorkflow()
+		c.recoverWorkflow(fmt.Sprintf("transaction %s is rejected", msg.TxID.Base58()))
 	}
 }
 
@@ -634,6 +678,11 @@ func (c *Consensus) setNewState(msg *messages.StateTransitionMsg) {
 	}
 	c.log.Debugf("SET NEW STATE #%d%s, output: %s, hash: %s",
 		msg.StateOutput.GetStateIndex(), r, iscp.OID(msg.StateOutput.ID()), msg.State.StateCommitment().String())
+	if c.consecutiveRecoveries > 0 {
+		// the state has moved on, so there is no reason to delay proposals any longer
+		c.consecutiveRecoveries = 0
+		c.delayBatchProposalUntil = time.Time{}
+	}
 	c.resetWorkflow()
 }
 
@@ -48,6 +48,9 @@ type Consensus struct {
 	finalTx                          *ledgerstate.Transaction
 	postTxDeadline                   time.Time
 	pullInclusionStateDeadline       time.Time
+	txConfirmationDeadline           time.Time
+	recoveries                       uint32
+	consecutiveRecoveries            uint32
 	lastTimerTick                    atomic.Int64
 	consensusInfoSnapshot            atomic.Value
 	timers                           ConsensusTimers
@@ -76,6 +79,7 @@ type workflowFlags struct {
 	transactionFinalized bool
 	transactionPosted    bool
 	transactionSeen      bool
+	transactionConfirmed bool
 	inProgress           bool
 }
 
@@ -194,11 +198,12 @@ func (c *Consensus) refreshConsensusInfo() {
 		StateIndex: index,
 		Mempool:    c.mempool.Info(),
 		TimerTick:  int(c.lastTimerTick.Load()),
+		Recoveries: c.recoveries,
 	}
-	c.log.Debugf("Refreshing consensus info: index=%v, timerTick=%v, "+
+	c.log.Debugf("Refreshing consensus info: index=%v, timerTick=%v, recoveries=%v, "+
 		"totalPool=%v, mempoolReady=%v, inBufCounter=%v, outBufCounter=%v, "+
 		"inPoolCounter=%v, outPoolCounter=%v",
-		consensusInfo.StateIndex, consensusInfo.TimerTick,
+		consensusInfo.StateIndex, consensusInfo.TimerTick, consensusInfo.Recoveries,
 		consensusInfo.Mempool.TotalPool, consensusInfo.Mempool.ReadyCounter,
 		consensusInfo.Mempool.InBufCounter, consensusInfo.Mempool.OutBufCounter,
 		consensusInfo.Mempool.InPoolCounter, consensusInfo.Mempool.OutPoolCounter,
@@ -99,6 +99,86 @@ func TestConsensusPostRequestMockedACS(t *testing.T) {
 	})
 }
 
+func TestConsensusRecoveryMockedACS(t *testing.T) {
+	timers := consensus.NewConsensusTimers()
+	timers.PullInclusionStateRetry = 100 * time.Millisecond
+	timers.TxConfirmationTimeout = 500 * time.Millisecond
+	timers.RecoveryBackoff = 100 * time.Millisecond
+	timers.MaxRecoveryBackoff = 400 * time.Millisecond
+
+	t.Run("dropped transaction", func(t *testing.T) {
+		env, _ := consensus.NewMockedEnvWithMockedACS(t, 4, 3, false)
+		env.CreateNodes(timers)
+		defer env.Log.Sync()
+		env.DropTransactions(1)
+		env.StartTimers()
+		env.SetInitialConsensusState()
+		env.PostDummyRequests(1)
+		err := env.WaitMempool(1, 3, 15*time.Second)
+		require.NoError(t, err)
+		err = env.WaitRecoveries(1, 3)
+		require.NoError(t, err)
+	})
+	t.Run("rejected transaction", func(t *testing.T) {
+		env, _ := consensus.NewMockedEnvWithMockedACS(t, 4, 3, false)
+		env.CreateNodes(timers)
+		defer env.Log.Sync()
+		env.RejectTransactions(1)
+		env.StartTimers()
+		env.SetInitialConsensusState()
+		env.PostDummyRequests(1)
+		err := env.WaitMempool(1, 3, 15*time.Second)
+		require.NoError(t, err)
+		err = env.WaitRecoveries(1, 3)
+		require.NoError(t, err)
+	})
+	t.Run("pending transaction", func(t *testing.T) {
+		env, _ := consensus.NewMockedEnvWithMockedACS(t, 4, 3, false)
+		env.CreateNodes(timers)
+		defer env.Log.Sync()
+		env.KeepTransactionsPending(1)
+		env.StartTimers()
+		env.SetInitialConsensusState()
+		env.PostDummyRequests(1)
+		err := env.WaitMempool(1, 3, 15*time.Second)
+		require.NoError(t, err)
+		err = env.WaitRecoveries(1, 3)
+		require.NoError(t, err)
+		err = env.WaitStateIndex(3, 1)
+		require.NoError(t, err)
+	})
+	t.Run("confirmed transaction", func(t *testing.T) {
+		env, _ := consensus.NewMockedEnvWithMockedACS(t, 4, 3, false)
+		env.CreateNodes(timers)
+		defer env.Log.Sync()
+		env.StartTimers()
+		env.SetInitialConsensusState()
+		env.PostDummyRequests(1)
+		err := env.WaitMempool(1, 3, 15*time.Second)
+		require.NoError(t, err)
+		err = env.WaitStateIndex(3, 1)
+		require.NoError(t, err)
+		time.Sleep(2 * timers.TxConfirmationTimeout)
+		require.EqualValues(t, 0, env.MaxRecoveries())
+	})
+	t.Run("consecutive failures", func(t *testing.T) {
+		env, _ := consensus.NewMockedEnvWithMockedACS(t, 4, 3, false)
+		env.CreateNodes(timers)
+		defer env.Log.Sync()
+		env.RejectTransactions(2)
+		env.DropTransactions(1)
+		env.StartTimers()
+		env.SetInitialConsensusState()
+		env.PostDummyRequests(10)
+		err := env.WaitMempool(10, 3, 30*time.Second)
+		require.NoError(t, err)
+		err = env.WaitRecoveries(3, 3)
+		require.NoError(t, err)
+		err = env.WaitStateIndex(3, 1)
+		require.NoError(t, err)
+	})
+}
+
 func TestConsensusMoreNodesMockedACS(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
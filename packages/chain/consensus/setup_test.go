@@ -63,21 +63,28 @@ type MockedEnv struct {
 	InitStateOutput   *ledgerstate.AliasOutput
 	mutex             sync.Mutex
 	Nodes             []*mockedNode
+	txDropsLeft       int
+	txRejectsLeft     int
+	txPendingLeft     int
+	droppedTxs        map[ledgerstate.TransactionID]bool
+	rejectedTxs       map[ledgerstate.TransactionID]bool
+	pendingTxs        map[ledgerstate.TransactionID]bool
 }
 
 type mockedNode struct {
-	NodeID      string
-	Env         *MockedEnv
-	NodeConn    *testchain.MockedNodeConn  // GoShimmer mock
-	ChainCore   *testchain.MockedChainCore // Chain mock
-	stateSync   coreutil.ChainStateSync    // Chain mock
-	Mempool     chain.Mempool              // Consensus needs
-	Consensus   chain.Consensus            // Consensus needs
-	store       kvstore.KVStore            // State manager mock
-	SolidState  state.VirtualStateAccess   // State manager mock
-	StateOutput *ledgerstate.AliasOutput   // State manager mock
-	Log         *logger.Logger
-	mutex       sync.Mutex
+	NodeID         string
+	Env            *MockedEnv
+	NodeConn       *testchain.MockedNodeConn  // GoShimmer mock
+	ChainCore      *testchain.MockedChainCore // Chain mock
+	stateSync      coreutil.ChainStateSync    // Chain mock
+	Mempool        chain.Mempool              // Consensus needs
+	Consensus      chain.Consensus            // Consensus needs
+	store          kvstore.KVStore            // State manager mock
+	SolidState     state.VirtualStateAccess   // State manager mock
+	candidateState state.VirtualStateAccess   // State manager mock
+	StateOutput    *ledgerstate.AliasOutput   // State manager mock
+	Log            *logger.Logger
+	mutex          sync.Mutex
 }
 
 func NewMockedEnv(t *testing.T, n, quorum uint16, debug bool) (*MockedEnv, *ledgerstate.Transaction) {
@@ -99,11 +106,14 @@ func newMockedEnv(t *testing.T, n, quorum uint16, debug, mockACS bool) (*MockedE
 	log.Infof("creating test environment with N = %d, T = %d", n, quorum)
 
 	ret := &MockedEnv{
-		T:      t,
-		Quorum: quorum,
-		Log:    log,
-		Ledger: utxodb.New(),
-		Nodes:  make([]*mockedNode, n),
+		T:           t,
+		Quorum:      quorum,
+		Log:         log,
+		Ledger:      utxodb.New(),
+		Nodes:       make([]*mockedNode, n),
+		droppedTxs:  make(map[ledgerstate.TransactionID]bool),
+		rejectedTxs: make(map[ledgerstate.TransactionID]bool),
+		pendingTxs:  make(map[ledgerstate.TransactionID]bool),
 	}
 	if mockACS {
 		ret.MockedACS = testchain.NewMockedACSRunner(quorum, log)
@@ -175,6 +185,10 @@ func (env *MockedEnv) NewNode(nodeIndex uint16, timers ConsensusTimers) *mockedN
 		env.mutex.Lock()
 		defer env.mutex.Unlock()
 
+		if env.isTransactionLost(tx.ID()) {
+			ret.Log.Infof("transaction lost: %s", tx.ID().Base58())
+			return
+		}
 		if _, already := env.Ledger.GetTransaction(tx.ID()); !already {
 			if err := env.Ledger.AddTransaction(tx); err != nil {
 				ret.Log.Error(err)
@@ -186,8 +200,8 @@ func (env *MockedEnv) NewNode(nodeIndex uint16, timers ConsensusTimers) *mockedN
 			ret.Log.Infof("stored transaction to the ledger: %s", tx.ID().Base58())
 			for _, node := range env.Nodes {
 				go func(n *mockedNode) {
-					ret.mutex.Lock()
-					defer ret.mutex.Unlock()
+					n.mutex.Lock()
+					defer n.mutex.Unlock()
 					n.StateOutput = stateOutput
 					n.checkStateApproval()
 				}(node)
@@ -197,12 +211,41 @@ func (env *MockedEnv) NewNode(nodeIndex uint16, timers ConsensusTimers) *mockedN
 		}
 	})
 	ret.NodeConn.OnPullTransactionInclusionState(func(addr ledgerstate.Address, txid ledgerstate.TransactionID) {
+		env.mutex.Lock()
+		defer env.mutex.Unlock()
+
 		if _, already := env.Ledger.GetTransaction(txid); already {
 			go ret.ChainCore.ReceiveMessage(&messages.InclusionStateMsg{
 				TxID:  txid,
 				State: ledgerstate.Confirmed,
 			})
 		}
+		if env.rejectedTxs[txid] {
+			go ret.ChainCore.ReceiveMessage(&messages.InclusionStateMsg{
+				TxID:  txid,
+				State: ledgerstate.Rejected,
+			})
+		}
+		if env.pendingTxs[txid] {
+			go ret.ChainCore.ReceiveMessage(&messages.InclusionStateMsg{
+				TxID:  txid,
+				State: ledgerstate.Pending,
+			})
+		}
+	})
+	ret.NodeConn.OnPullState(func(addr *ledgerstate.AliasAddress) {
+		env.mutex.Lock()
+		outputs := env.Ledger.GetAliasOutputs(addr)
+		env.mutex.Unlock()
+		require.Len(env.T, outputs, 1)
+		go func() {
+			ret.mutex.Lock()
+			defer ret.mutex.Unlock()
+			if ret.StateOutput.ID() != outputs[0].ID() {
+				ret.StateOutput = outputs[0]
+				ret.checkStateApproval()
+			}
+		}()
 	})
 	mempoolMetrics := metrics.DefaultChainMetrics()
 	ret.Mempool = mempool.New(ret.ChainCore.GetStateReader(), iscp.NewInMemoryBlobCache(), log, mempoolMetrics)
@@ -258,15 +301,13 @@ func (env *MockedEnv) NewNode(nodeIndex uint16, timers ConsensusTimers) *mockedN
 		ret.Log.Infof("chainCore.StateCandidateMsg: state hash: %s, approving output: %s",
 			msg.State.StateCommitment(), iscp.OID(msg.ApprovingOutputID))
 
-		if ret.SolidState != nil && ret.SolidState.BlockIndex() == newState.BlockIndex() {
+		if ret.SolidState != nil && ret.SolidState.BlockIndex() >= newState.BlockIndex() {
 			ret.Log.Debugf("new state already committed for index %d", newState.BlockIndex())
 			return
 		}
-		err := newState.Commit()
-		require.NoError(env.T, err)
-
-		ret.SolidState = newState
-		ret.Log.Debugf("committed new state for index %d", newState.BlockIndex())
+		// the state is committed only when approved by the state output. A later candidate
+		// for the same index replaces the one, which transaction was not confirmed
+		ret.candidateState = newState
 
 		ret.checkStateApproval()
 	})
@@ -286,6 +327,56 @@ func (env *MockedEnv) NewNode(nodeIndex uint16, timers ConsensusTimers) *mockedN
 	return ret
 }
 
+// DropTransactions makes the ledger ignore the next n distinct posted transactions:
+// they are never booked and their inclusion state is never reported
+func (env *MockedEnv) DropTransactions(n int) {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	env.txDropsLeft = n
+}
+
+// RejectTransactions makes the ledger reject the next n distinct posted transactions,
+// as if they were conflicting with another transaction
+func (env *MockedEnv) RejectTransactions(n int) {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	env.txRejectsLeft = n
+}
+
+// KeepTransactionsPending makes the ledger report the next n distinct posted transactions
+// as pending forever: they are seen, but never booked nor confirmed
+func (env *MockedEnv) KeepTransactionsPending(n int) {
+	env.mutex.Lock()
+	defer env.mutex.Unlock()
+	env.txPendingLeft = n
+}
+
+// isTransactionLost must be called with env.mutex locked
+func (env *MockedEnv) isTransactionLost(txid ledgerstate.TransactionID) bool {
+	if env.droppedTxs[txid] || env.rejectedTxs[txid] || env.pendingTxs[txid] {
+		return true
+	}
+	if _, already := env.Ledger.GetTransaction(txid); already {
+		return false
+	}
+	if env.txDropsLeft > 0 {
+		env.txDropsLeft--
+		env.droppedTxs[txid] = true
+		return true
+	}
+	if env.txRejectsLeft > 0 {
+		env.txRejectsLeft--
+		env.rejectedTxs[txid] = true
+		return true
+	}
+	if env.txPendingLeft > 0 {
+		env.txPendingLeft--
+		env.pendingTxs[txid] = true
+		return true
+	}
+	return false
+}
+
 func (env *MockedEnv) nodeCount() int {
 	return len(env.NodeIDs)
 }
@@ -304,15 +395,23 @@ func (env *MockedEnv) SetInitialConsensusState() {
 }
 
 func (n *mockedNode) checkStateApproval() {
-	if n.SolidState == nil || n.StateOutput == nil {
+	if n.candidateState == nil || n.StateOutput == nil {
 		return
 	}
-	if n.SolidState.BlockIndex() != n.StateOutput.GetStateIndex() {
+	if n.candidateState.BlockIndex() != n.StateOutput.GetStateIndex() {
 		return
 	}
 	stateHash, err := hashing.HashValueFromBytes(n.StateOutput.GetStateData())
 	require.NoError(n.Env.T, err)
-	require.EqualValues(n.Env.T, stateHash, n.SolidState.StateCommitment())
+	if stateHash != n.candidateState.StateCommitment() {
+		n.Log.Debugf("state candidate %s is not approved by the state output %s", n.candidateState.StateCommitment(), iscp.OID(n.StateOutput.ID()))
+		return
+	}
+	err = n.candidateState.Commit()
+	require.NoError(n.Env.T, err)
+	n.SolidState = n.candidateState
+	n.candidateState = nil
+	n.Log.Debugf("committed new state for index %d", n.SolidState.BlockIndex())
 
 	reqIDsForLastState := make([]iscp.RequestID, 0)
 	prefix := kv.Key(util.Uint32To4Bytes(n.SolidState.BlockIndex()))
@@ -383,6 +482,25 @@ func (env *MockedEnv) WaitStateIndex(quorum int, stateIndex uint32, timeout ...t
 	return env.WaitForEventFromNodesQuorum("stateIndex", quorum, checkStateIndexFun, timeout...)
 }
 
+func (env *MockedEnv) WaitRecoveries(recoveries uint32, quorum int, timeout ...time.Duration) error {
+	checkRecoveriesFun := func(node *mockedNode) bool {
+		snap := node.Consensus.GetStatusSnapshot()
+		return snap != nil && snap.Recoveries >= recoveries
+	}
+	return env.WaitForEventFromNodesQuorum("recoveries", quorum, checkRecoveriesFun, timeout...)
+}
+
+// MaxRecoveries returns the largest number of consensus recoveries among the nodes
+func (env *MockedEnv) MaxRecoveries() uint32 {
+	ret := uint32(0)
+	for _, node := range env.Nodes {
+		if snap := node.Consensus.GetStatusSnapshot(); snap != nil && snap.Recoveries > ret {
+			ret = snap.Recoveries
+		}
+	}
+	return ret
+}
+
 func (env *MockedEnv) WaitMempool(numRequests int, quorum int, timeout ...time.Duration) error { //nolint:gocritic
 	checkMempoolFun := func(node *mockedNode) bool {
 		snap := node.Consensus.GetStatusSnapshot()
@@ -14,6 +14,11 @@ type ConsensusTimers struct {
 	PullInclusionStateRetry          time.Duration
 	ProposeBatchRetry                time.Duration
 	ProposeBatchDelayForNewState     time.Duration
+	// how long to wait for the state transaction to be confirmed before restarting the workflow
+	TxConfirmationTimeout time.Duration
+	// delay of the batch proposal after the first recovery; doubled with each consecutive recovery
+	RecoveryBackoff    time.Duration
+	MaxRecoveryBackoff time.Duration
 }
 
 func NewConsensusTimers() ConsensusTimers {
@@ -24,5 +29,8 @@ func NewConsensusTimers() ConsensusTimers {
 		PullInclusionStateRetry:          1 * time.Second,
 		ProposeBatchRetry:                500 * time.Millisecond,
 		ProposeBatchDelayForNewState:     1 * time.Second, // experimental !!!!!
+		TxConfirmationTimeout:            30 * time.Second,
+		RecoveryBackoff:                  1 * time.Second,
+		MaxRecoveryBackoff:               1 * time.Minute,
 	}
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/iotaledger/wasp/packages/registry"
 	"github.com/iotaledger/wasp/packages/state"
 	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"golang.org/x/xerrors"
 )
 
 type Mempool struct {
@@ -127,6 +129,20 @@ func (m *Mempool) addToPool(req iscp.Request) bool {
 		// remove from the in-buffer but not include into the pool
 		return true
 	}
+	if req.IsOffLedger() {
+		// on-ledger requests are rejected by the VM, so that their tokens can be sent back
+		targetContract, _ := req.Target()
+		if err := governance.CheckACLFromChainState(m.stateReader.KVStoreReader(), req.SenderAccount(), targetContract); err != nil {
+			var vmErr *iscp.VMError
+			if !xerrors.As(err, &vmErr) {
+				// may be invalidated state. Do not remove from in-buffer yet
+				m.log.Debugf("addToPool, CheckACL error: %v", err)
+				return false
+			}
+			m.log.Warnf("addToPool: request %s rejected: %v", reqid.Base58(), vmErr)
+			return true
+		}
+	}
 	m.poolMutex.Lock()
 	defer m.poolMutex.Unlock()
 
@@ -23,6 +23,7 @@ import (
 	"github.com/iotaledger/wasp/packages/testutil/testlogger"
 	"github.com/iotaledger/wasp/packages/util"
 	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
@@ -264,6 +265,36 @@ func TestProcessedRequest(t *testing.T) {
 	require.EqualValues(t, 0, stats.ReadyCounter)
 }
 
+// Test if off-ledger requests of senders denied by the ACL are not admitted to the mempool
+func TestACLOffLedgerRequest(t *testing.T) {
+	log := testlogger.NewLogger(t)
+	glb := coreutil.NewChainStateSync().SetSolidIndex(0)
+	rdr, vs := createStateReader(t, glb)
+	pool := New(rdr, iscp.NewInMemoryBlobCache(), log, new(MockMempoolMetrics))
+	require.NotNil(t, pool)
+
+	onLedgerRequests, keyPair := getRequestsOnLedger(t, 2)
+	contract, entryPoint := onLedgerRequests[0].Target()
+	denied := request.NewOffLedger(contract, entryPoint, onLedgerRequests[0].GetMetadata().Args())
+	denied.Sign(keyPair)
+	allowed := request.NewOffLedger(contract, entryPoint, onLedgerRequests[1].GetMetadata().Args())
+	otherKeyPair, _ := testkey.GenKeyAddr()
+	allowed.Sign(otherKeyPair)
+
+	// put the sender of the first request into the global deny list
+	governancePartition := subrealm.New(vs.KVStore(), kv.Key(governance.Contract.Hname().Bytes()))
+	governance.ACLMap(governancePartition, governance.ACLDeny, 0).MustSetAt(denied.SenderAccount().Bytes(), []byte{0xFF})
+	blocklogPartition := subrealm.New(vs.KVStore(), kv.Key(blocklog.Contract.Hname().Bytes()))
+	blocklogPartition.Set(coreutil.StateVarBlockIndex, util.Uint64To8Bytes(1))
+	err := vs.Commit()
+	require.NoError(t, err)
+
+	pool.ReceiveRequests(denied, allowed)
+	require.True(t, pool.WaitRequestInPool(allowed.ID(), 1*time.Second))
+	require.False(t, pool.HasRequest(denied.ID()))
+	require.EqualValues(t, 1, pool.Info().TotalPool)
+}
+
 // Test if adding and removing requests is handled correctly
 func TestAddRemoveRequests(t *testing.T) {
 	log := testlogger.NewLogger(t)
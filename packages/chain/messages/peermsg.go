@@ -9,6 +9,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/peering"
 	"github.com/iotaledger/wasp/packages/state"
 	"github.com/iotaledger/wasp/packages/util"
@@ -26,6 +27,7 @@ const (
 	MsgMissingRequestIDs
 	MsgMissingRequest
 	MsgRequestAck
+	MsgGetBlocks
 )
 
 type TimerTick int
@@ -43,13 +45,21 @@ type SignedResultAckMsg struct {
 	EssenceHash  hashing.HashValue
 }
 
-// GetBlockMsg StateManager queries specific block data from another peer (access node)
+// GetBlockMsg StateManager queries specific block data from another peer (access node).
+// Sent by nodes which do not request block ranges yet
 type GetBlockMsg struct {
 	SenderNetID string
 	BlockIndex  uint32
 }
 
-// BlockMsg StateManager in response to GetBlockMsg sends block data to the querying node's StateManager
+// GetBlocksMsg StateManager queries a range of blocks [FromIndex, ToIndex] from another peer (access node)
+type GetBlocksMsg struct {
+	SenderNetID string
+	FromIndex   uint32
+	ToIndex     uint32
+}
+
+// BlockMsg StateManager in response to GetBlocksMsg sends data of each requested block to the querying node's StateManager
 type BlockMsg struct {
 	SenderNetID string
 	BlockBytes  []byte
@@ -87,6 +97,11 @@ type AsynchronousCommonSubsetMsg struct {
 	SessionID          uint64
 }
 
+// BatchProposalSentMsg Consensus notifies the chain about the requests it included in its batch proposal
+type BatchProposalSentMsg struct {
+	RequestIDs []iscp.RequestID
+}
+
 // InclusionStateMsg txstream plugin sends inclusions state of the transaction to ConsensusOld
 type InclusionStateMsg struct {
 	TxID  ledgerstate.TransactionID
@@ -107,6 +122,20 @@ func (msg *GetBlockMsg) Read(r io.Reader) error {
 	return util.ReadUint32(r, &msg.BlockIndex)
 }
 
+func (msg *GetBlocksMsg) Write(w io.Writer) error {
+	if err := util.WriteUint32(w, msg.FromIndex); err != nil {
+		return err
+	}
+	return util.WriteUint32(w, msg.ToIndex)
+}
+
+func (msg *GetBlocksMsg) Read(r io.Reader) error {
+	if err := util.ReadUint32(r, &msg.FromIndex); err != nil {
+		return err
+	}
+	return util.ReadUint32(r, &msg.ToIndex)
+}
+
 func (msg *BlockMsg) Write(w io.Writer) error {
 	return util.WriteBytes32(w, msg.BlockBytes)
 }
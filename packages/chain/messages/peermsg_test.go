@@ -0,0 +1,34 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package messages
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/peering"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlockMsgCodes(t *testing.T) {
+	// nodes which only request single blocks must still be answered
+	require.EqualValues(t, 1+peering.FirstUserMsgCode, MsgGetBlock)
+	require.NotEqual(t, MsgGetBlock, MsgGetBlocks)
+
+	msg := &GetBlockMsg{BlockIndex: 42}
+	var buf bytes.Buffer
+	require.NoError(t, msg.Write(&buf))
+	require.EqualValues(t, 4, buf.Len())
+	msg1 := &GetBlockMsg{}
+	require.NoError(t, msg1.Read(&buf))
+	require.EqualValues(t, 42, msg1.BlockIndex)
+
+	msgs := &GetBlocksMsg{FromIndex: 3, ToIndex: 7}
+	buf.Reset()
+	require.NoError(t, msgs.Write(&buf))
+	msgs1 := &GetBlocksMsg{}
+	require.NoError(t, msgs1.Read(&buf))
+	require.EqualValues(t, 3, msgs1.FromIndex)
+	require.EqualValues(t, 7, msgs1.ToIndex)
+}
@@ -118,13 +118,22 @@ func (sm *stateManager) addStateCandidateFromConsensus(nextState state.VirtualSt
 	return true
 }
 
-func (sm *stateManager) addBlockFromPeer(block state.Block) bool {
-	sm.log.Debugf("addBlockFromPeer: adding block index %v", block.BlockIndex())
+func (sm *stateManager) addBlockFromPeer(block state.Block, netID string) bool {
+	sm.log.Debugf("addBlockFromPeer: adding block index %v from peer %v", block.BlockIndex(), netID)
 	if !sm.syncingBlocks.isSyncing(block.BlockIndex()) {
 		// not asked
 		sm.log.Debugf("addBlockFromPeer failed: not asked for block index %v", block.BlockIndex())
 		return false
 	}
+	if !sm.isBlockFromPeerValid(block) {
+		sm.log.Warnf("addBlockFromPeer failed: block index %v from peer %v does not extend the known chain of states",
+			block.BlockIndex(), netID)
+		sm.syncingPeers.blockRejected(netID)
+		return false
+	}
+	if latency, ok := sm.syncingBlocks.setAnswered(block.BlockIndex(), netID); ok {
+		sm.syncingPeers.blockReceived(netID, latency)
+	}
 	if sm.addBlockAndCheckStateOutput(block, nil) {
 		// ask for approving output
 		chainAddress := sm.chain.ID().AsAddress()
@@ -134,6 +143,29 @@ func (sm *stateManager) addBlockFromPeer(block state.Block) bool {
 	return true
 }
 
+// isBlockFromPeerValid checks the previous state hash of the block against the hash of the
+// solid state or of the approved block candidates with the preceding index, if those are known.
+// Blocks, which cannot be checked yet, are verified once the candidates are committed.
+func (sm *stateManager) isBlockFromPeerValid(block state.Block) bool {
+	prevIndex := block.BlockIndex() - 1
+	if prevIndex < sm.solidState.BlockIndex() {
+		return false
+	}
+	if prevIndex == sm.solidState.BlockIndex() {
+		return block.PreviousStateHash() == sm.solidState.StateCommitment()
+	}
+	knownHashes := sm.syncingBlocks.getApprovedNextStateHashes(prevIndex)
+	if len(knownHashes) == 0 {
+		return true
+	}
+	for _, hash := range knownHashes {
+		if hash == block.PreviousStateHash() {
+			return true
+		}
+	}
+	return false
+}
+
 // addBlockAndCheckStateOutput function adds block to candidate list and returns true iff the block is new and is not yet approved by current stateOutput
 func (sm *stateManager) addBlockAndCheckStateOutput(block state.Block, nextState state.VirtualStateAccess) bool {
 	isBlockNew, candidate := sm.syncingBlocks.addBlockCandidate(block, nextState)
@@ -164,6 +196,7 @@ func (sm *stateManager) storeSyncingData() {
 	}
 	sm.log.Debugf("storeSyncingData: storing values: Synced %v, SyncedBlockIndex %v, SyncedStateHash %v, SyncedStateTimestamp %v, StateOutputBlockIndex %v, StateOutputID %v, StateOutputHash %v, StateOutputTimestamp %v",
 		sm.isSynced(), sm.solidState.BlockIndex(), sm.solidState.StateCommitment().String(), sm.solidState.Timestamp(), sm.stateOutput.GetStateIndex(), iscp.OID(sm.stateOutput.ID()), outputStateHash.String(), sm.stateOutputTimestamp)
+	fetched, requested := sm.syncingBlocks.getSyncProgress(sm.solidState.BlockIndex()+1, sm.stateOutput.GetStateIndex())
 	sm.currentSyncData.Store(&chain.SyncInfo{
 		Synced:                sm.isSynced(),
 		SyncedBlockIndex:      sm.solidState.BlockIndex(),
@@ -173,5 +206,8 @@ func (sm *stateManager) storeSyncingData() {
 		StateOutputID:         sm.stateOutput.ID(),
 		StateOutputHash:       outputStateHash,
 		StateOutputTimestamp:  sm.stateOutputTimestamp,
+		BlocksFetched:         fetched,
+		BlocksRequested:       requested,
+		SyncPeers:             sm.syncingPeers.getInfo(),
 	})
 }
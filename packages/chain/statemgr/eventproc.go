@@ -12,41 +12,48 @@ import (
 	"github.com/iotaledger/wasp/packages/util"
 )
 
-// EventGetBlockMsg is a request for a block while syncing
-func (sm *stateManager) EventGetBlockMsg(msg *messages.GetBlockMsg) {
-	sm.eventGetBlockMsgCh <- msg
+// EventGetBlocksMsg is a request for a range of blocks while syncing
+func (sm *stateManager) EventGetBlocksMsg(msg *messages.GetBlocksMsg) {
+	sm.eventGetBlocksMsgCh <- msg
 }
 
-func (sm *stateManager) eventGetBlockMsg(msg *messages.GetBlockMsg) {
-	sm.log.Debugw("EventGetBlockMsg received: ",
+func (sm *stateManager) eventGetBlocksMsg(msg *messages.GetBlocksMsg) {
+	sm.log.Debugw("EventGetBlocksMsg received: ",
 		"sender", msg.SenderNetID,
-		"block index", msg.BlockIndex,
+		"from index", msg.FromIndex,
+		"to index", msg.ToIndex,
 	)
 	if sm.stateOutput == nil { // Not a necessary check, only for optimization.
-		sm.log.Debugf("EventGetBlockMsg ignored: stateOutput is nil")
+		sm.log.Debugf("EventGetBlocksMsg ignored: stateOutput is nil")
 		return
 	}
-	if msg.BlockIndex > sm.stateOutput.GetStateIndex() { // Not a necessary check, only for optimization.
-		sm.log.Debugf("EventGetBlockMsg ignored 1: block #%d not found. Current state index: #%d",
-			msg.BlockIndex, sm.stateOutput.GetStateIndex())
+	if msg.FromIndex > msg.ToIndex {
+		sm.log.Warnf("EventGetBlocksMsg ignored: wrong range #%d..#%d requested by peer %s", msg.FromIndex, msg.ToIndex, msg.SenderNetID)
 		return
 	}
-	blockBytes, err := state.LoadBlockBytes(sm.store, msg.BlockIndex)
-	if err != nil {
-		sm.log.Errorf("EventGetBlockMsg: LoadBlockBytes: %v", err)
-		return
+	toIndex := msg.ToIndex
+	if toIndex-msg.FromIndex >= maxBlocksPerRequestConst {
+		toIndex = msg.FromIndex + maxBlocksPerRequestConst - 1
 	}
-	if blockBytes == nil {
-		sm.log.Debugf("EventGetBlockMsg ignored 2: block #%d not found. Current state index: #%d",
-			msg.BlockIndex, sm.stateOutput.GetStateIndex())
-		return
+	if toIndex > sm.stateOutput.GetStateIndex() { // Not a necessary check, only for optimization.
+		toIndex = sm.stateOutput.GetStateIndex()
+	}
+	for i := msg.FromIndex; i <= toIndex; i++ {
+		blockBytes, err := state.LoadBlockBytes(sm.store, i)
+		if err != nil {
+			sm.log.Errorf("EventGetBlocksMsg: LoadBlockBytes: %v", err)
+			return
+		}
+		if blockBytes == nil {
+			sm.log.Debugf("EventGetBlocksMsg: block #%d not found. Current state index: #%d",
+				i, sm.stateOutput.GetStateIndex())
+			return
+		}
+		sm.log.Debugf("EventGetBlocksMsg for state index #%d --> responding to peer %s", i, msg.SenderNetID)
+		sm.peers.SendSimple(msg.SenderNetID, messages.MsgBlock, util.MustBytes(&messages.BlockMsg{
+			BlockBytes: blockBytes,
+		}))
 	}
-
-	sm.log.Debugf("EventGetBlockMsg for state index #%d --> responding to peer %s", msg.BlockIndex, msg.SenderNetID)
-
-	sm.peers.SendSimple(msg.SenderNetID, messages.MsgBlock, util.MustBytes(&messages.BlockMsg{
-		BlockBytes: blockBytes,
-	}))
 }
 
 // EventBlockMsg
@@ -70,7 +77,7 @@ func (sm *stateManager) eventBlockMsg(msg *messages.BlockMsg) {
 		"block index", block.BlockIndex(),
 		"approving output", iscp.OID(block.ApprovingOutputID()),
 	)
-	if sm.addBlockFromPeer(block) {
+	if sm.addBlockFromPeer(block, msg.SenderNetID) {
 		sm.takeAction()
 	}
 }
@@ -230,15 +230,15 @@ func (env *MockedEnv) NewMockedNode(nodeIndex int, timers StateManagerTimers) *M
 		rdr := bytes.NewReader(recvEvent.Msg.MsgData)
 
 		switch recvEvent.Msg.MsgType {
-		case messages.MsgGetBlock:
-			msgt := &messages.GetBlockMsg{}
+		case messages.MsgGetBlocks:
+			msgt := &messages.GetBlocksMsg{}
 			if err := msgt.Read(rdr); err != nil {
 				log.Error(err)
 				return
 			}
 
 			msgt.SenderNetID = recvEvent.Msg.SenderNetID
-			ret.StateManager.EventGetBlockMsg(msgt)
+			ret.StateManager.EventGetBlocksMsg(msgt)
 
 		case messages.MsgBlock:
 			msgt := &messages.BlockMsg{}
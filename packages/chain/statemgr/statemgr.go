@@ -33,11 +33,12 @@ type stateManager struct {
 	currentSyncData        atomic.Value
 	notifiedAnchorOutputID ledgerstate.OutputID
 	syncingBlocks          *syncingBlocks
+	syncingPeers           *syncingPeers
 	timers                 StateManagerTimers
 	log                    *logger.Logger
 
 	// Channels for accepting external events.
-	eventGetBlockMsgCh       chan *messages.GetBlockMsg
+	eventGetBlocksMsgCh      chan *messages.GetBlocksMsg
 	eventBlockMsgCh          chan *messages.BlockMsg
 	eventStateOutputMsgCh    chan *messages.StateMsg
 	eventOutputMsgCh         chan ledgerstate.Output
@@ -49,6 +50,8 @@ type stateManager struct {
 const (
 	numberOfNodesToRequestBlockFromConst = 5
 	maxBlocksToCommitConst               = 10000 // 10k
+	maxBlocksPerRequestConst             = 32    // size of a single range request to a peer
+	syncWindowConst                      = 128   // max number of missing blocks requested at once
 )
 
 func New(store kvstore.KVStore, c chain.ChainCore, peers peering.PeerDomainProvider, nodeconn chain.NodeConnection, timersOpt ...StateManagerTimers) chain.StateManager {
@@ -65,10 +68,11 @@ func New(store kvstore.KVStore, c chain.ChainCore, peers peering.PeerDomainProvi
 		nodeConn:                 nodeconn,
 		peers:                    peers,
 		syncingBlocks:            newSyncingBlocks(c.Log(), timers.GetBlockRetry),
+		syncingPeers:             newSyncingPeers(timers.GetBlockRetry / 2),
 		timers:                   timers,
 		log:                      c.Log().Named("s"),
 		pullStateRetryTime:       time.Now(),
-		eventGetBlockMsgCh:       make(chan *messages.GetBlockMsg),
+		eventGetBlocksMsgCh:      make(chan *messages.GetBlocksMsg),
 		eventBlockMsgCh:          make(chan *messages.BlockMsg),
 		eventStateOutputMsgCh:    make(chan *messages.StateMsg),
 		eventOutputMsgCh:         make(chan ledgerstate.Output),
@@ -143,9 +147,9 @@ func (sm *stateManager) recvLoop() {
 	sm.ready.SetReady()
 	for {
 		select {
-		case msg, ok := <-sm.eventGetBlockMsgCh:
+		case msg, ok := <-sm.eventGetBlocksMsgCh:
 			if ok {
-				sm.eventGetBlockMsg(msg)
+				sm.eventGetBlocksMsg(msg)
 			}
 		case msg, ok := <-sm.eventBlockMsgCh:
 			if ok {
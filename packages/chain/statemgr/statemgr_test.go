@@ -12,7 +12,9 @@ import (
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxoutil"
 	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/peering"
 	"github.com/iotaledger/wasp/packages/state"
+	"github.com/iotaledger/wasp/packages/testutil/testlogger"
 	"github.com/stretchr/testify/require"
 )
 
@@ -206,6 +208,128 @@ func TestManyStateTransitionsManyNodes(t *testing.T) {
 	}
 }
 
+// Catching up node has to fetch more blocks than fit into a single range request.
+// The ranges are requested from several peers and the blocks are not approved
+// by confirmed outputs, so they are verified only against the chain of state hashes.
+func TestCatchUpPipelined(t *testing.T) {
+	numberOfSourcePeers := 3
+	env, _ := NewMockedEnv(numberOfSourcePeers+1, t, true)
+	env.SetPushStateToNodesOption(true)
+
+	sourceNodes := make([]*MockedNode, numberOfSourcePeers)
+	for i := 0; i < numberOfSourcePeers; i++ {
+		sourceNodes[i] = env.NewMockedNode(i, NewStateManagerTimers())
+		sourceNodes[i].StateManager.Ready().MustWait()
+		sourceNodes[i].StartTimer()
+		sourceNodes[i].NodeConn.OnPullConfirmedOutput(func(addr ledgerstate.Address, outputID ledgerstate.OutputID) {})
+		env.AddNode(sourceNodes[i])
+	}
+
+	const targetBlockIndex = 2*maxBlocksPerRequestConst + 5
+	sourceNodes[0].OnStateTransitionMakeNewStateTransition(targetBlockIndex)
+	sourceNodes[0].MakeNewStateTransition()
+	for i := 0; i < numberOfSourcePeers; i++ {
+		waitSyncBlockIndexAndCheck(30*time.Second, t, sourceNodes[i], targetBlockIndex)
+	}
+
+	node := env.NewMockedNode(numberOfSourcePeers, NewStateManagerTimers())
+	node.StateManager.Ready().MustWait()
+	node.StartTimer()
+	node.NodeConn.OnPullConfirmedOutput(func(addr ledgerstate.Address, outputID ledgerstate.OutputID) {})
+	env.AddNode(node)
+
+	syncInfo := waitSyncBlockIndexAndCheck(10*time.Second, t, node, targetBlockIndex)
+	require.EqualValues(t, targetBlockIndex, syncInfo.StateOutputBlockIndex)
+	require.NotEmpty(t, syncInfo.SyncPeers)
+	delivered := uint32(0)
+	for _, peer := range syncInfo.SyncPeers {
+		delivered += peer.Delivered
+	}
+	require.GreaterOrEqual(t, delivered, uint32(targetBlockIndex))
+}
+
+func TestSyncingPeersRank(t *testing.T) {
+	peers := newSyncingPeers(100 * time.Millisecond)
+	peers.blockReceived("fast", 10*time.Millisecond)
+	peers.blockReceived("slow", 500*time.Millisecond)
+	peers.blockReceived("timingOut", 10*time.Millisecond)
+	for i := 0; i < 9; i++ {
+		peers.requestTimedOut("timingOut")
+	}
+	peers.blockReceived("cheating", 10*time.Millisecond)
+	peers.blockRejected("cheating")
+	peers.blockRejected("cheating")
+	peers.blockRejected("cheating")
+
+	ranked := peers.rank([]string{"slow", "unknown", "cheating", "timingOut", "fast"})
+	require.EqualValues(t, []string{"fast", "unknown", "slow", "timingOut", "cheating"}, ranked)
+
+	info := peers.getInfo()
+	require.Len(t, info, 4)
+	require.EqualValues(t, "cheating", info[0].NetID)
+	require.EqualValues(t, 3, info[0].Rejected)
+	require.EqualValues(t, 9, info[3].Timeouts)
+}
+
+type recordingPeers struct {
+	peering.PeerDomainProvider
+	netIDs []string
+	sentTo []string
+}
+
+func (p *recordingPeers) GetRandomPeers(upToNumPeers int) []string {
+	return p.netIDs
+}
+
+func (p *recordingPeers) SendSimple(netID string, msgType byte, msgData []byte) {
+	p.sentTo = append(p.sentTo, netID)
+}
+
+// A repeated block request goes to another peer than the one which did not answer
+func TestRequestBlocksRetryAnotherPeer(t *testing.T) {
+	peers := &recordingPeers{netIDs: []string{"a", "b", "c"}}
+	timers := NewStateManagerTimers()
+	sm := &stateManager{
+		peers:         peers,
+		log:           testlogger.NewLogger(t),
+		timers:        timers,
+		syncingBlocks: newSyncingBlocks(testlogger.NewLogger(t), timers.GetBlockRetry),
+		syncingPeers:  newSyncingPeers(timers.GetBlockRetry / 2),
+	}
+	nowis := time.Now()
+	sm.requestBlocks([]uint32{1}, nowis)
+	require.Len(t, peers.sentTo, 1)
+
+	nowis = nowis.Add(2 * timers.GetBlockRetry)
+	require.EqualValues(t, []uint32{1}, sm.getBlocksToRequest(1, 1, nowis))
+	sm.requestBlocks([]uint32{1}, nowis)
+	require.Len(t, peers.sentTo, 2)
+	require.NotEqual(t, peers.sentTo[0], peers.sentTo[1])
+}
+
+// Blocks from peers must extend the solid state or an approved block candidate
+func TestBlockFromPeerVerified(t *testing.T) {
+	env, _ := NewMockedEnv(1, t, false)
+	node := env.NewMockedNode(0, NewStateManagerTimers())
+	node.StateManager.Ready().MustWait()
+	manager := node.StateManager.(*stateManager)
+	nextBlockFun := func(vstate state.VirtualStateAccess, blockIndex uint32) state.Block {
+		vstate.ApplyStateUpdates(state.NewStateUpdateWithBlocklogValues(blockIndex, time.Now(), vstate.StateCommitment()))
+		block, err := vstate.ExtractBlock()
+		require.NoError(t, err)
+		return block
+	}
+	nextState := manager.solidState.Copy()
+	block1 := nextBlockFun(nextState, 1)
+	block2 := nextBlockFun(nextState, 2)
+	wrongBlock1 := nextBlockFun(nextState.Copy(), 1)
+
+	require.True(t, manager.isBlockFromPeerValid(block1))
+	require.False(t, manager.isBlockFromPeerValid(wrongBlock1))
+	// previous state unknown yet
+	require.True(t, manager.isBlockFromPeerValid(block2))
+}
+
 // Call to MsgGetConfirmetOutput does not return anything. Synchronization must
 // be done using stateOutput only.
 func TestCatchUpNoConfirmedOutput(t *testing.T) {
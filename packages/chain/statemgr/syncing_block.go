@@ -21,6 +21,9 @@ type syncingBlocks struct {
 
 type syncingBlock struct {
 	requestBlockRetryTime time.Time
+	requestedFrom         string // peer, which was asked for the block the last time
+	requestTime           time.Time
+	answered              bool // true, if requestedFrom has already responded with the block
 	blockCandidates       map[hashing.HashValue]*candidateBlock
 }
 
@@ -45,6 +48,44 @@ func (syncsT *syncingBlocks) setRequestBlockRetryTime(stateIndex uint32, request
 	}
 }
 
+// setRequested records that the block was requested from the peer at requestTime
+func (syncsT *syncingBlocks) setRequested(stateIndex uint32, netID string, requestTime, requestBlockRetryTime time.Time) {
+	if sync, ok := syncsT.blocks[stateIndex]; ok {
+		sync.requestedFrom = netID
+		sync.requestTime = requestTime
+		sync.answered = false
+		sync.requestBlockRetryTime = requestBlockRetryTime
+	}
+}
+
+// getRequestedFrom returns the peer, which was asked for the block the last time
+func (syncsT *syncingBlocks) getRequestedFrom(stateIndex uint32) string {
+	if sync, ok := syncsT.blocks[stateIndex]; ok {
+		return sync.requestedFrom
+	}
+	return ""
+}
+
+// getUnansweredRequest returns the peer, which was asked for the block and has not responded yet
+func (syncsT *syncingBlocks) getUnansweredRequest(stateIndex uint32) (string, bool) {
+	sync, ok := syncsT.blocks[stateIndex]
+	if !ok || sync.requestedFrom == "" || sync.answered {
+		return "", false
+	}
+	return sync.requestedFrom, true
+}
+
+// setAnswered marks the request for the block as answered, if it was sent to the peer.
+// It returns the time elapsed since the request.
+func (syncsT *syncingBlocks) setAnswered(stateIndex uint32, netID string) (time.Duration, bool) {
+	sync, ok := syncsT.blocks[stateIndex]
+	if !ok || sync.requestedFrom != netID || sync.answered {
+		return 0, false
+	}
+	sync.answered = true
+	return time.Since(sync.requestTime), true
+}
+
 func (syncsT *syncingBlocks) getBlockCandidates(stateIndex uint32) []*candidateBlock {
 	sync, ok := syncsT.blocks[stateIndex]
 	if !ok {
@@ -94,6 +135,36 @@ func (syncsT *syncingBlocks) getApprovedBlockCandidatesCount(stateIndex uint32)
 	return approvedCount
 }
 
+// getApprovedNextStateHashes returns the hashes of the states, which are produced by
+// the approved block candidates of the state index
+func (syncsT *syncingBlocks) getApprovedNextStateHashes(stateIndex uint32) []hashing.HashValue {
+	result := make([]hashing.HashValue, 0, 1)
+	if sync, ok := syncsT.blocks[stateIndex]; ok {
+		for _, candidate := range sync.blockCandidates {
+			if candidate.isApproved() {
+				result = append(result, candidate.getNextStateHash())
+			}
+		}
+	}
+	return result
+}
+
+// getSyncProgress returns the number of blocks in the range, which have candidates,
+// and the number of blocks, which are requested, but have not arrived yet
+func (syncsT *syncingBlocks) getSyncProgress(fromIndex, toIndex uint32) (fetched, requested uint32) {
+	for i, sync := range syncsT.blocks {
+		if i < fromIndex || i > toIndex {
+			continue
+		}
+		if len(sync.blockCandidates) > 0 {
+			fetched++
+		} else if sync.requestedFrom != "" {
+			requested++
+		}
+	}
+	return fetched, requested
+}
+
 func (syncsT *syncingBlocks) hasBlockCandidates() bool {
 	return syncsT.hasBlockCandidatesNotOlderThan(0)
 }
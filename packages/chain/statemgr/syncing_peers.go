@@ -0,0 +1,96 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package statemgr
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iotaledger/wasp/packages/chain"
+)
+
+// syncingPeers keeps track of how responsive the peers are when asked for blocks.
+// Peers, which respond fast, are preferred when spreading block requests.
+type syncingPeers struct {
+	peers          map[string]*syncingPeer
+	defaultLatency time.Duration
+}
+
+type syncingPeer struct {
+	latency   time.Duration // exponential moving average of the block response time
+	delivered uint32
+	timeouts  uint32
+	rejected  uint32
+}
+
+// latencyWeightConst is the weight of the latest measurement in the moving average of peer latency
+const latencyWeightConst = 0.3
+
+func newSyncingPeers(defaultLatency time.Duration) *syncingPeers {
+	return &syncingPeers{
+		peers:          make(map[string]*syncingPeer),
+		defaultLatency: defaultLatency,
+	}
+}
+
+func (spT *syncingPeers) getPeer(netID string) *syncingPeer {
+	peer, ok := spT.peers[netID]
+	if !ok {
+		peer = &syncingPeer{latency: spT.defaultLatency}
+		spT.peers[netID] = peer
+	}
+	return peer
+}
+
+func (spT *syncingPeers) blockReceived(netID string, latency time.Duration) {
+	peer := spT.getPeer(netID)
+	peer.latency = time.Duration(latencyWeightConst*float64(latency) + (1-latencyWeightConst)*float64(peer.latency))
+	peer.delivered++
+}
+
+func (spT *syncingPeers) requestTimedOut(netID string) {
+	spT.getPeer(netID).timeouts++
+}
+
+func (spT *syncingPeers) blockRejected(netID string) {
+	spT.getPeer(netID).rejected++
+}
+
+// score of the peer; the lower, the better. Unanswered requests and invalid
+// blocks make the peer look slower. The penalty decays as the peer delivers blocks.
+func (spT *syncingPeers) score(netID string) float64 {
+	peer, ok := spT.peers[netID]
+	if !ok {
+		return float64(spT.defaultLatency)
+	}
+	penalty := float64(peer.timeouts+4*peer.rejected) / float64(peer.delivered+1)
+	return float64(peer.latency) * (1 + penalty)
+}
+
+// rank sorts the provided peers from the most to the least responsive one
+func (spT *syncingPeers) rank(netIDs []string) []string {
+	result := make([]string, len(netIDs))
+	copy(result, netIDs)
+	sort.SliceStable(result, func(i, j int) bool {
+		return spT.score(result[i]) < spT.score(result[j])
+	})
+	return result
+}
+
+func (spT *syncingPeers) getInfo() []chain.SyncPeerInfo {
+	result := make([]chain.SyncPeerInfo, 0, len(spT.peers))
+	for netID, peer := range spT.peers {
+		result = append(result, chain.SyncPeerInfo{
+			NetID:     netID,
+			Latency:   peer.latency,
+			Delivered: peer.delivered,
+			Timeouts:  peer.timeouts,
+			Rejected:  peer.rejected,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NetID < result[j].NetID
+	})
+	return result
+}
@@ -70,45 +70,103 @@ func (sm *stateManager) doSyncActionIfNeeded() {
 	}
 	// not synced
 	startSyncFromIndex := sm.solidState.BlockIndex() + 1
-	sm.log.Debugf("doSyncAction: trying to sync state from index %v to %v", startSyncFromIndex, sm.stateOutput.GetStateIndex())
-	for i := startSyncFromIndex; i <= sm.stateOutput.GetStateIndex(); i++ {
-		requestBlockRetryTime := sm.syncingBlocks.getRequestBlockRetryTime(i)
-		blockCandidatesCount := sm.syncingBlocks.getBlockCandidatesCount(i)
-		approvedBlockCandidatesCount := sm.syncingBlocks.getApprovedBlockCandidatesCount(i)
-		sm.log.Debugf("doSyncAction: trying to sync state for index %v; requestBlockRetryTime %v, blockCandidates count %v, approved blockCandidates count %v",
-			i, requestBlockRetryTime, blockCandidatesCount, approvedBlockCandidatesCount)
-		// TODO: temporary if. We need to find a solution to synchronize over large gaps. Making state snapshots may help.
-		if i > startSyncFromIndex+maxBlocksToCommitConst {
-			go sm.chain.ReceiveMessage(messages.DismissChainMsg{
-				Reason: fmt.Sprintf("StateManager.doSyncActionIfNeeded: too many blocks to catch up: %v", sm.stateOutput.GetStateIndex()-startSyncFromIndex+1),
-			},
-			)
+	targetIndex := sm.stateOutput.GetStateIndex()
+	sm.log.Debugf("doSyncAction: trying to sync state from index %v to %v", startSyncFromIndex, targetIndex)
+	// TODO: temporary if. We need to find a solution to synchronize over large gaps. Making state snapshots may help.
+	if targetIndex-startSyncFromIndex >= maxBlocksToCommitConst {
+		go sm.chain.ReceiveMessage(messages.DismissChainMsg{
+			Reason: fmt.Sprintf("StateManager.doSyncActionIfNeeded: too many blocks to catch up: %v", targetIndex-startSyncFromIndex+1),
+		},
+		)
+		return
+	}
+	nowis := time.Now()
+	sm.requestBlocks(sm.getBlocksToRequest(startSyncFromIndex, targetIndex, nowis), nowis)
+	for i := startSyncFromIndex; i <= targetIndex; i++ {
+		if sm.syncingBlocks.getApprovedBlockCandidatesCount(i) == 0 {
+			continue
+		}
+		sm.log.Debugf("doSyncAction: trying to find candidates to commit from index %v to %v", startSyncFromIndex, i)
+		candidates, tentativeState, ok := sm.getCandidatesToCommit(make([]*candidateBlock, 0, i-startSyncFromIndex+1), sm.solidState.Copy(), startSyncFromIndex, i)
+		if ok {
+			sm.log.Debugf("doSyncAction: candidates to commit found, committing")
+			sm.commitCandidates(candidates, tentativeState)
+			sm.log.Debugf("doSyncAction: blocks from index %v to %v committed", startSyncFromIndex, i)
 			return
 		}
-		nowis := time.Now()
-		if nowis.After(requestBlockRetryTime) {
-			// have to pull
-			sm.log.Debugf("doSyncAction: requesting block index %v from %v random peers", i, numberOfNodesToRequestBlockFromConst)
-			data := util.MustBytes(&messages.GetBlockMsg{
-				BlockIndex: i,
-			})
-			sm.peers.SendMsgToRandomPeersSimple(numberOfNodesToRequestBlockFromConst, messages.MsgGetBlock, data)
-			sm.syncingBlocks.startSyncingIfNeeded(i)
-			sm.syncingBlocks.setRequestBlockRetryTime(i, nowis.Add(sm.timers.GetBlockRetry))
-			if blockCandidatesCount == 0 {
-				return
-			}
+	}
+}
+
+// getBlocksToRequest returns the indices of the blocks, which have to be (re)requested from peers.
+// Missing blocks are requested in a window of syncWindowConst blocks, which slides forward
+// as the blocks arrive. If all the blocks are already fetched, but still cannot be committed,
+// the blocks at the beginning of the window are requested again to get alternative candidates.
+func (sm *stateManager) getBlocksToRequest(fromIndex, toIndex uint32, nowis time.Time) []uint32 {
+	result := make([]uint32, 0)
+	inFlight := 0
+	allFetched := true
+	for i := fromIndex; i <= toIndex && inFlight+len(result) < syncWindowConst; i++ {
+		if sm.syncingBlocks.getBlockCandidatesCount(i) > 0 {
+			continue
 		}
-		if approvedBlockCandidatesCount > 0 {
-			sm.log.Debugf("doSyncAction: trying to find candidates to commit from index %v to %v", startSyncFromIndex, i)
-			candidates, tentativeState, ok := sm.getCandidatesToCommit(make([]*candidateBlock, 0, i-startSyncFromIndex+1), sm.solidState.Copy(), startSyncFromIndex, i)
-			if ok {
-				sm.log.Debugf("doSyncAction: candidates to commit found, committing")
-				sm.commitCandidates(candidates, tentativeState)
-				sm.log.Debugf("doSyncAction: blocks from index %v to %v committed", startSyncFromIndex, i)
-				return
+		allFetched = false
+		if nowis.After(sm.syncingBlocks.getRequestBlockRetryTime(i)) {
+			if netID, ok := sm.syncingBlocks.getUnansweredRequest(i); ok {
+				sm.log.Debugf("doSyncAction: peer %v did not respond with block index %v in time", netID, i)
+				sm.syncingPeers.requestTimedOut(netID)
 			}
+			result = append(result, i)
+		} else {
+			inFlight++
+		}
+	}
+	if !allFetched {
+		return result
+	}
+	for i := fromIndex; i <= toIndex && len(result) < syncWindowConst; i++ {
+		if nowis.After(sm.syncingBlocks.getRequestBlockRetryTime(i)) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// requestBlocks splits the blocks into ranges of consecutive indices and spreads
+// the range requests across the most responsive of up to numberOfNodesToRequestBlockFromConst peers.
+// A repeated request goes to another peer than the previous one, which either did not respond in time
+// or sent a block which could not be committed
+func (sm *stateManager) requestBlocks(indices []uint32, nowis time.Time) {
+	if len(indices) == 0 {
+		return
+	}
+	peers := sm.syncingPeers.rank(sm.peers.GetRandomPeers(numberOfNodesToRequestBlockFromConst))
+	if len(peers) == 0 {
+		sm.log.Debugf("doSyncAction: no peers to request blocks from")
+		return
+	}
+	ranges := 0
+	for start := 0; start < len(indices); {
+		end := start + 1
+		for end < len(indices) && end-start < maxBlocksPerRequestConst && indices[end] == indices[end-1]+1 {
+			end++
+		}
+		fromIndex := indices[start]
+		toIndex := indices[end-1]
+		peer := peers[ranges%len(peers)]
+		if len(peers) > 1 && sm.syncingBlocks.getRequestedFrom(fromIndex) == peer {
+			peer = peers[(ranges+1)%len(peers)]
+		}
+		sm.log.Debugf("doSyncAction: requesting blocks from index %v to %v from peer %v", fromIndex, toIndex, peer)
+		sm.peers.SendSimple(peer, messages.MsgGetBlocks, util.MustBytes(&messages.GetBlocksMsg{
+			FromIndex: fromIndex,
+			ToIndex:   toIndex,
+		}))
+		for i := fromIndex; i <= toIndex; i++ {
+			sm.syncingBlocks.startSyncingIfNeeded(i)
+			sm.syncingBlocks.setRequested(i, peer, nowis, nowis.Add(sm.timers.GetBlockRetry))
 		}
+		ranges++
+		start = end
 	}
 }
 
@@ -42,6 +42,16 @@ func PublishRequestsSettled(chainID *iscp.ChainID, stateIndex uint32, reqids []i
 	}
 }
 
+func PublishRequestPropagation(chainID *iscp.ChainID, reqID iscp.RequestID, info *RequestPropagationInfo) {
+	publisher.Publish("request_propagation",
+		chainID.Base58(),
+		reqID.String(),
+		info.State.String(),
+		strconv.Itoa(info.Mempools),
+		strconv.Itoa(info.Quorum),
+	)
+}
+
 func PublishStateTransition(chainID *iscp.ChainID, stateOutput *ledgerstate.AliasOutput, reqIDsLength int) {
 	stateHash, _ := hashing.HashValueFromBytes(stateOutput.GetStateData())
 
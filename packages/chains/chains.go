@@ -119,6 +119,10 @@ func (c *Chains) Activate(chr *registry.ChainRecord, registryProvider registry.P
 	if !chr.Active {
 		return xerrors.Errorf("cannot activate chain for deactivated chain record")
 	}
+	if chr.Observer && len(chr.Peers) == 0 {
+		// observers take no part in the committee, the peers are the only source of blocks
+		return xerrors.Errorf("cannot activate chain as observer without peers to sync from")
+	}
 	chainArr := chr.ChainID.Array()
 	_, ok := c.allChains[chainArr]
 	if ok {
@@ -153,12 +157,17 @@ func (c *Chains) Activate(chr *registry.ChainRecord, registryProvider registry.P
 		c.offledgerBroadcastInterval,
 		c.pullMissingRequestsFromCommittee,
 		chainMetrics,
+		chr.Observer,
 	)
 	if newChain == nil {
 		return xerrors.New("Chains.Activate: failed to create chain object")
 	}
 	c.allChains[chainArr] = newChain
 	c.nodeConn.Subscribe(chr.ChainID.AliasAddress)
+	if chr.Observer {
+		c.log.Infof("activated chain as observer: %s", chr.ChainID.String())
+		return nil
+	}
 	c.log.Infof("activated chain: %s", chr.ChainID.String())
 	return nil
 }
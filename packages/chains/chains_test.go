@@ -9,6 +9,7 @@ import (
 	txstream "github.com/iotaledger/goshimmer/packages/txstream/client"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/registry"
 	"github.com/iotaledger/wasp/packages/testutil/testlogger"
 	"github.com/iotaledger/wasp/packages/vm/processors"
 	"github.com/stretchr/testify/require"
@@ -30,3 +31,15 @@ func TestBasic(t *testing.T) {
 	})
 	ch.Attach(nconn)
 }
+
+func TestActivateObserverWithoutPeers(t *testing.T) {
+	logger := testlogger.NewLogger(t)
+	ch := New(logger, processors.NewConfig(), 10, time.Second, false, nil, nil)
+
+	err := ch.Activate(&registry.ChainRecord{
+		ChainID:  iscp.RandomChainID(),
+		Active:   true,
+		Observer: true,
+	}, nil, nil)
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/wasp/packages/chain"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/registry"
 	"github.com/iotaledger/wasp/packages/wasp"
@@ -81,6 +82,13 @@ func (d *Dashboard) Stop() {
 	close(d.stop)
 }
 
+// callViewPaged retrieves all pages of a view that returns its results page by page
+func (d *Dashboard) callViewPaged(chainID *iscp.ChainID, scName, fname string, params dict.Dict, f func(page dict.Dict) error) error {
+	return coreutil.CallPaged(params, func(args dict.Dict) (dict.Dict, error) {
+		return d.wasp.CallView(chainID, scName, fname, args)
+	}, f)
+}
+
 func (d *Dashboard) BaseParams(c echo.Context, breadcrumbs ...Tab) BaseTemplateParams {
 	return BaseTemplateParams{
 		NavPages:    d.navPages,
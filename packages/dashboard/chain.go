@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/registry"
 	"github.com/iotaledger/wasp/packages/vm/core/accounts"
 	"github.com/iotaledger/wasp/packages/vm/core/blob"
@@ -104,18 +105,19 @@ func (d *Dashboard) getLatestBlock(chainID *iscp.ChainID) (*LatestBlock, error)
 }
 
 func (d *Dashboard) fetchAccounts(chainID *iscp.ChainID) ([]*iscp.AgentID, error) {
-	accs, err := d.wasp.CallView(chainID, accounts.Contract.Name, accounts.FuncViewAccounts.Name, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	ret := make([]*iscp.AgentID, 0)
-	for k := range accs {
-		agentid, err := codec.DecodeAgentID([]byte(k))
-		if err != nil {
-			return nil, err
+	err := d.callViewPaged(chainID, accounts.Contract.Name, accounts.FuncViewAccounts.Name, nil, func(page dict.Dict) error {
+		for k := range page {
+			agentid, err := codec.DecodeAgentID([]byte(k))
+			if err != nil {
+				return err
+			}
+			ret = append(ret, agentid)
 		}
-		ret = append(ret, agentid)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return ret, nil
 }
@@ -129,11 +131,21 @@ func (d *Dashboard) fetchTotalAssets(chainID *iscp.ChainID) (colored.Balances, e
 }
 
 func (d *Dashboard) fetchBlobs(chainID *iscp.ChainID) (map[hashing.HashValue]uint32, error) {
-	ret, err := d.wasp.CallView(chainID, blob.Contract.Name, blob.FuncListBlobs.Name, nil)
+	ret := make(map[hashing.HashValue]uint32)
+	err := d.callViewPaged(chainID, blob.Contract.Name, blob.FuncListBlobs.Name, nil, func(page dict.Dict) error {
+		blobs, err := blob.DecodeDirectory(page)
+		if err != nil {
+			return err
+		}
+		for hash, size := range blobs {
+			ret[hash] = size
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return blob.DecodeDirectory(ret)
+	return ret, nil
 }
 
 type LatestBlock struct {
@@ -5,6 +5,7 @@ import (
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/governance"
 	"github.com/iotaledger/wasp/packages/vm/core/root"
 )
@@ -51,11 +52,17 @@ func (d *Dashboard) fetchRootInfo(chainID *iscp.ChainID) (ret RootInfo, err erro
 		return
 	}
 
-	recs, err := d.wasp.CallView(chainID, root.Contract.Name, root.FuncGetContractRecords.Name, nil)
-	if err != nil {
-		return
-	}
-	ret.Contracts, err = root.DecodeContractRegistry(collections.NewMapReadOnly(recs, root.VarContractRegistry))
+	ret.Contracts = make(map[iscp.Hname]*root.ContractRecord)
+	err = d.callViewPaged(chainID, root.Contract.Name, root.FuncGetContractRecords.Name, nil, func(page dict.Dict) error {
+		recs, err := root.DecodeContractRegistry(collections.NewMapReadOnly(page, root.VarContractRegistry))
+		if err != nil {
+			return err
+		}
+		for hname, rec := range recs {
+			ret.Contracts[hname] = rec
+		}
+		return nil
+	})
 	if err != nil {
 		return
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/contracts/native/evm"
 	"github.com/iotaledger/wasp/packages/evm/evmtypes"
@@ -26,10 +27,34 @@ type EVMChain struct {
 	backend      ChainBackend
 	chainID      int
 	contractName string
+	txQueue      *TxQueue
+	receipts     *lru.Cache
 }
 
 func NewEVMChain(backend ChainBackend, chainID int, contractName string) *EVMChain {
-	return &EVMChain{backend, chainID, contractName}
+	return &EVMChain{backend: backend, chainID: chainID, contractName: contractName}
+}
+
+// EnableTxQueue makes SendTransaction return as soon as the transaction is
+// accepted by a TxQueue, instead of waiting until it is processed
+func (e *EVMChain) EnableTxQueue(limits TxQueueLimits) {
+	e.txQueue = newTxQueue(e, limits)
+}
+
+// TxQueue returns the TxQueue, or nil if it is not enabled
+func (e *EVMChain) TxQueue() *TxQueue {
+	return e.txQueue
+}
+
+// EnableReceiptCache keeps the last size receipts in memory. A receipt never
+// changes once the transaction is included in a block.
+func (e *EVMChain) EnableReceiptCache(size int) error {
+	cache, err := lru.New(size)
+	if err != nil {
+		return err
+	}
+	e.receipts = cache
+	return nil
 }
 
 func (e *EVMChain) Signer() types.Signer {
@@ -89,6 +114,13 @@ func (e *EVMChain) GetOnChainBalance() (colored.Balances, error) {
 }
 
 func (e *EVMChain) SendTransaction(tx *types.Transaction) error {
+	if e.txQueue != nil {
+		return e.txQueue.Add(tx)
+	}
+	return e.postTransaction(tx)
+}
+
+func (e *EVMChain) postTransaction(tx *types.Transaction) error {
 	feeColor, feeAmount, err := e.GasLimitFee(tx)
 	if err != nil {
 		return err
@@ -242,6 +274,12 @@ func (e *EVMChain) BlockByHash(hash common.Hash) (*types.Block, error) {
 }
 
 func (e *EVMChain) TransactionReceipt(txHash common.Hash) (*types.Receipt, error) {
+	if e.receipts != nil {
+		if receipt, ok := e.receipts.Get(txHash); ok {
+			return receipt.(*types.Receipt), nil
+		}
+	}
+
 	ret, err := e.backend.CallView(e.contractName, evm.FuncGetReceipt.Name, dict.Dict{
 		evm.FieldTransactionHash: txHash.Bytes(),
 	})
@@ -250,6 +288,11 @@ func (e *EVMChain) TransactionReceipt(txHash common.Hash) (*types.Receipt, error
 	}
 
 	if !ret.MustHas(evm.FieldResult) {
+		if e.txQueue != nil {
+			if err := e.txQueue.FailedTransaction(txHash); err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	}
 
@@ -257,6 +300,9 @@ func (e *EVMChain) TransactionReceipt(txHash common.Hash) (*types.Receipt, error
 	if err != nil {
 		return nil, err
 	}
+	if e.receipts != nil {
+		e.receipts.Add(txHash, receipt)
+	}
 	return receipt, nil
 }
 
@@ -267,7 +313,17 @@ func (e *EVMChain) TransactionCount(address common.Address, blockNumberOrHash rp
 	if err != nil {
 		return 0, err
 	}
-	return codec.DecodeUint64(ret.MustGet(evm.FieldResult), 0)
+	n, err := codec.DecodeUint64(ret.MustGet(evm.FieldResult), 0)
+	if err != nil {
+		return 0, err
+	}
+	if blockNumber, ok := blockNumberOrHash.Number(); ok && blockNumber == rpc.PendingBlockNumber && e.txQueue != nil {
+		// include the transactions that are waiting in the queue
+		if pending, ok := e.txQueue.PendingNonce(address); ok && pending > n {
+			n = pending
+		}
+	}
+	return n, nil
 }
 
 func (e *EVMChain) CallContract(args ethereum.CallMsg, blockNumberOrHash rpc.BlockNumberOrHash) ([]byte, error) {
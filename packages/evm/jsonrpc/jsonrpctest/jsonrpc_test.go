@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -42,7 +43,7 @@ type soloTestEnv struct {
 	solo *solo.Solo
 }
 
-func newSoloTestEnv(t *testing.T, evmFlavor *coreutil.ContractInfo) *soloTestEnv {
+func newSoloTestEnv(t *testing.T, evmFlavor *coreutil.ContractInfo, configure ...func(*jsonrpc.EVMChain)) *soloTestEnv {
 	evmtest.InitGoEthLogger(t)
 
 	chainID := evm.DefaultChainID
@@ -60,6 +61,9 @@ func newSoloTestEnv(t *testing.T, evmFlavor *coreutil.ContractInfo) *soloTestEnv
 	signer, _ := s.NewKeyPairWithFunds()
 	backend := jsonrpc.NewSoloBackend(s, chain, signer)
 	evmChain := jsonrpc.NewEVMChain(backend, chainID, evmFlavor.Name)
+	for _, f := range configure {
+		f(evmChain)
+	}
 
 	accountManager := jsonrpc.NewAccountManager(evmtest.Accounts)
 
@@ -458,3 +462,196 @@ func TestRPCEthChainID(t *testing.T) {
 		require.EqualValues(t, evm.DefaultChainID, chainID)
 	})
 }
+
+func TestRPCBlockTags(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		env := newSoloTestEnv(t, evmFlavor)
+		_, receiverAddress := generateKey(t)
+		env.RequestFunds(receiverAddress)
+		block1 := env.BlockByNumber(nil)
+		_, otherAddress := generateKey(t)
+		for i := 0; i < 3; i++ {
+			env.RequestFunds(otherAddress)
+		}
+		env.RequestFunds(receiverAddress)
+
+		balanceAt := func(blockNumberOrHash interface{}) (*big.Int, error) {
+			var ret hexutil.Big
+			err := env.RawClient.Call(&ret, "eth_getBalance", receiverAddress, blockNumberOrHash)
+			return (*big.Int)(&ret), err
+		}
+		for _, tag := range []string{"latest", "pending"} {
+			bal, err := balanceAt(tag)
+			require.NoError(t, err)
+			require.Zero(t, big.NewInt(2e18).Cmp(bal))
+		}
+
+		if evmFlavor.Name == "evmlight" {
+			// evmlight does not keep the past states
+			_, err := balanceAt("earliest")
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "historical")
+			_, err = balanceAt(rpc.BlockNumberOrHashWithHash(block1.Hash(), false))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "historical")
+			return
+		}
+
+		bal, err := balanceAt("earliest")
+		require.NoError(t, err)
+		require.Zero(t, bal.Sign())
+		bal, err = balanceAt(hexutil.EncodeBig(block1.Number()))
+		require.NoError(t, err)
+		require.Zero(t, big.NewInt(1e18).Cmp(bal))
+		bal, err = balanceAt(rpc.BlockNumberOrHashWithHash(block1.Hash(), false))
+		require.NoError(t, err)
+		require.Zero(t, big.NewInt(1e18).Cmp(bal))
+		nonce, err := env.Client.NonceAt(context.Background(), evmtest.FaucetAddress, block1.Number())
+		require.NoError(t, err)
+		require.EqualValues(t, 1, nonce)
+		code, err := env.Client.CodeAt(context.Background(), evmtest.FaucetAddress, block1.Number())
+		require.NoError(t, err)
+		require.Empty(t, code)
+
+		// blocks in the future do not exist
+		_, err = balanceAt(hexutil.EncodeUint64(100))
+		require.Error(t, err)
+		_, err = env.Client.CallContract(context.Background(), ethereum.CallMsg{To: &receiverAddress}, big.NewInt(100))
+		require.Error(t, err)
+	})
+}
+
+func TestRPCTxQueue(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		env := newSoloTestEnv(t, evmFlavor, func(evmChain *jsonrpc.EVMChain) {
+			evmChain.EnableTxQueue(jsonrpc.DefaultTxQueueLimits())
+			require.NoError(t, evmChain.EnableReceiptCache(100))
+		})
+		from, fromAddress := evmtest.Accounts[0], evmtest.AccountAddress(0)
+		fundsTx := env.RequestFunds(fromAddress)
+		require.Eventually(t, func() bool {
+			receipt, err := env.TxReceipt(fundsTx.Hash())
+			return err == nil && receipt != nil
+		}, 10*time.Second, 50*time.Millisecond)
+
+		_, toAddress := generateKey(t)
+		newTx := func(nonce uint64) *types.Transaction {
+			tx, err := types.SignTx(
+				types.NewTransaction(nonce, toAddress, big.NewInt(1), params.TxGas, evm.GasPrice, nil),
+				env.signer(),
+				from,
+			)
+			require.NoError(t, err)
+			return tx
+		}
+		pendingNonce := func() uint64 {
+			n, err := env.Client.PendingNonceAt(context.Background(), fromAddress)
+			require.NoError(t, err)
+			return n
+		}
+		txPoolStatus := func() (pending, queued uint) {
+			var ret map[string]hexutil.Uint
+			require.NoError(t, env.RawClient.Call(&ret, "txpool_status"))
+			return uint(ret["pending"]), uint(ret["queued"])
+		}
+
+		// a transaction with a nonce gap is held in the queue
+		txs := []*types.Transaction{newTx(0), newTx(1), newTx(2)}
+		require.NoError(t, env.Client.SendTransaction(context.Background(), txs[2]))
+		pending, queued := txPoolStatus()
+		require.EqualValues(t, 0, pending)
+		require.EqualValues(t, 1, queued)
+		require.EqualValues(t, 0, pendingNonce())
+
+		// filling the gap releases the queued transactions, in nonce order
+		require.NoError(t, env.Client.SendTransaction(context.Background(), txs[0]))
+		require.NoError(t, env.Client.SendTransaction(context.Background(), txs[1]))
+		require.Eventually(t, func() bool {
+			return env.NonceAt(fromAddress) == 3
+		}, 10*time.Second, 50*time.Millisecond)
+		for _, tx := range txs {
+			receipt := env.MustTxReceipt(tx.Hash())
+			require.EqualValues(t, types.ReceiptStatusSuccessful, receipt.Status)
+			// the second time the receipt is served from the cache
+			require.Equal(t, receipt, env.MustTxReceipt(tx.Hash()))
+		}
+		require.Zero(t, big.NewInt(3).Cmp(env.Balance(toAddress)))
+		require.EqualValues(t, 3, pendingNonce())
+		pending, queued = txPoolStatus()
+		require.EqualValues(t, 0, pending)
+		require.EqualValues(t, 0, queued)
+
+		// a used nonce is rejected right away
+		err := env.Client.SendTransaction(context.Background(), newTx(1))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "nonce too low")
+
+		// a transaction that cannot be posted is evicted, and the error is
+		// reported when asking for its receipt
+		failing, err := types.SignTx(
+			types.NewTransaction(3, toAddress, new(big.Int).Lsh(big.NewInt(1), 200), params.TxGas, evm.GasPrice, nil),
+			env.signer(),
+			from,
+		)
+		require.NoError(t, err)
+		require.NoError(t, env.Client.SendTransaction(context.Background(), failing))
+		require.Eventually(t, func() bool {
+			pending, queued := txPoolStatus()
+			return pending == 0 && queued == 0
+		}, 10*time.Second, 50*time.Millisecond)
+		_, err = env.TxReceipt(failing.Hash())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed")
+	})
+}
+
+func TestRPCTxQueueLimits(t *testing.T) {
+	withEVMFlavors(t, func(t *testing.T, evmFlavor *coreutil.ContractInfo) {
+		env := newSoloTestEnv(t, evmFlavor, func(evmChain *jsonrpc.EVMChain) {
+			evmChain.EnableTxQueue(jsonrpc.TxQueueLimits{
+				MaxPerSender: jsonrpc.DefaultTxQueueMaxPerSender,
+				MaxSenders:   1,
+				MaxTotal:     2,
+				Expiry:       time.Second,
+			})
+		})
+		_, toAddress := generateKey(t)
+		newTx := func(account int, nonce uint64) *types.Transaction {
+			tx, err := types.SignTx(
+				types.NewTransaction(nonce, toAddress, big.NewInt(1), params.TxGas, evm.GasPrice, nil),
+				env.signer(),
+				evmtest.Accounts[account],
+			)
+			require.NoError(t, err)
+			return tx
+		}
+		txPoolStatus := func() (pending, queued uint) {
+			var ret map[string]hexutil.Uint
+			require.NoError(t, env.RawClient.Call(&ret, "txpool_status"))
+			return uint(ret["pending"]), uint(ret["queued"])
+		}
+
+		// transactions with a nonce gap are held, up to the total limit
+		held := newTx(0, 5)
+		require.NoError(t, env.Client.SendTransaction(context.Background(), held))
+		require.NoError(t, env.Client.SendTransaction(context.Background(), newTx(0, 6)))
+		err := env.Client.SendTransaction(context.Background(), newTx(0, 7))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "too many queued transactions")
+
+		// only one sender can have queued transactions
+		err = env.Client.SendTransaction(context.Background(), newTx(1, 5))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "too many senders")
+
+		// held transactions expire, and the error is reported when asking for the receipt
+		require.Eventually(t, func() bool {
+			_, queued := txPoolStatus()
+			return queued == 0
+		}, 10*time.Second, 100*time.Millisecond)
+		_, err = env.TxReceipt(held.Hash())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "expired")
+		require.NoError(t, env.Client.SendTransaction(context.Background(), newTx(1, 5)))
+	})
+}
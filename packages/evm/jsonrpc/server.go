@@ -16,7 +16,7 @@ func NewServer(evmChain *EVMChain, accountManager *AccountManager) *rpc.Server {
 		{"web3", NewWeb3Service()},
 		{"net", NewNetService(evmChain.chainID)},
 		{"eth", NewEthService(evmChain, accountManager)},
-		{"txpool", NewTxPoolService()},
+		{"txpool", NewTxPoolService(evmChain)},
 	} {
 		err := rpcsrv.RegisterName(srv.namespace, srv.service)
 		if err != nil {
@@ -323,29 +323,69 @@ func (s *Web3Service) Sha3(input hexutil.Bytes) hexutil.Bytes {
 	return crypto.Keccak256(input)
 }
 
-type TxPoolService struct{}
+type TxPoolService struct {
+	evmChain *EVMChain
+}
+
+func NewTxPoolService(evmChain *EVMChain) *TxPoolService {
+	return &TxPoolService{evmChain}
+}
+
+// txPoolContent returns the transactions waiting in the TxQueue, if enabled
+func (s *TxPoolService) txPoolContent() (pending, queued map[common.Address][]*types.Transaction) {
+	if s.evmChain.TxQueue() == nil {
+		return nil, nil
+	}
+	return s.evmChain.TxQueue().Content()
+}
 
-func NewTxPoolService() *TxPoolService {
-	return &TxPoolService{}
+func txPoolMap(txs map[common.Address][]*types.Transaction, f func(tx *types.Transaction) interface{}) map[string]map[string]interface{} {
+	ret := make(map[string]map[string]interface{})
+	for sender, senderTxs := range txs {
+		m := make(map[string]interface{})
+		for _, tx := range senderTxs {
+			m[strconv.FormatUint(tx.Nonce(), 10)] = f(tx)
+		}
+		ret[sender.Hex()] = m
+	}
+	return ret
 }
 
-func (s *TxPoolService) Content() map[string]map[string]map[string]*RPCTransaction {
-	return map[string]map[string]map[string]*RPCTransaction{
-		"pending": make(map[string]map[string]*RPCTransaction),
-		"queued":  make(map[string]map[string]*RPCTransaction),
+func (s *TxPoolService) Content() map[string]map[string]map[string]interface{} {
+	pending, queued := s.txPoolContent()
+	f := func(tx *types.Transaction) interface{} {
+		return newRPCTransaction(tx, common.Hash{}, 0, 0)
+	}
+	return map[string]map[string]map[string]interface{}{
+		"pending": txPoolMap(pending, f),
+		"queued":  txPoolMap(queued, f),
 	}
 }
 
-func (s *TxPoolService) Inspect() map[string]map[string]map[string]string {
-	return map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+func (s *TxPoolService) Inspect() map[string]map[string]map[string]interface{} {
+	pending, queued := s.txPoolContent()
+	f := func(tx *types.Transaction) interface{} {
+		if to := tx.To(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return map[string]map[string]map[string]interface{}{
+		"pending": txPoolMap(pending, f),
+		"queued":  txPoolMap(queued, f),
 	}
 }
 
 func (s *TxPoolService) Status() map[string]hexutil.Uint {
+	pending, queued := s.txPoolContent()
+	count := func(txs map[common.Address][]*types.Transaction) (n hexutil.Uint) {
+		for _, senderTxs := range txs {
+			n += hexutil.Uint(len(senderTxs))
+		}
+		return n
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": count(pending),
+		"queued":  count(queued),
 	}
 }
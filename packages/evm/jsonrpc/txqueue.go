@@ -0,0 +1,275 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonrpc
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/xerrors"
+)
+
+const (
+	DefaultTxQueueMaxPerSender = 64
+	DefaultTxQueueMaxSenders   = 1024
+	DefaultTxQueueMaxTotal     = 4096
+	DefaultTxQueueExpiry       = 3 * time.Hour
+)
+
+// TxQueueLimits bounds the resources used by a TxQueue. A zero value
+// means no limit
+type TxQueueLimits struct {
+	// MaxPerSender is the maximum amount of queued transactions of a sender
+	MaxPerSender int
+	// MaxSenders is the maximum amount of senders with queued transactions
+	MaxSenders int
+	// MaxTotal is the maximum amount of queued transactions of all senders
+	MaxTotal int
+	// Expiry is the time after which a transaction that is held because
+	// of a nonce gap is evicted
+	Expiry time.Duration
+}
+
+func DefaultTxQueueLimits() TxQueueLimits {
+	return TxQueueLimits{
+		MaxPerSender: DefaultTxQueueMaxPerSender,
+		MaxSenders:   DefaultTxQueueMaxSenders,
+		MaxTotal:     DefaultTxQueueMaxTotal,
+		Expiry:       DefaultTxQueueExpiry,
+	}
+}
+
+// maxFailedTxs is the amount of failed transactions remembered by the TxQueue
+const maxFailedTxs = 1000
+
+// TxQueue accepts Ethereum transactions and posts them to the chain in the
+// background. Transactions of each sender are posted one at a time, in nonce
+// order; transactions with a nonce gap are held until the gap is filled.
+type TxQueue struct {
+	evmChain *EVMChain
+	limits   TxQueueLimits
+
+	mutex   sync.Mutex
+	senders map[common.Address]*senderQueue
+	// total is the amount of queued transactions of all senders
+	total int
+	// failed holds the error of the most recent transactions that could not be posted
+	failed *lru.Cache
+}
+
+type senderQueue struct {
+	// nextNonce is the nonce of the transaction that is being posted, or
+	// the next one to be posted
+	nextNonce uint64
+	txs       map[uint64]*queuedTx
+	// posting is true while the transaction with nextNonce is being posted
+	posting bool
+	running bool
+}
+
+type queuedTx struct {
+	tx    *types.Transaction
+	added time.Time
+}
+
+func newTxQueue(evmChain *EVMChain, limits TxQueueLimits) *TxQueue {
+	failed, err := lru.New(maxFailedTxs)
+	if err != nil {
+		panic(err)
+	}
+	return &TxQueue{
+		evmChain: evmChain,
+		limits:   limits,
+		senders:  make(map[common.Address]*senderQueue),
+		failed:   failed,
+	}
+}
+
+// Add queues the transaction, and returns an error if it is rejected
+func (q *TxQueue) Add(tx *types.Transaction) error {
+	sender, err := types.Sender(q.evmChain.Signer(), tx)
+	if err != nil {
+		return err
+	}
+	onChainNonce, err := q.evmChain.TransactionCount(sender, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.evictExpired()
+	sq := q.senders[sender]
+	if sq == nil {
+		if q.limits.MaxSenders > 0 && len(q.senders) >= q.limits.MaxSenders {
+			return xerrors.Errorf("too many senders with queued transactions")
+		}
+		sq = &senderQueue{nextNonce: onChainNonce, txs: make(map[uint64]*queuedTx)}
+		q.senders[sender] = sq
+	}
+	if !sq.running && onChainNonce > sq.nextNonce {
+		q.total -= sq.skipTo(onChainNonce)
+	}
+	if tx.Nonce() < sq.nextNonce || (sq.posting && tx.Nonce() == sq.nextNonce) {
+		q.dropIfIdle(sender, sq)
+		return xerrors.Errorf("nonce too low: address %s, tx: %d state: %d", sender.Hex(), tx.Nonce(), sq.nextNonce)
+	}
+	if _, replaced := sq.txs[tx.Nonce()]; !replaced {
+		if q.limits.MaxPerSender > 0 && len(sq.txs) >= q.limits.MaxPerSender {
+			return xerrors.Errorf("too many queued transactions for %s", sender.Hex())
+		}
+		if q.limits.MaxTotal > 0 && q.total >= q.limits.MaxTotal {
+			q.dropIfIdle(sender, sq)
+			return xerrors.Errorf("too many queued transactions")
+		}
+		q.total++
+	}
+	sq.txs[tx.Nonce()] = &queuedTx{tx: tx, added: time.Now()}
+	if !sq.running {
+		sq.running = true
+		go q.run(sender, sq)
+	}
+	return nil
+}
+
+// dropIfIdle forgets the sender if it has nothing queued
+func (q *TxQueue) dropIfIdle(sender common.Address, sq *senderQueue) {
+	if !sq.running && len(sq.txs) == 0 {
+		delete(q.senders, sender)
+	}
+}
+
+// evictExpired evicts the transactions that waited longer than the expiry
+// time, unless they are being posted
+func (q *TxQueue) evictExpired() {
+	if q.limits.Expiry <= 0 {
+		return
+	}
+	expired := time.Now().Add(-q.limits.Expiry)
+	for sender, sq := range q.senders {
+		for n, qtx := range sq.txs {
+			if qtx.added.After(expired) || (sq.posting && n == sq.nextNonce) {
+				continue
+			}
+			q.failed.Add(qtx.tx.Hash(), xerrors.Errorf("expired after waiting %v in the queue", q.limits.Expiry))
+			delete(sq.txs, n)
+			q.total--
+		}
+		q.dropIfIdle(sender, sq)
+	}
+}
+
+// run posts the transactions of the sender until a nonce gap is found
+func (q *TxQueue) run(sender common.Address, sq *senderQueue) {
+	for {
+		q.mutex.Lock()
+		qtx := sq.txs[sq.nextNonce]
+		if qtx == nil {
+			sq.running = false
+			q.dropIfIdle(sender, sq)
+			q.mutex.Unlock()
+			return
+		}
+		tx := qtx.tx
+		sq.posting = true
+		q.mutex.Unlock()
+
+		err := q.evmChain.postTransaction(tx)
+
+		nonce := tx.Nonce() + 1
+		if err != nil {
+			// the transaction is evicted; the error is kept so that it can
+			// be reported when the client asks for the receipt
+			q.failed.Add(tx.Hash(), err)
+			// the nonce may or may not have been consumed
+			n, err2 := q.evmChain.TransactionCount(sender, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+			if err2 == nil {
+				nonce = n
+			}
+		}
+
+		q.mutex.Lock()
+		sq.posting = false
+		delete(sq.txs, tx.Nonce())
+		q.total--
+		q.total -= sq.skipTo(nonce)
+		q.mutex.Unlock()
+	}
+}
+
+// FailedTransaction returns the error of the transaction if it was evicted
+// from the queue because it could not be posted, or nil otherwise
+func (q *TxQueue) FailedTransaction(txHash common.Hash) error {
+	if err, ok := q.failed.Get(txHash); ok {
+		return xerrors.Errorf("transaction %s failed: %w", txHash.Hex(), err.(error))
+	}
+	return nil
+}
+
+// skipTo discards the transactions with a nonce lower than the given one,
+// and returns the amount of discarded transactions
+func (sq *senderQueue) skipTo(nonce uint64) int {
+	discarded := 0
+	for n := range sq.txs {
+		if n < nonce {
+			delete(sq.txs, n)
+			discarded++
+		}
+	}
+	sq.nextNonce = nonce
+	return discarded
+}
+
+// PendingNonce returns the nonce following the last transaction of the sender
+// that is either being posted or ready to be posted. ok is false if the
+// sender has no queued transactions.
+func (q *TxQueue) PendingNonce(sender common.Address) (nonce uint64, ok bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.evictExpired()
+	sq := q.senders[sender]
+	if sq == nil {
+		return 0, false
+	}
+	nonce = sq.nextNonce
+	for sq.txs[nonce] != nil {
+		nonce++
+	}
+	return nonce, true
+}
+
+// Content returns the queued transactions of each sender, split in pending
+// (ready to be posted) and queued (waiting for a nonce gap to be filled)
+func (q *TxQueue) Content() (pending, queued map[common.Address][]*types.Transaction) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.evictExpired()
+	pending = make(map[common.Address][]*types.Transaction)
+	queued = make(map[common.Address][]*types.Transaction)
+	for sender, sq := range q.senders {
+		nonces := make([]uint64, 0, len(sq.txs))
+		for n := range sq.txs {
+			nonces = append(nonces, n)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+		next := sq.nextNonce
+		for _, n := range nonces {
+			if n == next {
+				pending[sender] = append(pending[sender], sq.txs[n].tx)
+				next++
+			} else {
+				queued[sender] = append(queued[sender], sq.txs[n].tx)
+			}
+		}
+	}
+	return pending, queued
+}
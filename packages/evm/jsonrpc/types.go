@@ -149,7 +149,8 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 func parseBlockNumber(bn rpc.BlockNumber) *big.Int {
 	n := bn.Int64()
 	if n < 0 {
-		// for our uses there is no "pending" block, so always assume "latest"
+		// "latest" or "pending": the pending EVM block is only visible while
+		// the ISCP block is being produced, so "pending" is the same as "latest"
 		return nil
 	}
 	return big.NewInt(n)
@@ -240,7 +241,7 @@ func (args *SendTxArgs) setDefaults(e *EthService) error {
 		args.Value = new(hexutil.Big)
 	}
 	if args.Nonce == nil {
-		nonce, err := e.evmChain.TransactionCount(args.From, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+		nonce, err := e.evmChain.TransactionCount(args.From, rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber))
 		if err != nil {
 			return err
 		}
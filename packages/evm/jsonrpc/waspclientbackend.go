@@ -4,26 +4,52 @@
 package jsonrpc
 
 import (
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/wasp/client/chainclient"
+	"github.com/iotaledger/wasp/contracts/native/evm"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/iscp/request"
 	"github.com/iotaledger/wasp/packages/iscp/requestargs"
 	"github.com/iotaledger/wasp/packages/kv/dict"
+	"golang.org/x/xerrors"
+)
+
+const (
+	DefaultRequestTimeout   = 1 * time.Minute
+	DefaultResubmitInterval = 10 * time.Second
 )
 
 type WaspClientBackend struct {
 	ChainClient *chainclient.Client
+	// RequestTimeout is the maximum amount of time to wait for a request to
+	// be processed
+	RequestTimeout time.Duration
+	// ResubmitInterval is the amount of time after which the propagation
+	// state of an off-ledger request is checked. If the node does not know
+	// about the request (e.g. it was dropped from the mempool), a new copy
+	// is posted.
+	ResubmitInterval time.Duration
+
+	mutex sync.Mutex
+	// posting holds the hashes of the Ethereum transactions being posted, so
+	// that the same transaction is never in flight twice
+	posting map[common.Hash]bool
 }
 
 var _ ChainBackend = &WaspClientBackend{}
 
 func NewWaspClientBackend(chainClient *chainclient.Client) *WaspClientBackend {
 	return &WaspClientBackend{
-		ChainClient: chainClient,
+		ChainClient:      chainClient,
+		RequestTimeout:   DefaultRequestTimeout,
+		ResubmitInterval: DefaultResubmitInterval,
+		posting:          make(map[common.Hash]bool),
 	}
 }
 
@@ -39,7 +65,7 @@ func (w *WaspClientBackend) PostOnLedgerRequest(scName, funName string, transfer
 	if err != nil {
 		return err
 	}
-	err = w.ChainClient.WaspClient.WaitUntilAllRequestsProcessed(w.ChainClient.ChainID, tx, 1*time.Minute)
+	err = w.ChainClient.WaspClient.WaitUntilAllRequestsProcessed(w.ChainClient.ChainID, tx, w.RequestTimeout)
 	if err != nil {
 		return err
 	}
@@ -50,18 +76,109 @@ func (w *WaspClientBackend) PostOnLedgerRequest(scName, funName string, transfer
 }
 
 func (w *WaspClientBackend) PostOffLedgerRequest(scName, funName string, transfer colored.Balances, args dict.Dict) error {
-	req, err := w.ChainClient.PostOffLedgerRequest(iscp.Hn(scName), iscp.Hn(funName), chainclient.PostRequestParams{
+	params := chainclient.PostRequestParams{
 		Transfer: transfer,
 		Args:     requestargs.New().AddEncodeSimpleMany(args),
-	})
+	}
+	txHash, isEVMTx := evmTransactionHash(funName, args)
+	if isEVMTx {
+		if !w.startPosting(txHash) {
+			return xerrors.Errorf("transaction %s is already being posted", txHash.Hex())
+		}
+		defer w.donePosting(txHash)
+	}
+
+	deadline := time.Now().Add(w.RequestTimeout)
+	req, err := w.ChainClient.PostOffLedgerRequest(iscp.Hn(scName), iscp.Hn(funName), params)
 	if err != nil {
 		return err
 	}
-	err = w.ChainClient.WaspClient.WaitUntilRequestProcessed(w.ChainClient.ChainID, req.ID(), 1*time.Minute)
+	// all the copies of the request posted so far; any of them may still be processed
+	posted := []iscp.RequestID{req.ID()}
+	for {
+		timeout := w.ResubmitInterval
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+		if timeout <= 0 {
+			return xerrors.Errorf("request %s was not processed after %s", req.ID().Base58(), w.RequestTimeout)
+		}
+		if err = w.ChainClient.WaspClient.WaitUntilRequestProcessed(w.ChainClient.ChainID, req.ID(), timeout); err == nil {
+			return w.ChainClient.CheckRequestResult(req.ID())
+		}
+		inPipeline := false
+		for _, reqID := range posted {
+			status, err := w.ChainClient.WaspClient.RequestStatus(w.ChainClient.ChainID, reqID)
+			if err != nil {
+				return err
+			}
+			if status.IsProcessed {
+				return w.ChainClient.CheckRequestResult(reqID)
+			}
+			if status.State != "unknown" {
+				inPipeline = true
+			}
+		}
+		if inPipeline {
+			continue
+		}
+		if isEVMTx {
+			// the transaction may have been included by a copy which the node
+			// does not remember anymore
+			processed, err := w.hasReceipt(scName, txHash)
+			if err != nil {
+				return err
+			}
+			if processed {
+				return nil
+			}
+		}
+		// the node dropped the request; the same request cannot be posted
+		// twice, so post a copy with a new nonce
+		req, err = w.ChainClient.PostOffLedgerRequest(iscp.Hn(scName), iscp.Hn(funName), params)
+		if err != nil {
+			return err
+		}
+		posted = append(posted, req.ID())
+	}
+}
+
+// evmTransactionHash returns the hash of the Ethereum transaction carried by the request, if any
+func evmTransactionHash(funName string, args dict.Dict) (common.Hash, bool) {
+	if funName != evm.FuncSendTransaction.Name {
+		return common.Hash{}, false
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(args.MustGet(evm.FieldTransactionData)); err != nil {
+		return common.Hash{}, false
+	}
+	return tx.Hash(), true
+}
+
+func (w *WaspClientBackend) startPosting(txHash common.Hash) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.posting[txHash] {
+		return false
+	}
+	w.posting[txHash] = true
+	return true
+}
+
+func (w *WaspClientBackend) donePosting(txHash common.Hash) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	delete(w.posting, txHash)
+}
+
+func (w *WaspClientBackend) hasReceipt(scName string, txHash common.Hash) (bool, error) {
+	ret, err := w.CallView(scName, evm.FuncGetReceipt.Name, dict.Dict{
+		evm.FieldTransactionHash: txHash.Bytes(),
+	})
 	if err != nil {
-		return err
+		return false, err
 	}
-	return w.ChainClient.CheckRequestResult(req.ID())
+	return ret.MustHas(evm.FieldResult), nil
 }
 
 func (w *WaspClientBackend) CallView(scName, funName string, args dict.Dict) (dict.Dict, error) {
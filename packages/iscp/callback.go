@@ -0,0 +1,26 @@
+package iscp
+
+// Reserved request parameters of the cross-chain callback protocol.
+// An on-ledger request that carries ParamCallback asks the target chain to send
+// back the outcome of the request to the sender contract. The target chain does
+// so by posting a result request to the ParamCallback entry point of the sender
+// contract on the sender chain. The result request carries the results of the
+// original request, the other reserved parameters below, and, when the original
+// request failed, the refund of its tokens.
+// A request that carries ParamCallbackDeadline is not run once the deadline has
+// passed: its tokens stay in escrow on the target chain until then, and come
+// back as the refund of a failed outcome afterwards.
+const (
+	// Hname of the entry point of the sender contract that receives the outcome
+	ParamCallback = "$cb"
+	// Int64 call ID chosen by the sender, passed back unchanged
+	ParamCallbackID = "$cb.id"
+	// RequestID of the original request on the target chain
+	ParamCallbackRequestID = "$cb.req"
+	// Bool that indicates whether the original request succeeded
+	ParamCallbackSuccess = "$cb.ok"
+	// String error message in case the original request failed
+	ParamCallbackError = "$cb.err"
+	// Int64 timestamp (unix nanoseconds) from which the target chain refuses to run the request
+	ParamCallbackDeadline = "$cb.deadline"
+)
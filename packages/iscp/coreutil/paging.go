@@ -0,0 +1,102 @@
+package coreutil
+
+import (
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
+	"golang.org/x/xerrors"
+)
+
+// standard parameters and result of the views that return their results page by page
+const (
+	// ParamLimit is the maximum number of elements to return, defaults to MaxPageSize
+	ParamLimit = "limit"
+	// ParamCursor is the cursor returned by the previous page, absent for the first page
+	ParamCursor = "cursor"
+	// ResultNextCursor is the cursor of the next page, absent when there are no more elements
+	ResultNextCursor = "nextCursor"
+	// ResultTruncated is true when there are more elements than the page returned,
+	// so that callers that do not page are not silently given a partial list
+	ResultTruncated = "truncated"
+)
+
+// MaxPageSize is the maximum number of elements a paginated view returns in a single call
+const MaxPageSize = 1000
+
+// DecodePageParams decodes the standard paging parameters. The limit is capped at MaxPageSize.
+func DecodePageParams(params kv.KVStoreReader) (limit uint32, cursor []byte, err error) {
+	decoder := kvdecoder.New(params)
+	limit, err = decoder.GetUint32(ParamLimit, MaxPageSize)
+	if err != nil {
+		return 0, nil, err
+	}
+	if limit == 0 {
+		return 0, nil, xerrors.New("page limit must be positive")
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	cursor, err = decoder.GetBytes(ParamCursor, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	return limit, cursor, nil
+}
+
+// PageMap calls f for at most limit elements of the map in the order of their keys,
+// starting at the element with key cursor, or at the first element when cursor is nil.
+// It returns the cursor of the next page, or nil when there are no more elements.
+func PageMap(m *collections.ImmutableOrderedMap, limit uint32, cursor []byte, f func(elemKey, value []byte)) []byte {
+	return m.MustPage(cursor, nil, int(limit), false, f)
+}
+
+// SetNextCursor stores the cursor of the next page in the view results,
+// and marks the results as truncated when there is a next page
+func SetNextCursor(ret dict.Dict, cursor []byte) {
+	if cursor != nil {
+		ret.Set(ResultNextCursor, cursor)
+		ret.Set(ResultTruncated, codec.EncodeBool(true))
+	}
+}
+
+// CallPaged retrieves all pages of a paginated view. It passes args to the
+// first call of the view, and args plus the cursor of the next page to the
+// following calls. It calls f with the results of each page, from which the
+// next cursor and the truncated flag have been removed.
+func CallPaged(args dict.Dict, call func(args dict.Dict) (dict.Dict, error), f func(page dict.Dict) error) error {
+	var cursor []byte
+	for {
+		pageArgs := dict.New()
+		for key, value := range args {
+			pageArgs.Set(key, value)
+		}
+		if cursor != nil {
+			pageArgs.Set(ParamCursor, cursor)
+		}
+		page, err := call(pageArgs)
+		if err != nil {
+			return err
+		}
+		cursor = page.MustGet(ResultNextCursor)
+		page.Del(ResultNextCursor)
+		page.Del(ResultTruncated)
+		if err = f(page); err != nil {
+			return err
+		}
+		if cursor == nil {
+			return nil
+		}
+	}
+}
+
+// EncodePagePosition encodes a position in a list as a cursor
+func EncodePagePosition(position uint32) []byte {
+	return codec.EncodeUint32(position)
+}
+
+// DecodePagePosition decodes a position in a list from a cursor, nil decodes as 0
+func DecodePagePosition(cursor []byte) (uint32, error) {
+	return codec.DecodeUint32(cursor, 0)
+}
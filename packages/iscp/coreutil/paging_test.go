@@ -0,0 +1,100 @@
+package coreutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePageParams(t *testing.T) {
+	limit, cursor, err := DecodePageParams(dict.New())
+	require.NoError(t, err)
+	require.EqualValues(t, MaxPageSize, limit)
+	require.Nil(t, cursor)
+
+	limit, cursor, err = DecodePageParams(dict.Dict{
+		ParamLimit:  codec.EncodeUint32(MaxPageSize + 1),
+		ParamCursor: []byte("abc"),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, MaxPageSize, limit)
+	require.EqualValues(t, []byte("abc"), cursor)
+
+	_, _, err = DecodePageParams(dict.Dict{ParamLimit: codec.EncodeUint32(0)})
+	require.Error(t, err)
+}
+
+func TestCallPaged(t *testing.T) {
+	state := dict.New()
+	m := collections.NewOrderedMap(state, "m")
+	for i := 0; i < 25; i++ {
+		key := []byte(fmt.Sprintf("k%02d", i))
+		m.MustSetAt(key, key)
+	}
+
+	calls := 0
+	view := func(args dict.Dict) (dict.Dict, error) {
+		calls++
+		limit, cursor, err := DecodePageParams(args)
+		if err != nil {
+			return nil, err
+		}
+		require.EqualValues(t, []byte("x"), args.MustGet("other"))
+		ret := dict.New()
+		next := PageMap(m.Immutable(), limit, cursor, func(elemKey, value []byte) {
+			ret.Set(kv.Key(elemKey), value)
+		})
+		SetNextCursor(ret, next)
+		return ret, nil
+	}
+
+	keys := make([]string, 0)
+	args := dict.Dict{ParamLimit: codec.EncodeUint32(10), "other": []byte("x")}
+	err := CallPaged(args, view, func(page dict.Dict) error {
+		require.False(t, page.MustHas(ResultNextCursor))
+		require.False(t, page.MustHas(ResultTruncated))
+		for _, key := range page.KeysSorted() {
+			keys = append(keys, string(key))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, calls)
+	require.Len(t, keys, 25)
+	for i, key := range keys {
+		require.EqualValues(t, fmt.Sprintf("k%02d", i), key)
+	}
+	require.False(t, args.MustHas(ParamCursor))
+}
+
+func TestPageMap(t *testing.T) {
+	m := collections.NewOrderedMap(dict.New(), "m")
+	for i := 0; i < 5; i++ {
+		key := []byte(fmt.Sprintf("k%d", i))
+		m.MustSetAt(key, key)
+	}
+
+	keys := make([]string, 0)
+	next := PageMap(m.Immutable(), 2, []byte("k1"), func(elemKey, value []byte) {
+		keys = append(keys, string(elemKey))
+	})
+	require.EqualValues(t, []string{"k1", "k2"}, keys)
+	require.EqualValues(t, []byte("k3"), next)
+
+	ret := dict.New()
+	SetNextCursor(ret, next)
+	truncated, err := codec.DecodeBool(ret.MustGet(ResultTruncated))
+	require.NoError(t, err)
+	require.True(t, truncated)
+
+	next = PageMap(m.Immutable(), 2, next, func(elemKey, value []byte) {})
+	require.Nil(t, next)
+	ret = dict.New()
+	SetNextCursor(ret, next)
+	require.True(t, ret.IsEmpty())
+}
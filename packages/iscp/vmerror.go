@@ -0,0 +1,145 @@
+package iscp
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/iotaledger/hive.go/marshalutil"
+	"golang.org/x/xerrors"
+)
+
+// Error codes below VMErrorCodeUser are reserved for the VM itself.
+// Smart contracts are free to use any code starting at VMErrorCodeUser.
+const (
+	// VMErrorCodeGeneric is the code of any error that was not raised as a VMError
+	VMErrorCodeGeneric = uint16(0)
+	// VMErrorCodeNotEnoughFees is the code of a request that could not pay the fees
+	VMErrorCodeNotEnoughFees = uint16(1)
+	// VMErrorCodeInvalidRequest is the code of a request that failed validation
+	VMErrorCodeInvalidRequest = uint16(2)
+	// VMErrorCodeNotAllowed is the code of a request rejected by the access control lists of the chain
+	VMErrorCodeNotAllowed = uint16(3)
+	// VMErrorCodeUser is the first code available to smart contracts
+	VMErrorCodeUser = uint16(1000)
+)
+
+// VMError is a typed error raised while processing a request. Smart contracts
+// raise it by panicking with a VMError, the VM stores it in the request receipt.
+type VMError struct {
+	// Code identifies the kind of error, see VMErrorCodeUser
+	Code uint16
+	// Contract is the hname of the contract that raised the error
+	Contract Hname
+	// Message is the human readable error message
+	Message string
+	// Params are optional values that further describe the error
+	Params []string
+}
+
+// NewVMError creates a VMError. The VM fills in the contract when the error is raised.
+func NewVMError(code uint16, message string, params ...string) *VMError {
+	return &VMError{
+		Code:    code,
+		Message: message,
+		Params:  params,
+	}
+}
+
+// VMErrorFromError converts any error into a VMError. When err wraps a VMError that
+// one is returned, otherwise the result is a generic error raised by the contract.
+func VMErrorFromError(err error, contract Hname) *VMError {
+	var vmErr *VMError
+	if xerrors.As(err, &vmErr) {
+		if vmErr.Contract == 0 {
+			vmErr.Contract = contract
+		}
+		return vmErr
+	}
+	return &VMError{
+		Code:     VMErrorCodeGeneric,
+		Contract: contract,
+		Message:  err.Error(),
+	}
+}
+
+func VMErrorFromBytes(data []byte) (*VMError, error) {
+	return VMErrorFromMarshalUtil(marshalutil.New(data))
+}
+
+func VMErrorFromMarshalUtil(mu *marshalutil.MarshalUtil) (*VMError, error) {
+	ret := &VMError{}
+	var err error
+	if ret.Code, err = mu.ReadUint16(); err != nil {
+		return nil, err
+	}
+	if ret.Contract, err = HnameFromMarshalUtil(mu); err != nil {
+		return nil, err
+	}
+	if ret.Message, err = readVMErrorString(mu); err != nil {
+		return nil, err
+	}
+	var n uint16
+	if n, err = mu.ReadUint16(); err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		ret.Params = make([]string, n)
+	}
+	for i := range ret.Params {
+		if ret.Params[i], err = readVMErrorString(mu); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func readVMErrorString(mu *marshalutil.MarshalUtil) (string, error) {
+	size, err := mu.ReadUint16()
+	if err != nil {
+		return "", err
+	}
+	data, err := mu.ReadBytes(int(size))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (e *VMError) Bytes() []byte {
+	mu := marshalutil.New()
+	mu.WriteUint16(e.Code).
+		Write(e.Contract)
+	writeVMErrorString(mu, e.Message)
+	mu.WriteUint16(uint16(len(e.Params)))
+	for _, param := range e.Params {
+		writeVMErrorString(mu, param)
+	}
+	return mu.Bytes()
+}
+
+// writeVMErrorString truncates strings that do not fit their uint16 size prefix
+func writeVMErrorString(mu *marshalutil.MarshalUtil, s string) {
+	if len(s) > math.MaxUint16 {
+		s = s[:math.MaxUint16]
+	}
+	mu.WriteUint16(uint16(len(s))).
+		WriteBytes([]byte(s))
+}
+
+// Error returns the message. Typed errors are prefixed with their code and
+// followed by their params, so that generic errors keep their original text.
+func (e *VMError) Error() string {
+	ret := e.Message
+	if e.Code != VMErrorCodeGeneric {
+		ret = fmt.Sprintf("error %d: %s", e.Code, ret)
+	}
+	if len(e.Params) > 0 {
+		ret += " (" + strings.Join(e.Params, ", ") + ")"
+	}
+	return ret
+}
+
+func (e *VMError) String() string {
+	return fmt.Sprintf("%s: %s", e.Contract, e.Error())
+}
@@ -0,0 +1,35 @@
+package iscp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+)
+
+func TestVMErrorSerde(t *testing.T) {
+	vmErr := NewVMError(VMErrorCodeUser+1, "insufficient balance", "100", "200")
+	vmErr.Contract = Hn("test")
+	back, err := VMErrorFromBytes(vmErr.Bytes())
+	require.NoError(t, err)
+	require.EqualValues(t, vmErr, back)
+	require.EqualValues(t, "error 1001: insufficient balance (100, 200)", back.Error())
+
+	vmErr = NewVMError(VMErrorCodeGeneric, "generic")
+	back, err = VMErrorFromBytes(vmErr.Bytes())
+	require.NoError(t, err)
+	require.EqualValues(t, vmErr, back)
+	require.EqualValues(t, "generic", back.Error())
+}
+
+func TestVMErrorFromError(t *testing.T) {
+	vmErr := VMErrorFromError(xerrors.New("plain"), Hn("test"))
+	require.EqualValues(t, VMErrorCodeGeneric, vmErr.Code)
+	require.EqualValues(t, Hn("test"), vmErr.Contract)
+	require.EqualValues(t, "plain", vmErr.Message)
+
+	typed := NewVMError(VMErrorCodeUser, "typed")
+	vmErr = VMErrorFromError(xerrors.Errorf("wrapped: %w", typed), Hn("test"))
+	require.Same(t, typed, vmErr)
+	require.EqualValues(t, Hn("test"), vmErr.Contract)
+}
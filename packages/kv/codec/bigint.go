@@ -0,0 +1,41 @@
+package codec
+
+import (
+	"math/big"
+
+	"golang.org/x/xerrors"
+)
+
+// DecodeBigInt decodes a non-negative integer from little-endian bytes
+func DecodeBigInt(b []byte, def ...*big.Int) (*big.Int, error) {
+	if b == nil {
+		if len(def) == 0 {
+			return nil, xerrors.Errorf("cannot decode nil bytes")
+		}
+		return def[0], nil
+	}
+	if len(b) == 0 || (len(b) > 1 && b[len(b)-1] == 0) {
+		return nil, xerrors.Errorf("invalid big int encoding")
+	}
+	return new(big.Int).SetBytes(reverseBytes(b)), nil
+}
+
+// EncodeBigInt encodes a non-negative integer as little-endian bytes without
+// trailing zero bytes. Zero is encoded as a single zero byte.
+func EncodeBigInt(value *big.Int) []byte {
+	if value.Sign() < 0 {
+		panic("cannot encode negative big int")
+	}
+	if value.Sign() == 0 {
+		return []byte{0}
+	}
+	return reverseBytes(value.Bytes())
+}
+
+func reverseBytes(b []byte) []byte {
+	ret := make([]byte, len(b))
+	for i := range b {
+		ret[len(b)-1-i] = b[i]
+	}
+	return ret
+}
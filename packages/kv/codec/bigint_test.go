@@ -0,0 +1,46 @@
+package codec
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigIntEncoding(t *testing.T) {
+	require.Equal(t, []byte{0}, EncodeBigInt(big.NewInt(0)))
+	require.Equal(t, []byte{0x00, 0x01}, EncodeBigInt(big.NewInt(256)))
+
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(255),
+		big.NewInt(256),
+		new(big.Int).Lsh(big.NewInt(1), 200),
+	}
+	for _, v := range values {
+		back, err := DecodeBigInt(EncodeBigInt(v))
+		require.NoError(t, err)
+		require.Zero(t, v.Cmp(back))
+	}
+
+	// non-canonical encodings are rejected
+	_, err := DecodeBigInt([]byte{})
+	require.Error(t, err)
+	_, err = DecodeBigInt([]byte{1, 0})
+	require.Error(t, err)
+
+	require.Panics(t, func() { EncodeBigInt(big.NewInt(-1)) })
+}
+
+func TestBoolEncoding(t *testing.T) {
+	for _, v := range []bool{false, true} {
+		back, err := DecodeBool(EncodeBool(v))
+		require.NoError(t, err)
+		require.Equal(t, v, back)
+	}
+	_, err := DecodeBool([]byte{2})
+	require.Error(t, err)
+	_, err = DecodeBool([]byte{0, 0})
+	require.Error(t, err)
+}
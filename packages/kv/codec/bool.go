@@ -0,0 +1,26 @@
+package codec
+
+import "golang.org/x/xerrors"
+
+func DecodeBool(b []byte, def ...bool) (bool, error) {
+	if b == nil {
+		if len(def) == 0 {
+			return false, xerrors.Errorf("cannot decode nil bytes")
+		}
+		return def[0], nil
+	}
+	if len(b) != 1 {
+		return false, xerrors.Errorf("invalid bool size")
+	}
+	if b[0] > 1 {
+		return false, xerrors.Errorf("invalid bool value")
+	}
+	return b[0] != 0, nil
+}
+
+func EncodeBool(value bool) []byte {
+	if value {
+		return []byte{1}
+	}
+	return []byte{0}
+}
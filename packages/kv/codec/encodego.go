@@ -2,6 +2,7 @@ package codec
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/iotaledger/wasp/packages/iscp/colored"
@@ -17,6 +18,10 @@ func Encode(v interface{}) []byte {
 		return EncodeInt64(int64(vt))
 	case byte:
 		return EncodeInt64(int64(vt))
+	case bool:
+		return EncodeBool(vt)
+	case int8:
+		return EncodeInt8(vt)
 	case int16:
 		return EncodeInt16(vt)
 	case int32:
@@ -29,6 +34,8 @@ func Encode(v interface{}) []byte {
 		return EncodeUint32(vt)
 	case uint64:
 		return EncodeUint64(vt)
+	case *big.Int:
+		return EncodeBigInt(vt)
 	case string:
 		return EncodeString(vt)
 	case []byte:
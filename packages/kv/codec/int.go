@@ -5,6 +5,40 @@ import (
 	"golang.org/x/xerrors"
 )
 
+func DecodeInt8(b []byte, def ...int8) (int8, error) {
+	if b == nil {
+		if len(def) == 0 {
+			return 0, xerrors.Errorf("cannot decode nil bytes")
+		}
+		return def[0], nil
+	}
+	if len(b) != 1 {
+		return 0, xerrors.Errorf("invalid int8 size")
+	}
+	return int8(b[0]), nil
+}
+
+func EncodeInt8(value int8) []byte {
+	return []byte{byte(value)}
+}
+
+func DecodeUint8(b []byte, def ...uint8) (uint8, error) {
+	if b == nil {
+		if len(def) == 0 {
+			return 0, xerrors.Errorf("cannot decode nil bytes")
+		}
+		return def[0], nil
+	}
+	if len(b) != 1 {
+		return 0, xerrors.Errorf("invalid uint8 size")
+	}
+	return b[0], nil
+}
+
+func EncodeUint8(value uint8) []byte {
+	return []byte{value}
+}
+
 func DecodeInt16(b []byte, def ...int16) (int16, error) {
 	if b == nil {
 		if len(def) == 0 {
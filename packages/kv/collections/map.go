@@ -167,9 +167,17 @@ func (m *ImmutableMap) Len() (uint32, error) {
 	return util.MustUint32From4Bytes(v), nil
 }
 
+// Erase deletes all elements of the map
 func (m *Map) Erase() {
 	// TODO needs DelPrefix method in KVStore
-	panic("implement me")
+	keys := make([][]byte, 0)
+	m.MustIterateKeys(func(elemKey []byte) bool {
+		keys = append(keys, elemKey)
+		return true
+	})
+	for _, key := range keys {
+		m.MustDelAt(key)
+	}
 }
 
 // Iterate non-deterministic
@@ -205,6 +213,22 @@ func (m *ImmutableMap) MustIterateKeys(f func(elemKey []byte) bool) {
 	}
 }
 
+// IterateSorted iterates the elements in the order of their keys
+func (m *ImmutableMap) IterateSorted(f func(elemKey []byte, value []byte) bool) error {
+	prefix := m.getElemKey(nil)
+	return m.kvr.IterateSorted(prefix, func(key kv.Key, value []byte) bool {
+		return f([]byte(key)[len(prefix):], value)
+	})
+}
+
+// MustIterateSorted iterates the elements in the order of their keys
+func (m *ImmutableMap) MustIterateSorted(f func(elemKey []byte, value []byte) bool) {
+	err := m.IterateSorted(f)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (m *ImmutableMap) MustIterateBalances(f func(color colored.Color, bal uint64) bool) {
 	m.MustIterate(func(elemKey []byte, value []byte) bool {
 		col, err := colored.ColorFromBytes(elemKey)
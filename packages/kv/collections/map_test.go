@@ -114,3 +114,22 @@ func TestMapConcurrentAccess(t *testing.T) {
 	require.EqualValues(t, m1.MustLen(), 0)
 	require.EqualValues(t, m2.MustLen(), 0)
 }
+
+func TestMapErase(t *testing.T) {
+	vars := dict.New()
+	m := NewMap(vars, "testMap")
+	other := NewMap(vars, "testMap2")
+
+	m.MustSetAt([]byte("k1"), []byte("datum1"))
+	m.MustSetAt([]byte("k2"), []byte("datum2"))
+	other.MustSetAt([]byte("k1"), []byte("datum1"))
+	require.EqualValues(t, 2, m.MustLen())
+
+	m.Erase()
+	require.Zero(t, m.MustLen())
+	require.False(t, m.MustHasAt([]byte("k1")))
+	require.False(t, m.MustHasAt([]byte("k2")))
+	require.EqualValues(t, 1, other.MustLen())
+	// only the size and the element of the other map remain
+	require.Len(t, vars, 2)
+}
@@ -0,0 +1,184 @@
+package collections
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/util"
+)
+
+// The state, params and results of Wasm smart contracts keep their maps and arrays
+// in the nested layout below, which allows containers to be nested to any depth:
+//  - the element of a map with the specified key is stored under name.key
+//  - the element of an array with the specified index is stored under name.index,
+//    where index is the decimal representation of the index
+//  - the length of an array is stored under its name, as a 4-byte int32
+// A nested container is stored under the key of the element that holds it, so the
+// first value of the array stored in map m under key k is found under m.k.0
+
+const nestedElemKeyCode = '.'
+
+// NestedMapElemKey returns the key of the map element with the specified key
+func NestedMapElemKey(name string, key []byte) kv.Key {
+	return kv.Key(name + string(nestedElemKeyCode) + string(key))
+}
+
+// NestedArrayElemKey returns the key of the array element with the specified index
+func NestedArrayElemKey(name string, idx uint32) kv.Key {
+	return kv.Key(name + string(nestedElemKeyCode) + strconv.FormatUint(uint64(idx), 10))
+}
+
+// ImmutableNestedMap provides read-only access to a map in the nested layout
+type ImmutableNestedMap struct {
+	kvr  kv.KVStoreReader
+	name string
+}
+
+func NewNestedMapReadOnly(kvReader kv.KVStoreReader, name string) *ImmutableNestedMap {
+	return &ImmutableNestedMap{
+		kvr:  kvReader,
+		name: name,
+	}
+}
+
+func (m *ImmutableNestedMap) Name() string {
+	return m.name
+}
+
+func (m *ImmutableNestedMap) GetAt(key []byte) ([]byte, error) {
+	return m.kvr.Get(NestedMapElemKey(m.name, key))
+}
+
+func (m *ImmutableNestedMap) MustGetAt(key []byte) []byte {
+	ret, err := m.GetAt(key)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func (m *ImmutableNestedMap) HasAt(key []byte) (bool, error) {
+	return m.kvr.Has(NestedMapElemKey(m.name, key))
+}
+
+func (m *ImmutableNestedMap) MustHasAt(key []byte) bool {
+	ret, err := m.HasAt(key)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Map returns the nested map stored under the specified key
+func (m *ImmutableNestedMap) Map(key []byte) *ImmutableNestedMap {
+	return NewNestedMapReadOnly(m.kvr, string(NestedMapElemKey(m.name, key)))
+}
+
+// Array returns the nested array stored under the specified key
+func (m *ImmutableNestedMap) Array(key []byte) *ImmutableNestedArray {
+	return NewNestedArrayReadOnly(m.kvr, string(NestedMapElemKey(m.name, key)))
+}
+
+// ImmutableNestedArray provides read-only access to an array in the nested layout
+type ImmutableNestedArray struct {
+	kvr  kv.KVStoreReader
+	name string
+}
+
+func NewNestedArrayReadOnly(kvReader kv.KVStoreReader, name string) *ImmutableNestedArray {
+	return &ImmutableNestedArray{
+		kvr:  kvReader,
+		name: name,
+	}
+}
+
+func (a *ImmutableNestedArray) Name() string {
+	return a.name
+}
+
+// Len == 0/empty/non-existent are equivalent
+func (a *ImmutableNestedArray) Len() (uint32, error) {
+	v, err := a.kvr.Get(kv.Key(a.name))
+	if err != nil {
+		return 0, err
+	}
+	if v == nil {
+		return 0, nil
+	}
+	n, err := util.Uint32From4Bytes(v)
+	if err != nil {
+		return 0, err
+	}
+	if int32(n) < 0 {
+		return 0, fmt.Errorf("invalid length of nested array %s", a.name)
+	}
+	return n, nil
+}
+
+func (a *ImmutableNestedArray) MustLen() uint32 {
+	n, err := a.Len()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (a *ImmutableNestedArray) checkIndex(idx uint32) error {
+	n, err := a.Len()
+	if err != nil {
+		return err
+	}
+	if idx >= n {
+		return fmt.Errorf("index %d out of range for array of len %d", idx, n)
+	}
+	return nil
+}
+
+// GetAt returns the value of the element with the specified index
+func (a *ImmutableNestedArray) GetAt(idx uint32) ([]byte, error) {
+	if err := a.checkIndex(idx); err != nil {
+		return nil, err
+	}
+	return a.kvr.Get(NestedArrayElemKey(a.name, idx))
+}
+
+func (a *ImmutableNestedArray) MustGetAt(idx uint32) []byte {
+	ret, err := a.GetAt(idx)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Map returns the nested map stored at the specified index
+func (a *ImmutableNestedArray) Map(idx uint32) (*ImmutableNestedMap, error) {
+	if err := a.checkIndex(idx); err != nil {
+		return nil, err
+	}
+	return NewNestedMapReadOnly(a.kvr, string(NestedArrayElemKey(a.name, idx))), nil
+}
+
+func (a *ImmutableNestedArray) MustMap(idx uint32) *ImmutableNestedMap {
+	ret, err := a.Map(idx)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Array returns the nested array stored at the specified index
+func (a *ImmutableNestedArray) Array(idx uint32) (*ImmutableNestedArray, error) {
+	if err := a.checkIndex(idx); err != nil {
+		return nil, err
+	}
+	return NewNestedArrayReadOnly(a.kvr, string(NestedArrayElemKey(a.name, idx))), nil
+}
+
+func (a *ImmutableNestedArray) MustArray(idx uint32) *ImmutableNestedArray {
+	ret, err := a.Array(idx)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
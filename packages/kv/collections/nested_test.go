@@ -0,0 +1,43 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNestedLayout(t *testing.T) {
+	vars := dict.New()
+	vars.Set("arrays", util.Uint32To4Bytes(2))
+	vars.Set("arrays.0", util.Uint32To4Bytes(1))
+	vars.Set("arrays.0.0", []byte("a"))
+	vars.Set("arrays.1.x", []byte("b"))
+	vars.Set("maps.k", []byte("c"))
+	vars.Set("maps.m.k", []byte("d"))
+	vars.Set("maps.a", util.Uint32To4Bytes(11))
+	vars.Set("maps.a.10", []byte("e"))
+
+	require.EqualValues(t, "arrays.10", NestedArrayElemKey("arrays", 10))
+	require.EqualValues(t, "maps.k", NestedMapElemKey("maps", []byte("k")))
+
+	arrays := NewNestedArrayReadOnly(vars, "arrays")
+	require.EqualValues(t, 2, arrays.MustLen())
+	require.EqualValues(t, "a", arrays.MustArray(0).MustGetAt(0))
+	require.Panics(t, func() { arrays.MustArray(0).MustGetAt(1) })
+	require.EqualValues(t, "b", arrays.MustMap(1).MustGetAt([]byte("x")))
+	require.Panics(t, func() { arrays.MustMap(2) })
+
+	maps := NewNestedMapReadOnly(vars, "maps")
+	require.EqualValues(t, "c", maps.MustGetAt([]byte("k")))
+	require.EqualValues(t, "d", maps.Map([]byte("m")).MustGetAt([]byte("k")))
+	require.False(t, maps.Map([]byte("m")).MustHasAt([]byte("x")))
+	require.EqualValues(t, 11, maps.Array([]byte("a")).MustLen())
+	require.EqualValues(t, "e", maps.Array([]byte("a")).MustGetAt(10))
+
+	require.EqualValues(t, 0, NewNestedArrayReadOnly(vars, "missing").MustLen())
+	vars.Set("invalid", []byte{1, 2})
+	_, err := NewNestedArrayReadOnly(vars, "invalid").Len()
+	require.Error(t, err)
+}
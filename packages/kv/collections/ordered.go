@@ -0,0 +1,935 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"golang.org/x/xerrors"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/util"
+)
+
+// OrderedMap represents a key-value collection in a kv.KVStore that keeps its
+// keys in byte-wise lexicographical order. Besides the element access of Map
+// it supports range scans in both directions, seeking and paginated iteration.
+// The order is maintained by a B+tree whose nodes are stored alongside the
+// elements, so that scans never depend on the iteration order of the store.
+// Elements are stored with the same key layout as Map, so a Map can be turned
+// into an OrderedMap. Its elements are then indexed by the first update.
+// Use the EncodeSortable functions to encode integer keys that sort in their
+// natural order.
+type OrderedMap struct {
+	*ImmutableOrderedMap
+	kvw kv.KVWriter
+}
+
+// ImmutableOrderedMap provides read-only access to an OrderedMap in a kv.KVStoreReader.
+type ImmutableOrderedMap struct {
+	kvr  kv.KVStoreReader
+	name string
+}
+
+const (
+	orderedElemKeyCode = byte('#')
+	orderedNodeKeyCode = byte('@')
+
+	// maximum number of keys in a tree node before it is split
+	orderedMaxKeys = 32
+	// minimum number of keys in a tree node other than the root
+	orderedMinKeys = orderedMaxKeys / 2
+)
+
+// orderedNode is a node of the B+tree. Leaf nodes hold the element keys and
+// are doubly linked in key order. Internal nodes hold separator keys, where
+// all keys in children[i] are less than keys[i] and all keys in children[i+1]
+// are greater than or equal to keys[i].
+type orderedNode struct {
+	id       uint32
+	leaf     bool
+	keys     [][]byte
+	children []uint32
+	prev     uint32
+	next     uint32
+}
+
+// orderedTree holds the root of the B+tree and the next free node id.
+// Node id 0 indicates no node.
+type orderedTree struct {
+	root   uint32
+	nextID uint32
+}
+
+func NewOrderedMap(kvStore kv.KVStore, name string) *OrderedMap {
+	return &OrderedMap{
+		ImmutableOrderedMap: NewOrderedMapReadOnly(kvStore, name),
+		kvw:                 kvStore,
+	}
+}
+
+func NewOrderedMapReadOnly(kvReader kv.KVStoreReader, name string) *ImmutableOrderedMap {
+	return &ImmutableOrderedMap{
+		kvr:  kvReader,
+		name: name,
+	}
+}
+
+func (m *OrderedMap) Immutable() *ImmutableOrderedMap {
+	return m.ImmutableOrderedMap
+}
+
+func (m *ImmutableOrderedMap) Name() string {
+	return m.name
+}
+
+func (m *ImmutableOrderedMap) getSizeKey() kv.Key {
+	return kv.Key(m.name)
+}
+
+func (m *ImmutableOrderedMap) getElemKey(key []byte) kv.Key {
+	var buf bytes.Buffer
+	buf.Write([]byte(m.name))
+	buf.WriteByte(orderedElemKeyCode)
+	buf.Write(key)
+	return kv.Key(buf.Bytes())
+}
+
+func (m *ImmutableOrderedMap) getTreeKey() kv.Key {
+	return kv.Key(m.name + string(orderedNodeKeyCode))
+}
+
+func (m *ImmutableOrderedMap) getNodeKey(id uint32) kv.Key {
+	var buf bytes.Buffer
+	buf.Write([]byte(m.name))
+	buf.WriteByte(orderedNodeKeyCode)
+	var idBytes [4]byte
+	binary.BigEndian.PutUint32(idBytes[:], id)
+	buf.Write(idBytes[:])
+	return kv.Key(buf.Bytes())
+}
+
+func (m *ImmutableOrderedMap) GetAt(key []byte) ([]byte, error) {
+	return m.kvr.Get(m.getElemKey(key))
+}
+
+func (m *ImmutableOrderedMap) MustGetAt(key []byte) []byte {
+	ret, err := m.GetAt(key)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func (m *ImmutableOrderedMap) HasAt(key []byte) (bool, error) {
+	return m.kvr.Has(m.getElemKey(key))
+}
+
+func (m *ImmutableOrderedMap) MustHasAt(key []byte) bool {
+	ret, err := m.HasAt(key)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func (m *ImmutableOrderedMap) Len() (uint32, error) {
+	v, err := m.kvr.Get(m.getSizeKey())
+	if err != nil {
+		return 0, err
+	}
+	if v == nil {
+		return 0, nil
+	}
+	return util.Uint32From4Bytes(v)
+}
+
+func (m *ImmutableOrderedMap) MustLen() uint32 {
+	n, err := m.Len()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// Seek returns the smallest key that is greater than or equal to key,
+// or nil when there is no such key
+func (m *ImmutableOrderedMap) Seek(key []byte) ([]byte, error) {
+	var ret []byte
+	err := m.scanKeys(key, nil, false, func(elemKey []byte) bool {
+		ret = elemKey
+		return false
+	})
+	return ret, err
+}
+
+func (m *ImmutableOrderedMap) MustSeek(key []byte) []byte {
+	ret, err := m.Seek(key)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// Range calls f for all elements with keys in the range [from, to) in ascending
+// key order, until f returns false. A nil from or to leaves that end unbounded.
+func (m *ImmutableOrderedMap) Range(from, to []byte, f func(key, value []byte) bool) error {
+	return m.scan(from, to, false, f)
+}
+
+func (m *ImmutableOrderedMap) MustRange(from, to []byte, f func(key, value []byte) bool) {
+	err := m.Range(from, to, f)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RangeReverse calls f for all elements with keys in the range [from, to) in
+// descending key order, until f returns false. A nil from or to leaves that
+// end unbounded.
+func (m *ImmutableOrderedMap) RangeReverse(from, to []byte, f func(key, value []byte) bool) error {
+	return m.scan(from, to, true, f)
+}
+
+func (m *ImmutableOrderedMap) MustRangeReverse(from, to []byte, f func(key, value []byte) bool) {
+	err := m.RangeReverse(from, to, f)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Page calls f for at most limit elements with keys in the range [from, to),
+// in ascending key order, or in descending key order when reverse is set.
+// It returns the cursor for the next page, or nil when there are no more
+// elements in the range. To retrieve the next page pass the cursor as from,
+// or as to when reverse is set, and keep the other end of the range.
+func (m *ImmutableOrderedMap) Page(from, to []byte, limit int, reverse bool, f func(key, value []byte)) ([]byte, error) {
+	if limit <= 0 {
+		return nil, xerrors.Errorf("ordered map %s: invalid page limit %d", m.name, limit)
+	}
+	var cursor, last []byte
+	count := 0
+	err := m.scan(from, to, reverse, func(key, value []byte) bool {
+		if count == limit {
+			// there is at least one more element
+			cursor = key
+			if reverse {
+				cursor = last
+			}
+			return false
+		}
+		f(key, value)
+		last = key
+		count++
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+func (m *ImmutableOrderedMap) MustPage(from, to []byte, limit int, reverse bool, f func(key, value []byte)) []byte {
+	cursor, err := m.Page(from, to, limit, reverse, f)
+	if err != nil {
+		panic(err)
+	}
+	return cursor
+}
+
+// IterateKeys calls f for all keys in ascending order, until f returns false
+func (m *ImmutableOrderedMap) IterateKeys(f func(elemKey []byte) bool) error {
+	return m.scanKeys(nil, nil, false, f)
+}
+
+func (m *ImmutableOrderedMap) MustIterateKeys(f func(elemKey []byte) bool) {
+	err := m.IterateKeys(f)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (m *ImmutableOrderedMap) scan(from, to []byte, reverse bool, f func(key, value []byte) bool) error {
+	var err error
+	err2 := m.scanKeys(from, to, reverse, func(key []byte) bool {
+		var value []byte
+		value, err = m.GetAt(key)
+		if err != nil {
+			return false
+		}
+		return f(key, value)
+	})
+	if err2 != nil {
+		return err2
+	}
+	return err
+}
+
+// scanKeys walks the linked leaves, starting at the leaf that covers the
+// inclusive lower bound, or the exclusive upper bound when reverse is set
+func (m *ImmutableOrderedMap) scanKeys(from, to []byte, reverse bool, f func(key []byte) bool) error {
+	tree, err := m.loadTree()
+	if err != nil {
+		return err
+	}
+	if tree.root == 0 {
+		return m.scanUnindexedKeys(from, to, reverse, f)
+	}
+	start := from
+	if reverse {
+		start = to
+	}
+	leaf, err := m.findLeaf(tree.root, start, reverse)
+	if err != nil {
+		return err
+	}
+
+	if !reverse {
+		i := 0
+		if from != nil {
+			i = leaf.keyIndex(from)
+		}
+		for {
+			for i == len(leaf.keys) {
+				if leaf.next == 0 {
+					return nil
+				}
+				leaf, err = m.loadNode(leaf.next)
+				if err != nil {
+					return err
+				}
+				i = 0
+			}
+			key := leaf.keys[i]
+			if to != nil && bytes.Compare(key, to) >= 0 {
+				return nil
+			}
+			if !f(key) {
+				return nil
+			}
+			i++
+		}
+	}
+
+	i := len(leaf.keys) - 1
+	if to != nil {
+		i = leaf.keyIndex(to) - 1
+	}
+	for {
+		for i < 0 {
+			if leaf.prev == 0 {
+				return nil
+			}
+			leaf, err = m.loadNode(leaf.prev)
+			if err != nil {
+				return err
+			}
+			i = len(leaf.keys) - 1
+		}
+		key := leaf.keys[i]
+		if from != nil && bytes.Compare(key, from) < 0 {
+			return nil
+		}
+		if !f(key) {
+			return nil
+		}
+		i--
+	}
+}
+
+// scanUnindexedKeys scans elements that were stored without the index, for example
+// by a Map that was later turned into an OrderedMap. It sorts all keys of the map,
+// so it is only used until the next update of the map builds the index.
+func (m *ImmutableOrderedMap) scanUnindexedKeys(from, to []byte, reverse bool, f func(key []byte) bool) error {
+	keys, err := m.unindexedKeys()
+	if err != nil {
+		return err
+	}
+	if reverse {
+		for i := len(keys) - 1; i >= 0; i-- {
+			if to != nil && bytes.Compare(keys[i], to) >= 0 {
+				continue
+			}
+			if from != nil && bytes.Compare(keys[i], from) < 0 {
+				return nil
+			}
+			if !f(keys[i]) {
+				return nil
+			}
+		}
+		return nil
+	}
+	for _, key := range keys {
+		if from != nil && bytes.Compare(key, from) < 0 {
+			continue
+		}
+		if to != nil && bytes.Compare(key, to) >= 0 {
+			return nil
+		}
+		if !f(key) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// unindexedKeys returns the sorted keys of the elements when the map has no index
+func (m *ImmutableOrderedMap) unindexedKeys() ([][]byte, error) {
+	n, err := m.Len()
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	keys := make([][]byte, 0, n)
+	prefix := m.getElemKey(nil)
+	err = m.kvr.IterateSorted(prefix, func(key kv.Key, value []byte) bool {
+		keys = append(keys, []byte(key)[len(prefix):])
+		return true
+	})
+	return keys, err
+}
+
+// findLeaf descends to the leaf that covers key. A nil key selects the
+// leftmost leaf, or the rightmost leaf when last is set.
+func (m *ImmutableOrderedMap) findLeaf(id uint32, key []byte, last bool) (*orderedNode, error) {
+	for {
+		n, err := m.loadNode(id)
+		if err != nil {
+			return nil, err
+		}
+		if n.leaf {
+			return n, nil
+		}
+		switch {
+		case key != nil:
+			id = n.children[n.childIndex(key)]
+		case last:
+			id = n.children[len(n.children)-1]
+		default:
+			id = n.children[0]
+		}
+	}
+}
+
+func (m *ImmutableOrderedMap) loadTree() (*orderedTree, error) {
+	tree := &orderedTree{nextID: 1}
+	data, err := m.kvr.Get(m.getTreeKey())
+	if err != nil || data == nil {
+		return tree, err
+	}
+	if len(data) != 8 {
+		return nil, xerrors.Errorf("ordered map %s: corrupted tree", m.name)
+	}
+	tree.root = binary.LittleEndian.Uint32(data[:4])
+	tree.nextID = binary.LittleEndian.Uint32(data[4:])
+	return tree, nil
+}
+
+func (m *ImmutableOrderedMap) loadNode(id uint32) (*orderedNode, error) {
+	data, err := m.kvr.Get(m.getNodeKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, xerrors.Errorf("ordered map %s: missing node %d", m.name, id)
+	}
+	n, err := orderedNodeFromBytes(id, data)
+	if err != nil {
+		return nil, xerrors.Errorf("ordered map %s: corrupted node %d: %w", m.name, id, err)
+	}
+	return n, nil
+}
+
+// SetAt sets the value of the element with the specified key
+func (m *OrderedMap) SetAt(key, value []byte) error {
+	if err := m.buildIndex(); err != nil {
+		return err
+	}
+	ok, err := m.HasAt(key)
+	if err != nil {
+		return err
+	}
+	m.kvw.Set(m.getElemKey(key), value)
+	if ok {
+		return nil
+	}
+	err = m.addToSize(1)
+	if err != nil {
+		return err
+	}
+	return m.insert(key)
+}
+
+func (m *OrderedMap) MustSetAt(key, value []byte) {
+	err := m.SetAt(key, value)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// DelAt deletes the element with the specified key
+func (m *OrderedMap) DelAt(key []byte) error {
+	if err := m.buildIndex(); err != nil {
+		return err
+	}
+	ok, err := m.HasAt(key)
+	if err != nil || !ok {
+		return err
+	}
+	m.kvw.Del(m.getElemKey(key))
+	err = m.addToSize(-1)
+	if err != nil {
+		return err
+	}
+	return m.remove(key)
+}
+
+func (m *OrderedMap) MustDelAt(key []byte) {
+	err := m.DelAt(key)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Erase deletes all elements of the map
+func (m *OrderedMap) Erase() error {
+	if err := m.buildIndex(); err != nil {
+		return err
+	}
+	tree, err := m.loadTree()
+	if err != nil {
+		return err
+	}
+	ids := make([]uint32, 0)
+	if tree.root != 0 {
+		ids = append(ids, tree.root)
+	}
+	for len(ids) != 0 {
+		n, err := m.loadNode(ids[0])
+		if err != nil {
+			return err
+		}
+		ids = append(ids[1:], n.children...)
+		if n.leaf {
+			for _, key := range n.keys {
+				m.kvw.Del(m.getElemKey(key))
+			}
+		}
+		m.kvw.Del(m.getNodeKey(n.id))
+	}
+	m.kvw.Del(m.getTreeKey())
+	m.kvw.Del(m.getSizeKey())
+	return nil
+}
+
+func (m *OrderedMap) MustErase() {
+	err := m.Erase()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// buildIndex indexes the elements that were stored without the index,
+// so that a Map can be turned into an OrderedMap without migrating its state
+func (m *OrderedMap) buildIndex() error {
+	tree, err := m.loadTree()
+	if err != nil || tree.root != 0 {
+		return err
+	}
+	keys, err := m.unindexedKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = m.insert(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *OrderedMap) addToSize(amount int) error {
+	n, err := m.Len()
+	if err != nil {
+		return err
+	}
+	n = uint32(int(n) + amount)
+	if n == 0 {
+		m.kvw.Del(m.getSizeKey())
+	} else {
+		m.kvw.Set(m.getSizeKey(), util.Uint32To4Bytes(n))
+	}
+	return nil
+}
+
+func (m *OrderedMap) saveTree(tree *orderedTree) {
+	if tree.root == 0 {
+		m.kvw.Del(m.getTreeKey())
+		return
+	}
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint32(data[:4], tree.root)
+	binary.LittleEndian.PutUint32(data[4:], tree.nextID)
+	m.kvw.Set(m.getTreeKey(), data)
+}
+
+func (m *OrderedMap) saveNode(n *orderedNode) {
+	m.kvw.Set(m.getNodeKey(n.id), n.Bytes())
+}
+
+func (m *OrderedMap) newNode(tree *orderedTree, leaf bool) *orderedNode {
+	n := &orderedNode{id: tree.nextID, leaf: leaf}
+	tree.nextID++
+	return n
+}
+
+// insert adds a key that is not in the tree yet
+func (m *OrderedMap) insert(key []byte) error {
+	tree, err := m.loadTree()
+	if err != nil {
+		return err
+	}
+	if tree.root == 0 {
+		root := m.newNode(tree, true)
+		root.keys = [][]byte{key}
+		m.saveNode(root)
+		tree.root = root.id
+		m.saveTree(tree)
+		return nil
+	}
+	splitKey, right, err := m.insertAt(tree, tree.root, key)
+	if err != nil {
+		return err
+	}
+	if right != nil {
+		// the root was split, grow the tree by one level
+		root := m.newNode(tree, false)
+		root.keys = [][]byte{splitKey}
+		root.children = []uint32{tree.root, right.id}
+		m.saveNode(root)
+		tree.root = root.id
+	}
+	m.saveTree(tree)
+	return nil
+}
+
+// insertAt adds key to the subtree rooted at node id. When the node
+// overflows it is split and the separator key and new right sibling
+// are returned for insertion into the parent.
+func (m *OrderedMap) insertAt(tree *orderedTree, id uint32, key []byte) ([]byte, *orderedNode, error) {
+	n, err := m.loadNode(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n.leaf {
+		n.keys = insertKey(n.keys, n.keyIndex(key), key)
+	} else {
+		i := n.childIndex(key)
+		splitKey, right, err := m.insertAt(tree, n.children[i], key)
+		if err != nil || right == nil {
+			return nil, nil, err
+		}
+		n.keys = insertKey(n.keys, i, splitKey)
+		n.children = insertChild(n.children, i+1, right.id)
+	}
+	if len(n.keys) <= orderedMaxKeys {
+		m.saveNode(n)
+		return nil, nil, nil
+	}
+	splitKey, right, err := m.split(tree, n)
+	return splitKey, right, err
+}
+
+func (m *OrderedMap) split(tree *orderedTree, n *orderedNode) ([]byte, *orderedNode, error) {
+	mid := len(n.keys) / 2
+	right := m.newNode(tree, n.leaf)
+	var splitKey []byte
+	if n.leaf {
+		right.keys = append([][]byte(nil), n.keys[mid:]...)
+		n.keys = n.keys[:mid]
+		right.prev = n.id
+		right.next = n.next
+		if n.next != 0 {
+			next, err := m.loadNode(n.next)
+			if err != nil {
+				return nil, nil, err
+			}
+			next.prev = right.id
+			m.saveNode(next)
+		}
+		n.next = right.id
+		splitKey = right.keys[0]
+	} else {
+		splitKey = n.keys[mid]
+		right.keys = append([][]byte(nil), n.keys[mid+1:]...)
+		right.children = append([]uint32(nil), n.children[mid+1:]...)
+		n.keys = n.keys[:mid]
+		n.children = n.children[:mid+1]
+	}
+	m.saveNode(n)
+	m.saveNode(right)
+	return splitKey, right, nil
+}
+
+// remove deletes a key that is in the tree
+func (m *OrderedMap) remove(key []byte) error {
+	tree, err := m.loadTree()
+	if err != nil {
+		return err
+	}
+	if tree.root == 0 {
+		return xerrors.Errorf("ordered map %s: missing tree", m.name)
+	}
+	root, err := m.removeAt(tree.root, key)
+	if err != nil {
+		return err
+	}
+	if len(root.keys) == 0 {
+		// an empty leaf root means an empty tree,
+		// an internal root without keys is replaced by its only child
+		m.kvw.Del(m.getNodeKey(root.id))
+		tree.root = 0
+		if !root.leaf {
+			tree.root = root.children[0]
+		}
+		m.saveTree(tree)
+	}
+	return nil
+}
+
+// removeAt deletes key from the subtree rooted at node id, and returns
+// the node, which can be left with too few keys for its parent to fix
+func (m *OrderedMap) removeAt(id uint32, key []byte) (*orderedNode, error) {
+	n, err := m.loadNode(id)
+	if err != nil {
+		return nil, err
+	}
+	if n.leaf {
+		i := n.keyIndex(key)
+		if i == len(n.keys) || !bytes.Equal(n.keys[i], key) {
+			return nil, xerrors.Errorf("ordered map %s: missing key in tree", m.name)
+		}
+		n.keys = removeKey(n.keys, i)
+		m.saveNode(n)
+		return n, nil
+	}
+	i := n.childIndex(key)
+	child, err := m.removeAt(n.children[i], key)
+	if err != nil {
+		return nil, err
+	}
+	if len(child.keys) < orderedMinKeys {
+		err = m.rebalance(n, i, child)
+		if err != nil {
+			return nil, err
+		}
+		m.saveNode(n)
+	}
+	return n, nil
+}
+
+// rebalance fixes the underflow of the child at index i of parent by
+// borrowing a key from a sibling, or by merging it with a sibling
+func (m *OrderedMap) rebalance(parent *orderedNode, i int, child *orderedNode) error {
+	var left, right *orderedNode
+	var err error
+	if i > 0 {
+		left, err = m.loadNode(parent.children[i-1])
+		if err != nil {
+			return err
+		}
+		if len(left.keys) > orderedMinKeys {
+			m.borrowFromLeft(parent, i, left, child)
+			return nil
+		}
+	}
+	if i < len(parent.children)-1 {
+		right, err = m.loadNode(parent.children[i+1])
+		if err != nil {
+			return err
+		}
+		if len(right.keys) > orderedMinKeys {
+			m.borrowFromRight(parent, i, child, right)
+			return nil
+		}
+	}
+	if left != nil {
+		return m.merge(parent, i-1, left, child)
+	}
+	return m.merge(parent, i, child, right)
+}
+
+func (m *OrderedMap) borrowFromLeft(parent *orderedNode, i int, left, child *orderedNode) {
+	last := len(left.keys) - 1
+	if child.leaf {
+		child.keys = append([][]byte{left.keys[last]}, child.keys...)
+		parent.keys[i-1] = child.keys[0]
+	} else {
+		child.keys = append([][]byte{parent.keys[i-1]}, child.keys...)
+		child.children = append([]uint32{left.children[last+1]}, child.children...)
+		parent.keys[i-1] = left.keys[last]
+		left.children = left.children[:last+1]
+	}
+	left.keys = left.keys[:last]
+	m.saveNode(left)
+	m.saveNode(child)
+}
+
+func (m *OrderedMap) borrowFromRight(parent *orderedNode, i int, child, right *orderedNode) {
+	if child.leaf {
+		child.keys = append(child.keys, right.keys[0])
+		right.keys = removeKey(right.keys, 0)
+		parent.keys[i] = right.keys[0]
+	} else {
+		child.keys = append(child.keys, parent.keys[i])
+		child.children = append(child.children, right.children[0])
+		parent.keys[i] = right.keys[0]
+		right.keys = removeKey(right.keys, 0)
+		right.children = append([]uint32(nil), right.children[1:]...)
+	}
+	m.saveNode(child)
+	m.saveNode(right)
+}
+
+// merge moves all keys of right, the child at index i+1 of parent,
+// into left, the child at index i, and deletes right
+func (m *OrderedMap) merge(parent *orderedNode, i int, left, right *orderedNode) error {
+	if left.leaf {
+		left.keys = append(left.keys, right.keys...)
+		left.next = right.next
+		if right.next != 0 {
+			next, err := m.loadNode(right.next)
+			if err != nil {
+				return err
+			}
+			next.prev = left.id
+			m.saveNode(next)
+		}
+	} else {
+		left.keys = append(append(left.keys, parent.keys[i]), right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+	parent.keys = removeKey(parent.keys, i)
+	parent.children = append(parent.children[:i+1], parent.children[i+2:]...)
+	m.saveNode(left)
+	m.kvw.Del(m.getNodeKey(right.id))
+	return nil
+}
+
+// childIndex returns the index of the child that covers key
+func (n *orderedNode) childIndex(key []byte) int {
+	return sort.Search(len(n.keys), func(i int) bool {
+		return bytes.Compare(n.keys[i], key) > 0
+	})
+}
+
+// keyIndex returns the index of the first key that is greater than or equal to key
+func (n *orderedNode) keyIndex(key []byte) int {
+	return sort.Search(len(n.keys), func(i int) bool {
+		return bytes.Compare(n.keys[i], key) >= 0
+	})
+}
+
+func (n *orderedNode) Bytes() []byte {
+	var buf bytes.Buffer
+	_ = util.WriteBoolByte(&buf, n.leaf)
+	_ = util.WriteUint16(&buf, uint16(len(n.keys)))
+	for _, key := range n.keys {
+		_ = util.WriteBytes16(&buf, key)
+	}
+	if n.leaf {
+		_ = util.WriteUint32(&buf, n.prev)
+		_ = util.WriteUint32(&buf, n.next)
+		return buf.Bytes()
+	}
+	for _, child := range n.children {
+		_ = util.WriteUint32(&buf, child)
+	}
+	return buf.Bytes()
+}
+
+func orderedNodeFromBytes(id uint32, data []byte) (*orderedNode, error) {
+	n := &orderedNode{id: id}
+	r := bytes.NewReader(data)
+	err := util.ReadBoolByte(r, &n.leaf)
+	if err != nil {
+		return nil, err
+	}
+	var count uint16
+	err = util.ReadUint16(r, &count)
+	if err != nil {
+		return nil, err
+	}
+	n.keys = make([][]byte, count)
+	for i := range n.keys {
+		n.keys[i], err = util.ReadBytes16(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if n.leaf {
+		err = util.ReadUint32(r, &n.prev)
+		if err != nil {
+			return nil, err
+		}
+		err = util.ReadUint32(r, &n.next)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		n.children = make([]uint32, count+1)
+		for i := range n.children {
+			err = util.ReadUint32(r, &n.children[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if r.Len() != 0 {
+		return nil, xerrors.New("unexpected trailing bytes")
+	}
+	return n, nil
+}
+
+func insertKey(keys [][]byte, i int, key []byte) [][]byte {
+	keys = append(keys, nil)
+	copy(keys[i+1:], keys[i:])
+	keys[i] = key
+	return keys
+}
+
+func insertChild(children []uint32, i int, child uint32) []uint32 {
+	children = append(children, 0)
+	copy(children[i+1:], children[i:])
+	children[i] = child
+	return children
+}
+
+func removeKey(keys [][]byte, i int) [][]byte {
+	return append(keys[:i:i], keys[i+1:]...)
+}
+
+// EncodeSortableInt64 encodes an int64 so that the encodings sort in the same
+// order as the values, which makes it usable as key in an OrderedMap
+func EncodeSortableInt64(value int64) []byte {
+	return EncodeSortableUint64(uint64(value) ^ (1 << 63))
+}
+
+func DecodeSortableInt64(data []byte) (int64, error) {
+	value, err := DecodeSortableUint64(data)
+	return int64(value ^ (1 << 63)), err
+}
+
+// EncodeSortableUint64 encodes a uint64 so that the encodings sort in the same
+// order as the values, which makes it usable as key in an OrderedMap
+func EncodeSortableUint64(value uint64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, value)
+	return data
+}
+
+func DecodeSortableUint64(data []byte) (uint64, error) {
+	if len(data) != 8 {
+		return 0, xerrors.New("invalid sortable integer")
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
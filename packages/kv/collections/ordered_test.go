@@ -0,0 +1,211 @@
+package collections
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/buffered"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/kv/subrealm"
+	"github.com/stretchr/testify/require"
+)
+
+func orderedStores() map[string]kv.KVStore {
+	return map[string]kv.KVStore{
+		"dict":     dict.New(),
+		"buffered": buffered.NewBufferedKVStoreAccess(dict.New()),
+		"subrealm": subrealm.New(dict.New(), "sub"),
+	}
+}
+
+func orderedKeys(m *ImmutableOrderedMap, from, to []byte, reverse bool) []string {
+	ret := make([]string, 0)
+	f := func(key, value []byte) bool {
+		ret = append(ret, string(key))
+		return true
+	}
+	if reverse {
+		m.MustRangeReverse(from, to, f)
+	} else {
+		m.MustRange(from, to, f)
+	}
+	return ret
+}
+
+func expectedKeys(ref map[string]bool, from, to []byte, reverse bool) []string {
+	ret := make([]string, 0)
+	for key := range ref {
+		if from != nil && key < string(from) {
+			continue
+		}
+		if to != nil && key >= string(to) {
+			continue
+		}
+		ret = append(ret, key)
+	}
+	sort.Strings(ret)
+	if reverse {
+		for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+			ret[i], ret[j] = ret[j], ret[i]
+		}
+	}
+	return ret
+}
+
+func TestOrderedMapBasic(t *testing.T) {
+	m := NewOrderedMap(dict.New(), "testOrdered")
+	require.Zero(t, m.MustLen())
+	require.Nil(t, m.MustSeek(nil))
+	require.Empty(t, orderedKeys(m.Immutable(), nil, nil, false))
+
+	m.MustSetAt([]byte("b"), []byte("2"))
+	m.MustSetAt([]byte("a"), []byte("1"))
+	m.MustSetAt([]byte("c"), []byte("3"))
+	m.MustSetAt([]byte("b"), []byte("22"))
+	require.EqualValues(t, 3, m.MustLen())
+	require.EqualValues(t, []byte("22"), m.MustGetAt([]byte("b")))
+	require.EqualValues(t, []string{"a", "b", "c"}, orderedKeys(m.Immutable(), nil, nil, false))
+	require.EqualValues(t, []string{"c", "b", "a"}, orderedKeys(m.Immutable(), nil, nil, true))
+	require.EqualValues(t, []string{"b"}, orderedKeys(m.Immutable(), []byte("b"), []byte("c"), false))
+	require.EqualValues(t, []byte("b"), m.MustSeek([]byte("ab")))
+	require.Nil(t, m.MustSeek([]byte("d")))
+
+	m.MustDelAt([]byte("b"))
+	m.MustDelAt([]byte("x"))
+	require.EqualValues(t, 2, m.MustLen())
+	require.False(t, m.MustHasAt([]byte("b")))
+	require.EqualValues(t, []string{"a", "c"}, orderedKeys(m.Immutable(), nil, nil, false))
+}
+
+func TestOrderedMapRandom(t *testing.T) {
+	for name, store := range orderedStores() {
+		t.Run(name, func(t *testing.T) {
+			m := NewOrderedMap(store, "testOrdered")
+			ref := make(map[string]bool)
+			rnd := rand.New(rand.NewSource(1))
+			for i := 0; i < 5000; i++ {
+				key := []byte(fmt.Sprintf("k%04d", rnd.Intn(1000)))
+				if rnd.Intn(3) == 0 {
+					m.MustDelAt(key)
+					delete(ref, string(key))
+					continue
+				}
+				m.MustSetAt(key, key)
+				ref[string(key)] = true
+			}
+			require.EqualValues(t, len(ref), m.MustLen())
+
+			for i := 0; i < 50; i++ {
+				from := []byte(fmt.Sprintf("k%04d", rnd.Intn(1000)))
+				to := []byte(fmt.Sprintf("k%04d", rnd.Intn(1000)))
+				if bytes.Compare(from, to) > 0 {
+					from, to = to, from
+				}
+				switch i % 4 {
+				case 1:
+					from = nil
+				case 2:
+					to = nil
+				}
+				for _, reverse := range []bool{false, true} {
+					require.EqualValues(t, expectedKeys(ref, from, to, reverse), orderedKeys(m.Immutable(), from, to, reverse))
+				}
+				expected := expectedKeys(ref, from, nil, false)
+				if len(expected) == 0 {
+					require.Nil(t, m.MustSeek(from))
+				} else {
+					require.EqualValues(t, expected[0], m.MustSeek(from))
+				}
+			}
+
+			for key := range ref {
+				m.MustDelAt([]byte(key))
+			}
+			require.Zero(t, m.MustLen())
+			require.Empty(t, orderedKeys(m.Immutable(), nil, nil, false))
+			store.MustIterateKeys("", func(key kv.Key) bool {
+				t.Errorf("leftover key: %s", key.Hex())
+				return true
+			})
+		})
+	}
+}
+
+func TestOrderedMapPage(t *testing.T) {
+	m := NewOrderedMap(dict.New(), "testOrdered")
+	for i := int64(-50); i < 50; i++ {
+		m.MustSetAt(EncodeSortableInt64(i), []byte{byte(i)})
+	}
+
+	for _, reverse := range []bool{false, true} {
+		from := EncodeSortableInt64(-20)
+		to := EncodeSortableInt64(25)
+		values := make([]int64, 0)
+		pages := 0
+		for {
+			cursor := m.MustPage(from, to, 10, reverse, func(key, value []byte) {
+				v, err := DecodeSortableInt64(key)
+				require.NoError(t, err)
+				values = append(values, v)
+			})
+			pages++
+			if cursor == nil {
+				break
+			}
+			if reverse {
+				to = cursor
+			} else {
+				from = cursor
+			}
+		}
+		require.EqualValues(t, 5, pages)
+		require.Len(t, values, 45)
+		for i, v := range values {
+			expected := int64(-20 + i)
+			if reverse {
+				expected = int64(24 - i)
+			}
+			require.EqualValues(t, expected, v)
+		}
+	}
+}
+
+func TestOrderedMapErase(t *testing.T) {
+	store := dict.New()
+	m := NewOrderedMap(store, "testOrdered")
+	for i := uint64(0); i < 200; i++ {
+		m.MustSetAt(EncodeSortableUint64(i), []byte{1})
+	}
+	m.MustErase()
+	require.Zero(t, m.MustLen())
+	require.True(t, store.IsEmpty())
+}
+
+func TestOrderedMapFromMap(t *testing.T) {
+	store := dict.New()
+	old := NewMap(store, "testOrdered")
+	ref := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("k%03d", rand.Intn(1000))
+		old.MustSetAt([]byte(key), []byte(key))
+		ref[key] = true
+	}
+
+	// elements stored by the Map are scanned in order before they are indexed
+	m := NewOrderedMap(store, "testOrdered")
+	from, to := []byte("k200"), []byte("k700")
+	require.EqualValues(t, expectedKeys(ref, nil, nil, false), orderedKeys(m.Immutable(), nil, nil, false))
+	require.EqualValues(t, expectedKeys(ref, from, to, true), orderedKeys(m.Immutable(), from, to, true))
+
+	// the first update indexes all elements
+	m.MustSetAt([]byte("k500"), []byte("k500"))
+	ref["k500"] = true
+	require.EqualValues(t, len(ref), m.MustLen())
+	require.EqualValues(t, expectedKeys(ref, nil, nil, false), orderedKeys(m.Immutable(), nil, nil, false))
+	require.EqualValues(t, expectedKeys(ref, from, to, true), orderedKeys(m.Immutable(), from, to, true))
+	require.EqualValues(t, "k500", old.MustGetAt([]byte("k500")))
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/processors"
+	"github.com/iotaledger/wasp/packages/vm/wasmhost"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/prometheus/client_golang/prometheus"
@@ -111,4 +113,26 @@ func (m *Metrics) registerMetrics() {
 		Help: "Time it takes to run the vm",
 	}, []string{"chain"})
 	prometheus.MustRegister(m.vmRunTime)
+
+	m.registerCacheMetrics()
+}
+
+func (m *Metrics) registerCacheMetrics() {
+	for _, c := range []struct {
+		name  string
+		help  string
+		value func() uint64
+	}{
+		{"wasp_processor_cache_hits", "Number of Wasm processors found in the processor caches", func() uint64 { return processors.GetCacheStats().Hits }},
+		{"wasp_processor_cache_misses", "Number of Wasm processors created because they were not in the processor caches", func() uint64 { return processors.GetCacheStats().Misses }},
+		{"wasp_wasm_module_cache_memory_hits", "Number of compiled Wasm modules found in memory", func() uint64 { return wasmhost.DefaultModuleCache.Stats().MemoryHits }},
+		{"wasp_wasm_module_cache_disk_hits", "Number of compiled Wasm modules loaded from disk", func() uint64 { return wasmhost.DefaultModuleCache.Stats().DiskHits }},
+		{"wasp_wasm_module_cache_misses", "Number of Wasm modules compiled because they were not cached", func() uint64 { return wasmhost.DefaultModuleCache.Stats().Misses }},
+	} {
+		value := c.value
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: c.name,
+			Help: c.help,
+		}, func() float64 { return float64(value()) }))
+	}
 }
@@ -44,6 +44,7 @@ const (
 	OffledgerBroadcastUpToNPeers = "offledger.broadcastUpToNPeers"
 	OffledgerBroadcastInterval   = "offledger.broadcastInterval"
 	OffledgerAPICacheTTL         = "offledger.apiCacheTTL"
+	OffledgerStreamOrigins       = "offledger.streamOrigins"
 
 	ProfilingBindAddress   = "profiling.bindAddress"
 	ProfilingEnabled       = "profiling.enabled"
@@ -51,6 +52,11 @@ const (
 
 	MetricsBindAddress = "metrics.bindAddress"
 	MetricsEnabled     = "metrics.enabled"
+
+	ProcessorsCacheSize = "processors.cacheSize"
+
+	WasmModuleCacheDir  = "wasm.moduleCacheDir"
+	WasmModuleCacheSize = "wasm.moduleCacheSize"
 )
 
 func Init() *configuration.Configuration {
@@ -89,6 +95,7 @@ func Init() *configuration.Configuration {
 	flag.Int(OffledgerBroadcastUpToNPeers, 2, "number of peers an offledger request is broadcasted to")
 	flag.Int(OffledgerBroadcastInterval, 5000, "time between re-broadcast of offledger requests (in ms)")
 	flag.Int(OffledgerAPICacheTTL, 5*60, "time to keep processed offledger requests in api cache (in seconds)")
+	flag.StringSlice(OffledgerStreamOrigins, []string{}, "origin host patterns allowed to open the offledger request stream [default: same origin only]")
 
 	flag.String(ProfilingBindAddress, "127.0.0.1:6060", "pprof http server address")
 	flag.Bool(ProfilingEnabled, false, "whether profiling is enabled")
@@ -97,6 +104,11 @@ func Init() *configuration.Configuration {
 	flag.String(MetricsBindAddress, "127.0.0.1:2112", "prometheus metrics http server address")
 	flag.Bool(MetricsEnabled, false, "disable and enable prometheus metrics")
 
+	flag.Int(ProcessorsCacheSize, 32, "maximum number of Wasm processors kept in memory by each chain")
+
+	flag.String(WasmModuleCacheDir, "", "path to the folder of compiled Wasm modules [default: <database.directory>/wasm; not used if database.inMemory]")
+	flag.Int(WasmModuleCacheSize, 64, "maximum number of compiled Wasm modules kept in memory")
+
 	return all
 }
 
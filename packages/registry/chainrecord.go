@@ -14,6 +14,10 @@ type ChainRecord struct {
 	ChainID *iscp.ChainID
 	Peers   []string
 	Active  bool
+	// Observer nodes follow the chain by fetching blocks from the peers and
+	// verifying them against the L1 state outputs, but never join the committee.
+	// An observer record must list at least one peer.
+	Observer bool
 }
 
 func FromMarshalUtil(mu *marshalutil.MarshalUtil) (*ChainRecord, error) {
@@ -44,6 +48,13 @@ func FromMarshalUtil(mu *marshalutil.MarshalUtil) (*ChainRecord, error) {
 		}
 		ret.Peers[i] = string(d)
 	}
+	// the observer flag was added later, records without it are non-observer records
+	if mu.ReadOffset() < len(mu.Bytes()) {
+		ret.Observer, err = mu.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return ret, nil
 }
 
@@ -61,6 +72,7 @@ func (rec *ChainRecord) Bytes() []byte {
 		mu.WriteUint16(uint16(len(b))).
 			WriteBytes(b)
 	}
+	mu.WriteBool(rec.Observer)
 	return mu.Bytes()
 }
 
@@ -68,5 +80,6 @@ func (rec *ChainRecord) String() string {
 	ret := "ChainID: " + rec.ChainID.String() + "\n"
 	ret += fmt.Sprintf("      Peers:  %+v\n", rec.Peers)
 	ret += fmt.Sprintf("      Active: %v\n", rec.Active)
+	ret += fmt.Sprintf("      Observer: %v\n", rec.Observer)
 	return ret
 }
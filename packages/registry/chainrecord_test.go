@@ -36,3 +36,23 @@ func TestChainRecord(t *testing.T) {
 	require.EqualValues(t, rec.Bytes(), recBack.Bytes())
 	t.Logf("\n%s", rec.String())
 }
+
+func TestChainRecordObserver(t *testing.T) {
+	rec := ChainRecord{
+		ChainID:  iscp.RandomChainID(),
+		Peers:    []string{"a", "b"},
+		Active:   true,
+		Observer: true,
+	}
+	recBack, err := ChainRecordFromBytes(rec.Bytes())
+	require.NoError(t, err)
+	require.True(t, recBack.Observer)
+	require.EqualValues(t, rec.Bytes(), recBack.Bytes())
+
+	// records stored before the observer flag existed lack the trailing byte
+	data := rec.Bytes()
+	recBack, err = ChainRecordFromBytes(data[:len(data)-1])
+	require.NoError(t, err)
+	require.False(t, recBack.Observer)
+	require.EqualValues(t, rec.Peers, recBack.Peers)
+}
@@ -178,6 +178,60 @@ func (ch *Chain) UploadBlobOptimized(optimalSize int, keyPair *ed25519.KeyPair,
 	return ret, err
 }
 
+// UploadBlobChunked uploads the blob through a sequence of requests to the 'blob' core contract, each carrying
+// at most chunkSize bytes of a field. It lets blobs bypass the governance max blob size limit,
+// which then only applies to single chunks.
+// The parameters must be either a dict.Dict, or a sequence of pairs 'fieldName': 'fieldValue'
+func (ch *Chain) UploadBlobChunked(keyPair *ed25519.KeyPair, chunkSize int, params ...interface{}) (ret hashing.HashValue, err error) {
+	fields := parseParams(params)
+	expectedHash := blob.MustGetBlobHash(fields)
+	if _, ok := ch.GetBlobInfo(expectedHash); ok {
+		// blob exists, return hash of existing
+		return expectedHash, nil
+	}
+	iotas := ch.blobFee()
+	for _, field := range fields.KeysSorted() {
+		for i, chunk := range blob.SplitChunks(fields.MustGet(field), chunkSize) {
+			req := NewCallParams(blob.Contract.Name, blob.FuncStoreBlobChunk.Name,
+				blob.ParamHash, expectedHash,
+				blob.ParamField, []byte(field),
+				blob.ParamIndex, uint32(i),
+				blob.ParamBytes, chunk,
+			).WithIotas(iotas)
+			if _, err = ch.PostRequestSync(req, keyPair); err != nil {
+				return
+			}
+		}
+	}
+	req := NewCallParams(blob.Contract.Name, blob.FuncFinishBlobUpload.Name, blob.ParamHash, expectedHash).WithIotas(iotas)
+	res, err := ch.PostRequestSync(req, keyPair)
+	if err != nil {
+		return
+	}
+	ret, err = codec.DecodeHashValue(res.MustGet(blob.ParamHash))
+	if err != nil {
+		return
+	}
+	require.EqualValues(ch.Env.T, expectedHash, ret)
+	return ret, err
+}
+
+// DeleteBlob calls core 'blob' smart contract blob.FuncDeleteBlob entry point to remove the blob from the chain
+func (ch *Chain) DeleteBlob(keyPair *ed25519.KeyPair, blobHash hashing.HashValue) error {
+	req := NewCallParams(blob.Contract.Name, blob.FuncDeleteBlob.Name, blob.ParamHash, blobHash).WithIotas(ch.blobFee())
+	_, err := ch.PostRequestSync(req, keyPair)
+	return err
+}
+
+func (ch *Chain) blobFee() uint64 {
+	feeColor, ownerFee, validatorFee := ch.GetFeeInfo(blob.Contract.Name)
+	require.EqualValues(ch.Env.T, feeColor, colored.IOTA)
+	if totalFee := ownerFee + validatorFee; totalFee > 0 {
+		return totalFee
+	}
+	return 1
+}
+
 const (
 	OptimizeUpload  = true
 	OptimalBlobSize = 512
@@ -272,10 +326,17 @@ func (ch *Chain) GetInfo() (*iscp.ChainID, *iscp.AgentID, map[iscp.Hname]*root.C
 	chainOwnerID, err := codec.DecodeAgentID(res.MustGet(governance.VarChainOwnerID))
 	require.NoError(ch.Env.T, err)
 
-	res, err = ch.CallView(root.Contract.Name, root.FuncGetContractRecords.Name)
-	require.NoError(ch.Env.T, err)
-
-	contracts, err := root.DecodeContractRegistry(collections.NewMapReadOnly(res, root.VarContractRegistry))
+	contracts := make(map[iscp.Hname]*root.ContractRecord)
+	err = ch.CallViewPaged(root.Contract.Name, root.FuncGetContractRecords.Name, func(page dict.Dict) error {
+		recs, err := root.DecodeContractRegistry(collections.NewMapReadOnly(page, root.VarContractRegistry))
+		if err != nil {
+			return err
+		}
+		for hname, rec := range recs {
+			contracts[hname] = rec
+		}
+		return nil
+	})
 	require.NoError(ch.Env.T, err)
 	return chainID, chainOwnerID, contracts
 }
@@ -295,15 +356,18 @@ func (env *Solo) GetAddressBalances(addr ledgerstate.Address) colored.Balances {
 
 // GetAccounts returns all accounts on the chain with non-zero balances
 func (ch *Chain) GetAccounts() []*iscp.AgentID {
-	d, err := ch.CallView(accounts.Contract.Name, accounts.FuncViewAccounts.Name)
+	ret := make([]*iscp.AgentID, 0)
+	err := ch.CallViewPaged(accounts.Contract.Name, accounts.FuncViewAccounts.Name, func(page dict.Dict) error {
+		for _, key := range page.KeysSorted() {
+			aid, err := codec.DecodeAgentID([]byte(key))
+			if err != nil {
+				return err
+			}
+			ret = append(ret, aid)
+		}
+		return nil
+	})
 	require.NoError(ch.Env.T, err)
-	keys := d.KeysSorted()
-	ret := make([]*iscp.AgentID, 0, len(keys)-1)
-	for _, key := range keys {
-		aid, err := codec.DecodeAgentID([]byte(key))
-		require.NoError(ch.Env.T, err)
-		ret = append(ret, aid)
-	}
 	return ret
 }
 
@@ -416,15 +480,15 @@ func eventsFromViewResult(t TestContext, viewResult dict.Dict) []string {
 
 // GetEventsForContract calls the view in the  'blocklog' core smart contract to retrieve events for a given smart contract.
 func (ch *Chain) GetEventsForContract(name string) ([]string, error) {
-	viewResult, err := ch.CallView(
-		blocklog.Contract.Name, blocklog.FuncGetEventsForContract.Name,
-		blocklog.ParamContractHname, iscp.Hn(name),
-	)
+	ret := make([]string, 0)
+	err := ch.CallViewPaged(blocklog.Contract.Name, blocklog.FuncGetEventsForContract.Name, func(page dict.Dict) error {
+		ret = append(ret, eventsFromViewResult(ch.Env.T, page)...)
+		return nil
+	}, blocklog.ParamContractHname, iscp.Hn(name))
 	if err != nil {
 		return nil, err
 	}
-
-	return eventsFromViewResult(ch.Env.T, viewResult), nil
+	return ret, nil
 }
 
 // GetEventsForRequest calls the view in the  'blocklog' core smart contract to retrieve events for a given request.
@@ -625,6 +689,48 @@ func (ch *Chain) GetAllowedStateControllerAddresses() []ledgerstate.Address {
 	return ret
 }
 
+// AddToACL adds the agent to the access control list of the contract, hname 0 is the global list.
+// The list is governance.ACLAllow or governance.ACLDeny
+func (ch *Chain) AddToACL(list string, agentID *iscp.AgentID, hname iscp.Hname, keyPair *ed25519.KeyPair) error {
+	req := NewCallParams(coreutil.CoreContractGovernance, governance.FuncAddToACL.Name,
+		governance.ParamACLList, list,
+		governance.ParamAgentID, agentID,
+		governance.ParamHname, hname,
+	).WithIotas(1)
+	_, err := ch.PostRequestSync(req, keyPair)
+	return err
+}
+
+// RemoveFromACL removes the agent from the access control list of the contract, hname 0 is the global list
+func (ch *Chain) RemoveFromACL(list string, agentID *iscp.AgentID, hname iscp.Hname, keyPair *ed25519.KeyPair) error {
+	req := NewCallParams(coreutil.CoreContractGovernance, governance.FuncRemoveFromACL.Name,
+		governance.ParamACLList, list,
+		governance.ParamAgentID, agentID,
+		governance.ParamHname, hname,
+	).WithIotas(1)
+	_, err := ch.PostRequestSync(req, keyPair)
+	return err
+}
+
+// GetACL returns the agents of the access control list of the contract, hname 0 is the global list
+func (ch *Chain) GetACL(list string, hname iscp.Hname) []*iscp.AgentID {
+	res, err := ch.CallView(coreutil.CoreContractGovernance, governance.FuncGetACL.Name,
+		governance.ParamACLList, list,
+		governance.ParamHname, hname,
+	)
+	require.NoError(ch.Env.T, err)
+	if len(res) == 0 {
+		return nil
+	}
+	arr := collections.NewArray16ReadOnly(res, governance.ParamACLAgentIDs)
+	ret := make([]*iscp.AgentID, arr.MustLen())
+	for i := range ret {
+		ret[i], err = codec.DecodeAgentID(arr.MustGetAt(uint16(i)))
+		require.NoError(ch.Env.T, err)
+	}
+	return ret
+}
+
 // RotateStateController rotates the chain to the new controller address.
 // We assume self-governed chain here.
 // Mostly use for the testinng of committee rotation logic, otherwise not much needed for smart contract testing
@@ -0,0 +1,71 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package solo
+
+import (
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/stretchr/testify/require"
+)
+
+// MintNFT mints a single token of a new color in the request transaction and registers it
+// as an NFT with the given metadata in the 'accounts' core contract.
+// The NFT is deposited to the on-chain account of the sender (or of the OriginatorKeyPair if nil).
+// It returns the color of the NFT.
+func (ch *Chain) MintNFT(keyPair *ed25519.KeyPair, metadata []byte) (colored.Color, error) {
+	req := NewCallParams(accounts.Contract.Name, accounts.FuncMintNFT.Name,
+		accounts.ParamNFTMetadata, metadata,
+	).WithIotas(1).WithMint(ch.ChainID.AsAddress(), 1)
+	res, err := ch.PostRequestSync(req, keyPair)
+	if err != nil {
+		return colored.Color{}, err
+	}
+	return codec.DecodeColor(res.MustGet(accounts.ParamNFTColor))
+}
+
+// TransferNFT moves the NFT owned by the sender to the on-chain account of the target agent
+func (ch *Chain) TransferNFT(keyPair *ed25519.KeyPair, nftColor colored.Color, target *iscp.AgentID) error {
+	req := NewCallParams(accounts.Contract.Name, accounts.FuncTransferNFT.Name,
+		accounts.ParamNFTColor, nftColor,
+		accounts.ParamAgentID, target,
+	).WithIotas(1)
+	_, err := ch.PostRequestSync(req, keyPair)
+	return err
+}
+
+// WithdrawNFT sends the NFT owned by the sender back to the sender's address on L1
+func (ch *Chain) WithdrawNFT(keyPair *ed25519.KeyPair, nftColor colored.Color) error {
+	req := NewCallParams(accounts.Contract.Name, accounts.FuncWithdrawNFT.Name,
+		accounts.ParamNFTColor, nftColor,
+	).WithIotas(1)
+	_, err := ch.PostRequestSync(req, keyPair)
+	return err
+}
+
+// GetNFTData returns the NFT record of the color and its on-chain owner.
+// The owner is nil if the NFT is not on the chain
+func (ch *Chain) GetNFTData(nftColor colored.Color) (*accounts.NFT, *iscp.AgentID) {
+	res, err := ch.CallView(accounts.Contract.Name, accounts.FuncGetNFTData.Name, accounts.ParamNFTColor, nftColor)
+	require.NoError(ch.Env.T, err)
+	par := kvdecoder.New(res, ch.Log)
+	nft := &accounts.NFT{
+		Color:    nftColor,
+		Issuer:   par.MustGetAgentID(accounts.ParamNFTIssuer),
+		Metadata: par.MustGetBytes(accounts.ParamNFTMetadata, nil),
+	}
+	return nft, par.MustGetAgentID(accounts.ParamNFTOwner, nil)
+}
+
+// GetAccountNFTs returns the colors of the NFTs held by the on-chain account of the agent
+func (ch *Chain) GetAccountNFTs(agentID *iscp.AgentID) []colored.Color {
+	res, err := ch.CallView(accounts.Contract.Name, accounts.FuncGetAccountNFTs.Name, accounts.ParamAgentID, agentID)
+	require.NoError(ch.Env.T, err)
+	ret, err := accounts.DecodeNFTColors(res)
+	require.NoError(ch.Env.T, err)
+	return ret
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxoutil"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/iscp/request"
 	"github.com/iotaledger/wasp/packages/iscp/requestargs"
 	"github.com/iotaledger/wasp/packages/kv"
@@ -93,7 +94,9 @@ func (r *CallParams) WithIotas(amount uint64) *CallParams {
 	return r.WithTransfer(colored.IOTA, amount)
 }
 
-// WithMint adds additional mint proof
+// WithMint adds additional mint proof.
+// When the target address is the chain address, the tokens are minted in the request output itself,
+// out of the transferred iotas, so that the request brings the newly minted tokens to the chain
 func (r *CallParams) WithMint(targetAddress ledgerstate.Address, amount uint64) *CallParams {
 	r.mintAddress = targetAddress
 	r.mintAmount = amount
@@ -161,9 +164,15 @@ func (ch *Chain) RequestFromParamsToLedger(req *CallParams, keyPair *ed25519.Key
 
 	txb := utxoutil.NewBuilder(allOuts...).WithTimestamp(ch.Env.LogicalTime())
 	var err error
-	err = txb.AddExtendedOutputConsume(ch.ChainID.AsAddress(), mdata, colored.ToL1Map(req.transfer))
-	require.NoError(ch.Env.T, err)
-	if req.mintAmount > 0 {
+	if req.mintAmount > 0 && req.mintAddress.Equals(ch.ChainID.AsAddress()) {
+		// tokens minted to the chain are minted in the request output itself, out of the transferred iotas
+		err = txb.AddExtendedOutputConsume(ch.ChainID.AsAddress(), mdata, colored.ToL1Map(req.transfer), req.mintAmount)
+		require.NoError(ch.Env.T, err)
+	} else {
+		err = txb.AddExtendedOutputConsume(ch.ChainID.AsAddress(), mdata, colored.ToL1Map(req.transfer))
+		require.NoError(ch.Env.T, err)
+	}
+	if req.mintAmount > 0 && !req.mintAddress.Equals(ch.ChainID.AsAddress()) {
 		err = txb.AddMintingOutputConsume(req.mintAddress, req.mintAmount)
 		require.NoError(ch.Env.T, err)
 	}
@@ -239,11 +248,10 @@ func (ch *Chain) PostRequestSyncTx(req *CallParams, keyPair *ed25519.KeyPair) (*
 func (ch *Chain) mustGetErrorFromReceipt(reqid iscp.RequestID) error {
 	rec, _, _, ok := ch.GetRequestReceipt(reqid)
 	require.True(ch.Env.T, ok)
-	var err error
-	if len(rec.Error) > 0 {
-		err = xerrors.New(rec.Error)
+	if rec.Error != nil {
+		return rec.Error
 	}
-	return err
+	return nil
 }
 
 // callViewFull calls the view entry point of the smart contract
@@ -278,6 +286,15 @@ func (ch *Chain) CallView(scName, funName string, params ...interface{}) (dict.D
 	return vctx.CallView(iscp.Hn(scName), iscp.Hn(funName), p)
 }
 
+// CallViewPaged calls a view that returns its results page by page until all
+// pages have been retrieved, and calls f with the results of each page.
+// See coreutil.CallPaged
+func (ch *Chain) CallViewPaged(scName, funName string, f func(page dict.Dict) error, params ...interface{}) error {
+	return coreutil.CallPaged(parseParams(params), func(args dict.Dict) (dict.Dict, error) {
+		return ch.CallView(scName, funName, args)
+	}, f)
+}
+
 // WaitForRequestsThrough waits for the moment when counters for incoming requests and removed
 // requests in the mempool of the chain both become equal to the specified number
 func (ch *Chain) WaitForRequestsThrough(numReq int, maxWait ...time.Duration) bool {
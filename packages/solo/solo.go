@@ -18,6 +18,7 @@ import (
 	"github.com/iotaledger/wasp/packages/chain"
 	"github.com/iotaledger/wasp/packages/chain/mempool"
 	"github.com/iotaledger/wasp/packages/database/dbmanager"
+	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/iscp/coreutil"
@@ -150,8 +151,8 @@ func NewWithLogger(t TestContext, log *logger.Logger, seedOpt ...*ed25519.Seed)
 	}
 
 	processorConfig := processors.NewConfig()
-	err := processorConfig.RegisterVMType(vmtypes.WasmTime, func(binary []byte) (iscp.VMProcessor, error) {
-		return wasmproc.GetProcessor(binary, log)
+	err := processorConfig.RegisterVMType(vmtypes.WasmTime, func(programHash hashing.HashValue, binary []byte) (iscp.VMProcessor, error) {
+		return wasmproc.GetProcessor(programHash, binary, log)
 	})
 	require.NoError(t, err)
 
@@ -358,6 +359,11 @@ func (env *Solo) requestsByChain(tx *ledgerstate.Transaction) map[[33]byte][]isc
 			lst = make([]iscp.Request, 0)
 		}
 		mintedAmounts := colored.BalancesFromL1Map(utxoutil.GetMintedAmounts(tx))
+		if _, mints := o.Balances().Get(ledgerstate.ColorMint); mints {
+			// the output mints tokens in itself (see CallParams.WithMint), resolve the color
+			// of the minted tokens the same way the node does, see request.OnLedgerFromTransaction
+			o = o.UpdateMintingColor().(*ledgerstate.ExtendedLockedOutput)
+		}
 		ret[arr] = append(lst, request.OnLedgerFromOutput(o, sender, tx.Essence().Timestamp(), mintedAmounts))
 	}
 	return ret
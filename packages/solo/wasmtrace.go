@@ -0,0 +1,48 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package solo
+
+import (
+	"io/ioutil"
+
+	"github.com/iotaledger/wasp/packages/vm/wasmhost"
+	"github.com/stretchr/testify/require"
+)
+
+// EnableWasmTracing starts recording a structured trace of every Wasm host call,
+// key read/write, nested contract call and panic.
+// Any previously recorded trace is discarded.
+// Note that the tracer is global, so it covers all chains in all Solo environments.
+func (env *Solo) EnableWasmTracing() *wasmhost.WasmTracer {
+	tracer := wasmhost.NewWasmTracer()
+	wasmhost.SetHostTracer(tracer)
+	return tracer
+}
+
+// DisableWasmTracing stops recording Wasm host calls and returns the recorded trace, if any
+func (env *Solo) DisableWasmTracing() *wasmhost.WasmTracer {
+	tracer := wasmhost.GetHostTracer()
+	wasmhost.SetHostTracer(nil)
+	return tracer
+}
+
+// LogWasmTrace writes the Wasm trace recorded so far to the log in readable form
+func (env *Solo) LogWasmTrace() {
+	tracer := wasmhost.GetHostTracer()
+	if tracer == nil {
+		env.logger.Infof("Wasm tracing is not enabled")
+		return
+	}
+	env.logger.Infof("Wasm trace:\n%s", tracer.String())
+}
+
+// SaveWasmTrace writes the Wasm trace recorded so far as JSON to the specified file
+func (env *Solo) SaveWasmTrace(fileName string) {
+	tracer := wasmhost.GetHostTracer()
+	require.NotNil(env.T, tracer, "Wasm tracing is not enabled")
+	data, err := tracer.ToJSON()
+	require.NoError(env.T, err)
+	err = ioutil.WriteFile(fileName, data, 0o600)
+	require.NoError(env.T, err)
+}
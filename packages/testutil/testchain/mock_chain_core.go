@@ -200,3 +200,6 @@ func (m *MockedChainCore) GlobalSolidIndex() *atomic.Uint32 {
 
 func (m *MockedChainCore) ReceiveOffLedgerRequest(_ *request.OffLedger, _ string) {
 }
+
+func (m *MockedChainCore) ReceiveOffLedgerRequests(_ []*request.OffLedger) {
+}
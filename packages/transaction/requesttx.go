@@ -27,6 +27,30 @@ type NewRequestTransactionParams struct {
 // NewRequestTransaction creates a transaction including one or more requests to a chain.
 // To avoid empty transfer it defaults to 1 iota
 func NewRequestTransaction(par NewRequestTransactionParams) (*ledgerstate.Transaction, error) {
+	essence, inputs, err := NewRequestTransactionEssence(NewRequestTransactionEssenceParams{
+		SenderAddress:  ledgerstate.NewED25519Address(par.SenderKeyPair.PublicKey),
+		UnspentOutputs: par.UnspentOutputs,
+		Requests:       par.Requests,
+	})
+	if err != nil {
+		return nil, err
+	}
+	unlockBlocks, err := utxoutil.UnlockInputsWithED25519KeyPairs(inputs, essence, par.SenderKeyPair)
+	if err != nil {
+		return nil, err
+	}
+	return ledgerstate.NewTransaction(essence, unlockBlocks), nil
+}
+
+type NewRequestTransactionEssenceParams struct {
+	SenderAddress  ledgerstate.Address
+	UnspentOutputs []ledgerstate.Output
+	Requests       []RequestParams
+}
+
+// NewRequestTransactionEssence creates the unsigned essence of a transaction including one or more
+// requests to a chain. The outputs consumed by the essence are returned too, they are needed to unlock the inputs.
+func NewRequestTransactionEssence(par NewRequestTransactionEssenceParams) (*ledgerstate.TransactionEssence, []ledgerstate.Output, error) {
 	txb := utxoutil.NewBuilder(par.UnspentOutputs...)
 	for _, req := range par.Requests {
 		metadata := request.NewMetadata().
@@ -42,17 +66,12 @@ func NewRequestTransaction(par NewRequestTransactionParams) (*ledgerstate.Transa
 		}
 		err := txb.AddExtendedOutputConsume(req.ChainID.AsAddress(), metadata, colored.ToL1Map(transfer))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	addr := ledgerstate.NewED25519Address(par.SenderKeyPair.PublicKey)
-	if err := txb.AddRemainderOutputIfNeeded(addr, nil, true); err != nil {
-		return nil, err
-	}
-	tx, err := txb.BuildWithED25519(par.SenderKeyPair)
-	if err != nil {
-		return nil, err
+	if err := txb.AddRemainderOutputIfNeeded(par.SenderAddress, nil, true); err != nil {
+		return nil, nil, err
 	}
-	return tx, nil
+	return txb.BuildEssence()
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/stretchr/testify/require"
 )
@@ -256,3 +258,32 @@ func TestCreditDebit7(t *testing.T) {
 	total = checkLedger(t, state, "cp1")
 	require.True(t, transfer.Equals(total))
 }
+
+func TestAccountsPage(t *testing.T) {
+	state := dict.New()
+	for i := 0; i < 5; i++ {
+		CreditToAccount(state, iscp.NewRandomAgentID(), colored.NewBalancesForIotas(1))
+	}
+
+	seen := make(map[kv.Key]bool)
+	pages := 0
+	var cursor []byte
+	for {
+		page := getAccountsPage(state, 2, cursor)
+		pages++
+		cursor = page.MustGet(coreutil.ResultNextCursor)
+		require.EqualValues(t, cursor != nil, page.MustHas(coreutil.ResultTruncated))
+		page.Del(coreutil.ResultNextCursor)
+		page.Del(coreutil.ResultTruncated)
+		require.LessOrEqual(t, len(page), 2)
+		for key := range page {
+			require.False(t, seen[key])
+			seen[key] = true
+		}
+		if cursor == nil {
+			break
+		}
+	}
+	require.EqualValues(t, 3, pages)
+	require.EqualValues(t, 5, len(seen))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/assert"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
@@ -20,6 +21,11 @@ var Processor = Contract.Processor(initialize,
 	FuncWithdraw.WithHandler(withdraw),
 	FuncHarvest.WithHandler(harvest),
 	FuncGetAccountNonce.WithHandler(getAccountNonce),
+	FuncMintNFT.WithHandler(mintNFT),
+	FuncTransferNFT.WithHandler(transferNFT),
+	FuncWithdrawNFT.WithHandler(withdrawNFT),
+	FuncGetNFTData.WithHandler(getNFTData),
+	FuncGetAccountNFTs.WithHandler(viewAccountNFTs),
 )
 
 // initialize the init call
@@ -47,9 +53,15 @@ func viewTotalAssets(ctx iscp.SandboxView) (dict.Dict, error) {
 	return getAccountBalanceDict(ctx, getTotalAssetsAccountR(ctx.State()), "viewTotalAssets"), nil
 }
 
-// viewAccounts returns list of all accounts as keys of the ImmutableCodec
+// viewAccounts returns a page of the list of all accounts as keys of the ImmutableCodec
+// Params:
+// - coreutil.ParamLimit, coreutil.ParamCursor
 func viewAccounts(ctx iscp.SandboxView) (dict.Dict, error) {
-	return getAccountsIntern(ctx.State()), nil
+	limit, cursor, err := coreutil.DecodePageParams(ctx.Params())
+	if err != nil {
+		return nil, err
+	}
+	return getAccountsPage(ctx.State(), limit, cursor), nil
 }
 
 // deposit moves transfer to the specified account on the chain. Can be send as request or can be called
@@ -160,3 +172,143 @@ func getAccountNonce(ctx iscp.SandboxView) (dict.Dict, error) {
 	ret.Set(ParamAccountNonce, codec.EncodeUint64(nonce))
 	return ret, nil
 }
+
+// mintNFT registers the token minted by the request transaction as an NFT with immutable metadata.
+// The request must mint exactly one token of a new color and send it to the chain together with the request.
+// The NFT and the rest of the incoming transfer are deposited to the target account.
+// Params:
+// - ParamNFTMetadata []byte
+// - ParamAgentID. default is ctx.Caller()
+// Returns the color of the NFT in ParamNFTColor
+func mintNFT(ctx iscp.Sandbox) (dict.Dict, error) {
+	ctx.Log().Debugf("accounts.mintNFT.begin -- %s", ctx.IncomingTransfer())
+	state := ctx.State()
+	mustCheckLedger(state, "accounts.mintNFT.begin")
+
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	metadata := params.MustGetBytes(ParamNFTMetadata, nil)
+	targetAccount := params.MustGetAgentID(ParamAgentID, ctx.Caller())
+	targetAccount = commonaccount.AdjustIfNeeded(targetAccount, ctx.ChainID())
+
+	// the NFT is the token which was minted in the request transaction with a supply of 1
+	// and which was sent to the chain with this request
+	var nftColor colored.Color
+	found := 0
+	ctx.Minted().ForEachRandomly(func(col colored.Color, bal uint64) bool {
+		if bal == 1 && ctx.IncomingTransfer()[col] == 1 {
+			nftColor = col
+			found++
+		}
+		return true
+	})
+	a.Require(found == 1, "accounts.mintNFT.fail: the request must mint exactly one token and send it to the chain")
+	a.Require(!isNFT(state, nftColor), "accounts.mintNFT.fail: NFT %s already exists", nftColor.String())
+
+	registerNFT(state, &NFT{
+		Color:    nftColor,
+		Issuer:   ctx.Caller(),
+		Metadata: metadata,
+	})
+	// the token was credited to the common account before it became an NFT, record the ownership now.
+	// Moving it to the target account updates the ownership, unless the target is the common account itself
+	commonAccount := commonaccount.Get(ctx.ChainID())
+	updateNFTOwnership(state, getAccount(state, commonAccount), nftColor, GetBalance(state, commonAccount, nftColor))
+	succ := MoveBetweenAccounts(state, commonAccount, targetAccount, ctx.IncomingTransfer())
+	a.Require(succ, "internal error: failed to deposit to %s", targetAccount.String())
+
+	ctx.Event(fmt.Sprintf("[mintNFT] color: %s, owner: %s", nftColor.String(), targetAccount.String()))
+	mustCheckLedger(state, "accounts.mintNFT.exit")
+	ret := dict.New()
+	ret.Set(ParamNFTColor, codec.EncodeColor(nftColor))
+	return ret, nil
+}
+
+// transferNFT moves the NFT from the account of the caller to the target account
+// Params:
+// - ParamNFTColor
+// - ParamAgentID target account
+func transferNFT(ctx iscp.Sandbox) (dict.Dict, error) {
+	state := ctx.State()
+	mustCheckLedger(state, "accounts.transferNFT.begin")
+
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	nftColor := params.MustGetColor(ParamNFTColor)
+	targetAccount := params.MustGetAgentID(ParamAgentID)
+	targetAccount = commonaccount.AdjustIfNeeded(targetAccount, ctx.ChainID())
+
+	a.Require(isNFT(state, nftColor), "accounts.transferNFT.fail: %s is not an NFT", nftColor.String())
+	a.Require(GetBalance(state, ctx.Caller(), nftColor) == 1,
+		"accounts.transferNFT.fail: NFT %s is not owned by the caller", nftColor.String())
+	// incoming tokens go to the caller, otherwise they would end up in the common account
+	a.Require(MoveBetweenAccounts(state, commonaccount.Get(ctx.ChainID()), ctx.Caller(), ctx.IncomingTransfer()),
+		"accounts.transferNFT.inconsistency: failed to deposit incoming tokens")
+	a.Require(MoveBetweenAccounts(state, ctx.Caller(), targetAccount, colored.NewBalancesForColor(nftColor, 1)),
+		"accounts.transferNFT.inconsistency: failed to move NFT %s", nftColor.String())
+
+	ctx.Log().Debugf("accounts.transferNFT.success: %s -> %s", nftColor.String(), targetAccount.String())
+	mustCheckLedger(state, "accounts.transferNFT.exit")
+	return nil, nil
+}
+
+// withdrawNFT sends the NFT owned by the caller to the caller's address on L1
+// Params:
+// - ParamNFTColor
+func withdrawNFT(ctx iscp.Sandbox) (dict.Dict, error) {
+	state := ctx.State()
+	mustCheckLedger(state, "accounts.withdrawNFT.begin")
+
+	a := assert.NewAssert(ctx.Log())
+	a.Require(!ctx.Caller().Address().Equals(ctx.ChainID().AsAddress()),
+		"accounts.withdrawNFT.fail: caller is on the same chain")
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	nftColor := params.MustGetColor(ParamNFTColor)
+	a.Require(isNFT(state, nftColor), "accounts.withdrawNFT.fail: %s is not an NFT", nftColor.String())
+
+	// bring the NFT to the current account (common account). It is needed for subsequent Send call
+	a.Require(MoveBetweenAccounts(state, ctx.Caller(), commonaccount.Get(ctx.ChainID()), colored.NewBalancesForColor(nftColor, 1)),
+		"accounts.withdrawNFT.fail: NFT %s is not owned by the caller", nftColor.String())
+
+	// incoming tokens are sent back together with the NFT
+	tokensToWithdraw := colored.NewBalancesForColor(nftColor, 1)
+	tokensToWithdraw.AddAll(ctx.IncomingTransfer())
+	a.Require(ctx.Send(ctx.Caller().Address(), tokensToWithdraw, &iscp.SendMetadata{
+		TargetContract: ctx.Caller().Hname(),
+	}), "accounts.withdrawNFT.inconsistency: failed sending tokens")
+
+	ctx.Log().Debugf("accounts.withdrawNFT.success. Sent to address %s", tokensToWithdraw.String())
+	mustCheckLedger(state, "accounts.withdrawNFT.exit")
+	return nil, nil
+}
+
+// getNFTData returns the issuer and metadata of the NFT, and its owner if the NFT is on the chain
+// Params:
+// - ParamNFTColor
+func getNFTData(ctx iscp.SandboxView) (dict.Dict, error) {
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	nftColor := params.MustGetColor(ParamNFTColor)
+	nft, ok := GetNFT(ctx.State(), nftColor)
+	if !ok {
+		return nil, fmt.Errorf("NFT %s not found", nftColor.String())
+	}
+	ret := dict.New()
+	ret.Set(ParamNFTIssuer, codec.EncodeAgentID(nft.Issuer))
+	ret.Set(ParamNFTMetadata, nft.Metadata)
+	if owner, ok := GetNFTOwner(ctx.State(), nftColor); ok {
+		ret.Set(ParamNFTOwner, codec.EncodeAgentID(owner))
+	}
+	return ret, nil
+}
+
+// viewAccountNFTs returns the colors of the NFTs held by the account as keys
+// Params:
+// - ParamAgentID
+func viewAccountNFTs(ctx iscp.SandboxView) (dict.Dict, error) {
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	aid, err := params.GetAgentID(ParamAgentID)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeNFTColors(GetAccountNFTs(ctx.State(), aid)), nil
+}
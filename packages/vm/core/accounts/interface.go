@@ -14,6 +14,11 @@ var (
 	FuncWithdraw        = coreutil.Func("withdraw")
 	FuncHarvest         = coreutil.Func("harvest")
 	FuncGetAccountNonce = coreutil.ViewFunc("getAccountNonce")
+	FuncMintNFT         = coreutil.Func("mintNFT")
+	FuncTransferNFT     = coreutil.Func("transferNFT")
+	FuncWithdrawNFT     = coreutil.Func("withdrawNFT")
+	FuncGetNFTData      = coreutil.ViewFunc("getNFTData")
+	FuncGetAccountNFTs  = coreutil.ViewFunc("getAccountNFTs")
 )
 
 const (
@@ -21,4 +26,8 @@ const (
 	ParamWithdrawColor  = "c"
 	ParamWithdrawAmount = "m"
 	ParamAccountNonce   = "n"
+	ParamNFTColor       = "nc"
+	ParamNFTIssuer      = "ni"
+	ParamNFTMetadata    = "nm"
+	ParamNFTOwner       = "no"
 )
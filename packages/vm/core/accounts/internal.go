@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/collections"
@@ -18,12 +19,12 @@ const (
 	varStateTotalAssets = "t"
 )
 
-func getAccountsMap(state kv.KVStore) *collections.Map {
-	return collections.NewMap(state, varStateAccounts)
+func getAccountsMap(state kv.KVStore) *collections.OrderedMap {
+	return collections.NewOrderedMap(state, varStateAccounts)
 }
 
-func getAccountsMapR(state kv.KVStoreReader) *collections.ImmutableMap {
-	return collections.NewMapReadOnly(state, varStateAccounts)
+func getAccountsMapR(state kv.KVStoreReader) *collections.ImmutableOrderedMap {
+	return collections.NewOrderedMapReadOnly(state, varStateAccounts)
 }
 
 func getAccount(state kv.KVStore, agentID *iscp.AgentID) *collections.Map {
@@ -63,6 +64,7 @@ func creditToAccount(state kv.KVStore, account *collections.Map, transfer colore
 			currentBalance = util.MustUint64From8Bytes(v)
 		}
 		account.MustSetAt(col[:], util.Uint64To8Bytes(currentBalance+bal))
+		updateNFTOwnership(state, account, col, currentBalance+bal)
 		return true
 	})
 }
@@ -106,6 +108,9 @@ func debitFromAccount(state kv.KVStore, account *collections.Map, transfer color
 		} else {
 			account.MustDelAt(col[:])
 		}
+		if _, ok := transfer[col]; ok {
+			updateNFTOwnership(state, account, col, bal)
+		}
 		return true
 	})
 	return true
@@ -150,13 +155,22 @@ func GetBalance(state kv.KVStoreReader, agentID *iscp.AgentID, col colored.Color
 
 func getAccountsIntern(state kv.KVStoreReader) dict.Dict {
 	ret := dict.New()
-	getAccountsMapR(state).MustIterate(func(agentID []byte, val []byte) bool {
+	getAccountsMapR(state).MustIterateKeys(func(agentID []byte) bool {
 		ret.Set(kv.Key(agentID), []byte{})
 		return true
 	})
 	return ret
 }
 
+func getAccountsPage(state kv.KVStoreReader, limit uint32, cursor []byte) dict.Dict {
+	ret := dict.New()
+	next := coreutil.PageMap(getAccountsMapR(state), limit, cursor, func(agentID []byte, val []byte) {
+		ret.Set(kv.Key(agentID), []byte{})
+	})
+	coreutil.SetNextCursor(ret, next)
+	return ret
+}
+
 func getAccountBalances(account *collections.ImmutableMap) colored.Balances {
 	ret := colored.NewBalances()
 	account.MustIterateBalances(func(col colored.Color, bal uint64) bool {
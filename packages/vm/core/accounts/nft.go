@@ -0,0 +1,173 @@
+package accounts
+
+import (
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+)
+
+// NFT is a non-fungible token: a color with a total supply of exactly one token,
+// together with the immutable metadata it was minted with
+type NFT struct {
+	Color colored.Color
+	// Issuer is the agent which minted the token
+	Issuer   *iscp.AgentID
+	Metadata []byte
+}
+
+func NFTFromMarshalUtil(mu *marshalutil.MarshalUtil) (*NFT, error) {
+	ret := &NFT{}
+	var err error
+	if ret.Color, err = colored.ColorFromMarshalUtil(mu); err != nil {
+		return nil, err
+	}
+	if ret.Issuer, err = iscp.AgentIDFromMarshalUtil(mu); err != nil {
+		return nil, err
+	}
+	size, err := mu.ReadUint32()
+	if err != nil {
+		return nil, err
+	}
+	if ret.Metadata, err = mu.ReadBytes(int(size)); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func NFTFromBytes(data []byte) (*NFT, error) {
+	return NFTFromMarshalUtil(marshalutil.New(data))
+}
+
+func (nft *NFT) Bytes() []byte {
+	mu := marshalutil.New()
+	mu.WriteBytes(nft.Color.Bytes())
+	mu.Write(nft.Issuer)
+	mu.WriteUint32(uint32(len(nft.Metadata)))
+	mu.WriteBytes(nft.Metadata)
+	return mu.Bytes()
+}
+
+const (
+	varStateNFTs      = "N"
+	varStateNFTOwners = "O"
+	// prefix of the set of NFTs owned by an account, followed by the agent ID
+	prefixAccountNFTs = "A"
+)
+
+func getNFTsMap(state kv.KVStore) *collections.Map {
+	return collections.NewMap(state, varStateNFTs)
+}
+
+func getNFTsMapR(state kv.KVStoreReader) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, varStateNFTs)
+}
+
+func getNFTOwnersMap(state kv.KVStore) *collections.Map {
+	return collections.NewMap(state, varStateNFTOwners)
+}
+
+func getNFTOwnersMapR(state kv.KVStoreReader) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, varStateNFTOwners)
+}
+
+// account maps are named after the agent ID, so the NFT set of an account is found by the account map name
+func getAccountNFTs(state kv.KVStore, accountName string) *collections.Map {
+	return collections.NewMap(state, prefixAccountNFTs+accountName)
+}
+
+func getAccountNFTsR(state kv.KVStoreReader, agentID *iscp.AgentID) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, prefixAccountNFTs+string(agentID.Bytes()))
+}
+
+// registerNFT stores the immutable NFT record. The token itself is tracked by the account balances
+func registerNFT(state kv.KVStore, nft *NFT) {
+	getNFTsMap(state).MustSetAt(nft.Color[:], nft.Bytes())
+}
+
+func isNFT(state kv.KVStoreReader, col colored.Color) bool {
+	return getNFTsMapR(state).MustHasAt(col[:])
+}
+
+// updateNFTOwnership keeps the NFT ownership index in sync with the balance of an account
+func updateNFTOwnership(state kv.KVStore, account *collections.Map, col colored.Color, balance uint64) {
+	if account.Name() == varStateTotalAssets || !isNFT(state, col) {
+		return
+	}
+	owners := getNFTOwnersMap(state)
+	nfts := getAccountNFTs(state, account.Name())
+	if balance > 0 {
+		owners.MustSetAt(col[:], []byte(account.Name()))
+		nfts.MustSetAt(col[:], []byte{0xFF})
+		return
+	}
+	if owner := owners.MustGetAt(col[:]); owner != nil && string(owner) == account.Name() {
+		owners.MustDelAt(col[:])
+	}
+	nfts.MustDelAt(col[:])
+}
+
+// GetNFT returns the NFT record of the color, if it was minted as NFT on the chain
+func GetNFT(state kv.KVStoreReader, col colored.Color) (*NFT, bool) {
+	data := getNFTsMapR(state).MustGetAt(col[:])
+	if data == nil {
+		return nil, false
+	}
+	nft, err := NFTFromBytes(data)
+	if err != nil {
+		panic(err)
+	}
+	return nft, true
+}
+
+// GetNFTOwner returns the account which holds the NFT on the chain.
+// It returns false if the NFT has been withdrawn from the chain
+func GetNFTOwner(state kv.KVStoreReader, col colored.Color) (*iscp.AgentID, bool) {
+	data := getNFTOwnersMapR(state).MustGetAt(col[:])
+	if data == nil {
+		return nil, false
+	}
+	owner, err := iscp.AgentIDFromBytes(data)
+	if err != nil {
+		panic(err)
+	}
+	return owner, true
+}
+
+// GetAccountNFTs returns the colors of all NFTs held by the account
+func GetAccountNFTs(state kv.KVStoreReader, agentID *iscp.AgentID) []colored.Color {
+	ret := make([]colored.Color, 0)
+	getAccountNFTsR(state, agentID).MustIterateKeys(func(key []byte) bool {
+		col, err := colored.ColorFromBytes(key)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, col)
+		return true
+	})
+	colored.Sort(ret)
+	return ret
+}
+
+func EncodeNFTColors(colors []colored.Color) dict.Dict {
+	ret := dict.New()
+	for _, col := range colors {
+		ret.Set(kv.Key(col[:]), []byte{0xFF})
+	}
+	return ret
+}
+
+func DecodeNFTColors(nfts dict.Dict) ([]colored.Color, error) {
+	ret := make([]colored.Color, 0, len(nfts))
+	for key := range nfts {
+		col, err := colored.ColorFromBytes([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, col)
+	}
+	colored.Sort(ret)
+	return ret, nil
+}
@@ -3,19 +3,29 @@ package blob
 import (
 	"fmt"
 
+	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
 	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"github.com/iotaledger/wasp/packages/vm/core/root"
 )
 
 var Processor = Contract.Processor(initialize,
 	FuncStoreBlob.WithHandler(storeBlob),
+	FuncStoreBlobChunk.WithHandler(storeBlobChunk),
+	FuncFinishBlobUpload.WithHandler(finishBlobUpload),
+	FuncAbortBlobUpload.WithHandler(abortBlobUpload),
+	FuncDeleteBlob.WithHandler(deleteBlob),
 	FuncGetBlobInfo.WithHandler(getBlobInfo),
 	FuncGetBlobField.WithHandler(getBlobField),
+	FuncGetBlobOwner.WithHandler(getBlobOwner),
+	FuncGetBlobUploadStatus.WithHandler(getBlobUploadStatus),
 	FuncListBlobs.WithHandler(listBlobs),
 )
 
@@ -41,10 +51,25 @@ func getMaxBlobSize(ctx iscp.Sandbox) uint32 {
 // Returns hash of the blob
 func storeBlob(ctx iscp.Sandbox) (dict.Dict, error) {
 	ctx.Log().Debugf("blob.storeBlob.begin")
+	maxBlobSize := getMaxBlobSize(ctx)
+	for _, v := range ctx.Params() {
+		if uint32(len(v)) > maxBlobSize {
+			ctx.Log().Panicf("blob too big. received size: %d", len(v))
+		}
+	}
+	eraseExpiredUploadSessions(ctx.State(), ctx.GetTimestamp())
+	blobHash := mustStoreBlobFields(ctx, ctx.Params())
+
+	ret := dict.New()
+	ret.Set(ParamHash, codec.EncodeHashValue(blobHash))
+	return ret, nil
+}
+
+// mustStoreBlobFields stores the fields as a new blob owned by the caller and returns the hash of the blob
+func mustStoreBlobFields(ctx iscp.Sandbox, fields dict.Dict) hashing.HashValue {
 	state := ctx.State()
-	params := ctx.Params()
 	// calculate a deterministic hash of all blob fields
-	blobHash, kSorted, values := mustGetBlobHash(params)
+	blobHash, kSorted, values := mustGetBlobHash(fields)
 
 	directory := GetDirectory(state)
 	assert.NewAssert(ctx.Log()).Require(!directory.MustHasAt(blobHash[:]),
@@ -60,24 +85,154 @@ func storeBlob(ctx iscp.Sandbox) (dict.Dict, error) {
 	sizes := make([]uint32, len(kSorted))
 	for i, k := range kSorted {
 		size := uint32(len(values[i]))
-		if size > getMaxBlobSize(ctx) {
-			ctx.Log().Panicf("blob too big. received size: %d", totalSize)
-		}
 		blbValues.MustSetAt([]byte(k), values[i])
 		blbSizes.MustSetAt([]byte(k), EncodeSize(size))
 		sizes[i] = size
 		totalSize += size
 	}
 
-	ret := dict.New()
-	ret.Set(ParamHash, codec.EncodeHashValue(blobHash))
-
 	directory.MustSetAt(blobHash[:], EncodeSize(totalSize))
+	GetBlobOwners(state).MustSetAt(blobHash[:], ctx.Caller().Bytes())
 
 	ctx.Event(fmt.Sprintf("[blob] hash: %s, field sizes: %+v", blobHash.String(), sizes))
+	return blobHash
+}
+
+// storeBlobChunk adds the next chunk of a blob field to the upload session of the caller.
+// The session is identified by the hash of the complete blob, so that an interrupted upload
+// can be resumed by querying getBlobUploadStatus and sending the remaining chunks.
+// Sessions without a new chunk for UploadSessionTimeout are discarded by later store requests.
+// Input:
+// - ParamHash hashing.HashValue expected hash of the complete blob
+// - ParamField []byte name of the field
+// - ParamIndex uint32 index of the chunk within the field
+// - ParamBytes []byte the chunk data, at most governance max blob size bytes
+func storeBlobChunk(ctx iscp.Sandbox) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.storeBlobChunk.begin")
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+	field := params.MustGetBytes(ParamField)
+	index := params.MustGetUint32(ParamIndex)
+	chunk := params.MustGetBytes(ParamBytes)
+
+	state := ctx.State()
+	eraseExpiredUploadSessions(state, ctx.GetTimestamp())
+	a.Require(!GetDirectory(state).MustHasAt(blobHash[:]),
+		"blob.storeBlobChunk.fail: blob with hash %s already exist", blobHash.String())
+	a.Require(uint32(len(chunk)) <= getMaxBlobSize(ctx),
+		"blob.storeBlobChunk.fail: chunk too big. received size: %d", len(chunk))
+
+	counts := GetChunkCounts(state, blobHash, ctx.Caller())
+	count := uint32(0)
+	if v := counts.MustGetAt(field); v != nil {
+		var err error
+		count, err = DecodeSize(v)
+		a.RequireNoError(err)
+	}
+	if index < count {
+		// chunk was already stored, a retry of the same request is harmless
+		return nil, nil
+	}
+	a.Require(index == count, "blob.storeBlobChunk.fail: expected chunk %d of field '%s', got %d", count, string(field), index)
+
+	GetChunks(state, blobHash, ctx.Caller()).MustSetAt(chunkKey(field, index), chunk)
+	counts.MustSetAt(field, EncodeSize(count+1))
+	touchUploadSession(state, blobHash, ctx.Caller(), ctx.GetTimestamp())
+	return nil, nil
+}
+
+// finishBlobUpload assembles the chunks of the upload session of the caller into a blob.
+// The hash of the assembled blob must be equal to the hash the session was started with.
+// Returns hash of the blob
+func finishBlobUpload(ctx iscp.Sandbox) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.finishBlobUpload.begin")
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+
+	state := ctx.State()
+	counts := GetChunkCounts(state, blobHash, ctx.Caller())
+	chunks := GetChunks(state, blobHash, ctx.Caller())
+	a.Require(counts.MustLen() > 0, "blob.finishBlobUpload.fail: no upload session for blob %s", blobHash.String())
+
+	fields := dict.New()
+	counts.MustIterate(func(field []byte, value []byte) bool {
+		count, err := DecodeSize(value)
+		a.RequireNoError(err)
+		var data []byte
+		for i := uint32(0); i < count; i++ {
+			data = append(data, chunks.MustGetAt(chunkKey(field, i))...)
+		}
+		fields.Set(kv.Key(field), data)
+		return true
+	})
+	a.Require(MustGetBlobHash(fields) == blobHash,
+		"blob.finishBlobUpload.fail: uploaded data does not match blob hash %s", blobHash.String())
+
+	mustStoreBlobFields(ctx, fields)
+	eraseUploadSession(state, uploadSessionID(blobHash, ctx.Caller()))
+
+	ret := dict.New()
+	ret.Set(ParamHash, codec.EncodeHashValue(blobHash))
 	return ret, nil
 }
 
+// abortBlobUpload discards the upload session of the caller together with all uploaded chunks
+func abortBlobUpload(ctx iscp.Sandbox) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.abortBlobUpload.begin")
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+
+	eraseUploadSession(ctx.State(), uploadSessionID(blobHash, ctx.Caller()))
+	return nil, nil
+}
+
+// deleteBlob removes the blob from the state. Only the owner of the blob or the chain owner
+// can delete it, and only when no deployed contract refers to it as its program
+func deleteBlob(ctx iscp.Sandbox) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.deleteBlob.begin")
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+
+	state := ctx.State()
+	directory := GetDirectory(state)
+	a.Require(directory.MustHasAt(blobHash[:]), "blob.deleteBlob.fail: blob with hash %s does not exist", blobHash.String())
+
+	owners := GetBlobOwners(state)
+	authorized := ctx.Caller().Equals(ctx.ChainOwnerID())
+	if ownerBin := owners.MustGetAt(blobHash[:]); ownerBin != nil {
+		owner, err := codec.DecodeAgentID(ownerBin)
+		a.RequireNoError(err)
+		authorized = authorized || ctx.Caller().Equals(owner)
+	}
+	a.Require(authorized, "blob.deleteBlob.fail: not authorized")
+
+	err := coreutil.CallPaged(nil, func(args dict.Dict) (dict.Dict, error) {
+		return ctx.Call(root.Contract.Hname(), root.FuncGetContractRecords.Hname(), args, nil)
+	}, func(page dict.Dict) error {
+		contracts, err := root.DecodeContractRegistry(collections.NewMapReadOnly(page, root.VarContractRegistry))
+		if err != nil {
+			return err
+		}
+		for _, rec := range contracts {
+			a.Require(rec.ProgramHash != blobHash,
+				"blob.deleteBlob.fail: blob %s is the program of contract '%s'", blobHash.String(), rec.Name)
+		}
+		return nil
+	})
+	a.RequireNoError(err)
+
+	GetBlobValues(state, blobHash).Erase()
+	GetBlobSizes(state, blobHash).Erase()
+	owners.MustDelAt(blobHash[:])
+	directory.MustDelAt(blobHash[:])
+
+	ctx.Event(fmt.Sprintf("[blob] deleted hash: %s", blobHash.String()))
+	return nil, nil
+}
+
 // getBlobInfo return lengths of all fields in the blob
 func getBlobInfo(ctx iscp.SandboxView) (dict.Dict, error) {
 	ctx.Log().Debugf("blob.getBlobInfo.begin")
@@ -115,12 +270,52 @@ func getBlobField(ctx iscp.SandboxView) (dict.Dict, error) {
 	return ret, nil
 }
 
+// getBlobOwner returns the agent which stored the blob
+func getBlobOwner(ctx iscp.SandboxView) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.getBlobOwner.begin")
+
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+
+	owner := GetBlobOwnersR(ctx.State()).MustGetAt(blobHash[:])
+	if owner == nil {
+		return nil, fmt.Errorf("owner of blob with hash %s has not been found", blobHash.String())
+	}
+	ret := dict.New()
+	ret.Set(ParamOwner, owner)
+	return ret, nil
+}
+
+// getBlobUploadStatus returns the number of chunks uploaded so far for each field
+// of the upload session of the given owner
+func getBlobUploadStatus(ctx iscp.SandboxView) (dict.Dict, error) {
+	ctx.Log().Debugf("blob.getBlobUploadStatus.begin")
+
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	blobHash := params.MustGetHashValue(ParamHash)
+	owner := params.MustGetAgentID(ParamOwner)
+
+	ret := dict.New()
+	GetChunkCountsR(ctx.State(), blobHash, owner).MustIterate(func(field []byte, count []byte) bool {
+		ret.Set(kv.Key(field), count)
+		return true
+	})
+	return ret, nil
+}
+
+// listBlobs returns a page of the total sizes of all blobs, keyed by blob hash
+// Params:
+// - coreutil.ParamLimit, coreutil.ParamCursor
 func listBlobs(ctx iscp.SandboxView) (dict.Dict, error) {
 	ctx.Log().Debugf("blob.listBlobs.begin")
+	limit, cursor, err := coreutil.DecodePageParams(ctx.Params())
+	if err != nil {
+		return nil, err
+	}
 	ret := dict.New()
-	GetDirectoryR(ctx.State()).MustIterate(func(hash []byte, totalSize []byte) bool {
+	next := coreutil.PageMap(GetDirectoryR(ctx.State()), limit, cursor, func(hash []byte, totalSize []byte) {
 		ret.Set(kv.Key(hash), totalSize)
-		return true
 	})
+	coreutil.SetNextCursor(ret, next)
 	return ret, nil
 }
@@ -11,6 +11,8 @@ const (
 	ParamHash  = "hash"
 	ParamField = "field"
 	ParamBytes = "bytes"
+	ParamIndex = "index"
+	ParamOwner = "owner"
 
 	// variable names of standard blob's field
 	// user-defined field must be different
@@ -20,8 +22,14 @@ const (
 )
 
 var (
-	FuncStoreBlob    = coreutil.Func("storeBlob")
-	FuncGetBlobInfo  = coreutil.ViewFunc("getBlobInfo")
-	FuncGetBlobField = coreutil.ViewFunc("getBlobField")
-	FuncListBlobs    = coreutil.ViewFunc("listBlobs")
+	FuncStoreBlob           = coreutil.Func("storeBlob")
+	FuncStoreBlobChunk      = coreutil.Func("storeBlobChunk")
+	FuncFinishBlobUpload    = coreutil.Func("finishBlobUpload")
+	FuncAbortBlobUpload     = coreutil.Func("abortBlobUpload")
+	FuncDeleteBlob          = coreutil.Func("deleteBlob")
+	FuncGetBlobInfo         = coreutil.ViewFunc("getBlobInfo")
+	FuncGetBlobField        = coreutil.ViewFunc("getBlobField")
+	FuncGetBlobOwner        = coreutil.ViewFunc("getBlobOwner")
+	FuncGetBlobUploadStatus = coreutil.ViewFunc("getBlobUploadStatus")
+	FuncListBlobs           = coreutil.ViewFunc("listBlobs")
 )
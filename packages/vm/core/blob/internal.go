@@ -2,8 +2,10 @@ package blob
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/collections"
@@ -12,7 +14,19 @@ import (
 	"github.com/iotaledger/wasp/packages/vm/vmtypes"
 )
 
-const varStateDirectory = "d"
+const (
+	varStateDirectory     = "d"
+	varStateOwners        = "o"
+	varStateUploads       = "u"
+	varStateUploadsExpiry = "x"
+)
+
+const (
+	// UploadSessionTimeout is the time after the last stored chunk when an unfinished upload session expires
+	UploadSessionTimeout = 24 * time.Hour
+	// maxExpiredUploadsPerCall bounds the cleanup work done by a single store request
+	maxExpiredUploadsPerCall = 10
+)
 
 func valuesKey(blobHash hashing.HashValue) string {
 	return "v" + string(blobHash[:])
@@ -22,6 +36,85 @@ func sizesKey(blobHash hashing.HashValue) string {
 	return "s" + string(blobHash[:])
 }
 
+// an upload session is identified by the expected hash of the blob and the uploader
+func uploadSessionID(blobHash hashing.HashValue, uploader *iscp.AgentID) string {
+	return string(blobHash[:]) + string(uploader.Bytes())
+}
+
+func chunkCountsKey(blobHash hashing.HashValue, uploader *iscp.AgentID) string {
+	return "n" + uploadSessionID(blobHash, uploader)
+}
+
+func chunksKey(blobHash hashing.HashValue, uploader *iscp.AgentID) string {
+	return "c" + uploadSessionID(blobHash, uploader)
+}
+
+// getUploadSessions retrieves the upload session id to timestamp of the last stored chunk map
+func getUploadSessions(state kv.KVStore) *collections.Map {
+	return collections.NewMap(state, varStateUploads)
+}
+
+// getUploadsExpiry retrieves the upload sessions ordered by the timestamp of their
+// last stored chunk, so that expired sessions can be found without a full scan
+func getUploadsExpiry(state kv.KVStore) *collections.OrderedMap {
+	return collections.NewOrderedMap(state, varStateUploadsExpiry)
+}
+
+func uploadExpiryKey(lastActive int64, sessionID string) []byte {
+	return append(collections.EncodeSortableInt64(lastActive), sessionID...)
+}
+
+// touchUploadSession marks the upload session as active at the given timestamp
+func touchUploadSession(state kv.KVStore, blobHash hashing.HashValue, uploader *iscp.AgentID, timestamp int64) {
+	sessionID := uploadSessionID(blobHash, uploader)
+	dropUploadExpiry(state, sessionID)
+	getUploadSessions(state).MustSetAt([]byte(sessionID), codec.EncodeInt64(timestamp))
+	getUploadsExpiry(state).MustSetAt(uploadExpiryKey(timestamp, sessionID), []byte(sessionID))
+}
+
+// dropUploadExpiry removes the upload session from the expiry index
+func dropUploadExpiry(state kv.KVStore, sessionID string) {
+	value := getUploadSessions(state).MustGetAt([]byte(sessionID))
+	if value == nil {
+		return
+	}
+	lastActive, err := codec.DecodeInt64(value, 0)
+	if err != nil {
+		panic(err)
+	}
+	getUploadsExpiry(state).MustDelAt(uploadExpiryKey(lastActive, sessionID))
+}
+
+// eraseUploadSession discards the upload session together with all uploaded chunks
+func eraseUploadSession(state kv.KVStore, sessionID string) {
+	collections.NewMap(state, "n"+sessionID).Erase()
+	collections.NewMap(state, "c"+sessionID).Erase()
+	dropUploadExpiry(state, sessionID)
+	getUploadSessions(state).MustDelAt([]byte(sessionID))
+}
+
+// eraseExpiredUploadSessions discards up to maxExpiredUploadsPerCall upload sessions
+// which had no chunk stored for UploadSessionTimeout. Only the head of the expiry
+// index is scanned. Returns the number of erased sessions
+func eraseExpiredUploadSessions(state kv.KVStore, timestamp int64) int {
+	expired := make([]string, 0, maxExpiredUploadsPerCall)
+	expiredBefore := collections.EncodeSortableInt64(timestamp - int64(UploadSessionTimeout))
+	getUploadsExpiry(state).MustRange(nil, expiredBefore, func(key []byte, sessionID []byte) bool {
+		expired = append(expired, string(sessionID))
+		return len(expired) < maxExpiredUploadsPerCall
+	})
+	for _, sessionID := range expired {
+		eraseUploadSession(state, sessionID)
+	}
+	return len(expired)
+}
+
+// chunkKey is the key of the chunk in the upload session. The index goes first,
+// because field names are arbitrary binaries
+func chunkKey(field []byte, index uint32) []byte {
+	return append(EncodeSize(index), field...)
+}
+
 func mustGetBlobHash(fields dict.Dict) (hashing.HashValue, []kv.Key, [][]byte) {
 	sorted := fields.KeysSorted() // mind determinism
 	values := make([][]byte, 0, len(sorted))
@@ -42,13 +135,38 @@ func MustGetBlobHash(fields dict.Dict) hashing.HashValue {
 }
 
 // GetDirectory retrieves the blob directory from the state
-func GetDirectory(state kv.KVStore) *collections.Map {
-	return collections.NewMap(state, varStateDirectory)
+func GetDirectory(state kv.KVStore) *collections.OrderedMap {
+	return collections.NewOrderedMap(state, varStateDirectory)
 }
 
 // GetDirectoryR retrieves the blob directory from the read-only state
-func GetDirectoryR(state kv.KVStoreReader) *collections.ImmutableMap {
-	return collections.NewMapReadOnly(state, varStateDirectory)
+func GetDirectoryR(state kv.KVStoreReader) *collections.ImmutableOrderedMap {
+	return collections.NewOrderedMapReadOnly(state, varStateDirectory)
+}
+
+// GetBlobOwners retrieves the blob hash to owner map from the state
+func GetBlobOwners(state kv.KVStore) *collections.Map {
+	return collections.NewMap(state, varStateOwners)
+}
+
+// GetBlobOwnersR retrieves the blob hash to owner map from the read-only state
+func GetBlobOwnersR(state kv.KVStoreReader) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, varStateOwners)
+}
+
+// GetChunkCounts retrieves the field to number of uploaded chunks map of the upload session
+func GetChunkCounts(state kv.KVStore, blobHash hashing.HashValue, uploader *iscp.AgentID) *collections.Map {
+	return collections.NewMap(state, chunkCountsKey(blobHash, uploader))
+}
+
+// GetChunkCountsR retrieves the field to number of uploaded chunks map of the upload session from the read-only state
+func GetChunkCountsR(state kv.KVStoreReader, blobHash hashing.HashValue, uploader *iscp.AgentID) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, chunkCountsKey(blobHash, uploader))
+}
+
+// GetChunks retrieves the uploaded chunks of the upload session
+func GetChunks(state kv.KVStore, blobHash hashing.HashValue, uploader *iscp.AgentID) *collections.Map {
+	return collections.NewMap(state, chunksKey(blobHash, uploader))
 }
 
 // GetBlobValues retrieves the blob field-value map from the state
@@ -120,3 +238,13 @@ func DecodeDirectory(blobs dict.Dict) (map[hashing.HashValue]uint32, error) {
 	}
 	return ret, nil
 }
+
+// SplitChunks splits the data into chunks of at most chunkSize bytes
+func SplitChunks(data []byte, chunkSize int) [][]byte {
+	chunks := make([][]byte, 0, len(data)/chunkSize+1)
+	for len(data) > chunkSize {
+		chunks = append(chunks, data[:chunkSize])
+		data = data[chunkSize:]
+	}
+	return append(chunks, data)
+}
@@ -2,11 +2,14 @@ package blocklog
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,10 +19,71 @@ func TestSerdeRequestLogRecord(t *testing.T) {
 	req := request.NewOffLedger(iscp.Hn("0"), iscp.Hn("0"), nil)
 	rec := &RequestReceipt{
 		Request: req,
-		Error:   "some log data",
+		Error:   iscp.NewVMError(iscp.VMErrorCodeUser, "some log data", "param"),
 	}
 	forward := rec.Bytes()
 	back, err := RequestReceiptFromBytes(forward)
 	require.NoError(t, err)
 	require.EqualValues(t, forward, back.Bytes())
+	require.EqualValues(t, rec.Error, back.Error)
+	require.Nil(t, back.Results)
+}
+
+func TestSerdeRequestReceiptResults(t *testing.T) {
+	req := request.NewOffLedger(iscp.Hn("0"), iscp.Hn("0"), nil)
+	rec := (&RequestReceipt{Request: req}).WithResults(dict.Dict{"a": []byte{1, 2, 3}})
+	require.NotNil(t, rec.Results)
+	require.False(t, rec.ResultsOmitted)
+	forward := rec.Bytes()
+	back, err := RequestReceiptFromBytes(forward)
+	require.NoError(t, err)
+	require.Nil(t, back.Error)
+	require.True(t, rec.Results.Equals(back.Results))
+
+	rec.WithResults(dict.Dict{"a": make([]byte, MaxReceiptResultsSize)})
+	require.Nil(t, rec.Results)
+	require.True(t, rec.ResultsOmitted)
+	back, err = RequestReceiptFromBytes(rec.Bytes())
+	require.NoError(t, err)
+	require.Nil(t, back.Results)
+	require.True(t, back.ResultsOmitted)
+}
+
+func TestDeserRequestReceiptLegacy(t *testing.T) {
+	req := request.NewOffLedger(iscp.Hn("0"), iscp.Hn("0"), nil)
+	for _, errStr := range []string{"", "some log data", strings.Repeat("x", 258)} {
+		// legacy receipts end with the uint16 size of the error string and the string
+		legacy := marshalutil.New().
+			WriteBytes(req.Bytes()).
+			WriteUint16(uint16(len(errStr))).
+			WriteBytes([]byte(errStr)).
+			Bytes()
+		back, err := RequestReceiptFromBytes(legacy)
+		require.NoError(t, err)
+		require.EqualValues(t, req.ID(), back.Request.ID())
+		require.Nil(t, back.Results)
+		if errStr == "" {
+			require.Nil(t, back.Error)
+			continue
+		}
+		require.EqualValues(t, iscp.VMErrorCodeGeneric, back.Error.Code)
+		require.EqualValues(t, errStr, back.Error.Error())
+	}
+}
+
+func TestSerdeRequestReceiptPadding(t *testing.T) {
+	req := request.NewOffLedger(iscp.Hn("0"), iscp.Hn("0"), nil)
+	// the serialized error takes 257 bytes, which together with the version
+	// and the error flag would read as the size of a legacy error string
+	rec := &RequestReceipt{
+		Request: req,
+		Error:   iscp.NewVMError(iscp.VMErrorCodeUser, strings.Repeat("x", 247)),
+	}
+	require.Len(t, rec.Error.Bytes(), 257)
+	forward := rec.Bytes()
+	require.NotZero(t, forward[len(req.Bytes())+1]&receiptFlagPadding)
+	back, err := RequestReceiptFromBytes(forward)
+	require.NoError(t, err)
+	require.EqualValues(t, rec.Error, back.Error)
+	require.EqualValues(t, forward, back.Bytes())
 }
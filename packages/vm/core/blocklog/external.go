@@ -34,6 +34,17 @@ func GetRequestIDsForBlock(stateReader state.OptimisticStateReader, blockIndex u
 	return ret, nil
 }
 
+// GetRequestReceipt reads the receipt of the request from the chain state, together with its block
+// index and request index. Returns nil if the request has not been processed
+func GetRequestReceipt(stateReader kv.KVStoreReader, reqid *iscp.RequestID) (*RequestReceipt, error) {
+	partition := subrealm.NewReadOnly(stateReader, kv.Key(Contract.Hname().Bytes()))
+	lst, err := mustGetLookupKeyListFromReqID(partition, reqid)
+	if err != nil {
+		return nil, err
+	}
+	return getCorrectRecordFromLookupKeyList(partition, lst, reqid)
+}
+
 // IsRequestProcessed check if reqid is stored in the chain state as processed
 func IsRequestProcessed(stateReader kv.KVStoreReader, reqid *iscp.RequestID) (bool, error) {
 	partition := subrealm.NewReadOnly(stateReader, kv.Key(Contract.Hname().Bytes()))
@@ -6,6 +6,7 @@ import (
 
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
@@ -211,6 +212,7 @@ func viewGetEventsForBlock(ctx iscp.SandboxView) (dict.Dict, error) {
 // ParamContractHname - hname of the contract
 // ParamFromBlock - defaults to 0
 // ParamToBlock - defaults to latest block
+// coreutil.ParamLimit, coreutil.ParamCursor - page of events to return
 func viewGetEventsForContract(ctx iscp.SandboxView) (dict.Dict, error) {
 	params := kvdecoder.New(ctx.Params())
 	contract := params.MustGetHname(ParamContractHname)
@@ -222,7 +224,11 @@ func viewGetEventsForContract(ctx iscp.SandboxView) (dict.Dict, error) {
 	if err != nil {
 		return nil, err
 	}
-	events, err := getSmartContractEventsInternal(ctx.State(), contract, fromBlock, toBlock)
+	limit, cursor, err := coreutil.DecodePageParams(ctx.Params())
+	if err != nil {
+		return nil, err
+	}
+	events, next, err := getSmartContractEventsInternal(ctx.State(), contract, fromBlock, toBlock, limit, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -232,5 +238,6 @@ func viewGetEventsForContract(ctx iscp.SandboxView) (dict.Dict, error) {
 	for _, event := range events {
 		arr.MustPush([]byte(event))
 	}
+	coreutil.SetNextCursor(ret, next)
 	return ret, nil
 }
@@ -14,7 +14,9 @@ import (
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/util"
+	"golang.org/x/xerrors"
 )
 
 var Contract = coreutil.NewContract(coreutil.CoreContractBlocklog, "Block log contract")
@@ -260,10 +262,34 @@ func EventLookupKeyFromBytes(r io.Reader) (*EventLookupKey, error) {
 
 // region RequestLogReqcord /////////////////////////////////////////////////////
 
+// MaxReceiptResultsSize is the maximum size of the serialized call results kept in a receipt.
+// Bigger results are omitted from the receipt.
+const MaxReceiptResultsSize = 1024
+
+// Receipts start with the request. Legacy receipts, written before receiptVersion was
+// introduced, follow it with the uint16 size of the error string and the error string,
+// so that the uint16 after the request always equals the number of bytes after it.
+// Current receipts follow the request with receiptVersion and the flags. Should these
+// ever read as the size of the rest of the receipt, a padding byte is appended.
+const receiptVersion = byte(1)
+
+const (
+	receiptFlagError = byte(1 << iota)
+	receiptFlagResults
+	receiptFlagResultsOmitted
+	// receiptFlagPadding marks the trailing byte that tells the receipt apart from a legacy one
+	receiptFlagPadding = byte(0x80)
+)
+
 // RequestReceipt represents log record of processed request on the chain
 type RequestReceipt struct {
 	Request iscp.Request
-	Error   string
+	// Error is nil when the request was processed successfully
+	Error *iscp.VMError
+	// Results returned by the call, nil when there were none or when they were omitted
+	Results dict.Dict
+	// ResultsOmitted is true when the results exceeded MaxReceiptResultsSize
+	ResultsOmitted bool
 	// not persistent
 	BlockIndex   uint32
 	RequestIndex uint16
@@ -279,26 +305,108 @@ func RequestReceiptFromMarshalutil(mu *marshalutil.MarshalUtil) (*RequestReceipt
 	if ret.Request, err = request.FromMarshalUtil(mu); err != nil {
 		return nil, err
 	}
-	var size uint16
-	if size, err = mu.ReadUint16(); err != nil {
+	if isLegacyReceipt(mu) {
+		return ret, ret.readLegacyError(mu)
+	}
+	version, err := mu.ReadByte()
+	if err != nil {
 		return nil, err
 	}
-	strBytes, err := mu.ReadBytes(int(size))
+	if version != receiptVersion {
+		return nil, xerrors.Errorf("unsupported receipt version %d", version)
+	}
+	flags, err := mu.ReadByte()
 	if err != nil {
 		return nil, err
 	}
-	ret.Error = string(strBytes)
+	if flags&receiptFlagError != 0 {
+		if ret.Error, err = iscp.VMErrorFromMarshalUtil(mu); err != nil {
+			return nil, err
+		}
+	}
+	if flags&receiptFlagResults != 0 {
+		if ret.Results, err = dict.FromMarshalUtil(mu); err != nil {
+			return nil, err
+		}
+	}
+	ret.ResultsOmitted = flags&receiptFlagResultsOmitted != 0
+	if flags&receiptFlagPadding != 0 {
+		if _, err = mu.ReadByte(); err != nil {
+			return nil, err
+		}
+	}
 	return ret, nil
 }
 
+// isLegacyReceipt checks whether the rest of the receipt is a uint16 sized error string
+func isLegacyReceipt(mu *marshalutil.MarshalUtil) bool {
+	offset := mu.ReadOffset()
+	defer mu.ReadSeek(offset)
+	size, err := mu.ReadUint16()
+	if err != nil {
+		return false
+	}
+	return int(size) == len(mu.Bytes())-mu.ReadOffset()
+}
+
+// readLegacyError reads the error string of a legacy receipt, where an empty string means no error
+func (r *RequestReceipt) readLegacyError(mu *marshalutil.MarshalUtil) error {
+	size, err := mu.ReadUint16()
+	if err != nil {
+		return err
+	}
+	errBytes, err := mu.ReadBytes(int(size))
+	if err != nil {
+		return err
+	}
+	if len(errBytes) != 0 {
+		r.Error = &iscp.VMError{Code: iscp.VMErrorCodeGeneric, Message: string(errBytes)}
+	}
+	return nil
+}
+
 func (r *RequestReceipt) Bytes() []byte {
+	flags := byte(0)
+	payload := marshalutil.New()
+	if r.Error != nil {
+		flags |= receiptFlagError
+		payload.WriteBytes(r.Error.Bytes())
+	}
+	if r.Results != nil {
+		flags |= receiptFlagResults
+		r.Results.WriteToMarshalUtil(payload)
+	}
+	if r.ResultsOmitted {
+		flags |= receiptFlagResultsOmitted
+	}
+	if int(receiptVersion)|int(flags)<<8 == payload.WriteOffset() {
+		// would be mistaken for a legacy receipt
+		flags |= receiptFlagPadding
+		payload.WriteByte(0)
+	}
 	mu := marshalutil.New()
 	mu.WriteBytes(r.Request.Bytes()).
-		WriteUint16(uint16(len(r.Error))).
-		WriteBytes([]byte(r.Error))
+		WriteByte(receiptVersion).
+		WriteByte(flags).
+		WriteBytes(payload.Bytes())
 	return mu.Bytes()
 }
 
+// WithResults keeps the results of the call in the receipt, unless they exceed MaxReceiptResultsSize
+func (r *RequestReceipt) WithResults(results dict.Dict) *RequestReceipt {
+	r.Results = nil
+	r.ResultsOmitted = false
+	if len(results) == 0 {
+		return r
+	}
+	if len(results.Bytes()) > MaxReceiptResultsSize {
+		r.ResultsOmitted = true
+		return r
+	}
+	r.Results = results
+	return r
+}
+
 func (r *RequestReceipt) WithBlockData(blockIndex uint32, requestIndex uint16) *RequestReceipt {
 	r.BlockIndex = blockIndex
 	r.RequestIndex = requestIndex
@@ -306,10 +414,20 @@ func (r *RequestReceipt) WithBlockData(blockIndex uint32, requestIndex uint16) *
 }
 
 func (r *RequestReceipt) String() string {
-	if len(r.Error) > 0 {
-		return fmt.Sprintf("%s\n Error: '%s'", r.Request.String(), r.Error)
+	ret := r.Request.String()
+	if r.Error != nil {
+		ret += fmt.Sprintf("\n Error: '%s'", r.Error.Error())
+		if r.Error.Code != iscp.VMErrorCodeGeneric {
+			ret += fmt.Sprintf("\n Error code: %d, contract: %s", r.Error.Code, r.Error.Contract)
+		}
+	}
+	if r.Results != nil {
+		ret += fmt.Sprintf("\n Results:\n%s", r.Results.String())
 	}
-	return r.Request.String()
+	if r.ResultsOmitted {
+		ret += "\n Results: omitted, too big"
+	}
+	return ret
 }
 
 func (r *RequestReceipt) Short() string {
@@ -318,8 +436,8 @@ func (r *RequestReceipt) Short() string {
 		prefix = "api"
 	}
 	ret := fmt.Sprintf("%s/%s", prefix, r.Request.ID())
-	if len(r.Error) > 0 {
-		ret += ": '" + r.Error + "'"
+	if r.Error != nil {
+		ret += ": '" + r.Error.Error() + "'"
 	}
 	return ret
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/collections"
 	"golang.org/x/xerrors"
@@ -181,33 +182,46 @@ func getRequestEventsInternal(partition kv.KVStoreReader, reqID *iscp.RequestID)
 	}
 }
 
-func getSmartContractEventsInternal(partition kv.KVStoreReader, contract iscp.Hname, fromBlock, toBlock uint32) ([]string, error) {
+// getSmartContractEventsInternal returns at most limit events of the contract, starting
+// at position cursor in the list of events of the contract. It also returns the cursor
+// of the next page, or nil when there are no more events.
+func getSmartContractEventsInternal(partition kv.KVStoreReader, contract iscp.Hname, fromBlock, toBlock, limit uint32, cursor []byte) ([]string, []byte, error) {
+	position, err := coreutil.DecodePagePosition(cursor)
+	if err != nil {
+		return nil, nil, err
+	}
 	scLut := collections.NewMapReadOnly(partition, StateVarSmartContractEventsLookup)
 	ret := []string{}
 	entries, err := scLut.GetAt(contract.Bytes())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if uint64(position)*8 > uint64(len(entries)) {
+		return nil, nil, xerrors.New("getSmartContractEventsIntern invalid cursor")
 	}
 	events := collections.NewMapReadOnly(partition, StateVarRequestEvents)
-	keysBuf := bytes.NewBuffer(entries)
-	for {
+	keysBuf := bytes.NewBuffer(entries[position*8:])
+	for ; ; position++ {
 		key, err := EventLookupKeyFromBytes(keysBuf)
 		if err != nil && !errors.Is(err, io.EOF) {
-			return nil, xerrors.Errorf("getSmartContractEventsIntern unable to parse key. %v", err)
+			return nil, nil, xerrors.Errorf("getSmartContractEventsIntern unable to parse key. %v", err)
 		}
 		if key == nil { // no more events
-			return ret, nil
+			return ret, nil, nil
 		}
 		keyBlockIndex := key.BlockIndex()
 		if keyBlockIndex < fromBlock {
 			continue
 		}
 		if keyBlockIndex > toBlock {
-			return ret, nil
+			return ret, nil, nil
+		}
+		if uint32(len(ret)) == limit {
+			return ret, coreutil.EncodePagePosition(position), nil
 		}
 		event, err := events.GetAt(key.Bytes())
 		if err != nil {
-			return nil, xerrors.Errorf("getSmartContractEventsIntern unable to get event by key. %v", err)
+			return nil, nil, xerrors.Errorf("getSmartContractEventsIntern unable to get event by key. %v", err)
 		}
 		ret = append(ret, string(event))
 	}
@@ -0,0 +1,107 @@
+package governance
+
+import (
+	"errors"
+
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/subrealm"
+)
+
+// The access control lists define who may post requests to the chain. There is an allow list and
+// a deny list for the whole chain (hname 0) and for each contract. An entry is an agent ID. An entry
+// with hname 0 covers all agents of its address, so that an L1 address or a whole chain can be listed.
+//
+// A request is rejected when its sender is in the global or in the contract deny list, or when
+// the global or the contract allow list is not empty and does not contain the sender.
+// The chain owner is never rejected, so that it can always fix the lists.
+
+// IsValidACLList checks that list is ACLAllow or ACLDeny
+func IsValidACLList(list string) bool {
+	return list == ACLAllow || list == ACLDeny
+}
+
+func aclMapName(list string, hname iscp.Hname) string {
+	if list == ACLAllow {
+		return VarACLAllow + string(hname.Bytes())
+	}
+	return VarACLDeny + string(hname.Bytes())
+}
+
+// ACLMap returns the access control list of the contract, hname 0 is the global list
+func ACLMap(state kv.KVStore, list string, hname iscp.Hname) *collections.Map {
+	return collections.NewMap(state, aclMapName(list, hname))
+}
+
+// ACLMapReadOnly returns the access control list of the contract, hname 0 is the global list
+func ACLMapReadOnly(state kv.KVStoreReader, list string, hname iscp.Hname) *collections.ImmutableMap {
+	return collections.NewMapReadOnly(state, aclMapName(list, hname))
+}
+
+func aclContains(acl *collections.ImmutableMap, sender *iscp.AgentID) bool {
+	if acl.MustHasAt(sender.Bytes()) {
+		return true
+	}
+	return sender.Hname() != 0 && acl.MustHasAt(iscp.NewAgentID(sender.Address(), 0).Bytes())
+}
+
+// CheckACL returns an error when the access control lists do not allow the sender to post
+// requests to the target contract
+// It is called from VMContext and from the mempool
+func CheckACL(state kv.KVStoreReader, sender *iscp.AgentID, target iscp.Hname) error {
+	if aclAllows(state, sender, target) {
+		return nil
+	}
+	if owner := state.MustGet(VarChainOwnerID); owner != nil {
+		if ownerID, err := iscp.AgentIDFromBytes(owner); err == nil && ownerID.Equals(sender) {
+			return nil
+		}
+	}
+	vmErr := iscp.NewVMError(iscp.VMErrorCodeNotAllowed, "sender is not allowed to post requests", sender.String(), target.String())
+	vmErr.Contract = Contract.Hname()
+	return vmErr
+}
+
+// CheckACLFromChainState is CheckACL on the state of the chain, e.g. from the mempool.
+// An invalidated optimistic read and database errors are returned as they are, any other panic
+// is not recovered. A rejection by the access control lists is returned as an *iscp.VMError
+func CheckACLFromChainState(stateReader kv.KVStoreReader, sender *iscp.AgentID, target iscp.Hname) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		switch e := r.(type) {
+		case *kv.DBError:
+			err = e
+			return
+		case error:
+			if errors.Is(e, coreutil.ErrorStateInvalidated) {
+				err = e
+				return
+			}
+		}
+		panic(r)
+	}()
+	partition := subrealm.NewReadOnly(stateReader, kv.Key(Contract.Hname().Bytes()))
+	return CheckACL(partition, sender, target)
+}
+
+func aclAllows(state kv.KVStoreReader, sender *iscp.AgentID, target iscp.Hname) bool {
+	hnames := []iscp.Hname{0}
+	if target != 0 {
+		hnames = append(hnames, target)
+	}
+	for _, hname := range hnames {
+		if aclContains(ACLMapReadOnly(state, ACLDeny, hname), sender) {
+			return false
+		}
+		allow := ACLMapReadOnly(state, ACLAllow, hname)
+		if allow.MustLen() > 0 && !aclContains(allow, sender) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package governance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/testutil/testkey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckACL(t *testing.T) {
+	state := dict.New()
+	_, addr := testkey.GenKeyAddr()
+	wallet := iscp.NewAgentID(addr, 0)
+	contract := iscp.NewAgentID(addr, iscp.Hn("contract"))
+	owner := iscp.NewRandomAgentID()
+	other := iscp.NewRandomAgentID()
+	state.Set(VarChainOwnerID, owner.Bytes())
+	target := iscp.Hn("target")
+
+	// empty lists allow everybody
+	require.NoError(t, CheckACL(state, other, target))
+
+	// an entry with hname 0 covers all the agents of the address
+	ACLMap(state, ACLDeny, 0).MustSetAt(wallet.Bytes(), []byte{0xFF})
+	require.Error(t, CheckACL(state, wallet, target))
+	require.Error(t, CheckACL(state, contract, target))
+	require.NoError(t, CheckACL(state, other, target))
+	ACLMap(state, ACLDeny, 0).MustDelAt(wallet.Bytes())
+
+	// the contract list applies to its contract only
+	ACLMap(state, ACLAllow, target).MustSetAt(contract.Bytes(), []byte{0xFF})
+	require.NoError(t, CheckACL(state, contract, target))
+	require.Error(t, CheckACL(state, wallet, target))
+	require.NoError(t, CheckACL(state, wallet, iscp.Hn("another")))
+
+	// both the global and the contract allow lists must contain the sender
+	ACLMap(state, ACLAllow, 0).MustSetAt(wallet.Bytes(), []byte{0xFF})
+	require.NoError(t, CheckACL(state, contract, target))
+	require.NoError(t, CheckACL(state, wallet, iscp.Hn("another")))
+	require.Error(t, CheckACL(state, other, iscp.Hn("another")))
+
+	// the chain owner is never rejected
+	require.NoError(t, CheckACL(state, owner, target))
+	ACLMap(state, ACLDeny, 0).MustSetAt(owner.Bytes(), []byte{0xFF})
+	require.NoError(t, CheckACL(state, owner, target))
+
+	err := CheckACL(state, other, target)
+	var vmErr *iscp.VMError
+	require.ErrorAs(t, err, &vmErr)
+	require.EqualValues(t, iscp.VMErrorCodeNotAllowed, vmErr.Code)
+	require.EqualValues(t, Contract.Hname(), vmErr.Contract)
+}
+
+// panickingReader panics with err on every read, like an invalidated optimistic state reader
+type panickingReader struct {
+	kv.KVStoreReader
+	err interface{}
+}
+
+func (r *panickingReader) Get(kv.Key) ([]byte, error) { panic(r.err) }
+func (r *panickingReader) Has(kv.Key) (bool, error)   { panic(r.err) }
+func (r *panickingReader) MustGet(kv.Key) []byte      { panic(r.err) }
+func (r *panickingReader) MustHas(kv.Key) bool        { panic(r.err) }
+
+func TestCheckACLFromChainStateRecover(t *testing.T) {
+	sender := iscp.NewRandomAgentID()
+
+	err := CheckACLFromChainState(&panickingReader{err: coreutil.ErrorStateInvalidated}, sender, 0)
+	require.ErrorIs(t, err, coreutil.ErrorStateInvalidated)
+
+	dbErr := &kv.DBError{}
+	err = CheckACLFromChainState(&panickingReader{err: dbErr}, sender, 0)
+	require.Equal(t, dbErr, err)
+
+	// anything else is a bug and must not be reported as a read error
+	require.Panics(t, func() {
+		_ = CheckACLFromChainState(&panickingReader{err: errors.New("unexpected")}, sender, 0)
+	})
+	require.Panics(t, func() {
+		_ = CheckACLFromChainState(&panickingReader{err: "unexpected"}, sender, 0)
+	})
+}
@@ -0,0 +1,68 @@
+package governanceimpl
+
+import (
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+)
+
+// addToACL adds an agent to an access control list
+// Input:
+// - ParamACLList string governance.ACLAllow or governance.ACLDeny
+// - ParamAgentID iscp.AgentID the agent. Hname 0 stands for all agents of the address
+// - ParamHname iscp.Hname contract of the list. May be skipped, then the global list is used
+func addToACL(ctx iscp.Sandbox) (dict.Dict, error) {
+	a := assert.NewAssert(ctx.Log())
+	a.RequireChainOwner(ctx, "addToACL")
+	list, agentID, hname := getACLParams(ctx, a)
+	governance.ACLMap(ctx.State(), list, hname).MustSetAt(agentID.Bytes(), []byte{0xFF})
+	return nil, nil
+}
+
+// removeFromACL removes an agent from an access control list
+// Input: same as addToACL
+func removeFromACL(ctx iscp.Sandbox) (dict.Dict, error) {
+	a := assert.NewAssert(ctx.Log())
+	a.RequireChainOwner(ctx, "removeFromACL")
+	list, agentID, hname := getACLParams(ctx, a)
+	governance.ACLMap(ctx.State(), list, hname).MustDelAt(agentID.Bytes())
+	return nil, nil
+}
+
+// getACL returns the agents of an access control list
+// Input:
+// - ParamACLList string governance.ACLAllow or governance.ACLDeny
+// - ParamHname iscp.Hname contract of the list. May be skipped, then the global list is returned
+// Output:
+// - ParamACLAgentIDs Array16 of iscp.AgentID
+func getACL(ctx iscp.SandboxView) (dict.Dict, error) {
+	a := assert.NewAssert(ctx.Log())
+	params := kvdecoder.New(ctx.Params(), ctx.Log())
+	list := params.MustGetString(governance.ParamACLList)
+	a.Require(governance.IsValidACLList(list), "getACL: invalid list %q", list)
+	hname := params.MustGetHname(governance.ParamHname, 0)
+
+	acl := governance.ACLMapReadOnly(ctx.State(), list, hname)
+	if acl.MustLen() == 0 {
+		return nil, nil
+	}
+	ret := dict.New()
+	retArr := collections.NewArray16(ret, governance.ParamACLAgentIDs)
+	acl.MustIterateKeys(func(elemKey []byte) bool {
+		retArr.MustPush(elemKey)
+		return true
+	})
+	return ret, nil
+}
+
+func getACLParams(ctx iscp.Sandbox, a assert.Assert) (string, *iscp.AgentID, iscp.Hname) {
+	par := kvdecoder.New(ctx.Params(), ctx.Log())
+	list := par.MustGetString(governance.ParamACLList)
+	a.Require(governance.IsValidACLList(list), "invalid access control list %q", list)
+	agentID := par.MustGetAgentID(governance.ParamAgentID)
+	hname := par.MustGetHname(governance.ParamHname, 0)
+	return list, agentID, hname
+}
@@ -29,6 +29,11 @@ var Processor = governance.Contract.Processor(initialize,
 	governance.FuncGetChainInfo.WithHandler(getChainInfo),
 	governance.FuncSetChainInfo.WithHandler(setChainInfo),
 	governance.FuncGetMaxBlobSize.WithHandler(getMaxBlobSize),
+
+	// access control lists
+	governance.FuncAddToACL.WithHandler(addToACL),
+	governance.FuncRemoveFromACL.WithHandler(removeFromACL),
+	governance.FuncGetACL.WithHandler(getACL),
 )
 
 func initialize(ctx iscp.Sandbox) (dict.Dict, error) {
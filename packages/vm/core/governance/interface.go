@@ -37,6 +37,11 @@ var (
 	FuncSetChainInfo   = coreutil.Func("setChainInfo")
 	FuncGetChainInfo   = coreutil.ViewFunc("getChainInfo")
 	FuncGetMaxBlobSize = coreutil.ViewFunc("getMaxBlobSize")
+
+	// access control lists
+	FuncAddToACL      = coreutil.Func("addToACL")
+	FuncRemoveFromACL = coreutil.Func("removeFromACL")
+	FuncGetACL        = coreutil.ViewFunc("getACL")
 )
 
 // state variables
@@ -63,6 +68,10 @@ const (
 	VarMaxBlobSize     = "mb"
 	VarMaxEventSize    = "me"
 	VarMaxEventsPerReq = "mr"
+
+	// access control lists
+	VarACLAllow = "la"
+	VarACLDeny  = "ld"
 )
 
 // params
@@ -86,4 +95,15 @@ const (
 	ParamMaxBlobSize         = "bs"
 	ParamMaxEventSize        = "es"
 	ParamMaxEventsPerRequest = "ne"
+
+	// access control lists
+	ParamACLList     = "al"
+	ParamAgentID     = "ag"
+	ParamACLAgentIDs = "aa"
+)
+
+// access control lists, see CheckACL
+const (
+	ACLAllow = "allow"
+	ACLDeny  = "deny"
 )
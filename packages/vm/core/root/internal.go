@@ -16,7 +16,7 @@ import (
 // the bool flag indicates if contract was found or not. It means, if hname == 0 it will
 // return default contract and true
 func FindContract(state kv.KVStoreReader, hname iscp.Hname) (*ContractRecord, bool) {
-	contractRegistry := collections.NewMapReadOnly(state, VarContractRegistry)
+	contractRegistry := collections.NewOrderedMapReadOnly(state, VarContractRegistry)
 	retBin := contractRegistry.MustGetAt(hname.Bytes())
 	if retBin != nil {
 		ret, err := ContractRecordFromBytes(retBin)
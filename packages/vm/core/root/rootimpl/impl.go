@@ -11,6 +11,7 @@ import (
 
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/assert"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/kv/kvdecoder"
@@ -50,7 +51,7 @@ func initialize(ctx iscp.Sandbox) (dict.Dict, error) {
 	a.Require(state.MustGet(root.VarStateInitialized) == nil, "root.initialize.fail: already initialized")
 	a.Require(ctx.Caller().Hname() == 0, "root.init.fail: chain deployer can't be another smart contract")
 
-	contractRegistry := collections.NewMap(state, root.VarContractRegistry)
+	contractRegistry := collections.NewOrderedMap(state, root.VarContractRegistry)
 	a.Require(contractRegistry.MustLen() == 0, "root.initialize.fail: registry not empty")
 
 	mustStoreContract(ctx, _default.Contract, a)
@@ -168,15 +169,22 @@ func revokeDeployPermission(ctx iscp.Sandbox) (dict.Dict, error) {
 	return nil, nil
 }
 
+// getContractRecords returns a page of the contract registry
+// Params:
+// - coreutil.ParamLimit, coreutil.ParamCursor
 func getContractRecords(ctx iscp.SandboxView) (dict.Dict, error) {
-	src := collections.NewMapReadOnly(ctx.State(), root.VarContractRegistry)
+	limit, cursor, err := coreutil.DecodePageParams(ctx.Params())
+	if err != nil {
+		return nil, err
+	}
+	src := collections.NewOrderedMapReadOnly(ctx.State(), root.VarContractRegistry)
 
 	ret := dict.New()
 	dst := collections.NewMap(ret, root.VarContractRegistry)
-	src.MustIterate(func(elemKey []byte, value []byte) bool {
+	next := coreutil.PageMap(src, limit, cursor, func(elemKey []byte, value []byte) {
 		dst.MustSetAt(elemKey, value)
-		return true
 	})
+	coreutil.SetNextCursor(ret, next)
 
 	return ret, nil
 }
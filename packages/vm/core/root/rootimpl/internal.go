@@ -29,7 +29,7 @@ func mustStoreAndInitCoreContract(ctx iscp.Sandbox, i *coreutil.ContractInfo, a
 
 func mustStoreContractRecord(ctx iscp.Sandbox, rec *root.ContractRecord, a assert.Assert) {
 	hname := rec.Hname()
-	contractRegistry := collections.NewMap(ctx.State(), root.VarContractRegistry)
+	contractRegistry := collections.NewOrderedMap(ctx.State(), root.VarContractRegistry)
 	a.Require(!contractRegistry.MustHasAt(hname.Bytes()), "contract '%s'/%s already exist", rec.Name, hname.String())
 	contractRegistry.MustSetAt(hname.Bytes(), rec.Bytes())
 }
@@ -0,0 +1,122 @@
+package testcore
+
+import (
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/solo"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/iotaledger/wasp/packages/vm/core/blob"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+)
+
+func requireNotAllowed(t *testing.T, err error) {
+	require.Error(t, err)
+	var vmErr *iscp.VMError
+	require.True(t, xerrors.As(err, &vmErr))
+	require.EqualValues(t, iscp.VMErrorCodeNotAllowed, vmErr.Code)
+	require.EqualValues(t, governance.Contract.Hname(), vmErr.Contract)
+}
+
+func TestACLManage(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	_, addr1 := env.NewKeyPair()
+	agent1 := iscp.NewAgentID(addr1, 0)
+	_, addr2 := env.NewKeyPair()
+	agent2 := iscp.NewAgentID(addr2, 0)
+
+	require.Empty(t, chain.GetACL(governance.ACLAllow, 0))
+
+	err := chain.AddToACL(governance.ACLAllow, agent1, 0, nil)
+	require.NoError(t, err)
+	err = chain.AddToACL(governance.ACLDeny, agent2, blob.Contract.Hname(), nil)
+	require.NoError(t, err)
+
+	lst := chain.GetACL(governance.ACLAllow, 0)
+	require.Len(t, lst, 1)
+	require.True(t, agent1.Equals(lst[0]))
+	require.Empty(t, chain.GetACL(governance.ACLDeny, 0))
+	lst = chain.GetACL(governance.ACLDeny, blob.Contract.Hname())
+	require.Len(t, lst, 1)
+	require.True(t, agent2.Equals(lst[0]))
+
+	err = chain.RemoveFromACL(governance.ACLAllow, agent1, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, chain.GetACL(governance.ACLAllow, 0))
+
+	err = chain.AddToACL("other", agent1, 0, nil)
+	require.Error(t, err)
+
+	// only the chain owner manages the lists
+	kp, _ := env.NewKeyPairWithFunds()
+	err = chain.AddToACL(governance.ACLAllow, agent1, 0, kp)
+	require.Error(t, err)
+	require.Empty(t, chain.GetACL(governance.ACLAllow, 0))
+}
+
+func TestACLAllowList(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	kp1, addr1 := env.NewKeyPairWithFunds()
+	kp2, addr2 := env.NewKeyPairWithFunds()
+	err := chain.AddToACL(governance.ACLAllow, iscp.NewAgentID(addr1, 0), 0, nil)
+	require.NoError(t, err)
+
+	req := solo.NewCallParams(accounts.Contract.Name, accounts.FuncDeposit.Name).WithIotas(42)
+	_, err = chain.PostRequestSync(req, kp1)
+	require.NoError(t, err)
+	chain.AssertAccountBalance(iscp.NewAgentID(addr1, 0), colored.IOTA, 42)
+
+	// the request is rejected and the tokens are sent back
+	tx, _, err := chain.PostRequestSyncTx(req, kp2)
+	requireNotAllowed(t, err)
+	chain.AssertAccountBalance(iscp.NewAgentID(addr2, 0), colored.IOTA, 0)
+	env.AssertAddressIotas(addr2, solo.Saldo)
+
+	reqs, err := env.RequestsForChain(tx, chain.ChainID)
+	require.NoError(t, err)
+	rec, _, _, ok := chain.GetRequestReceipt(reqs[0].ID())
+	require.True(t, ok)
+	require.EqualValues(t, iscp.VMErrorCodeNotAllowed, rec.Error.Code)
+
+	// the chain owner is always allowed
+	_, err = chain.PostRequestSync(req, nil)
+	require.NoError(t, err)
+}
+
+func TestACLDenyContract(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	kp, addr := env.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(addr, 0)
+
+	req := solo.NewCallParams(accounts.Contract.Name, accounts.FuncDeposit.Name).WithIotas(42)
+	_, err := chain.PostRequestSync(req, kp)
+	require.NoError(t, err)
+
+	err = chain.AddToACL(governance.ACLDeny, agentID, accounts.Contract.Hname(), nil)
+	require.NoError(t, err)
+
+	// on-ledger requests of the agent are rejected by the VM and their tokens are sent back
+	_, err = chain.PostRequestSync(req, kp)
+	requireNotAllowed(t, err)
+	chain.AssertAccountBalance(agentID, colored.IOTA, 42)
+
+	// other contracts are not affected by the list
+	_, err = chain.PostRequestOffLedger(solo.NewCallParams(blob.Contract.Name, blob.FuncStoreBlob.Name,
+		"file", []byte("data")), kp)
+	require.NoError(t, err)
+
+	err = chain.RemoveFromACL(governance.ACLDeny, agentID, accounts.Contract.Hname(), nil)
+	require.NoError(t, err)
+	_, err = chain.PostRequestSync(req, kp)
+	require.NoError(t, err)
+	chain.AssertAccountBalance(agentID, colored.IOTA, 84)
+}
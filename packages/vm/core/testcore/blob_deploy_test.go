@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/solo"
 	"github.com/iotaledger/wasp/packages/vm/core"
 	"github.com/iotaledger/wasp/packages/vm/core/blob"
@@ -235,3 +236,204 @@ func TestOpenDeploymentToAnyone(t *testing.T) {
 	err = chain.DeployWasmContract(userWallet, "testCore3", wasmFile)
 	require.Error(t, err)
 }
+
+func TestBlobUploadChunked(t *testing.T) {
+	env := solo.New(t, false, false)
+	ch := env.NewChain(nil, "chain1")
+
+	// lower max blob size, so that chunks still fit into a request transaction
+	const maxBlobSize uint32 = 1000
+	_, err := ch.PostRequestSync(
+		solo.NewCallParams(
+			governance.Contract.Name, governance.FuncSetChainInfo.Name,
+			governance.ParamMaxBlobSize, maxBlobSize,
+		).WithIotas(1),
+		nil,
+	)
+	require.NoError(t, err)
+
+	blobBin := make([]byte, 3*maxBlobSize+100)
+	for i := range blobBin {
+		blobBin[i] = byte(i)
+	}
+	_, err = ch.UploadWasm(nil, blobBin)
+	require.Error(t, err)
+
+	hash, err := ch.UploadBlobChunked(nil, int(maxBlobSize),
+		blob.VarFieldVMType, "test",
+		blob.VarFieldProgramBinary, blobBin,
+	)
+	require.NoError(t, err)
+
+	info, ok := ch.GetBlobInfo(hash)
+	require.True(t, ok)
+	require.EqualValues(t, len(blobBin), info[blob.VarFieldProgramBinary])
+
+	ret, err := ch.CallView(blob.Contract.Name, blob.FuncGetBlobField.Name,
+		blob.ParamHash, hash,
+		blob.ParamField, blob.VarFieldProgramBinary,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, blobBin, ret.MustGet(blob.ParamBytes))
+
+	// the upload session has been cleaned up
+	ret, err = ch.CallView(blob.Contract.Name, blob.FuncGetBlobUploadStatus.Name,
+		blob.ParamHash, hash,
+		blob.ParamOwner, ch.OriginatorAgentID,
+	)
+	require.NoError(t, err)
+	require.True(t, ret.IsEmpty())
+}
+
+func TestBlobUploadResume(t *testing.T) {
+	env := solo.New(t, false, false)
+	ch := env.NewChain(nil, "chain1")
+	data := []byte("0123456789")
+	hash := blob.MustGetBlobHash(dict.Dict{blob.VarFieldProgramBinary: data})
+
+	storeChunk := func(index uint32, chunk []byte) error {
+		req := solo.NewCallParams(blob.Contract.Name, blob.FuncStoreBlobChunk.Name,
+			blob.ParamHash, hash,
+			blob.ParamField, blob.VarFieldProgramBinary,
+			blob.ParamIndex, index,
+			blob.ParamBytes, chunk,
+		).WithIotas(1)
+		_, err := ch.PostRequestSync(req, nil)
+		return err
+	}
+	finish := func() error {
+		req := solo.NewCallParams(blob.Contract.Name, blob.FuncFinishBlobUpload.Name,
+			blob.ParamHash, hash,
+		).WithIotas(1)
+		_, err := ch.PostRequestSync(req, nil)
+		return err
+	}
+
+	require.NoError(t, storeChunk(0, data[:4]))
+	// chunks must be sent in order
+	require.Error(t, storeChunk(2, data[8:]))
+	// retrying an already stored chunk is harmless
+	require.NoError(t, storeChunk(0, data[:4]))
+	// incomplete data does not match the blob hash
+	require.Error(t, finish())
+
+	ret, err := ch.CallView(blob.Contract.Name, blob.FuncGetBlobUploadStatus.Name,
+		blob.ParamHash, hash,
+		blob.ParamOwner, ch.OriginatorAgentID,
+	)
+	require.NoError(t, err)
+	counts, err := blob.DecodeSizesMap(ret)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, counts[blob.VarFieldProgramBinary])
+
+	require.NoError(t, storeChunk(1, data[4:8]))
+	require.NoError(t, storeChunk(2, data[8:]))
+	require.NoError(t, finish())
+
+	ret, err = ch.CallView(blob.Contract.Name, blob.FuncGetBlobField.Name,
+		blob.ParamHash, hash,
+		blob.ParamField, blob.VarFieldProgramBinary,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, data, ret.MustGet(blob.ParamBytes))
+}
+
+func TestBlobDelete(t *testing.T) {
+	env := solo.New(t, false, false)
+	ch := env.NewChain(nil, "chain1")
+	user1, addr1 := env.NewKeyPairWithFunds()
+
+	hash, err := ch.UploadBlob(user1, "field", "data")
+	require.NoError(t, err)
+
+	ret, err := ch.CallView(blob.Contract.Name, blob.FuncGetBlobOwner.Name, blob.ParamHash, hash)
+	require.NoError(t, err)
+	require.EqualValues(t, iscp.NewAgentID(addr1, 0).Bytes(), ret.MustGet(blob.ParamOwner))
+
+	user2, _ := env.NewKeyPairWithFunds()
+	err = ch.DeleteBlob(user2, hash)
+	require.Error(t, err)
+
+	err = ch.DeleteBlob(user1, hash)
+	require.NoError(t, err)
+	_, ok := ch.GetBlobInfo(hash)
+	require.False(t, ok)
+
+	// blob does not exist anymore
+	err = ch.DeleteBlob(user1, hash)
+	require.Error(t, err)
+
+	// the blob can be stored again
+	_, err = ch.UploadBlob(user1, "field", "data")
+	require.NoError(t, err)
+	_, ok = ch.GetBlobInfo(hash)
+	require.True(t, ok)
+}
+
+func TestBlobDeleteDeployed(t *testing.T) {
+	env := solo.New(t, false, false)
+	ch := env.NewChain(nil, "chain1")
+	err := ch.DeployWasmContract(nil, "testCore", wasmFile)
+	require.NoError(t, err)
+
+	rec, err := ch.FindContract("testCore")
+	require.NoError(t, err)
+
+	err = ch.DeleteBlob(nil, rec.ProgramHash)
+	require.Error(t, err)
+	_, ok := ch.GetBlobInfo(rec.ProgramHash)
+	require.True(t, ok)
+}
+
+func TestBlobUploadExpires(t *testing.T) {
+	env := solo.New(t, false, false)
+	ch := env.NewChain(nil, "chain1")
+	data := []byte("0123456789")
+	hash := blob.MustGetBlobHash(dict.Dict{blob.VarFieldProgramBinary: data})
+
+	req := solo.NewCallParams(blob.Contract.Name, blob.FuncStoreBlobChunk.Name,
+		blob.ParamHash, hash,
+		blob.ParamField, blob.VarFieldProgramBinary,
+		blob.ParamIndex, uint32(0),
+		blob.ParamBytes, data[:4],
+	).WithIotas(1)
+	_, err := ch.PostRequestSync(req, nil)
+	require.NoError(t, err)
+
+	uploadStatus := func() dict.Dict {
+		ret, err := ch.CallView(blob.Contract.Name, blob.FuncGetBlobUploadStatus.Name,
+			blob.ParamHash, hash,
+			blob.ParamOwner, ch.OriginatorAgentID,
+		)
+		require.NoError(t, err)
+		return ret
+	}
+	storeOther := func(field string) {
+		_, err := ch.UploadBlob(nil, field, []byte("other data"))
+		require.NoError(t, err)
+	}
+
+	// the session is still active
+	env.AdvanceClockBy(blob.UploadSessionTimeout / 2)
+	storeOther("a")
+	require.False(t, uploadStatus().IsEmpty())
+
+	// storing the next chunk extends the session
+	req = solo.NewCallParams(blob.Contract.Name, blob.FuncStoreBlobChunk.Name,
+		blob.ParamHash, hash,
+		blob.ParamField, blob.VarFieldProgramBinary,
+		blob.ParamIndex, uint32(1),
+		blob.ParamBytes, data[4:8],
+	).WithIotas(1)
+	_, err = ch.PostRequestSync(req, nil)
+	require.NoError(t, err)
+	env.AdvanceClockBy(blob.UploadSessionTimeout * 3 / 4)
+	storeOther("c")
+	require.False(t, uploadStatus().IsEmpty())
+
+	// the abandoned session is discarded by the next store request
+	env.AdvanceClockBy(blob.UploadSessionTimeout)
+	require.False(t, uploadStatus().IsEmpty())
+	storeOther("b")
+	require.True(t, uploadStatus().IsEmpty())
+}
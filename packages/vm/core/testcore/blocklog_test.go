@@ -1,10 +1,13 @@
 package testcore
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/iotaledger/wasp/contracts/native/inccounter"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/solo"
 	"github.com/iotaledger/wasp/packages/vm/core"
@@ -126,7 +129,7 @@ func TestRequestLogRecord(t *testing.T) {
 	a := reqs[0].Bytes()
 	b := receipt.Request.Bytes()
 	require.Equal(t, a, b)
-	require.EqualValues(t, 0, len(receipt.Error))
+	require.Nil(t, receipt.Error)
 	require.EqualValues(t, 2, blockIndex)
 	require.EqualValues(t, 0, requestIndex)
 }
@@ -270,4 +273,49 @@ func TestGetEvents(t *testing.T) {
 	require.Contains(t, events[1], "counter = 1")
 }
 
+func TestGetEventsPaged(t *testing.T) {
+	env := solo.New(t, false, false).WithNativeContract(inccounter.Processor)
+	chain := env.NewChain(nil, "chain1")
+
+	err := chain.DeployContract(nil, inccounter.Contract.Name, inccounter.Contract.ProgramHash, inccounter.VarCounter, 0)
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		incrementSCCounter(t, chain)
+	}
+
+	events := make([]string, 0)
+	pages := 0
+	var cursor []byte
+	for {
+		params := dict.Dict{
+			blocklog.ParamContractHname: inccounter.Contract.Hname().Bytes(),
+			coreutil.ParamLimit:         codec.EncodeUint32(2),
+		}
+		if cursor != nil {
+			params.Set(coreutil.ParamCursor, cursor)
+		}
+		res, err := chain.CallView(blocklog.Contract.Name, blocklog.FuncGetEventsForContract.Name, params)
+		require.NoError(t, err)
+		page, err := EventsViewResultToStringArray(res)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 2)
+		events = append(events, page...)
+		pages++
+		cursor = res.MustGet(coreutil.ResultNextCursor)
+		require.EqualValues(t, cursor != nil, res.MustHas(coreutil.ResultTruncated))
+		if cursor == nil {
+			break
+		}
+	}
+	require.EqualValues(t, 3, pages)
+	require.Len(t, events, 5)
+	for i, event := range events {
+		require.Contains(t, event, fmt.Sprintf("counter = %d", i))
+	}
+
+	all, err := chain.GetEventsForContract(inccounter.Contract.Name)
+	require.NoError(t, err)
+	require.EqualValues(t, events, all)
+}
+
 /// end region ----------------------------------------------------------------
@@ -0,0 +1,129 @@
+package testcore
+
+import (
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/solo"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/iotaledger/wasp/packages/vm/core/accounts/commonaccount"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMintNFT(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	wallet, walletAddr := env.NewKeyPairWithFunds()
+	walletAgentID := iscp.NewAgentID(walletAddr, 0)
+
+	nftColor, err := chain.MintNFT(wallet, []byte("picture #1"))
+	require.NoError(t, err)
+	chain.AssertAccountBalance(walletAgentID, nftColor, 1)
+	env.AssertAddressIotas(walletAddr, solo.Saldo-1)
+
+	nft, owner := chain.GetNFTData(nftColor)
+	require.EqualValues(t, "picture #1", string(nft.Metadata))
+	require.True(t, walletAgentID.Equals(nft.Issuer))
+	require.True(t, walletAgentID.Equals(owner))
+	require.EqualValues(t, []colored.Color{nftColor}, chain.GetAccountNFTs(walletAgentID))
+	chain.CheckAccountLedger()
+}
+
+func TestMintNFTToCommonAccount(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	wallet, walletAddr := env.NewKeyPairWithFunds()
+	commonAccount := commonaccount.Get(chain.ChainID)
+	// core contract accounts are mapped to the common account
+	req := solo.NewCallParams(accounts.Contract.Name, accounts.FuncMintNFT.Name,
+		accounts.ParamNFTMetadata, []byte("shared"),
+		accounts.ParamAgentID, iscp.NewAgentID(chain.ChainID.AsAddress(), accounts.Contract.Hname()),
+	).WithIotas(1).WithMint(chain.ChainID.AsAddress(), 1)
+	res, err := chain.PostRequestSync(req, wallet)
+	require.NoError(t, err)
+	nftColor, err := codec.DecodeColor(res.MustGet(accounts.ParamNFTColor))
+	require.NoError(t, err)
+	chain.AssertAccountBalance(commonAccount, nftColor, 1)
+
+	nft, owner := chain.GetNFTData(nftColor)
+	require.True(t, iscp.NewAgentID(walletAddr, 0).Equals(nft.Issuer))
+	require.True(t, commonAccount.Equals(owner))
+	require.EqualValues(t, []colored.Color{nftColor}, chain.GetAccountNFTs(commonAccount))
+	chain.CheckAccountLedger()
+}
+
+func TestMintNFTWithoutMinting(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	wallet, _ := env.NewKeyPairWithFunds()
+	req := solo.NewCallParams(accounts.Contract.Name, accounts.FuncMintNFT.Name,
+		accounts.ParamNFTMetadata, []byte("fake"),
+	).WithIotas(1)
+	_, err := chain.PostRequestSync(req, wallet)
+	require.Error(t, err)
+}
+
+func TestTransferNFT(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	wallet1, walletAddr1 := env.NewKeyPairWithFunds()
+	agentID1 := iscp.NewAgentID(walletAddr1, 0)
+	wallet2, walletAddr2 := env.NewKeyPairWithFunds()
+	agentID2 := iscp.NewAgentID(walletAddr2, 0)
+
+	nftColor, err := chain.MintNFT(wallet1, []byte("ticket"))
+	require.NoError(t, err)
+
+	// only the owner can transfer the NFT
+	err = chain.TransferNFT(wallet2, nftColor, agentID2)
+	require.Error(t, err)
+
+	err = chain.TransferNFT(wallet1, nftColor, agentID2)
+	require.NoError(t, err)
+	chain.AssertAccountBalance(agentID1, nftColor, 0)
+	chain.AssertAccountBalance(agentID2, nftColor, 1)
+	require.Len(t, chain.GetAccountNFTs(agentID1), 0)
+	require.EqualValues(t, []colored.Color{nftColor}, chain.GetAccountNFTs(agentID2))
+
+	nft, owner := chain.GetNFTData(nftColor)
+	require.True(t, agentID1.Equals(nft.Issuer))
+	require.True(t, agentID2.Equals(owner))
+	chain.CheckAccountLedger()
+}
+
+func TestWithdrawNFT(t *testing.T) {
+	env := solo.New(t, false, false)
+	chain := env.NewChain(nil, "chain1")
+
+	wallet, walletAddr := env.NewKeyPairWithFunds()
+	agentID := iscp.NewAgentID(walletAddr, 0)
+
+	nftColor, err := chain.MintNFT(wallet, []byte("ticket"))
+	require.NoError(t, err)
+
+	err = chain.WithdrawNFT(wallet, nftColor)
+	require.NoError(t, err)
+	chain.AssertAccountBalance(agentID, nftColor, 0)
+	env.AssertAddressBalance(walletAddr, nftColor, 1)
+	require.Len(t, chain.GetAccountNFTs(agentID), 0)
+
+	// the metadata survives the NFT leaving the chain
+	nft, owner := chain.GetNFTData(nftColor)
+	require.EqualValues(t, "ticket", string(nft.Metadata))
+	require.Nil(t, owner)
+
+	// depositing the NFT back to the chain restores ownership
+	req := solo.NewCallParams(accounts.Contract.Name, accounts.FuncDeposit.Name).
+		WithTransfer(nftColor, 1)
+	_, err = chain.PostRequestSync(req, wallet)
+	require.NoError(t, err)
+	_, owner = chain.GetNFTData(nftColor)
+	require.True(t, agentID.Equals(owner))
+	chain.CheckAccountLedger()
+}
@@ -3,7 +3,9 @@ package processors
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/vm/core"
@@ -11,21 +13,47 @@ import (
 	"github.com/iotaledger/wasp/packages/vm/vmtypes"
 )
 
-// Cache stores all initialized VMProcessor instances used by a single chain
+// Cache stores all initialized VMProcessor instances used by a single chain.
+// Core and native processors are always kept. Processors created from binary
+// code are bounded by the cache size of the Config: the least recently used
+// ones are evicted, and created again when needed.
 type Cache struct {
 	mutex      *sync.Mutex
 	Config     *Config
 	processors map[hashing.HashValue]iscp.VMProcessor
+	binary     *lru.Cache
+}
+
+// CacheStats counts the lookups of processors created from binary code, in
+// all caches
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+var cacheStats CacheStats
+
+// GetCacheStats returns a snapshot of the lookup counters
+func GetCacheStats() CacheStats {
+	return CacheStats{
+		Hits:   atomic.LoadUint64(&cacheStats.Hits),
+		Misses: atomic.LoadUint64(&cacheStats.Misses),
+	}
 }
 
 func MustNew(config *Config) *Cache {
+	binary, err := lru.New(config.CacheSize())
+	if err != nil {
+		panic(err)
+	}
 	ret := &Cache{
 		mutex:      &sync.Mutex{},
 		Config:     config,
 		processors: make(map[hashing.HashValue]iscp.VMProcessor),
+		binary:     binary,
 	}
 	// default builtin processor has root contract hash
-	err := ret.NewProcessor(root.Contract.ProgramHash, nil, vmtypes.Core)
+	err = ret.NewProcessor(root.Contract.ProgramHash, nil, vmtypes.Core)
 	if err != nil {
 		panic(err)
 	}
@@ -61,20 +89,32 @@ func (cps *Cache) newProcessor(programHash hashing.HashValue, programCode []byte
 		}
 
 	default:
-		proc, err = cps.Config.NewProcessorFromBinary(vmtype, programCode)
+		proc, err = cps.Config.NewProcessorFromBinary(vmtype, programHash, programCode)
 		if err != nil {
 			return err
 		}
+		cps.binary.Add(programHash, proc)
+		return nil
 	}
 	cps.processors[programHash] = proc
 	return nil
 }
 
 func (cps *Cache) ExistsProcessor(h hashing.HashValue) bool {
-	_, ok := cps.processors[h]
+	_, ok := cps.get(h)
 	return ok
 }
 
+func (cps *Cache) get(h hashing.HashValue) (iscp.VMProcessor, bool) {
+	if proc, ok := cps.processors[h]; ok {
+		return proc, true
+	}
+	if proc, ok := cps.binary.Get(h); ok {
+		return proc.(iscp.VMProcessor), true
+	}
+	return nil, false
+}
+
 func (cps *Cache) GetOrCreateProcessor(rec *root.ContractRecord, getBinary func(hashing.HashValue) (string, []byte, error)) (iscp.VMProcessor, error) {
 	return cps.GetOrCreateProcessorByProgramHash(rec.ProgramHash, getBinary)
 }
@@ -86,14 +126,21 @@ func (cps *Cache) GetOrCreateProcessorByProgramHash(progHash hashing.HashValue,
 	if proc, ok := cps.processors[progHash]; ok {
 		return proc, nil
 	}
+	if proc, ok := cps.binary.Get(progHash); ok {
+		atomic.AddUint64(&cacheStats.Hits, 1)
+		return proc.(iscp.VMProcessor), nil
+	}
 	vmtype, binary, err := getBinary(progHash)
 	if err != nil {
 		return nil, fmt.Errorf("internal error: can't get the binary for the program: %v", err)
 	}
+	if vmtype != vmtypes.Core && vmtype != vmtypes.Native {
+		atomic.AddUint64(&cacheStats.Misses, 1)
+	}
 	if err := cps.newProcessor(progHash, binary, vmtype); err != nil {
 		return nil, err
 	}
-	if proc, ok := cps.processors[progHash]; ok {
+	if proc, ok := cps.get(progHash); ok {
 		return proc, nil
 	}
 	return nil, fmt.Errorf("internal error: can't get the deployed processor")
@@ -104,4 +151,5 @@ func (cps *Cache) RemoveProcessor(h hashing.HashValue) {
 	cps.mutex.Lock()
 	defer cps.mutex.Unlock()
 	delete(cps.processors, h)
+	cps.binary.Remove(h)
 }
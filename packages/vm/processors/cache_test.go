@@ -30,3 +30,50 @@ func TestBasic(t *testing.T) {
 	assert.True(t, exists)
 	assert.Same(t, ep.(*coreutil.EntryPointHandler).Info, &root.FuncDeployContract)
 }
+
+type testProcessor struct {
+	iscp.VMProcessor
+	code string
+}
+
+func TestBinaryProcessorEviction(t *testing.T) {
+	config := NewConfig()
+	config.SetCacheSize(2)
+	created := 0
+	err := config.RegisterVMType("test", func(programHash hashing.HashValue, binaryCode []byte) (iscp.VMProcessor, error) {
+		created++
+		return &testProcessor{code: string(binaryCode)}, nil
+	})
+	assert.NoError(t, err)
+	p := MustNew(config)
+
+	getBinary := func(h hashing.HashValue) (string, []byte, error) {
+		return "test", h[:1], nil
+	}
+	get := func(i byte) *testProcessor {
+		proc, err := p.GetOrCreateProcessorByProgramHash(hashing.HashValue{i}, getBinary)
+		assert.NoError(t, err)
+		return proc.(*testProcessor)
+	}
+
+	stats := GetCacheStats()
+	proc1 := get(1)
+	assert.Equal(t, string([]byte{1}), proc1.code)
+	assert.Same(t, proc1, get(1))
+	get(2)
+	assert.Equal(t, 2, created)
+
+	// processor 3 evicts processor 2, the least recently used one
+	assert.Same(t, proc1, get(1))
+	get(3)
+	assert.Equal(t, 3, created)
+	assert.False(t, p.ExistsProcessor(hashing.HashValue{2}))
+	get(2)
+	assert.Equal(t, 4, created)
+
+	// the root processor is never evicted
+	assert.True(t, p.ExistsProcessor(root.Contract.ProgramHash))
+
+	assert.EqualValues(t, 2, GetCacheStats().Hits-stats.Hits)
+	assert.EqualValues(t, 4, GetCacheStats().Misses-stats.Misses)
+}
@@ -10,7 +10,11 @@ import (
 	"github.com/iotaledger/wasp/packages/vm/vmtypes"
 )
 
-type VMConstructor func(binaryCode []byte) (iscp.VMProcessor, error)
+// DefaultCacheSize is the default maximum number of processors created from
+// binary code that each Cache keeps in memory
+const DefaultCacheSize = 32
+
+type VMConstructor func(programHash hashing.HashValue, binaryCode []byte) (iscp.VMProcessor, error)
 
 type Config struct {
 	// vmConstructors is the collection of registered non-native VM types
@@ -18,12 +22,17 @@ type Config struct {
 
 	// nativeContracts is the collection of registered native contracts
 	nativeContracts map[hashing.HashValue]iscp.VMProcessor
+
+	// cacheSize is the maximum number of processors created from binary code
+	// that each Cache keeps in memory
+	cacheSize int
 }
 
 func NewConfig(nativeContracts ...*coreutil.ContractProcessor) *Config {
 	p := &Config{
 		vmConstructors:  make(map[string]VMConstructor),
 		nativeContracts: make(map[hashing.HashValue]iscp.VMProcessor),
+		cacheSize:       DefaultCacheSize,
 	}
 	for _, c := range nativeContracts {
 		p.RegisterNativeContract(c)
@@ -31,6 +40,16 @@ func NewConfig(nativeContracts ...*coreutil.ContractProcessor) *Config {
 	return p
 }
 
+// SetCacheSize sets the maximum number of processors created from binary code
+// that each Cache keeps in memory. It only affects the caches created later.
+func (p *Config) SetCacheSize(size int) {
+	p.cacheSize = size
+}
+
+func (p *Config) CacheSize() int {
+	return p.cacheSize
+}
+
 // RegisterVMType registers new VM type by providing a constructor function to construct
 // an instance of the processor.
 // The constructor is a closure which also may encompass configuration params for the VM
@@ -44,12 +63,12 @@ func (p *Config) RegisterVMType(vmtype string, constructor VMConstructor) error
 }
 
 // NewProcessorFromBinary creates an instance of the processor by its VM type and the binary code
-func (p *Config) NewProcessorFromBinary(vmtype string, binaryCode []byte) (iscp.VMProcessor, error) {
+func (p *Config) NewProcessorFromBinary(vmtype string, programHash hashing.HashValue, binaryCode []byte) (iscp.VMProcessor, error) {
 	constructor, ok := p.vmConstructors[vmtype]
 	if !ok {
 		return nil, fmt.Errorf("unknown VM type '%s'", vmtype)
 	}
-	return constructor(binaryCode)
+	return constructor(programHash, binaryCode)
 }
 
 // GetNativeProcessorType returns the type of the native processor
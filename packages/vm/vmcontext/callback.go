@@ -0,0 +1,122 @@
+package vmcontext
+
+import (
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+)
+
+// keeps the outcome within the maximum parameter size
+const maxCallbackErrorLen = 256
+
+// prepareCallback checks whether the request asks for its outcome to be sent back
+// to the sender contract, see iscp.ParamCallback. Sending back the outcome takes one
+// iota of the request tokens and one output of the block, so both are reserved
+// before the request is run. The reserved iota is not part of the transfer to the
+// target contract, and it goes back with the outcome. Without iotas left after fees
+// there is nothing to carry the outcome, so none is sent.
+func (vmctx *VMContext) prepareCallback() {
+	vmctx.callback = 0
+	vmctx.callbackDeadline = 0
+	if vmctx.req.IsOffLedger() {
+		return
+	}
+	params, _ := vmctx.req.Params()
+	callback, err := codec.DecodeHname(params.MustGet(iscp.ParamCallback), 0)
+	if err != nil || callback == 0 {
+		return
+	}
+	if vmctx.remainingAfterFees.Get(colored.IOTA) == 0 {
+		vmctx.log.Warnf("prepareCallback: no iotas left to send back the outcome of %s", vmctx.req.ID())
+		return
+	}
+	vmctx.remainingAfterFees.SubNoOverflow(colored.IOTA, 1)
+	vmctx.callback = callback
+	vmctx.callbackDeadline, err = codec.DecodeInt64(params.MustGet(iscp.ParamCallbackDeadline), 0)
+	if err != nil {
+		vmctx.log.Warnf("prepareCallback: invalid deadline in %s: %v", vmctx.req.ID(), err)
+	}
+	vmctx.requestOutputCount++
+	vmctx.blockOutputCount++
+}
+
+// mustCheckCallbackRequest fails a request that asks for its outcome when its deadline
+// has passed, because the sender already gave up on it, or when its target contract
+// does not exist. Otherwise the default contract would keep the tokens and the sender
+// would be told that the call succeeded.
+func (vmctx *VMContext) mustCheckCallbackRequest() {
+	if vmctx.callback == 0 {
+		return
+	}
+	if vmctx.callbackDeadline != 0 && vmctx.Timestamp() >= vmctx.callbackDeadline {
+		panic(iscp.NewVMError(iscp.VMErrorCodeInvalidRequest, "deadline passed"))
+	}
+	targetContract, _ := vmctx.req.Target()
+	if vmctx.contractRecord.Hname() != targetContract {
+		panic(iscp.NewVMError(iscp.VMErrorCodeInvalidRequest, "contract not found", targetContract.String()))
+	}
+}
+
+// mustSendCallback posts the outcome of the request to the callback entry point
+// of the sender contract, together with the refund when the request failed.
+// Returns false when the request did not ask for its outcome.
+func (vmctx *VMContext) mustSendCallback(refund colored.Balances) bool {
+	if vmctx.callback == 0 || vmctx.exceededBlockOutputLimit {
+		return false
+	}
+	tokens := colored.NewBalancesForIotas(1)
+	tokens.AddAll(refund)
+
+	reqParams, _ := vmctx.req.Params()
+	outcome := dict.New()
+	if callID := reqParams.MustGet(iscp.ParamCallbackID); callID != nil {
+		outcome.Set(iscp.ParamCallbackID, callID)
+	}
+	outcome.Set(iscp.ParamCallbackRequestID, codec.EncodeRequestID(vmctx.req.ID()))
+	outcome.Set(iscp.ParamCallbackSuccess, codec.EncodeBool(vmctx.lastError == nil))
+	if vmctx.lastError != nil {
+		errMsg := vmctx.lastError.Error()
+		if len(errMsg) > maxCallbackErrorLen {
+			errMsg = errMsg[:maxCallbackErrorLen]
+		}
+		outcome.Set(iscp.ParamCallbackError, codec.EncodeString(errMsg))
+	}
+
+	params := dict.New()
+	if vmctx.lastError == nil && vmctx.lastResult != nil {
+		vmctx.lastResult.MustIterate("", func(key kv.Key, value []byte) bool {
+			params.Set(key, value)
+			return true
+		})
+	}
+	outcome.MustIterate("", func(key kv.Key, value []byte) bool {
+		params.Set(key, value)
+		return true
+	})
+	args, opt := requestargs.NewOptimizedRequestArgs(params, maxParamSize)
+	if len(opt) > 0 {
+		// the results do not fit, report the outcome without them
+		vmctx.log.Warnf("mustSendCallback: results of %s too big to send back", vmctx.req.ID())
+		args, _ = requestargs.NewOptimizedRequestArgs(outcome, maxParamSize)
+	}
+
+	// the outcome comes from the contract that the request targeted, also when
+	// that contract does not exist
+	sender := vmctx.req.SenderAccount()
+	targetContract, _ := vmctx.req.Target()
+	metadata := request.NewMetadata().
+		WithRequestNonce(vmctx.blockOutputCount).
+		WithSender(targetContract).
+		WithTarget(sender.Hname()).
+		WithEntryPoint(vmctx.callback).
+		WithArgs(args)
+	err := vmctx.txBuilder.AddExtendedOutputSpend(sender.Address(), metadata.Bytes(), colored.ToL1Map(tokens), nil)
+	if err != nil {
+		vmctx.log.Errorf("mustSendCallback: %v", err)
+	}
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/accounts"
 	"github.com/iotaledger/wasp/packages/vm/core/blob"
 	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
@@ -121,18 +122,19 @@ func (vmctx *VMContext) eventLookupKey() blocklog.EventLookupKey {
 	return blocklog.NewEventLookupKey(vmctx.virtualState.BlockIndex(), vmctx.requestIndex, vmctx.requestEventIndex)
 }
 
-func (vmctx *VMContext) mustLogRequestToBlockLog(errProvided error) {
+func (vmctx *VMContext) mustLogRequestToBlockLog(results dict.Dict, errProvided error) {
 	vmctx.pushCallContext(blocklog.Contract.Hname(), nil, nil)
 	defer vmctx.popCallContext()
 
-	errStr := ""
+	receipt := &blocklog.RequestReceipt{
+		Request: vmctx.req,
+	}
 	if errProvided != nil {
-		errStr = errProvided.Error()
+		receipt.Error = iscp.VMErrorFromError(errProvided, vmctx.contractRecord.Hname())
+	} else {
+		receipt.WithResults(results)
 	}
-	err := blocklog.SaveRequestLogRecord(vmctx.State(), &blocklog.RequestReceipt{
-		Request: vmctx.req,
-		Error:   errStr,
-	}, vmctx.requestLookupKey())
+	err := blocklog.SaveRequestLogRecord(vmctx.State(), receipt, vmctx.requestLookupKey())
 	if err != nil {
 		vmctx.Panicf("logRequestToBlockLog: %v", err)
 	}
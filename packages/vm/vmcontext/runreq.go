@@ -15,6 +15,7 @@ import (
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/state"
 	"github.com/iotaledger/wasp/packages/vm/core/accounts"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
 	"github.com/iotaledger/wasp/packages/vm/core/root"
 	"golang.org/x/xerrors"
 )
@@ -35,9 +36,11 @@ func (vmctx *VMContext) RunTheRequest(req iscp.Request, requestIndex uint16) {
 	vmctx.mustSetUpRequestContext(req, requestIndex)
 
 	// guard against replaying off-ledger requests here to prevent replaying fee deduction
-	// also verifies that account for off-ledger request exists
+	// also verifies that account for off-ledger request exists and that the sender is allowed by the ACLs
 	if !vmctx.validateRequest() {
 		vmctx.log.Debugw("vmctx.RunTheRequest: request failed validation", "id", vmctx.req.ID())
+		// tokens of rejected on-ledger requests are sent back
+		vmctx.mustSendBack(vmctx.remainingAfterFees)
 		return
 	}
 
@@ -50,6 +53,7 @@ func (vmctx *VMContext) RunTheRequest(req iscp.Request, requestIndex uint16) {
 			return
 		}
 	}
+	vmctx.prepareCallback()
 
 	// snapshot state baseline for rollback in case of panic
 	snapshotTxBuilder := vmctx.txBuilder.Clone()
@@ -75,10 +79,16 @@ func (vmctx *VMContext) RunTheRequest(req iscp.Request, requestIndex uint16) {
 				}
 			}
 			vmctx.lastResult = nil
-			vmctx.lastError = xerrors.Errorf("panic in VM: %v", r)
+			if vmErr, ok := r.(*iscp.VMError); ok {
+				// typed errors raised by the contract are kept as they are
+				vmctx.lastError = vmErr
+			} else {
+				vmctx.lastError = xerrors.Errorf("panic in VM: %v", r)
+			}
 			vmctx.Debugf("%v", vmctx.lastError)
 			vmctx.Debugf(string(debug.Stack()))
 		}()
+		vmctx.mustCheckCallbackRequest()
 		vmctx.mustCallFromRequest()
 	}()
 
@@ -97,8 +107,13 @@ func (vmctx *VMContext) RunTheRequest(req iscp.Request, requestIndex uint16) {
 		vmctx.txBuilder = snapshotTxBuilder
 		vmctx.currentStateUpdate = state.NewStateUpdate()
 
-		vmctx.mustSendBack(vmctx.remainingAfterFees)
+		// the refund goes along with the outcome when the sender asked for it
+		if !vmctx.mustSendCallback(vmctx.remainingAfterFees) {
+			vmctx.mustSendBack(vmctx.remainingAfterFees)
+		}
+		return
 	}
+	vmctx.mustSendCallback(nil)
 }
 
 // mustSetUpRequestContext sets up VMContext for request
@@ -111,6 +126,7 @@ func (vmctx *VMContext) mustSetUpRequestContext(req iscp.Request, requestIndex u
 	vmctx.requestEventIndex = 0
 	vmctx.requestOutputCount = 0
 	vmctx.exceededBlockOutputLimit = false
+	vmctx.lastResult = nil
 
 	if !req.IsOffLedger() {
 		vmctx.txBuilder.AddConsumable(vmctx.req.(*request.OnLedger).Output())
@@ -189,9 +205,12 @@ func (vmctx *VMContext) adjustOffLedgerTransfer() colored.Balances {
 }
 
 func (vmctx *VMContext) validateRequest() bool {
+	if !vmctx.checkACL() {
+		return false
+	}
 	req, ok := vmctx.req.(*request.OffLedger)
 	if !ok {
-		// on-ledger request is always valid
+		// on-ledger request allowed by the ACLs is always valid
 		return true
 	}
 
@@ -200,7 +219,8 @@ func (vmctx *VMContext) validateRequest() bool {
 
 	// off-ledger account must exist, i.e. it should have non zero balance on the chain
 	if _, exists := accounts.GetAccountBalances(vmctx.State(), req.SenderAccount()); !exists {
-		vmctx.lastError = fmt.Errorf("validateRequest: unverified account %s for %s", req.SenderAccount(), req.ID().String())
+		vmctx.lastError = iscp.NewVMError(iscp.VMErrorCodeInvalidRequest,
+			fmt.Sprintf("validateRequest: unverified account %s for %s", req.SenderAccount(), req.ID().String()))
 		return false
 	}
 
@@ -218,6 +238,22 @@ func (vmctx *VMContext) validateRequest() bool {
 	return req.Nonce() > maxAssumed-OffLedgerNonceStrictOrderTolerance
 }
 
+// checkACL checks the access control lists of the chain for the sender of the request
+func (vmctx *VMContext) checkACL() bool {
+	if vmctx.isInitChainRequest() {
+		return true
+	}
+	vmctx.pushCallContext(governance.Contract.Hname(), nil, nil)
+	defer vmctx.popCallContext()
+
+	targetContract, _ := vmctx.req.Target()
+	if err := governance.CheckACL(vmctx.State(), vmctx.req.SenderAccount(), targetContract); err != nil {
+		vmctx.lastError = err
+		return false
+	}
+	return true
+}
+
 // mustHandleFees handles node fees. If not enough, takes as much as it can, the rest sends back
 // Return false if not enough fees
 func (vmctx *VMContext) mustHandleFees() bool {
@@ -238,8 +274,9 @@ func (vmctx *VMContext) mustHandleFees() bool {
 	// not enough fees available
 	vmctx.mustSendBack(vmctx.remainingAfterFees)
 	vmctx.remainingAfterFees = nil
-	vmctx.lastError = fmt.Errorf("mustHandleFees: not enough fees for request %s. Remaining tokens were sent back to %s",
-		vmctx.req.ID(), vmctx.req.SenderAddress().Base58())
+	vmctx.lastError = iscp.NewVMError(iscp.VMErrorCodeNotEnoughFees,
+		fmt.Sprintf("mustHandleFees: not enough fees for request %s. Remaining tokens were sent back to %s",
+			vmctx.req.ID(), vmctx.req.SenderAddress().Base58()))
 	return false
 }
 
@@ -324,7 +361,7 @@ func (vmctx *VMContext) mustFinalizeRequestCall() {
 	if vmctx.exceededBlockOutputLimit {
 		return
 	}
-	vmctx.mustLogRequestToBlockLog(vmctx.lastError) // panic not caught
+	vmctx.mustLogRequestToBlockLog(vmctx.lastResult, vmctx.lastError) // panic not caught
 	vmctx.lastTotalAssets = vmctx.totalAssets()
 
 	vmctx.virtualState.ApplyStateUpdates(vmctx.currentStateUpdate)
@@ -60,10 +60,12 @@ type VMContext struct {
 	entropy                  hashing.HashValue // mutates with each request
 	contractRecord           *root.ContractRecord
 	lastError                error     // mutated
-	lastResult               dict.Dict // mutated. Used only by 'solo'
+	lastResult               dict.Dict // mutated
 	lastTotalAssets          colored.Balances
 	callStack                []*callContext
 	exceededBlockOutputLimit bool
+	callback                 iscp.Hname // entry point that receives the outcome of the request
+	callbackDeadline         int64      // timestamp from which the request is not run, see iscp.ParamCallbackDeadline
 }
 
 type callContext struct {
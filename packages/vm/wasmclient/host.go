@@ -81,7 +81,7 @@ func (w *WasmVMHost) Exists(objID, keyID, typeID int32) bool {
 }
 
 func (w *WasmVMHost) GetBytes(objID, keyID, typeID int32) []byte {
-	size := int32(wasmlib.TypeSizes[typeID])
+	size := int32(wasmlib.TypeSize(typeID))
 	if size == 0 {
 		// variable-sized type, first query expected length of bytes array
 		// (pass zero-length buffer)
@@ -17,7 +17,7 @@ const (
 	OBJTYPE_ARRAY    int32 = 0x20
 	OBJTYPE_ARRAY16  int32 = 0x30
 	OBJTYPE_CALL     int32 = 0x40
-	OBJTYPE_TYPEMASK int32 = 0x0f
+	OBJTYPE_TYPEMASK int32 = 0x8f
 
 	OBJTYPE_ADDRESS    int32 = 1
 	OBJTYPE_AGENT_ID   int32 = 2
@@ -32,6 +32,16 @@ const (
 	OBJTYPE_MAP        int32 = 11
 	OBJTYPE_REQUEST_ID int32 = 12
 	OBJTYPE_STRING     int32 = 13
+	OBJTYPE_BOOL       int32 = 14
+	OBJTYPE_INT8       int32 = 15
+
+	// extended types, which have the 0x80 bit set because the low 4 bits
+	// are all used and 0x10-0x70 are taken by the array and call flags
+	OBJTYPE_UINT8   int32 = 0x80
+	OBJTYPE_UINT16  int32 = 0x81
+	OBJTYPE_UINT32  int32 = 0x82
+	OBJTYPE_UINT64  int32 = 0x83
+	OBJTYPE_BIG_INT int32 = 0x84
 
 	OBJID_NULL    int32 = 0
 	OBJID_ROOT    int32 = 1
@@ -40,10 +50,22 @@ const (
 	OBJID_RESULTS int32 = 4
 )
 
+// TypeIndex maps a type id without array flags to 0-31, with the extended
+// types following the basic ones, so that it can index a table of types
+func TypeIndex(typeID int32) int32 {
+	return (typeID & 0x0f) | ((typeID & 0x80) >> 3)
+}
+
 // flag to indicate that this key id originally comes from a bytes key
 // this allows us to display better readable tracing information
 const KeyFromBytes int32 = 0x4000
 
+// HostArray can be implemented by a HostObject that is an array,
+// in which case the key ids passed to it are actually indexes
+type HostArray interface {
+	IsArray() bool
+}
+
 type HostObject interface {
 	CallFunc(keyID int32, params []byte) []byte
 	Exists(keyID, typeID int32) bool
@@ -61,6 +83,7 @@ type KvStoreHost struct {
 	keyIDToKey [][]byte
 	keyToKeyID map[string]int32
 	objIDToObj []HostObject
+	traceDepth int
 }
 
 func (h *KvStoreHost) Init(scKeys *KvStoreHost) {
@@ -71,11 +94,21 @@ func (h *KvStoreHost) Init(scKeys *KvStoreHost) {
 }
 
 func (h *KvStoreHost) CallFunc(objID, keyID int32, params []byte) []byte {
-	return h.FindObject(objID).CallFunc(keyID, params)
+	obj := h.FindObject(objID)
+	result := obj.CallFunc(keyID, params)
+	if tracer := GetHostTracer(); tracer != nil {
+		tracer.value(h.traceDepth, TraceFunc, objID, h.traceKey(obj, keyID), OBJTYPE_BYTES, result)
+	}
+	return result
 }
 
 func (h *KvStoreHost) Exists(objID, keyID, typeID int32) bool {
-	return h.FindObject(objID).Exists(keyID, typeID)
+	obj := h.FindObject(objID)
+	exists := obj.Exists(keyID, typeID)
+	if tracer := GetHostTracer(); tracer != nil {
+		tracer.record(h.traceDepth, TraceExists, objID, h.traceKey(obj, keyID), typeID, fmt.Sprint(exists))
+	}
+	return exists
 }
 
 func (h *KvStoreHost) FindObject(objID int32) HostObject {
@@ -89,9 +122,15 @@ func (h *KvStoreHost) GetBytes(objID, keyID, typeID int32) []byte {
 	obj := h.FindObject(objID)
 	if !obj.Exists(keyID, typeID) {
 		h.Tracef("GetBytes o%d k%d missing key", objID, keyID)
+		if tracer := GetHostTracer(); tracer != nil {
+			tracer.value(h.traceDepth, TraceGet, objID, h.traceKey(obj, keyID), typeID, nil)
+		}
 		return nil
 	}
 	bytes := obj.GetBytes(keyID, typeID)
+	if tracer := GetHostTracer(); tracer != nil {
+		tracer.value(h.traceDepth, TraceGet, objID, h.traceKey(obj, keyID), typeID, bytes)
+	}
 	switch typeID {
 	case OBJTYPE_INT16:
 		val16, err := codec.DecodeInt16(bytes, 0)
@@ -111,6 +150,8 @@ func (h *KvStoreHost) GetBytes(objID, keyID, typeID int32) []byte {
 			panic("GetBytes: invalid int64")
 		}
 		h.Tracef("GetBytes o%d k%d = %dl", objID, keyID, val64)
+	case OBJTYPE_BOOL, OBJTYPE_INT8, OBJTYPE_UINT8, OBJTYPE_UINT16, OBJTYPE_UINT32, OBJTYPE_UINT64, OBJTYPE_BIG_INT:
+		h.Tracef("GetBytes o%d k%d = %s", objID, keyID, traceValue(typeID, bytes))
 	case OBJTYPE_STRING:
 		h.Tracef("GetBytes o%d k%d = '%s'", objID, keyID, string(bytes))
 	default:
@@ -208,13 +249,22 @@ func (h *KvStoreHost) GetKeyStringFromID(keyID int32) string {
 
 func (h *KvStoreHost) GetObjectID(objID, keyID, typeID int32) int32 {
 	h.TraceAllf("GetObjectID(o%d,k%d,t%d)", objID, keyID, typeID)
-	subID := h.FindObject(objID).GetObjectID(keyID, typeID)
+	obj := h.FindObject(objID)
+	subID := obj.GetObjectID(keyID, typeID)
 	h.Tracef("GetObjectID o%d k%d t%d = o%d", objID, keyID, typeID, subID)
+	if tracer := GetHostTracer(); tracer != nil {
+		tracer.record(h.traceDepth, TraceObject, objID, h.traceKey(obj, keyID), typeID, fmt.Sprintf("o%d", subID))
+	}
 	return subID
 }
 
 func (h *KvStoreHost) SetBytes(objID, keyID, typeID int32, bytes []byte) {
-	h.FindObject(objID).SetBytes(keyID, typeID, bytes)
+	obj := h.FindObject(objID)
+	if tracer := GetHostTracer(); tracer != nil && keyID != KeyTrace {
+		// record before setting, because setting can trigger a panic or a nested call
+		tracer.value(h.traceDepth, TraceSet, objID, h.traceKey(obj, keyID), typeID, bytes)
+	}
+	obj.SetBytes(keyID, typeID, bytes)
 	switch typeID {
 	case OBJTYPE_INT16:
 		val16, err := codec.DecodeInt16(bytes, 0)
@@ -234,6 +284,8 @@ func (h *KvStoreHost) SetBytes(objID, keyID, typeID int32, bytes []byte) {
 			panic("SetBytes: invalid int64")
 		}
 		h.Tracef("SetBytes o%d k%d v=%dl", objID, keyID, val64)
+	case OBJTYPE_BOOL, OBJTYPE_INT8, OBJTYPE_UINT8, OBJTYPE_UINT16, OBJTYPE_UINT32, OBJTYPE_UINT64, OBJTYPE_BIG_INT:
+		h.Tracef("SetBytes o%d k%d v=%s", objID, keyID, traceValue(typeID, bytes))
 	case OBJTYPE_STRING:
 		if keyID != KeyTrace {
 			h.Tracef("SetBytes o%d k%d v='%s'", objID, keyID, string(bytes))
@@ -250,12 +302,41 @@ func (h *KvStoreHost) Tracef(format string, a ...interface{}) {
 	}
 }
 
+// traceKey returns a readable representation of keyID that does not depend on
+// the order in which key ids were assigned, so that traces can be compared
+func (h *KvStoreHost) traceKey(obj HostObject, keyID int32) string {
+	if keyID < 0 {
+		if int(-keyID) < len(predefinedKeys) {
+			return string(predefinedKeys[-keyID])
+		}
+		return fmt.Sprintf("k%d", keyID)
+	}
+	array, ok := obj.(HostArray)
+	if ok && array.IsArray() {
+		return fmt.Sprintf("[%d]", keyID)
+	}
+	key := h.getKeyFromID(keyID)
+	if (keyID & KeyFromBytes) != 0 {
+		return base58.Encode(key)
+	}
+	return string(key)
+}
+
 func (h *KvStoreHost) TraceAllf(format string, a ...interface{}) {
 	if HostTracingAll {
 		h.Tracef(format, a...)
 	}
 }
 
+// TraceDepth returns the depth at which the host tracer records the host calls
+func (h *KvStoreHost) TraceDepth() int {
+	return h.traceDepth
+}
+
+func (h *KvStoreHost) SetTraceDepth(depth int) {
+	h.traceDepth = depth
+}
+
 func (h *KvStoreHost) TrackObject(obj HostObject) int32 {
 	objID := int32(len(h.objIDToObj))
 	h.objIDToObj = append(h.objIDToObj, obj)
@@ -0,0 +1,28 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// the type ids are part of the interface with the deployed Wasm binaries
+func TestObjTypeIDsAreStable(t *testing.T) {
+	require.EqualValues(t, 0x20, OBJTYPE_ARRAY)
+	require.EqualValues(t, 0x30, OBJTYPE_ARRAY16)
+	require.EqualValues(t, 0x40, OBJTYPE_CALL)
+	require.EqualValues(t, OBJTYPE_STRING, (OBJTYPE_ARRAY16|OBJTYPE_STRING)&OBJTYPE_TYPEMASK)
+
+	// the extended types do not collide with the basic types or the flags
+	for _, typeID := range []int32{OBJTYPE_UINT8, OBJTYPE_UINT16, OBJTYPE_UINT32, OBJTYPE_UINT64, OBJTYPE_BIG_INT} {
+		require.Equal(t, typeID, (typeID|OBJTYPE_ARRAY16)&OBJTYPE_TYPEMASK)
+		require.Equal(t, OBJTYPE_ARRAY16, (typeID|OBJTYPE_ARRAY16)&^OBJTYPE_TYPEMASK)
+		require.Less(t, int(TypeIndex(typeID)), len(typeNames))
+	}
+	require.Equal(t, "Uint8", typeNames[TypeIndex(OBJTYPE_UINT8)])
+	require.Equal(t, "BigInt", typeNames[TypeIndex(OBJTYPE_BIG_INT)])
+	require.Equal(t, "String", typeNames[TypeIndex(OBJTYPE_STRING)])
+}
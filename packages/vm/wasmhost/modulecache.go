@@ -0,0 +1,239 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bytecodealliance/wasmtime-go"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/iotaledger/wasp/packages/hashing"
+)
+
+const (
+	DefaultModuleCacheSize = 64
+
+	wasmtimeModulePath = "github.com/bytecodealliance/wasmtime-go"
+	// wasmtimeVersionFallback is used when the build info is not available
+	wasmtimeVersionFallback = "v0.21.0"
+	// engineConfigTag identifies the engine settings that the compiled code
+	// depends on; change it whenever the settings in Engine change
+	engineConfigTag = "interruptable"
+)
+
+// ModuleCacheStats counts the lookups in the ModuleCache
+type ModuleCacheStats struct {
+	// MemoryHits is the number of modules found in memory
+	MemoryHits uint64
+	// DiskHits is the number of modules loaded from disk
+	DiskHits uint64
+	// Misses is the number of modules that had to be compiled
+	Misses uint64
+}
+
+// ModuleCache compiles Wasm modules with a shared wasmtime engine, keeps the
+// most recently used compiled modules in memory, and persists them to disk so
+// that a restarted node does not have to compile them again.
+// Modules are keyed by program hash and wasmtime version.
+// The compiled code is executed as is, so the files on disk are authenticated
+// with a MAC keyed by a node-local secret: files that were not written by the
+// node itself are never loaded.
+type ModuleCache struct {
+	engine     *wasmtime.Engine
+	engineOnce sync.Once
+	dir        string
+	secret     []byte
+	mutex      sync.Mutex
+	modules    *lru.Cache
+	pending    map[hashing.HashValue]*pendingModule
+	stats      ModuleCacheStats
+}
+
+// pendingModule is a module being loaded or compiled. Concurrent requests
+// for the same module wait for done instead of compiling it again.
+type pendingModule struct {
+	done   chan struct{}
+	module *wasmtime.Module
+	err    error
+}
+
+// DefaultModuleCache is the cache used by the WasmTimeVM. It only keeps the
+// compiled modules in memory until it is replaced by one with a directory.
+var DefaultModuleCache = NewModuleCache("", DefaultModuleCacheSize, nil)
+
+// NewModuleCache creates a cache that keeps up to size compiled modules in
+// memory. If dir is not empty, the compiled modules are also stored there,
+// authenticated with secret. The secret must not be stored in dir.
+func NewModuleCache(dir string, size int, secret []byte) *ModuleCache {
+	modules, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	if dir != "" && len(secret) == 0 {
+		panic("module cache directory requires a secret")
+	}
+	return &ModuleCache{
+		dir:     dir,
+		secret:  secret,
+		modules: modules,
+		pending: make(map[hashing.HashValue]*pendingModule),
+	}
+}
+
+var wasmtimeVersion = func() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == wasmtimeModulePath {
+				if dep.Replace != nil {
+					return dep.Replace.Version
+				}
+				return dep.Version
+			}
+		}
+	}
+	return wasmtimeVersionFallback
+}()
+
+// Engine returns the engine that compiles the modules. Modules can only be
+// instantiated in stores of the same engine.
+func (c *ModuleCache) Engine() *wasmtime.Engine {
+	// the engine is created on first use, so that processes that never run
+	// Wasm code do not initialize wasmtime
+	c.engineOnce.Do(func() {
+		config := wasmtime.NewConfig()
+		config.SetInterruptable(true)
+		c.engine = wasmtime.NewEngineWithConfig(config)
+	})
+	return c.engine
+}
+
+// Stats returns a snapshot of the lookup counters
+func (c *ModuleCache) Stats() ModuleCacheStats {
+	return ModuleCacheStats{
+		MemoryHits: atomic.LoadUint64(&c.stats.MemoryHits),
+		DiskHits:   atomic.LoadUint64(&c.stats.DiskHits),
+		Misses:     atomic.LoadUint64(&c.stats.Misses),
+	}
+}
+
+// Module returns the compiled module for the program. A zero program hash
+// bypasses the cache.
+func (c *ModuleCache) Module(programHash hashing.HashValue, wasmData []byte) (*wasmtime.Module, error) {
+	if programHash == hashing.NilHash {
+		return wasmtime.NewModule(c.Engine(), wasmData)
+	}
+
+	key := hashing.HashData(programHash[:], []byte(wasmtimeVersion), []byte(engineConfigTag))
+
+	c.mutex.Lock()
+	if module, ok := c.modules.Get(key); ok {
+		c.mutex.Unlock()
+		atomic.AddUint64(&c.stats.MemoryHits, 1)
+		return module.(*wasmtime.Module), nil
+	}
+	// compile each module only once, even with concurrent requests, but
+	// without holding the mutex, so that other modules can be served meanwhile
+	if pending, ok := c.pending[key]; ok {
+		c.mutex.Unlock()
+		<-pending.done
+		return pending.module, pending.err
+	}
+	pending := &pendingModule{done: make(chan struct{})}
+	c.pending[key] = pending
+	c.mutex.Unlock()
+
+	pending.module, pending.err = c.loadOrCompile(key, wasmData)
+
+	c.mutex.Lock()
+	delete(c.pending, key)
+	if pending.err == nil {
+		c.modules.Add(key, pending.module)
+	}
+	c.mutex.Unlock()
+	close(pending.done)
+	return pending.module, pending.err
+}
+
+func (c *ModuleCache) loadOrCompile(key hashing.HashValue, wasmData []byte) (*wasmtime.Module, error) {
+	if module := c.load(key); module != nil {
+		atomic.AddUint64(&c.stats.DiskHits, 1)
+		return module, nil
+	}
+	atomic.AddUint64(&c.stats.Misses, 1)
+	module, err := wasmtime.NewModule(c.Engine(), wasmData)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, module)
+	return module, nil
+}
+
+func (c *ModuleCache) path(key hashing.HashValue) string {
+	return filepath.Join(c.dir, key.String()+".cwasm")
+}
+
+// mac authenticates the compiled module stored under key
+func (c *ModuleCache) mac(key hashing.HashValue, compiled []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	_, _ = mac.Write(key[:])
+	_, _ = mac.Write(compiled)
+	return mac.Sum(nil)
+}
+
+// load reads a compiled module from disk. Files that fail authentication or
+// are incompatible are ignored, so that the module is compiled again.
+func (c *ModuleCache) load(key hashing.HashValue) *wasmtime.Module {
+	if c.dir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.path(key))
+	if err != nil || len(data) < sha256.Size {
+		return nil
+	}
+	// the compiled code is not validated when deserialized, so only
+	// load what this node has written itself
+	if !hmac.Equal(c.mac(key, data[sha256.Size:]), data[:sha256.Size]) {
+		return nil
+	}
+	module, err := wasmtime.NewModuleDeserialize(c.Engine(), data[sha256.Size:])
+	if err != nil {
+		return nil
+	}
+	return module
+}
+
+// store writes the compiled module to disk. The cache is only an
+// optimization, so errors are ignored.
+func (c *ModuleCache) store(key hashing.HashValue, module *wasmtime.Module) {
+	if c.dir == "" {
+		return
+	}
+	compiled, err := module.Serialize()
+	if err != nil {
+		return
+	}
+	if err = os.MkdirAll(c.dir, 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(append(c.mac(key, compiled), compiled...))
+	if err2 := tmp.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
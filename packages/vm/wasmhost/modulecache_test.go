@@ -0,0 +1,56 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleCacheIgnoresUnauthenticatedFiles(t *testing.T) {
+	c := NewModuleCache(t.TempDir(), DefaultModuleCacheSize, []byte("secret"))
+	key := hashing.HashStrings("program")
+
+	require.Nil(t, c.load(key))
+
+	// truncated file
+	require.NoError(t, os.WriteFile(c.path(key), []byte{1, 2, 3}, 0o600))
+	require.Nil(t, c.load(key))
+
+	// MAC mismatch
+	data := make([]byte, sha256.Size+10)
+	require.NoError(t, os.WriteFile(c.path(key), data, 0o600))
+	require.Nil(t, c.load(key))
+
+	// a plain hash of the content can be computed by anyone
+	compiled := []byte("compiled code")
+	checksum := sha256.Sum256(compiled)
+	require.NoError(t, os.WriteFile(c.path(key), append(checksum[:], compiled...), 0o600))
+	require.Nil(t, c.load(key))
+
+	// so can a file written by a node with another secret
+	other := NewModuleCache(c.dir, DefaultModuleCacheSize, []byte("other secret"))
+	require.NoError(t, os.WriteFile(c.path(key), append(other.mac(key, compiled), compiled...), 0o600))
+	require.Nil(t, c.load(key))
+
+	// and the MAC of another module
+	otherKey := hashing.HashStrings("other program")
+	require.NoError(t, os.WriteFile(c.path(key), append(c.mac(otherKey, compiled), compiled...), 0o600))
+	require.Nil(t, c.load(key))
+}
+
+func TestModuleCacheRequiresSecret(t *testing.T) {
+	require.Panics(t, func() {
+		NewModuleCache(t.TempDir(), DefaultModuleCacheSize, nil)
+	})
+}
+
+func TestWasmtimeVersion(t *testing.T) {
+	require.NotEmpty(t, wasmtimeVersion)
+	require.NotEqual(t, "(devel)", wasmtimeVersion)
+}
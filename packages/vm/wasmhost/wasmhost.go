@@ -57,6 +57,15 @@ func (host *WasmHost) FunctionFromCode(code uint32) string {
 	return host.codeToFunc[code]
 }
 
+func (host *WasmHost) FunctionFromIndex(index int32) string {
+	for function, funcIndex := range host.funcToIndex {
+		if funcIndex == index {
+			return function
+		}
+	}
+	return ""
+}
+
 func (host *WasmHost) IsView(function string) bool {
 	return (host.funcToIndex[function] & 0x8000) != 0
 }
@@ -74,6 +83,19 @@ func (host *WasmHost) LoadWasm(wasmData []byte) error {
 	return nil
 }
 
+// ResetVM restores the VM to the state right after LoadWasm, so that the
+// outcome of a request does not depend on the requests that used the VM
+// before it
+func (host *WasmHost) ResetVM() error {
+	reset, err := host.vm.Reset()
+	if err != nil || !reset {
+		return err
+	}
+	// on_load is deterministic, so the memory is the same as the one saved
+	// by LoadWasm
+	return host.RunFunction("on_load")
+}
+
 func (host *WasmHost) RunFunction(functionName string, args ...interface{}) (err error) {
 	return host.vm.RunFunction(functionName, args...)
 }
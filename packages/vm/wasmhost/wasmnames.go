@@ -0,0 +1,113 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"bytes"
+	"errors"
+)
+
+const (
+	wasmSectionCustom       = 0
+	wasmNameSubsectionFuncs = 1
+)
+
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+// ParseNameSection extracts the function index to function name mapping
+// from the custom "name" section of a Wasm binary, if present.
+// Only the Wasm binary is parsed, so this can be done without instantiating it.
+func ParseNameSection(wasmData []byte) (map[uint32]string, error) {
+	names := make(map[uint32]string)
+	if len(wasmData) < 8 || !bytes.Equal(wasmData[:4], wasmMagic) {
+		return nil, errors.New("ParseNameSection: not a Wasm binary")
+	}
+
+	r := &wasmReader{data: wasmData, pos: 8}
+	for !r.eof() {
+		id := r.byte()
+		size := r.u32()
+		end := r.pos + int(size)
+		if r.err != nil || end > len(r.data) {
+			return nil, errors.New("ParseNameSection: invalid section")
+		}
+		if id == wasmSectionCustom && r.name() == "name" {
+			r.funcNames(end, names)
+			if r.err != nil {
+				return nil, r.err
+			}
+		}
+		r.pos = end
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return names, nil
+}
+
+type wasmReader struct {
+	data []byte
+	err  error
+	pos  int
+}
+
+func (r *wasmReader) byte() byte {
+	if r.pos >= len(r.data) {
+		r.err = errors.New("ParseNameSection: unexpected end of data")
+		return 0
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *wasmReader) eof() bool {
+	return r.err != nil || r.pos >= len(r.data)
+}
+
+// funcNames decodes the function names subsection of the name section
+func (r *wasmReader) funcNames(end int, names map[uint32]string) {
+	for r.err == nil && r.pos < end {
+		id := r.byte()
+		size := r.u32()
+		subEnd := r.pos + int(size)
+		if r.err != nil || subEnd > end {
+			r.err = errors.New("ParseNameSection: invalid subsection")
+			return
+		}
+		if id == wasmNameSubsectionFuncs {
+			count := r.u32()
+			for i := uint32(0); i < count && r.err == nil; i++ {
+				index := r.u32()
+				names[index] = r.name()
+			}
+		}
+		r.pos = subEnd
+	}
+}
+
+func (r *wasmReader) name() string {
+	size := int(r.u32())
+	if r.err != nil || r.pos+size > len(r.data) {
+		r.err = errors.New("ParseNameSection: invalid name")
+		return ""
+	}
+	name := string(r.data[r.pos : r.pos+size])
+	r.pos += size
+	return name
+}
+
+// u32 decodes an unsigned LEB128 encoded value
+func (r *wasmReader) u32() uint32 {
+	value := uint32(0)
+	for shift := 0; shift < 35; shift += 7 {
+		b := r.byte()
+		value |= uint32(b&0x7f) << shift
+		if (b & 0x80) == 0 {
+			return value
+		}
+	}
+	r.err = errors.New("ParseNameSection: invalid LEB128 value")
+	return 0
+}
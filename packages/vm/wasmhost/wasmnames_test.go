@@ -0,0 +1,58 @@
+package wasmhost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func nameSection(names ...string) []byte {
+	funcs := []byte{byte(len(names))}
+	for i, name := range names {
+		funcs = append(funcs, byte(i), byte(len(name)))
+		funcs = append(funcs, name...)
+	}
+	section := append([]byte{4}, "name"...)
+	// module name subsection should be skipped
+	section = append(section, 0, 4, 3, 'm', 'o', 'd')
+	section = append(section, wasmNameSubsectionFuncs, byte(len(funcs)))
+	section = append(section, funcs...)
+	return append([]byte{wasmSectionCustom, byte(len(section))}, section...)
+}
+
+func wasmHeader() []byte {
+	header := make([]byte, 0, 8)
+	header = append(header, wasmMagic...)
+	return append(header, 1, 0, 0, 0)
+}
+
+func TestParseNameSection(t *testing.T) {
+	wasmData := wasmHeader()
+	// empty type section should be skipped
+	wasmData = append(wasmData, 1, 1, 0)
+	wasmData = append(wasmData, nameSection("on_load", "on_call", "wasmlib::panic")...)
+
+	names, err := ParseNameSection(wasmData)
+	require.NoError(t, err)
+	require.Len(t, names, 3)
+	require.EqualValues(t, "on_load", names[0])
+	require.EqualValues(t, "on_call", names[1])
+	require.EqualValues(t, "wasmlib::panic", names[2])
+}
+
+func TestParseNameSectionMissing(t *testing.T) {
+	wasmData := wasmHeader()
+	names, err := ParseNameSection(wasmData)
+	require.NoError(t, err)
+	require.Len(t, names, 0)
+}
+
+func TestParseNameSectionInvalid(t *testing.T) {
+	_, err := ParseNameSection([]byte("go:inccounter"))
+	require.Error(t, err)
+
+	wasmData := wasmHeader()
+	wasmData = append(wasmData, nameSection("on_load")...)
+	_, err = ParseNameSection(wasmData[:len(wasmData)-2])
+	require.Error(t, err)
+}
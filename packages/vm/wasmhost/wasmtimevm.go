@@ -5,30 +5,41 @@ package wasmhost
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/bytecodealliance/wasmtime-go"
+	"github.com/iotaledger/wasp/packages/hashing"
 )
 
 type WasmTimeVM struct {
 	WasmVMBase
-	instance  *wasmtime.Instance
-	interrupt *wasmtime.InterruptHandle
-	linker    *wasmtime.Linker
-	memory    *wasmtime.Memory
-	module    *wasmtime.Module
-	store     *wasmtime.Store
+	funcNames   map[uint32]string
+	instance    *wasmtime.Instance
+	interrupt   *wasmtime.InterruptHandle
+	linker      *wasmtime.Linker
+	memory      *wasmtime.Memory
+	module      *wasmtime.Module
+	modules     *ModuleCache
+	programHash hashing.HashValue
+	store       *wasmtime.Store
+	trapped     bool
 }
 
 var _ WasmVM = &WasmTimeVM{}
 
-func NewWasmTimeVM() *WasmTimeVM {
-	vm := &WasmTimeVM{}
-	config := wasmtime.NewConfig()
-	config.SetInterruptable(true)
-	vm.store = wasmtime.NewStore(wasmtime.NewEngineWithConfig(config))
+// NewWasmTimeVM creates a VM that gets the compiled module for the program
+// from the DefaultModuleCache
+func NewWasmTimeVM(programHash hashing.HashValue) *WasmTimeVM {
+	vm := &WasmTimeVM{modules: DefaultModuleCache, programHash: programHash}
+	vm.newStore()
+	return vm
+}
+
+// newStore replaces the store, which releases all instances of the old one
+func (vm *WasmTimeVM) newStore() {
+	vm.store = wasmtime.NewStore(vm.modules.Engine())
 	vm.interrupt, _ = vm.store.InterruptHandle()
 	vm.linker = wasmtime.NewLinker(vm.store)
-	return vm
 }
 
 func (vm *WasmTimeVM) Interrupt() {
@@ -37,7 +48,10 @@ func (vm *WasmTimeVM) Interrupt() {
 
 func (vm *WasmTimeVM) LinkHost(impl WasmVM, host *WasmHost) error {
 	_ = vm.WasmVMBase.LinkHost(impl, host)
+	return vm.defineHostFuncs()
+}
 
+func (vm *WasmTimeVM) defineHostFuncs() error {
 	err := vm.linker.DefineFunc("WasmLib", "hostGetBytes",
 		func(objID, keyID, typeID, stringRef, size int32) int32 {
 			return vm.HostGetBytes(objID, keyID, typeID, stringRef, size)
@@ -89,10 +103,36 @@ func (vm *WasmTimeVM) LinkHost(impl WasmVM, host *WasmHost) error {
 
 func (vm *WasmTimeVM) LoadWasm(wasmData []byte) error {
 	var err error
-	vm.module, err = wasmtime.NewModule(vm.store.Engine, wasmData)
+	// name section is optional, it is only used to provide readable traces
+	vm.funcNames, _ = ParseNameSection(wasmData)
+	vm.module, err = vm.modules.Module(vm.programHash, wasmData)
 	if err != nil {
 		return err
 	}
+	return vm.instantiate()
+}
+
+// Reset replaces the instance with a new one after a trap or an abort. A call
+// that did not complete can leave the globals (e.g. the stack pointer), which
+// are not accessible from the host, in any state. After a regular call the
+// memory snapshot restored by PreCall is enough.
+func (vm *WasmTimeVM) Reset() (bool, error) {
+	if !vm.trapped {
+		return false, nil
+	}
+	vm.newStore()
+	if err := vm.defineHostFuncs(); err != nil {
+		return false, err
+	}
+	if err := vm.instantiate(); err != nil {
+		return false, err
+	}
+	vm.trapped = false
+	vm.memoryDirty = false
+	return true, nil
+}
+
+func (vm *WasmTimeVM) instantiate() (err error) {
 	vm.instance, err = vm.linker.Instantiate(vm.module)
 	if err != nil {
 		return err
@@ -128,10 +168,42 @@ func (vm *WasmTimeVM) RunScFunction(index int32) error {
 	frame := vm.PreCall()
 	defer vm.PostCall(frame)
 
-	return vm.Run(func() (err error) {
+	// a panic in a host function (e.g. an abort) also leaves the call unfinished
+	trapped := true
+	defer func() {
+		if trapped {
+			vm.trapped = true
+		}
+	}()
+	err := vm.Run(func() (err error) {
 		_, err = export.Func().Call(index)
 		return
 	})
+	trapped = err != nil
+	if tracer := GetHostTracer(); err != nil && tracer != nil {
+		vm.traceTrap(tracer, index, err)
+	}
+	return err
+}
+
+// traceTrap records a Wasm trap with its call stack mapped to function names
+func (vm *WasmTimeVM) traceTrap(tracer *WasmTracer, index int32, err error) {
+	trap, ok := err.(*wasmtime.Trap)
+	if !ok {
+		return
+	}
+	stack := make([]string, 0)
+	for _, frame := range trap.Frames() {
+		name, ok := vm.funcNames[frame.FuncIndex()]
+		if !ok {
+			name = fmt.Sprintf("func[%d]", frame.FuncIndex())
+			if funcName := frame.FuncName(); funcName != nil {
+				name = *funcName
+			}
+		}
+		stack = append(stack, fmt.Sprintf("%s+0x%x", name, frame.FuncOffset()))
+	}
+	tracer.Panic(vm.getKvStore(0).traceDepth, vm.host.FunctionFromIndex(index), trap.Message(), stack)
 }
 
 func (vm *WasmTimeVM) UnsafeMemory() []byte {
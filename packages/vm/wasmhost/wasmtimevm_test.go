@@ -0,0 +1,56 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"testing"
+
+	"github.com/bytecodealliance/wasmtime-go"
+	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/stretchr/testify/require"
+)
+
+// on_call(0) succeeds only when it starts from the memory and globals left by
+// on_load, and changes the memory; on_call(1) corrupts a global and traps
+const resetTestWat = `
+(module
+  (memory (export "memory") 1)
+  (global $sp (mut i32) (i32.const 1024))
+  (func (export "on_load"))
+  (func (export "on_call") (param $index i32)
+    (if (i32.ne (i32.load (i32.const 16)) (i32.const 7)) (then unreachable))
+    (if (i32.ne (global.get $sp) (i32.const 1024)) (then unreachable))
+    (i32.store (i32.const 16) (i32.const 8))
+    (if (i32.eq (local.get $index) (i32.const 1))
+      (then
+        (global.set $sp (i32.const 0))
+        unreachable)))
+  (data (i32.const 16) "\07"))
+`
+
+func TestWasmTimeVMReset(t *testing.T) {
+	wasmData, err := wasmtime.Wat2Wasm(resetTestWat)
+	require.NoError(t, err)
+
+	vm := NewWasmTimeVM(hashing.NilHash)
+	host := &WasmHost{}
+	require.NoError(t, vm.LinkHost(vm, host))
+	require.NoError(t, host.LoadWasm(wasmData))
+
+	// consecutive calls on the same instance see the same initial state
+	for i := 0; i < 3; i++ {
+		reset, err := vm.Reset()
+		require.NoError(t, err)
+		require.False(t, reset)
+		require.NoError(t, vm.RunScFunction(0))
+	}
+
+	// after a trap the instance is replaced
+	require.Error(t, vm.RunScFunction(1))
+	require.NoError(t, host.ResetVM())
+	require.NoError(t, vm.RunScFunction(0))
+	reset, err := vm.Reset()
+	require.NoError(t, err)
+	require.False(t, reset)
+}
@@ -0,0 +1,296 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmhost
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/mr-tron/base58"
+)
+
+// hostTracer, when set, receives a structured record of every ScHost call.
+// Unlike HostTracing it does not produce log lines, but collects the events
+// so that they can be inspected, exported as JSON, or diffed between builds.
+// It is accessed atomically, because VMs run concurrently.
+var hostTracer atomic.Value
+
+// tracerRef wraps the tracer, because atomic.Value cannot store nil
+type tracerRef struct {
+	tracer *WasmTracer
+}
+
+// GetHostTracer returns the current host tracer, or nil when tracing is disabled
+func GetHostTracer() *WasmTracer {
+	ref, _ := hostTracer.Load().(tracerRef)
+	return ref.tracer
+}
+
+// SetHostTracer replaces the host tracer, nil disables tracing
+func SetHostTracer(tracer *WasmTracer) {
+	hostTracer.Store(tracerRef{tracer: tracer})
+}
+
+const (
+	TraceCall   = "call"
+	TraceEnter  = "enter"
+	TraceExists = "exists"
+	TraceFunc   = "func"
+	TraceGet    = "get"
+	TraceLeave  = "leave"
+	TraceObject = "object"
+	TracePanic  = "panic"
+	TraceSet    = "set"
+)
+
+var typeNames = [...]string{
+	"", "Address", "AgentID", "Bytes", "ChainID", "Color", "Hash",
+	"Hname", "Int16", "Int32", "Int64", "Map", "RequestID", "String",
+	"Bool", "Int8", "Uint8", "Uint16", "Uint32", "Uint64", "BigInt",
+}
+
+// TraceEvent is a single host interaction recorded by a WasmTracer.
+// Object and key ids are resolved into readable keys and decoded values
+// so that traces of different Wasm builds of the same contract can be compared.
+type TraceEvent struct {
+	Seq      int      `json:"seq"`
+	Depth    int      `json:"depth"`
+	Kind     string   `json:"kind"`
+	Function string   `json:"function,omitempty"`
+	ObjID    int32    `json:"objId,omitempty"`
+	Key      string   `json:"key,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Stack    []string `json:"stack,omitempty"`
+}
+
+func (e *TraceEvent) String() string {
+	text := strings.Repeat("  ", e.Depth) + e.Kind
+	if e.Function != "" {
+		text += " " + e.Function
+	}
+	if e.Kind != TraceEnter && e.Kind != TraceLeave && e.Kind != TraceCall && e.Kind != TracePanic {
+		text += fmt.Sprintf(" o%d", e.ObjID)
+	}
+	if e.Key != "" {
+		text += " '" + e.Key + "'"
+	}
+	if e.Type != "" {
+		text += " " + e.Type
+	}
+	if e.Value != "" {
+		text += " = " + e.Value
+	}
+	if e.Error != "" {
+		text += " ERROR: " + e.Error
+	}
+	for _, frame := range e.Stack {
+		text += "\n" + strings.Repeat("  ", e.Depth+1) + "at " + frame
+	}
+	return text
+}
+
+// WasmTracer collects TraceEvents across nested contract calls.
+// The tracer is shared by all VMs, so it does not track the nesting depth
+// itself. Every WasmContext keeps its own depth and passes it along.
+// Outgoing calls are tracked per scope (the chain ID), so that the context
+// of the called contract can continue at the depth of its caller.
+type WasmTracer struct {
+	mutex  sync.Mutex
+	calls  map[string][]int
+	events []*TraceEvent
+}
+
+func NewWasmTracer() *WasmTracer {
+	return &WasmTracer{calls: make(map[string][]int)}
+}
+
+func (t *WasmTracer) add(depth int, event *TraceEvent) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	event.Seq = len(t.events)
+	event.Depth = depth
+	t.events = append(t.events, event)
+}
+
+// Call records an outgoing call at depth from a contract to contract/function
+// within scope. Every Call must be matched by a Return once the call finishes.
+func (t *WasmTracer) Call(scope string, depth int, contract, function string) {
+	t.add(depth, &TraceEvent{Kind: TraceCall, Function: contract + "." + function})
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.calls[scope] = append(t.calls[scope], depth)
+}
+
+// Enter records the start of the SC function execution within scope.
+// It returns the depth of the event, which is the depth of the outgoing call
+// that caused it, or 0 when the function was not called by another contract.
+// The events of the function itself should be recorded one level deeper.
+func (t *WasmTracer) Enter(scope string, function string) int {
+	t.mutex.Lock()
+	depth := 0
+	if calls := t.calls[scope]; len(calls) != 0 {
+		depth = calls[len(calls)-1]
+	}
+	t.mutex.Unlock()
+	t.add(depth, &TraceEvent{Kind: TraceEnter, Function: function})
+	return depth
+}
+
+// Events returns a copy of the events recorded so far.
+func (t *WasmTracer) Events() []*TraceEvent {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	events := make([]*TraceEvent, len(t.events))
+	copy(events, t.events)
+	return events
+}
+
+// Leave records the end of the SC function execution at the depth returned by Enter.
+func (t *WasmTracer) Leave(depth int, function string, err error) {
+	event := &TraceEvent{Kind: TraceLeave, Function: function}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	t.add(depth, event)
+}
+
+// Panic records a panic or Wasm trap, together with the Wasm call stack, if known.
+func (t *WasmTracer) Panic(depth int, function string, msg string, stack []string) {
+	t.add(depth, &TraceEvent{Kind: TracePanic, Function: function, Error: msg, Stack: stack})
+}
+
+func (t *WasmTracer) Reset() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.calls = make(map[string][]int)
+	t.events = nil
+}
+
+// Return ends the most recent outgoing call within scope.
+func (t *WasmTracer) Return(scope string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	calls := t.calls[scope]
+	switch len(calls) {
+	case 0:
+	case 1:
+		delete(t.calls, scope)
+	default:
+		t.calls[scope] = calls[:len(calls)-1]
+	}
+}
+
+func (t *WasmTracer) String() string {
+	lines := make([]string, 0)
+	for _, event := range t.Events() {
+		lines = append(lines, event.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (t *WasmTracer) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(t.Events(), "", "  ")
+}
+
+func (t *WasmTracer) record(depth int, kind string, objID int32, key string, typeID int32, value string) {
+	event := &TraceEvent{Kind: kind, ObjID: objID, Key: key, Value: value}
+	if typeID != 0 {
+		event.Type = traceTypeName(typeID)
+	}
+	t.add(depth, event)
+}
+
+func (t *WasmTracer) value(depth int, kind string, objID int32, key string, typeID int32, bytes []byte) {
+	value := ""
+	if bytes != nil {
+		value = traceValue(typeID, bytes)
+	}
+	t.record(depth, kind, objID, key, typeID, value)
+}
+
+func traceTypeName(typeID int32) string {
+	name := "?"
+	baseType := TypeIndex(typeID & OBJTYPE_TYPEMASK)
+	if baseType > 0 && int(baseType) < len(typeNames) {
+		name = typeNames[baseType]
+	}
+	switch typeID &^ OBJTYPE_TYPEMASK {
+	case OBJTYPE_ARRAY:
+		return "[]" + name
+	case OBJTYPE_ARRAY16:
+		return "[16]" + name
+	case OBJTYPE_CALL:
+		return "call"
+	}
+	return name
+}
+
+func traceValue(typeID int32, bytes []byte) string {
+	switch typeID {
+	case OBJTYPE_INT16:
+		val16, err := codec.DecodeInt16(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val16)
+		}
+	case OBJTYPE_INT32:
+		val32, err := codec.DecodeInt32(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val32)
+		}
+	case OBJTYPE_INT64:
+		val64, err := codec.DecodeInt64(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val64)
+		}
+	case OBJTYPE_STRING:
+		return "'" + string(bytes) + "'"
+	case OBJTYPE_BOOL:
+		val, err := codec.DecodeBool(bytes, false)
+		if err == nil {
+			return fmt.Sprintf("%t", val)
+		}
+	case OBJTYPE_INT8:
+		val8, err := codec.DecodeInt8(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val8)
+		}
+	case OBJTYPE_UINT8:
+		val8, err := codec.DecodeUint8(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val8)
+		}
+	case OBJTYPE_UINT16:
+		val16, err := codec.DecodeUint16(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val16)
+		}
+	case OBJTYPE_UINT32:
+		val32, err := codec.DecodeUint32(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val32)
+		}
+	case OBJTYPE_UINT64:
+		val64, err := codec.DecodeUint64(bytes, 0)
+		if err == nil {
+			return fmt.Sprintf("%d", val64)
+		}
+	case OBJTYPE_BIG_INT:
+		val, err := codec.DecodeBigInt(bytes)
+		if err == nil {
+			return val.String()
+		}
+	}
+	return base58.Encode(bytes)
+}
@@ -0,0 +1,87 @@
+package wasmhost
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostTracerConcurrent(t *testing.T) {
+	require.Nil(t, GetHostTracer())
+
+	tracer := NewWasmTracer()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetHostTracer(tracer)
+		}()
+		go func() {
+			defer wg.Done()
+			if tr := GetHostTracer(); tr != nil {
+				tr.Call("chain", 0, "contract", "function")
+				tr.Return("chain")
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, tracer, GetHostTracer())
+
+	SetHostTracer(nil)
+	require.Nil(t, GetHostTracer())
+
+	tracer.Reset()
+	// every VM runs a function that calls a nested function,
+	// the depth of one VM must not affect the depth of the others
+	const vms = 10
+	for i := 0; i < vms; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scope := fmt.Sprintf("chain%d", i)
+			outer := &KvStoreHost{}
+			depth := tracer.Enter(scope, fmt.Sprintf("outer%d", i))
+			outer.SetTraceDepth(depth + 1)
+			tracer.record(outer.TraceDepth(), TraceGet, 1, fmt.Sprintf("outer%d", i), 0, "")
+
+			tracer.Call(scope, outer.TraceDepth(), "contract", fmt.Sprintf("inner%d", i))
+			inner := &KvStoreHost{}
+			innerDepth := tracer.Enter(scope, fmt.Sprintf("inner%d", i))
+			inner.SetTraceDepth(innerDepth + 1)
+			tracer.record(inner.TraceDepth(), TraceGet, 1, fmt.Sprintf("inner%d", i), 0, "")
+			tracer.Leave(innerDepth, fmt.Sprintf("inner%d", i), nil)
+			tracer.Return(scope)
+
+			tracer.record(outer.TraceDepth(), TraceSet, 1, fmt.Sprintf("outer%d", i), 0, "")
+			tracer.Leave(depth, fmt.Sprintf("outer%d", i), nil)
+		}(i)
+	}
+	wg.Wait()
+
+	events := tracer.Events()
+	require.Len(t, events, vms*8)
+	for _, event := range events {
+		name := event.Function + event.Key
+		var i int
+		switch {
+		case event.Kind == TraceCall:
+			require.Equal(t, 1, event.Depth, event.String())
+		case event.Kind == TraceEnter || event.Kind == TraceLeave:
+			if _, err := fmt.Sscanf(name, "outer%d", &i); err == nil {
+				require.Equal(t, 0, event.Depth, event.String())
+				continue
+			}
+			require.Equal(t, 1, event.Depth, event.String())
+		default:
+			if _, err := fmt.Sscanf(name, "outer%d", &i); err == nil {
+				require.Equal(t, 1, event.Depth, event.String())
+				continue
+			}
+			require.Equal(t, 2, event.Depth, event.String())
+		}
+	}
+	require.Empty(t, tracer.calls)
+}
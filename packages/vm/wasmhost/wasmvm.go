@@ -29,6 +29,7 @@ type WasmVM interface {
 	Interrupt()
 	LinkHost(impl WasmVM, host *WasmHost) error
 	LoadWasm(wasmData []byte) error
+	Reset() (bool, error)
 	RunFunction(functionName string, args ...interface{}) error
 	RunScFunction(index int32) error
 	SaveMemory()
@@ -185,6 +186,12 @@ func (vm *WasmVMBase) PostCall(frame []byte) {
 	copy(ptr, frame)
 }
 
+// Reset restores the state of the VM right after LoadWasm, and returns true
+// if on_load must be run again. By default there is nothing to restore.
+func (vm *WasmVMBase) Reset() (bool, error) {
+	return false, nil
+}
+
 func (vm *WasmVMBase) Run(runner func() error) (err error) {
 	if vm.timeoutStarted {
 		// no need to wrap nested calls in timeout code
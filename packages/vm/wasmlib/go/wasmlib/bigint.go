@@ -0,0 +1,207 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmlib
+
+import "encoding/binary"
+
+// ScBigInt is an arbitrary-precision unsigned integer.
+// It is encoded as little-endian bytes without trailing zero bytes,
+// and zero is encoded as a single zero byte.
+type ScBigInt struct {
+	// little-endian bytes without trailing zero bytes, empty for zero
+	bytes []byte
+}
+
+func NewScBigInt(value uint64) ScBigInt {
+	bytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bytes, value)
+	return newScBigInt(bytes)
+}
+
+func NewScBigIntFromBytes(bytes []byte) ScBigInt {
+	return newScBigInt(append([]byte(nil), bytes...))
+}
+
+func newScBigInt(bytes []byte) ScBigInt {
+	for len(bytes) != 0 && bytes[len(bytes)-1] == 0 {
+		bytes = bytes[:len(bytes)-1]
+	}
+	return ScBigInt{bytes: bytes}
+}
+
+func (o ScBigInt) Add(rhs ScBigInt) ScBigInt {
+	lhs := o.bytes
+	if len(lhs) < len(rhs.bytes) {
+		lhs, rhs.bytes = rhs.bytes, lhs
+	}
+	res := make([]byte, len(lhs)+1)
+	carry := uint16(0)
+	for i := range lhs {
+		carry += uint16(lhs[i])
+		if i < len(rhs.bytes) {
+			carry += uint16(rhs.bytes[i])
+		}
+		res[i] = byte(carry)
+		carry >>= 8
+	}
+	res[len(lhs)] = byte(carry)
+	return newScBigInt(res)
+}
+
+func (o ScBigInt) Bytes() []byte {
+	if len(o.bytes) == 0 {
+		return []byte{0}
+	}
+	return append([]byte(nil), o.bytes...)
+}
+
+// Cmp returns -1, 0, or 1 depending on whether o is less than, equal to,
+// or greater than rhs
+func (o ScBigInt) Cmp(rhs ScBigInt) int {
+	if len(o.bytes) != len(rhs.bytes) {
+		if len(o.bytes) < len(rhs.bytes) {
+			return -1
+		}
+		return 1
+	}
+	for i := len(o.bytes) - 1; i >= 0; i-- {
+		if o.bytes[i] != rhs.bytes[i] {
+			if o.bytes[i] < rhs.bytes[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func (o ScBigInt) Div(rhs ScBigInt) ScBigInt {
+	quo, _ := o.DivMod(rhs)
+	return quo
+}
+
+// DivMod returns both the quotient and the remainder of o divided by rhs
+func (o ScBigInt) DivMod(rhs ScBigInt) (ScBigInt, ScBigInt) {
+	if rhs.IsZero() {
+		Panic("division by zero")
+	}
+	if len(rhs.bytes) == 1 {
+		quo, rem := o.divModByte(rhs.bytes[0])
+		return quo, NewScBigInt(uint64(rem))
+	}
+
+	// binary long division, one bit at a time
+	quo := make([]byte, len(o.bytes))
+	rem := ScBigInt{}
+	for i := len(o.bytes)*8 - 1; i >= 0; i-- {
+		rem = rem.shl1((o.bytes[i>>3] >> (i & 7)) & 1)
+		if rem.Cmp(rhs) >= 0 {
+			rem = rem.Sub(rhs)
+			quo[i>>3] |= 1 << (i & 7)
+		}
+	}
+	return newScBigInt(quo), rem
+}
+
+func (o ScBigInt) divModByte(divisor byte) (ScBigInt, byte) {
+	quo := make([]byte, len(o.bytes))
+	rem := uint16(0)
+	for i := len(o.bytes) - 1; i >= 0; i-- {
+		rem = rem<<8 | uint16(o.bytes[i])
+		quo[i] = byte(rem / uint16(divisor))
+		rem %= uint16(divisor)
+	}
+	return newScBigInt(quo), byte(rem)
+}
+
+func (o ScBigInt) IsUint64() bool {
+	return len(o.bytes) <= 8
+}
+
+func (o ScBigInt) IsZero() bool {
+	return len(o.bytes) == 0
+}
+
+func (o ScBigInt) KeyID() Key32 {
+	return GetKeyIDFromBytes(o.Bytes())
+}
+
+func (o ScBigInt) Mod(rhs ScBigInt) ScBigInt {
+	_, rem := o.DivMod(rhs)
+	return rem
+}
+
+func (o ScBigInt) Mul(rhs ScBigInt) ScBigInt {
+	if o.IsZero() || rhs.IsZero() {
+		return ScBigInt{}
+	}
+	res := make([]byte, len(o.bytes)+len(rhs.bytes))
+	for i, l := range o.bytes {
+		carry := uint16(0)
+		for j, r := range rhs.bytes {
+			carry += uint16(l)*uint16(r) + uint16(res[i+j])
+			res[i+j] = byte(carry)
+			carry >>= 8
+		}
+		res[i+len(rhs.bytes)] = byte(carry)
+	}
+	return newScBigInt(res)
+}
+
+// shl1 shifts o left by one bit and shifts in the lowest bit of bit
+func (o ScBigInt) shl1(bit byte) ScBigInt {
+	res := make([]byte, len(o.bytes)+1)
+	for i, b := range o.bytes {
+		res[i] = b<<1 | bit
+		bit = b >> 7
+	}
+	res[len(o.bytes)] = bit
+	return newScBigInt(res)
+}
+
+func (o ScBigInt) String() string {
+	if o.IsZero() {
+		return "0"
+	}
+	digits := make([]byte, 0, len(o.bytes)*3)
+	for value := o; !value.IsZero(); {
+		var digit byte
+		value, digit = value.divModByte(10)
+		digits = append(digits, '0'+digit)
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}
+
+func (o ScBigInt) Sub(rhs ScBigInt) ScBigInt {
+	if o.Cmp(rhs) < 0 {
+		Panic("subtraction underflow")
+	}
+	res := make([]byte, len(o.bytes))
+	borrow := int16(0)
+	for i := range o.bytes {
+		diff := int16(o.bytes[i]) - borrow
+		if i < len(rhs.bytes) {
+			diff -= int16(rhs.bytes[i])
+		}
+		borrow = 0
+		if diff < 0 {
+			diff += 256
+			borrow = 1
+		}
+		res[i] = byte(diff)
+	}
+	return newScBigInt(res)
+}
+
+func (o ScBigInt) Uint64() uint64 {
+	if !o.IsUint64() {
+		Panic("big int too large for uint64")
+	}
+	bytes := make([]byte, 8)
+	copy(bytes, o.bytes)
+	return binary.LittleEndian.Uint64(bytes)
+}
@@ -19,6 +19,14 @@ func (d *BytesDecoder) AgentID() ScAgentID {
 	return NewScAgentIDFromBytes(d.Bytes())
 }
 
+func (d *BytesDecoder) BigInt() ScBigInt {
+	return NewScBigIntFromBytes(d.Bytes())
+}
+
+func (d *BytesDecoder) Bool() bool {
+	return d.Uint8() != 0
+}
+
 func (d *BytesDecoder) Bytes() []byte {
 	size := int(d.Int32())
 	if len(d.data) < size {
@@ -51,6 +59,10 @@ func (d *BytesDecoder) Hname() ScHname {
 	return NewScHnameFromBytes(d.Bytes())
 }
 
+func (d *BytesDecoder) Int8() int8 {
+	return int8(d.Uint8())
+}
+
 func (d *BytesDecoder) Int16() int16 {
 	return int16(d.leb128Decode(16))
 }
@@ -92,6 +104,30 @@ func (d *BytesDecoder) leb128Decode(bits int) int64 {
 	}
 }
 
+// unsigned leb128 decoder
+func (d *BytesDecoder) leb128DecodeUnsigned(bits int) uint64 {
+	val := uint64(0)
+	s := 0
+	for {
+		if len(d.data) == 0 {
+			panic("insufficient bytes")
+		}
+		b := d.data[0]
+		d.data = d.data[1:]
+		val |= uint64(b&0x7f) << s
+		if (b & 0x80) == 0 {
+			if byte(val>>s)&0x7f != b&0x7f || (bits < 64 && val>>bits != 0) {
+				panic("integer too large")
+			}
+			return val
+		}
+		s += 7
+		if s >= bits {
+			panic("integer representation too long")
+		}
+	}
+}
+
 func (d *BytesDecoder) RequestID() ScRequestID {
 	return NewScRequestIDFromBytes(d.Bytes())
 }
@@ -100,6 +136,27 @@ func (d *BytesDecoder) String() string {
 	return string(d.Bytes())
 }
 
+func (d *BytesDecoder) Uint8() uint8 {
+	if len(d.data) == 0 {
+		panic("insufficient bytes")
+	}
+	value := d.data[0]
+	d.data = d.data[1:]
+	return value
+}
+
+func (d *BytesDecoder) Uint16() uint16 {
+	return uint16(d.leb128DecodeUnsigned(16))
+}
+
+func (d *BytesDecoder) Uint32() uint32 {
+	return uint32(d.leb128DecodeUnsigned(32))
+}
+
+func (d *BytesDecoder) Uint64() uint64 {
+	return d.leb128DecodeUnsigned(64)
+}
+
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
 type BytesEncoder struct {
@@ -118,6 +175,17 @@ func (e *BytesEncoder) AgentID(value ScAgentID) *BytesEncoder {
 	return e.Bytes(value.Bytes())
 }
 
+func (e *BytesEncoder) BigInt(value ScBigInt) *BytesEncoder {
+	return e.Bytes(value.Bytes())
+}
+
+func (e *BytesEncoder) Bool(value bool) *BytesEncoder {
+	if value {
+		return e.Uint8(1)
+	}
+	return e.Uint8(0)
+}
+
 func (e *BytesEncoder) Bytes(value []byte) *BytesEncoder {
 	e.Int32(int32(len(value)))
 	e.data = append(e.data, value...)
@@ -144,6 +212,10 @@ func (e *BytesEncoder) Hname(value ScHname) *BytesEncoder {
 	return e.Bytes(value.Bytes())
 }
 
+func (e *BytesEncoder) Int8(value int8) *BytesEncoder {
+	return e.Uint8(uint8(value))
+}
+
 func (e *BytesEncoder) Int16(value int16) *BytesEncoder {
 	return e.leb128Encode(int64(value))
 }
@@ -170,6 +242,19 @@ func (e *BytesEncoder) leb128Encode(value int64) *BytesEncoder {
 	}
 }
 
+// unsigned leb128 encoder
+func (e *BytesEncoder) leb128EncodeUnsigned(value uint64) *BytesEncoder {
+	for {
+		b := byte(value) & 0x7f
+		value >>= 7
+		if value == 0 {
+			e.data = append(e.data, b)
+			return e
+		}
+		e.data = append(e.data, b|0x80)
+	}
+}
+
 func (e *BytesEncoder) RequestID(value ScRequestID) *BytesEncoder {
 	return e.Bytes(value.Bytes())
 }
@@ -177,3 +262,20 @@ func (e *BytesEncoder) RequestID(value ScRequestID) *BytesEncoder {
 func (e *BytesEncoder) String(value string) *BytesEncoder {
 	return e.Bytes([]byte(value))
 }
+
+func (e *BytesEncoder) Uint8(value uint8) *BytesEncoder {
+	e.data = append(e.data, value)
+	return e
+}
+
+func (e *BytesEncoder) Uint16(value uint16) *BytesEncoder {
+	return e.leb128EncodeUnsigned(uint64(value))
+}
+
+func (e *BytesEncoder) Uint32(value uint32) *BytesEncoder {
+	return e.leb128EncodeUnsigned(uint64(value))
+}
+
+func (e *BytesEncoder) Uint64(value uint64) *BytesEncoder {
+	return e.leb128EncodeUnsigned(value)
+}
@@ -199,6 +199,19 @@ func (ctx ScBaseContext) Panic(text string) {
 	Panic(text)
 }
 
+// panics with a typed error that ends up in the request receipt
+// error codes below 1000 are reserved for the VM itself, at most 16 params can be passed
+func (ctx ScBaseContext) PanicWithError(code uint16, text string, params ...string) {
+	encode := NewBytesEncoder()
+	encode.Int32(int32(code))
+	encode.String(text)
+	encode.Int32(int32(len(params)))
+	for _, param := range params {
+		encode.String(param)
+	}
+	Root.GetBytes(KeyPanic).SetValue(encode.Data())
+}
+
 // retrieve parameters passed to the smart contract function that was called
 func (ctx ScBaseContext) Params() ScImmutableMap {
 	return Root.GetMap(KeyParams).Immutable()
@@ -233,6 +246,33 @@ func (ctx ScBaseContext) Utility() ScUtility {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// reserved params of the cross-chain callback protocol, see PostWithCallback()
+const (
+	ParamCallback          = Key("$cb")
+	ParamCallbackID        = Key("$cb.id")
+	ParamCallbackRequestID = Key("$cb.req")
+	ParamCallbackSuccess   = Key("$cb.ok")
+	ParamCallbackError     = Key("$cb.err")
+	ParamCallbackDeadline  = Key("$cb.deadline")
+
+	// state that tracks the calls that were posted with PostWithCallback()
+	stateCallbackNonce    = Key("$callbacks.nonce")
+	stateCallbacks        = Key("$callbacks")
+	stateCallbackTimeouts = Key("$callbacks.timeout")
+)
+
+// outcome of a call that was posted with PostWithCallback()
+type ScCallResult struct {
+	CallID    int64          // call ID returned by PostWithCallback()
+	RequestID ScRequestID    // ID of the request on the target chain, not set on timeout
+	Success   bool           // false when the request failed or timed out
+	Error     string         // reason of the failure
+	Late      bool           // the outcome arrived after the call already timed out
+	Results   ScImmutableMap // results of the request
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // smart contract interface with mutable access to state
 type ScFuncContext struct {
 	ScBaseContext
@@ -328,6 +368,92 @@ func (ctx ScFuncContext) PostSelf(hFunction ScHname, params *ScMutableMap, trans
 	ctx.Post(ctx.ChainID(), ctx.Contract(), hFunction, params, transfer, delay)
 }
 
+// posts a smart contract function like Post, and has the target chain send back the
+// outcome of the request to the hCallback function of this contract, see CallResult()
+// one iota of the transfer pays for sending back the outcome, the target function
+// does not receive it
+// when the outcome does not arrive within timeout seconds the callback receives a
+// timeout failure instead, which costs another iota from the account of this contract
+// the target chain does not run the request after the timeout, so the transfer stays
+// in escrow until then, and is refunded with the failed outcome that arrives later
+// a zero timeout waits indefinitely
+// returns the call ID that identifies the outcome
+func (ctx ScFuncContext) PostWithCallback(chainID ScChainID, hContract, hFunction ScHname, params *ScMutableMap,
+	transfer ScTransfers, hCallback ScHname, timeout int32) int64 {
+	if timeout < 0 {
+		Panic("invalid timeout")
+	}
+	nonce := ctx.State().GetInt64(stateCallbackNonce)
+	callID := nonce.Value() + 1
+	nonce.SetValue(callID)
+	target := NewScAgentID(chainID.Address(), hContract)
+	ctx.State().GetOrderedMap(stateCallbacks).SetBytes(EncodeSortableInt64(callID), target.Bytes())
+
+	if params == nil {
+		params = NewScMutableMap()
+	}
+	params.GetHname(ParamCallback).SetValue(hCallback)
+	params.GetInt64(ParamCallbackID).SetValue(callID)
+	if timeout != 0 {
+		// same whole second as the time lock of the timeout failure
+		deadline := (ctx.Timestamp()/1_000_000_000 + int64(timeout)) * 1_000_000_000
+		params.GetInt64(ParamCallbackDeadline).SetValue(deadline)
+	}
+	ctx.Post(chainID, hContract, hFunction, params, transfer, 0)
+
+	if timeout != 0 {
+		failure := NewScMutableMap()
+		failure.GetInt64(ParamCallbackID).SetValue(callID)
+		failure.GetBool(ParamCallbackSuccess).SetValue(false)
+		failure.GetString(ParamCallbackError).SetValue("timeout")
+		ctx.PostSelf(hCallback, failure, NewScTransferIotas(1), timeout)
+	}
+	return callID
+}
+
+// retrieves the outcome of a call that was posted with PostWithCallback
+// must be called from the callback function
+// a call can deliver a timeout failure followed by its actual outcome, which then
+// has Late set, and the actual outcome is delivered only once
+// the call is forgotten once its actual outcome is delivered
+// ok is false when the request is not an outcome of a pending call of this contract
+func (ctx ScFuncContext) CallResult() (result ScCallResult, ok bool) {
+	params := ctx.Params()
+	callID := params.GetInt64(ParamCallbackID)
+	if !callID.Exists() {
+		return result, false
+	}
+	key := EncodeSortableInt64(callID.Value())
+	callbacks := ctx.State().GetOrderedMap(stateCallbacks)
+	if !callbacks.Exists(key) {
+		return result, false
+	}
+	timeouts := ctx.State().GetOrderedMap(stateCallbackTimeouts)
+	timedOut := timeouts.Exists(key)
+	if !params.GetRequestID(ParamCallbackRequestID).Exists() {
+		// only this contract itself can signal a timeout, and only once
+		if ctx.Caller() != ctx.AccountID() || timedOut {
+			return result, false
+		}
+		timeouts.SetBytes(key, []byte{1})
+	} else {
+		// only the target of the call can send its outcome
+		if ctx.Caller() != NewScAgentIDFromBytes(callbacks.GetBytes(key)) {
+			return result, false
+		}
+		result.RequestID = params.GetRequestID(ParamCallbackRequestID).Value()
+		result.Late = timedOut
+		callbacks.Delete(key)
+		timeouts.Delete(key)
+	}
+
+	result.CallID = callID.Value()
+	result.Success = params.GetBool(ParamCallbackSuccess).Value()
+	result.Error = params.GetString(ParamCallbackError).Value()
+	result.Results = params
+	return result, true
+}
+
 // retrieve the request id of this transaction
 func (ctx ScFuncContext) RequestID() ScRequestID {
 	return Root.GetRequestID(KeyRequestID).Value()
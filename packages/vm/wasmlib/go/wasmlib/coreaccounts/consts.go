@@ -17,28 +17,50 @@ const (
 
 const (
 	ParamAgentID        = wasmlib.Key("a")
+	ParamCursor         = wasmlib.Key("cursor")
+	ParamLimit          = wasmlib.Key("limit")
+	ParamNftColor       = wasmlib.Key("nc")
+	ParamNftMetadata    = wasmlib.Key("nm")
 	ParamWithdrawAmount = wasmlib.Key("m")
 	ParamWithdrawColor  = wasmlib.Key("c")
 )
 
-const ResultAccountNonce = wasmlib.Key("n")
+const (
+	ResultAccountNonce = wasmlib.Key("n")
+	ResultNextCursor   = wasmlib.Key("nextCursor")
+	ResultNftColor     = wasmlib.Key("nc")
+	ResultNftIssuer    = wasmlib.Key("ni")
+	ResultNftMetadata  = wasmlib.Key("nm")
+	ResultNftOwner     = wasmlib.Key("no")
+	ResultTruncated    = wasmlib.Key("truncated")
+)
 
 const (
 	FuncDeposit         = "deposit"
 	FuncHarvest         = "harvest"
+	FuncMintNFT         = "mintNFT"
+	FuncTransferNFT     = "transferNFT"
 	FuncWithdraw        = "withdraw"
+	FuncWithdrawNFT     = "withdrawNFT"
 	ViewAccounts        = "accounts"
 	ViewBalance         = "balance"
+	ViewGetAccountNFTs  = "getAccountNFTs"
 	ViewGetAccountNonce = "getAccountNonce"
+	ViewGetNFTData      = "getNFTData"
 	ViewTotalAssets     = "totalAssets"
 )
 
 const (
 	HFuncDeposit         = wasmlib.ScHname(0xbdc9102d)
 	HFuncHarvest         = wasmlib.ScHname(0x7b40efbd)
+	HFuncMintNFT         = wasmlib.ScHname(0x3b79d016)
+	HFuncTransferNFT     = wasmlib.ScHname(0xdc3e3f0d)
 	HFuncWithdraw        = wasmlib.ScHname(0x9dcc0f41)
+	HFuncWithdrawNFT     = wasmlib.ScHname(0x281b1980)
 	HViewAccounts        = wasmlib.ScHname(0x3c4b5e02)
 	HViewBalance         = wasmlib.ScHname(0x84168cb4)
+	HViewGetAccountNFTs  = wasmlib.ScHname(0x02010e79)
 	HViewGetAccountNonce = wasmlib.ScHname(0x529d7df9)
+	HViewGetNFTData      = wasmlib.ScHname(0xa5c5513d)
 	HViewTotalAssets     = wasmlib.ScHname(0xfab0f8d2)
 )
@@ -19,12 +19,29 @@ type HarvestCall struct {
 	Params MutableHarvestParams
 }
 
+type MintNFTCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableMintNFTParams
+	Results ImmutableMintNFTResults
+}
+
+type TransferNFTCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableTransferNFTParams
+}
+
 type WithdrawCall struct {
 	Func *wasmlib.ScFunc
 }
 
+type WithdrawNFTCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableWithdrawNFTParams
+}
+
 type AccountsCall struct {
 	Func    *wasmlib.ScView
+	Params  MutableAccountsParams
 	Results ImmutableAccountsResults
 }
 
@@ -34,12 +51,24 @@ type BalanceCall struct {
 	Results ImmutableBalanceResults
 }
 
+type GetAccountNFTsCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetAccountNFTsParams
+	Results ImmutableGetAccountNFTsResults
+}
+
 type GetAccountNonceCall struct {
 	Func    *wasmlib.ScView
 	Params  MutableGetAccountNonceParams
 	Results ImmutableGetAccountNonceResults
 }
 
+type GetNFTDataCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetNFTDataParams
+	Results ImmutableGetNFTDataResults
+}
+
 type TotalAssetsCall struct {
 	Func    *wasmlib.ScView
 	Results ImmutableTotalAssetsResults
@@ -61,13 +90,31 @@ func (sc Funcs) Harvest(ctx wasmlib.ScFuncCallContext) *HarvestCall {
 	return f
 }
 
+func (sc Funcs) MintNFT(ctx wasmlib.ScFuncCallContext) *MintNFTCall {
+	f := &MintNFTCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncMintNFT)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) TransferNFT(ctx wasmlib.ScFuncCallContext) *TransferNFTCall {
+	f := &TransferNFTCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncTransferNFT)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) Withdraw(ctx wasmlib.ScFuncCallContext) *WithdrawCall {
 	return &WithdrawCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncWithdraw)}
 }
 
+func (sc Funcs) WithdrawNFT(ctx wasmlib.ScFuncCallContext) *WithdrawNFTCall {
+	f := &WithdrawNFTCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncWithdrawNFT)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) Accounts(ctx wasmlib.ScViewCallContext) *AccountsCall {
 	f := &AccountsCall{Func: wasmlib.NewScView(ctx, HScName, HViewAccounts)}
-	f.Func.SetPtrs(nil, &f.Results.id)
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
@@ -77,12 +124,24 @@ func (sc Funcs) Balance(ctx wasmlib.ScViewCallContext) *BalanceCall {
 	return f
 }
 
+func (sc Funcs) GetAccountNFTs(ctx wasmlib.ScViewCallContext) *GetAccountNFTsCall {
+	f := &GetAccountNFTsCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetAccountNFTs)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) GetAccountNonce(ctx wasmlib.ScViewCallContext) *GetAccountNonceCall {
 	f := &GetAccountNonceCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetAccountNonce)}
 	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
+func (sc Funcs) GetNFTData(ctx wasmlib.ScViewCallContext) *GetNFTDataCall {
+	f := &GetNFTDataCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetNFTData)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) TotalAssets(ctx wasmlib.ScViewCallContext) *TotalAssetsCall {
 	f := &TotalAssetsCall{Func: wasmlib.NewScView(ctx, HScName, HViewTotalAssets)}
 	f.Func.SetPtrs(nil, &f.Results.id)
@@ -93,9 +152,14 @@ func OnLoad() {
 	exports := wasmlib.NewScExports()
 	exports.AddFunc(FuncDeposit, wasmlib.FuncError)
 	exports.AddFunc(FuncHarvest, wasmlib.FuncError)
+	exports.AddFunc(FuncMintNFT, wasmlib.FuncError)
+	exports.AddFunc(FuncTransferNFT, wasmlib.FuncError)
 	exports.AddFunc(FuncWithdraw, wasmlib.FuncError)
+	exports.AddFunc(FuncWithdrawNFT, wasmlib.FuncError)
 	exports.AddView(ViewAccounts, wasmlib.ViewError)
 	exports.AddView(ViewBalance, wasmlib.ViewError)
+	exports.AddView(ViewGetAccountNFTs, wasmlib.ViewError)
 	exports.AddView(ViewGetAccountNonce, wasmlib.ViewError)
+	exports.AddView(ViewGetNFTData, wasmlib.ViewError)
 	exports.AddView(ViewTotalAssets, wasmlib.ViewError)
 }
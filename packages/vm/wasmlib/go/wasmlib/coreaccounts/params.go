@@ -49,6 +49,94 @@ func (s MutableHarvestParams) WithdrawColor() wasmlib.ScMutableColor {
 	return wasmlib.NewScMutableColor(s.id, ParamWithdrawColor.KeyID())
 }
 
+type ImmutableMintNFTParams struct {
+	id int32
+}
+
+func (s ImmutableMintNFTParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s ImmutableMintNFTParams) NftMetadata() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamNftMetadata.KeyID())
+}
+
+type MutableMintNFTParams struct {
+	id int32
+}
+
+func (s MutableMintNFTParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s MutableMintNFTParams) NftMetadata() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamNftMetadata.KeyID())
+}
+
+type ImmutableTransferNFTParams struct {
+	id int32
+}
+
+func (s ImmutableTransferNFTParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s ImmutableTransferNFTParams) NftColor() wasmlib.ScImmutableColor {
+	return wasmlib.NewScImmutableColor(s.id, ParamNftColor.KeyID())
+}
+
+type MutableTransferNFTParams struct {
+	id int32
+}
+
+func (s MutableTransferNFTParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s MutableTransferNFTParams) NftColor() wasmlib.ScMutableColor {
+	return wasmlib.NewScMutableColor(s.id, ParamNftColor.KeyID())
+}
+
+type ImmutableWithdrawNFTParams struct {
+	id int32
+}
+
+func (s ImmutableWithdrawNFTParams) NftColor() wasmlib.ScImmutableColor {
+	return wasmlib.NewScImmutableColor(s.id, ParamNftColor.KeyID())
+}
+
+type MutableWithdrawNFTParams struct {
+	id int32
+}
+
+func (s MutableWithdrawNFTParams) NftColor() wasmlib.ScMutableColor {
+	return wasmlib.NewScMutableColor(s.id, ParamNftColor.KeyID())
+}
+
+type ImmutableAccountsParams struct {
+	id int32
+}
+
+func (s ImmutableAccountsParams) Cursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s ImmutableAccountsParams) Limit() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, ParamLimit.KeyID())
+}
+
+type MutableAccountsParams struct {
+	id int32
+}
+
+func (s MutableAccountsParams) Cursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s MutableAccountsParams) Limit() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, ParamLimit.KeyID())
+}
+
 type ImmutableBalanceParams struct {
 	id int32
 }
@@ -65,6 +153,22 @@ func (s MutableBalanceParams) AgentID() wasmlib.ScMutableAgentID {
 	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
 }
 
+type ImmutableGetAccountNFTsParams struct {
+	id int32
+}
+
+func (s ImmutableGetAccountNFTsParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+type MutableGetAccountNFTsParams struct {
+	id int32
+}
+
+func (s MutableGetAccountNFTsParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
 type ImmutableGetAccountNonceParams struct {
 	id int32
 }
@@ -80,3 +184,19 @@ type MutableGetAccountNonceParams struct {
 func (s MutableGetAccountNonceParams) AgentID() wasmlib.ScMutableAgentID {
 	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
 }
+
+type ImmutableGetNFTDataParams struct {
+	id int32
+}
+
+func (s ImmutableGetNFTDataParams) NftColor() wasmlib.ScImmutableColor {
+	return wasmlib.NewScImmutableColor(s.id, ParamNftColor.KeyID())
+}
+
+type MutableGetNFTDataParams struct {
+	id int32
+}
+
+func (s MutableGetNFTDataParams) NftColor() wasmlib.ScMutableColor {
+	return wasmlib.NewScMutableColor(s.id, ParamNftColor.KeyID())
+}
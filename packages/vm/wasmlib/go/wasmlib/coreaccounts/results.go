@@ -9,6 +9,22 @@ package coreaccounts
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ImmutableMintNFTResults struct {
+	id int32
+}
+
+func (s ImmutableMintNFTResults) NftColor() wasmlib.ScImmutableColor {
+	return wasmlib.NewScImmutableColor(s.id, ResultNftColor.KeyID())
+}
+
+type MutableMintNFTResults struct {
+	id int32
+}
+
+func (s MutableMintNFTResults) NftColor() wasmlib.ScMutableColor {
+	return wasmlib.NewScMutableColor(s.id, ResultNftColor.KeyID())
+}
+
 type MapAgentIDToImmutableBytes struct {
 	objID int32
 }
@@ -25,6 +41,14 @@ func (s ImmutableAccountsResults) Agents() MapAgentIDToImmutableBytes {
 	return MapAgentIDToImmutableBytes{objID: s.id}
 }
 
+func (s ImmutableAccountsResults) NextCursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s ImmutableAccountsResults) Truncated() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type MapAgentIDToMutableBytes struct {
 	objID int32
 }
@@ -45,6 +69,14 @@ func (s MutableAccountsResults) Agents() MapAgentIDToMutableBytes {
 	return MapAgentIDToMutableBytes{objID: s.id}
 }
 
+func (s MutableAccountsResults) NextCursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s MutableAccountsResults) Truncated() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type MapColorToImmutableInt64 struct {
 	objID int32
 }
@@ -81,6 +113,42 @@ func (s MutableBalanceResults) Balances() MapColorToMutableInt64 {
 	return MapColorToMutableInt64{objID: s.id}
 }
 
+type MapColorToImmutableBytes struct {
+	objID int32
+}
+
+func (m MapColorToImmutableBytes) GetBytes(key wasmlib.ScColor) wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(m.objID, key.KeyID())
+}
+
+type ImmutableGetAccountNFTsResults struct {
+	id int32
+}
+
+func (s ImmutableGetAccountNFTsResults) NftColors() MapColorToImmutableBytes {
+	return MapColorToImmutableBytes{objID: s.id}
+}
+
+type MapColorToMutableBytes struct {
+	objID int32
+}
+
+func (m MapColorToMutableBytes) Clear() {
+	wasmlib.Clear(m.objID)
+}
+
+func (m MapColorToMutableBytes) GetBytes(key wasmlib.ScColor) wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(m.objID, key.KeyID())
+}
+
+type MutableGetAccountNFTsResults struct {
+	id int32
+}
+
+func (s MutableGetAccountNFTsResults) NftColors() MapColorToMutableBytes {
+	return MapColorToMutableBytes{objID: s.id}
+}
+
 type ImmutableGetAccountNonceResults struct {
 	id int32
 }
@@ -97,6 +165,38 @@ func (s MutableGetAccountNonceResults) AccountNonce() wasmlib.ScMutableInt64 {
 	return wasmlib.NewScMutableInt64(s.id, ResultAccountNonce.KeyID())
 }
 
+type ImmutableGetNFTDataResults struct {
+	id int32
+}
+
+func (s ImmutableGetNFTDataResults) NftIssuer() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ResultNftIssuer.KeyID())
+}
+
+func (s ImmutableGetNFTDataResults) NftMetadata() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ResultNftMetadata.KeyID())
+}
+
+func (s ImmutableGetNFTDataResults) NftOwner() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ResultNftOwner.KeyID())
+}
+
+type MutableGetNFTDataResults struct {
+	id int32
+}
+
+func (s MutableGetNFTDataResults) NftIssuer() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ResultNftIssuer.KeyID())
+}
+
+func (s MutableGetNFTDataResults) NftMetadata() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ResultNftMetadata.KeyID())
+}
+
+func (s MutableGetNFTDataResults) NftOwner() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ResultNftOwner.KeyID())
+}
+
 type ImmutableTotalAssetsResults struct {
 	id int32
 }
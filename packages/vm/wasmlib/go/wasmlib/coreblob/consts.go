@@ -16,25 +16,45 @@ const (
 )
 
 const (
-	ParamField = wasmlib.Key("field")
-	ParamHash  = wasmlib.Key("hash")
+	ParamBytes  = wasmlib.Key("bytes")
+	ParamCursor = wasmlib.Key("cursor")
+	ParamField  = wasmlib.Key("field")
+	ParamHash   = wasmlib.Key("hash")
+	ParamIndex  = wasmlib.Key("index")
+	ParamLimit  = wasmlib.Key("limit")
+	ParamOwner  = wasmlib.Key("owner")
 )
 
 const (
-	ResultBytes = wasmlib.Key("bytes")
-	ResultHash  = wasmlib.Key("hash")
+	ResultBytes      = wasmlib.Key("bytes")
+	ResultHash       = wasmlib.Key("hash")
+	ResultNextCursor = wasmlib.Key("nextCursor")
+	ResultOwner      = wasmlib.Key("owner")
+	ResultTruncated  = wasmlib.Key("truncated")
 )
 
 const (
-	FuncStoreBlob    = "storeBlob"
-	ViewGetBlobField = "getBlobField"
-	ViewGetBlobInfo  = "getBlobInfo"
-	ViewListBlobs    = "listBlobs"
+	FuncAbortBlobUpload     = "abortBlobUpload"
+	FuncDeleteBlob          = "deleteBlob"
+	FuncFinishBlobUpload    = "finishBlobUpload"
+	FuncStoreBlob           = "storeBlob"
+	FuncStoreBlobChunk      = "storeBlobChunk"
+	ViewGetBlobField        = "getBlobField"
+	ViewGetBlobInfo         = "getBlobInfo"
+	ViewGetBlobOwner        = "getBlobOwner"
+	ViewGetBlobUploadStatus = "getBlobUploadStatus"
+	ViewListBlobs           = "listBlobs"
 )
 
 const (
-	HFuncStoreBlob    = wasmlib.ScHname(0xddd4c281)
-	HViewGetBlobField = wasmlib.ScHname(0x1f448130)
-	HViewGetBlobInfo  = wasmlib.ScHname(0xfde4ab46)
-	HViewListBlobs    = wasmlib.ScHname(0x62ca7990)
+	HFuncAbortBlobUpload     = wasmlib.ScHname(0x551f4a0e)
+	HFuncDeleteBlob          = wasmlib.ScHname(0xcae606c1)
+	HFuncFinishBlobUpload    = wasmlib.ScHname(0xcedd5ec2)
+	HFuncStoreBlob           = wasmlib.ScHname(0xddd4c281)
+	HFuncStoreBlobChunk      = wasmlib.ScHname(0x62a6d849)
+	HViewGetBlobField        = wasmlib.ScHname(0x1f448130)
+	HViewGetBlobInfo         = wasmlib.ScHname(0xfde4ab46)
+	HViewGetBlobOwner        = wasmlib.ScHname(0xefc4d2da)
+	HViewGetBlobUploadStatus = wasmlib.ScHname(0xa72cb02e)
+	HViewListBlobs           = wasmlib.ScHname(0x62ca7990)
 )
@@ -9,12 +9,33 @@ package coreblob
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type AbortBlobUploadCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableAbortBlobUploadParams
+}
+
+type DeleteBlobCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableDeleteBlobParams
+}
+
+type FinishBlobUploadCall struct {
+	Func    *wasmlib.ScFunc
+	Params  MutableFinishBlobUploadParams
+	Results ImmutableFinishBlobUploadResults
+}
+
 type StoreBlobCall struct {
 	Func    *wasmlib.ScFunc
 	Params  MutableStoreBlobParams
 	Results ImmutableStoreBlobResults
 }
 
+type StoreBlobChunkCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableStoreBlobChunkParams
+}
+
 type GetBlobFieldCall struct {
 	Func    *wasmlib.ScView
 	Params  MutableGetBlobFieldParams
@@ -27,8 +48,21 @@ type GetBlobInfoCall struct {
 	Results ImmutableGetBlobInfoResults
 }
 
+type GetBlobOwnerCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetBlobOwnerParams
+	Results ImmutableGetBlobOwnerResults
+}
+
+type GetBlobUploadStatusCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetBlobUploadStatusParams
+	Results ImmutableGetBlobUploadStatusResults
+}
+
 type ListBlobsCall struct {
 	Func    *wasmlib.ScView
+	Params  MutableListBlobsParams
 	Results ImmutableListBlobsResults
 }
 
@@ -36,12 +70,36 @@ type Funcs struct{}
 
 var ScFuncs Funcs
 
+func (sc Funcs) AbortBlobUpload(ctx wasmlib.ScFuncCallContext) *AbortBlobUploadCall {
+	f := &AbortBlobUploadCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncAbortBlobUpload)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) DeleteBlob(ctx wasmlib.ScFuncCallContext) *DeleteBlobCall {
+	f := &DeleteBlobCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncDeleteBlob)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
+func (sc Funcs) FinishBlobUpload(ctx wasmlib.ScFuncCallContext) *FinishBlobUploadCall {
+	f := &FinishBlobUploadCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncFinishBlobUpload)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) StoreBlob(ctx wasmlib.ScFuncCallContext) *StoreBlobCall {
 	f := &StoreBlobCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncStoreBlob)}
 	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
+func (sc Funcs) StoreBlobChunk(ctx wasmlib.ScFuncCallContext) *StoreBlobChunkCall {
+	f := &StoreBlobChunkCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncStoreBlobChunk)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) GetBlobField(ctx wasmlib.ScViewCallContext) *GetBlobFieldCall {
 	f := &GetBlobFieldCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetBlobField)}
 	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
@@ -54,16 +112,34 @@ func (sc Funcs) GetBlobInfo(ctx wasmlib.ScViewCallContext) *GetBlobInfoCall {
 	return f
 }
 
+func (sc Funcs) GetBlobOwner(ctx wasmlib.ScViewCallContext) *GetBlobOwnerCall {
+	f := &GetBlobOwnerCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetBlobOwner)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
+func (sc Funcs) GetBlobUploadStatus(ctx wasmlib.ScViewCallContext) *GetBlobUploadStatusCall {
+	f := &GetBlobUploadStatusCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetBlobUploadStatus)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) ListBlobs(ctx wasmlib.ScViewCallContext) *ListBlobsCall {
 	f := &ListBlobsCall{Func: wasmlib.NewScView(ctx, HScName, HViewListBlobs)}
-	f.Func.SetPtrs(nil, &f.Results.id)
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
 func OnLoad() {
 	exports := wasmlib.NewScExports()
+	exports.AddFunc(FuncAbortBlobUpload, wasmlib.FuncError)
+	exports.AddFunc(FuncDeleteBlob, wasmlib.FuncError)
+	exports.AddFunc(FuncFinishBlobUpload, wasmlib.FuncError)
 	exports.AddFunc(FuncStoreBlob, wasmlib.FuncError)
+	exports.AddFunc(FuncStoreBlobChunk, wasmlib.FuncError)
 	exports.AddView(ViewGetBlobField, wasmlib.ViewError)
 	exports.AddView(ViewGetBlobInfo, wasmlib.ViewError)
+	exports.AddView(ViewGetBlobOwner, wasmlib.ViewError)
+	exports.AddView(ViewGetBlobUploadStatus, wasmlib.ViewError)
 	exports.AddView(ViewListBlobs, wasmlib.ViewError)
 }
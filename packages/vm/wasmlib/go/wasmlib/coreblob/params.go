@@ -9,6 +9,54 @@ package coreblob
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ImmutableAbortBlobUploadParams struct {
+	id int32
+}
+
+func (s ImmutableAbortBlobUploadParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+type MutableAbortBlobUploadParams struct {
+	id int32
+}
+
+func (s MutableAbortBlobUploadParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
+type ImmutableDeleteBlobParams struct {
+	id int32
+}
+
+func (s ImmutableDeleteBlobParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+type MutableDeleteBlobParams struct {
+	id int32
+}
+
+func (s MutableDeleteBlobParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
+type ImmutableFinishBlobUploadParams struct {
+	id int32
+}
+
+func (s ImmutableFinishBlobUploadParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+type MutableFinishBlobUploadParams struct {
+	id int32
+}
+
+func (s MutableFinishBlobUploadParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
 type MapStringToImmutableBytes struct {
 	objID int32
 }
@@ -45,6 +93,46 @@ func (s MutableStoreBlobParams) Blobs() MapStringToMutableBytes {
 	return MapStringToMutableBytes{objID: s.id}
 }
 
+type ImmutableStoreBlobChunkParams struct {
+	id int32
+}
+
+func (s ImmutableStoreBlobChunkParams) Bytes() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamBytes.KeyID())
+}
+
+func (s ImmutableStoreBlobChunkParams) Field() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, ParamField.KeyID())
+}
+
+func (s ImmutableStoreBlobChunkParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+func (s ImmutableStoreBlobChunkParams) Index() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, ParamIndex.KeyID())
+}
+
+type MutableStoreBlobChunkParams struct {
+	id int32
+}
+
+func (s MutableStoreBlobChunkParams) Bytes() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamBytes.KeyID())
+}
+
+func (s MutableStoreBlobChunkParams) Field() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, ParamField.KeyID())
+}
+
+func (s MutableStoreBlobChunkParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
+func (s MutableStoreBlobChunkParams) Index() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, ParamIndex.KeyID())
+}
+
 type ImmutableGetBlobFieldParams struct {
 	id int32
 }
@@ -84,3 +172,67 @@ type MutableGetBlobInfoParams struct {
 func (s MutableGetBlobInfoParams) Hash() wasmlib.ScMutableHash {
 	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
 }
+
+type ImmutableGetBlobOwnerParams struct {
+	id int32
+}
+
+func (s ImmutableGetBlobOwnerParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+type MutableGetBlobOwnerParams struct {
+	id int32
+}
+
+func (s MutableGetBlobOwnerParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
+type ImmutableGetBlobUploadStatusParams struct {
+	id int32
+}
+
+func (s ImmutableGetBlobUploadStatusParams) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ParamHash.KeyID())
+}
+
+func (s ImmutableGetBlobUploadStatusParams) Owner() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamOwner.KeyID())
+}
+
+type MutableGetBlobUploadStatusParams struct {
+	id int32
+}
+
+func (s MutableGetBlobUploadStatusParams) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ParamHash.KeyID())
+}
+
+func (s MutableGetBlobUploadStatusParams) Owner() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamOwner.KeyID())
+}
+
+type ImmutableListBlobsParams struct {
+	id int32
+}
+
+func (s ImmutableListBlobsParams) Cursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s ImmutableListBlobsParams) Limit() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, ParamLimit.KeyID())
+}
+
+type MutableListBlobsParams struct {
+	id int32
+}
+
+func (s MutableListBlobsParams) Cursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s MutableListBlobsParams) Limit() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, ParamLimit.KeyID())
+}
@@ -9,6 +9,22 @@ package coreblob
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ImmutableFinishBlobUploadResults struct {
+	id int32
+}
+
+func (s ImmutableFinishBlobUploadResults) Hash() wasmlib.ScImmutableHash {
+	return wasmlib.NewScImmutableHash(s.id, ResultHash.KeyID())
+}
+
+type MutableFinishBlobUploadResults struct {
+	id int32
+}
+
+func (s MutableFinishBlobUploadResults) Hash() wasmlib.ScMutableHash {
+	return wasmlib.NewScMutableHash(s.id, ResultHash.KeyID())
+}
+
 type ImmutableStoreBlobResults struct {
 	id int32
 }
@@ -77,6 +93,38 @@ func (s MutableGetBlobInfoResults) BlobSizes() MapStringToMutableInt32 {
 	return MapStringToMutableInt32{objID: s.id}
 }
 
+type ImmutableGetBlobOwnerResults struct {
+	id int32
+}
+
+func (s ImmutableGetBlobOwnerResults) Owner() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ResultOwner.KeyID())
+}
+
+type MutableGetBlobOwnerResults struct {
+	id int32
+}
+
+func (s MutableGetBlobOwnerResults) Owner() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ResultOwner.KeyID())
+}
+
+type ImmutableGetBlobUploadStatusResults struct {
+	id int32
+}
+
+func (s ImmutableGetBlobUploadStatusResults) ChunkCounts() MapStringToImmutableInt32 {
+	return MapStringToImmutableInt32{objID: s.id}
+}
+
+type MutableGetBlobUploadStatusResults struct {
+	id int32
+}
+
+func (s MutableGetBlobUploadStatusResults) ChunkCounts() MapStringToMutableInt32 {
+	return MapStringToMutableInt32{objID: s.id}
+}
+
 type MapHashToImmutableInt32 struct {
 	objID int32
 }
@@ -93,6 +141,14 @@ func (s ImmutableListBlobsResults) BlobSizes() MapHashToImmutableInt32 {
 	return MapHashToImmutableInt32{objID: s.id}
 }
 
+func (s ImmutableListBlobsResults) NextCursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s ImmutableListBlobsResults) Truncated() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type MapHashToMutableInt32 struct {
 	objID int32
 }
@@ -112,3 +168,11 @@ type MutableListBlobsResults struct {
 func (s MutableListBlobsResults) BlobSizes() MapHashToMutableInt32 {
 	return MapHashToMutableInt32{objID: s.id}
 }
+
+func (s MutableListBlobsResults) NextCursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s MutableListBlobsResults) Truncated() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, ResultTruncated.KeyID())
+}
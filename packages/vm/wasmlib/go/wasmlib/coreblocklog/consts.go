@@ -18,7 +18,9 @@ const (
 const (
 	ParamBlockIndex    = wasmlib.Key("n")
 	ParamContractHname = wasmlib.Key("h")
+	ParamCursor        = wasmlib.Key("cursor")
 	ParamFromBlock     = wasmlib.Key("f")
+	ParamLimit         = wasmlib.Key("limit")
 	ParamRequestID     = wasmlib.Key("u")
 	ParamToBlock       = wasmlib.Key("t")
 )
@@ -28,11 +30,13 @@ const (
 	ResultBlockInfo              = wasmlib.Key("i")
 	ResultEvent                  = wasmlib.Key("e")
 	ResultGoverningAddress       = wasmlib.Key("g")
+	ResultNextCursor             = wasmlib.Key("nextCursor")
 	ResultRequestID              = wasmlib.Key("u")
 	ResultRequestIndex           = wasmlib.Key("r")
 	ResultRequestProcessed       = wasmlib.Key("p")
 	ResultRequestRecord          = wasmlib.Key("d")
 	ResultStateControllerAddress = wasmlib.Key("s")
+	ResultTruncated              = wasmlib.Key("truncated")
 )
 
 const (
@@ -49,10 +49,18 @@ func (s ImmutableGetEventsForContractParams) ContractHname() wasmlib.ScImmutable
 	return wasmlib.NewScImmutableHname(s.id, ParamContractHname.KeyID())
 }
 
+func (s ImmutableGetEventsForContractParams) Cursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamCursor.KeyID())
+}
+
 func (s ImmutableGetEventsForContractParams) FromBlock() wasmlib.ScImmutableInt32 {
 	return wasmlib.NewScImmutableInt32(s.id, ParamFromBlock.KeyID())
 }
 
+func (s ImmutableGetEventsForContractParams) Limit() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, ParamLimit.KeyID())
+}
+
 func (s ImmutableGetEventsForContractParams) ToBlock() wasmlib.ScImmutableInt32 {
 	return wasmlib.NewScImmutableInt32(s.id, ParamToBlock.KeyID())
 }
@@ -65,10 +73,18 @@ func (s MutableGetEventsForContractParams) ContractHname() wasmlib.ScMutableHnam
 	return wasmlib.NewScMutableHname(s.id, ParamContractHname.KeyID())
 }
 
+func (s MutableGetEventsForContractParams) Cursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamCursor.KeyID())
+}
+
 func (s MutableGetEventsForContractParams) FromBlock() wasmlib.ScMutableInt32 {
 	return wasmlib.NewScMutableInt32(s.id, ParamFromBlock.KeyID())
 }
 
+func (s MutableGetEventsForContractParams) Limit() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, ParamLimit.KeyID())
+}
+
 func (s MutableGetEventsForContractParams) ToBlock() wasmlib.ScMutableInt32 {
 	return wasmlib.NewScMutableInt32(s.id, ParamToBlock.KeyID())
 }
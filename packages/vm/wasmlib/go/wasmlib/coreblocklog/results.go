@@ -112,6 +112,14 @@ func (s ImmutableGetEventsForContractResults) Event() ArrayOfImmutableBytes {
 	return ArrayOfImmutableBytes{objID: arrID}
 }
 
+func (s ImmutableGetEventsForContractResults) NextCursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s ImmutableGetEventsForContractResults) Truncated() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type MutableGetEventsForContractResults struct {
 	id int32
 }
@@ -121,6 +129,14 @@ func (s MutableGetEventsForContractResults) Event() ArrayOfMutableBytes {
 	return ArrayOfMutableBytes{objID: arrID}
 }
 
+func (s MutableGetEventsForContractResults) NextCursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s MutableGetEventsForContractResults) Truncated() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type ImmutableGetEventsForRequestResults struct {
 	id int32
 }
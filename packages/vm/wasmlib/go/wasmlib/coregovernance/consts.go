@@ -16,6 +16,8 @@ const (
 )
 
 const (
+	ParamAclList                = wasmlib.Key("al")
+	ParamAgentID                = wasmlib.Key("ag")
 	ParamChainOwner             = wasmlib.Key("oi")
 	ParamFeeColor               = wasmlib.Key("fc")
 	ParamHname                  = wasmlib.Key("hn")
@@ -28,6 +30,7 @@ const (
 )
 
 const (
+	ResultAclAgentIDs                     = wasmlib.Key("aa")
 	ResultAllowedStateControllerAddresses = wasmlib.Key("a")
 	ResultChainID                         = wasmlib.Key("c")
 	ResultChainOwnerID                    = wasmlib.Key("o")
@@ -44,13 +47,16 @@ const (
 
 const (
 	FuncAddAllowedStateControllerAddress    = "addAllowedStateControllerAddress"
+	FuncAddToACL                            = "addToACL"
 	FuncClaimChainOwnership                 = "claimChainOwnership"
 	FuncDelegateChainOwnership              = "delegateChainOwnership"
 	FuncRemoveAllowedStateControllerAddress = "removeAllowedStateControllerAddress"
+	FuncRemoveFromACL                       = "removeFromACL"
 	FuncRotateStateController               = "rotateStateController"
 	FuncSetChainInfo                        = "setChainInfo"
 	FuncSetContractFee                      = "setContractFee"
 	FuncSetDefaultFee                       = "setDefaultFee"
+	ViewGetACL                              = "getACL"
 	ViewGetAllowedStateControllerAddresses  = "getAllowedStateControllerAddresses"
 	ViewGetChainInfo                        = "getChainInfo"
 	ViewGetFeeInfo                          = "getFeeInfo"
@@ -59,13 +65,16 @@ const (
 
 const (
 	HFuncAddAllowedStateControllerAddress    = wasmlib.ScHname(0x9469d567)
+	HFuncAddToACL                            = wasmlib.ScHname(0xf9d77ccb)
 	HFuncClaimChainOwnership                 = wasmlib.ScHname(0x03ff0fc0)
 	HFuncDelegateChainOwnership              = wasmlib.ScHname(0x93ecb6ad)
 	HFuncRemoveAllowedStateControllerAddress = wasmlib.ScHname(0x31f69447)
+	HFuncRemoveFromACL                       = wasmlib.ScHname(0x67bc7a99)
 	HFuncRotateStateController               = wasmlib.ScHname(0x244d1038)
 	HFuncSetChainInfo                        = wasmlib.ScHname(0x702f5d2b)
 	HFuncSetContractFee                      = wasmlib.ScHname(0x8421a42b)
 	HFuncSetDefaultFee                       = wasmlib.ScHname(0x3310ecd0)
+	HViewGetACL                              = wasmlib.ScHname(0x8dd122e4)
 	HViewGetAllowedStateControllerAddresses  = wasmlib.ScHname(0xf3505183)
 	HViewGetChainInfo                        = wasmlib.ScHname(0x434477e2)
 	HViewGetFeeInfo                          = wasmlib.ScHname(0x9fe54b48)
@@ -14,6 +14,11 @@ type AddAllowedStateControllerAddressCall struct {
 	Params MutableAddAllowedStateControllerAddressParams
 }
 
+type AddToACLCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableAddToACLParams
+}
+
 type ClaimChainOwnershipCall struct {
 	Func *wasmlib.ScFunc
 }
@@ -28,6 +33,11 @@ type RemoveAllowedStateControllerAddressCall struct {
 	Params MutableRemoveAllowedStateControllerAddressParams
 }
 
+type RemoveFromACLCall struct {
+	Func   *wasmlib.ScFunc
+	Params MutableRemoveFromACLParams
+}
+
 type RotateStateControllerCall struct {
 	Func   *wasmlib.ScFunc
 	Params MutableRotateStateControllerParams
@@ -48,6 +58,12 @@ type SetDefaultFeeCall struct {
 	Params MutableSetDefaultFeeParams
 }
 
+type GetACLCall struct {
+	Func    *wasmlib.ScView
+	Params  MutableGetACLParams
+	Results ImmutableGetACLResults
+}
+
 type GetAllowedStateControllerAddressesCall struct {
 	Func    *wasmlib.ScView
 	Results ImmutableGetAllowedStateControllerAddressesResults
@@ -79,6 +95,12 @@ func (sc Funcs) AddAllowedStateControllerAddress(ctx wasmlib.ScFuncCallContext)
 	return f
 }
 
+func (sc Funcs) AddToACL(ctx wasmlib.ScFuncCallContext) *AddToACLCall {
+	f := &AddToACLCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncAddToACL)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) ClaimChainOwnership(ctx wasmlib.ScFuncCallContext) *ClaimChainOwnershipCall {
 	return &ClaimChainOwnershipCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncClaimChainOwnership)}
 }
@@ -95,6 +117,12 @@ func (sc Funcs) RemoveAllowedStateControllerAddress(ctx wasmlib.ScFuncCallContex
 	return f
 }
 
+func (sc Funcs) RemoveFromACL(ctx wasmlib.ScFuncCallContext) *RemoveFromACLCall {
+	f := &RemoveFromACLCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRemoveFromACL)}
+	f.Func.SetPtrs(&f.Params.id, nil)
+	return f
+}
+
 func (sc Funcs) RotateStateController(ctx wasmlib.ScFuncCallContext) *RotateStateControllerCall {
 	f := &RotateStateControllerCall{Func: wasmlib.NewScFunc(ctx, HScName, HFuncRotateStateController)}
 	f.Func.SetPtrs(&f.Params.id, nil)
@@ -119,6 +147,12 @@ func (sc Funcs) SetDefaultFee(ctx wasmlib.ScFuncCallContext) *SetDefaultFeeCall
 	return f
 }
 
+func (sc Funcs) GetACL(ctx wasmlib.ScViewCallContext) *GetACLCall {
+	f := &GetACLCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetACL)}
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
+	return f
+}
+
 func (sc Funcs) GetAllowedStateControllerAddresses(ctx wasmlib.ScViewCallContext) *GetAllowedStateControllerAddressesCall {
 	f := &GetAllowedStateControllerAddressesCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetAllowedStateControllerAddresses)}
 	f.Func.SetPtrs(nil, &f.Results.id)
@@ -146,13 +180,16 @@ func (sc Funcs) GetMaxBlobSize(ctx wasmlib.ScViewCallContext) *GetMaxBlobSizeCal
 func OnLoad() {
 	exports := wasmlib.NewScExports()
 	exports.AddFunc(FuncAddAllowedStateControllerAddress, wasmlib.FuncError)
+	exports.AddFunc(FuncAddToACL, wasmlib.FuncError)
 	exports.AddFunc(FuncClaimChainOwnership, wasmlib.FuncError)
 	exports.AddFunc(FuncDelegateChainOwnership, wasmlib.FuncError)
 	exports.AddFunc(FuncRemoveAllowedStateControllerAddress, wasmlib.FuncError)
+	exports.AddFunc(FuncRemoveFromACL, wasmlib.FuncError)
 	exports.AddFunc(FuncRotateStateController, wasmlib.FuncError)
 	exports.AddFunc(FuncSetChainInfo, wasmlib.FuncError)
 	exports.AddFunc(FuncSetContractFee, wasmlib.FuncError)
 	exports.AddFunc(FuncSetDefaultFee, wasmlib.FuncError)
+	exports.AddView(ViewGetACL, wasmlib.ViewError)
 	exports.AddView(ViewGetAllowedStateControllerAddresses, wasmlib.ViewError)
 	exports.AddView(ViewGetChainInfo, wasmlib.ViewError)
 	exports.AddView(ViewGetFeeInfo, wasmlib.ViewError)
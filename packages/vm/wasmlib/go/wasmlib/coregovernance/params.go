@@ -41,6 +41,38 @@ func (s MutableAddAllowedStateControllerAddressParams) StateControllerAddress()
 	return wasmlib.NewScMutableAddress(s.id, ParamStateControllerAddress.KeyID())
 }
 
+type ImmutableAddToACLParams struct {
+	id int32
+}
+
+func (s ImmutableAddToACLParams) AclList() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s ImmutableAddToACLParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s ImmutableAddToACLParams) Hname() wasmlib.ScImmutableHname {
+	return wasmlib.NewScImmutableHname(s.id, ParamHname.KeyID())
+}
+
+type MutableAddToACLParams struct {
+	id int32
+}
+
+func (s MutableAddToACLParams) AclList() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s MutableAddToACLParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s MutableAddToACLParams) Hname() wasmlib.ScMutableHname {
+	return wasmlib.NewScMutableHname(s.id, ParamHname.KeyID())
+}
+
 type ImmutableDelegateChainOwnershipParams struct {
 	id int32
 }
@@ -73,6 +105,38 @@ func (s MutableRemoveAllowedStateControllerAddressParams) StateControllerAddress
 	return wasmlib.NewScMutableAddress(s.id, ParamStateControllerAddress.KeyID())
 }
 
+type ImmutableRemoveFromACLParams struct {
+	id int32
+}
+
+func (s ImmutableRemoveFromACLParams) AclList() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s ImmutableRemoveFromACLParams) AgentID() wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s ImmutableRemoveFromACLParams) Hname() wasmlib.ScImmutableHname {
+	return wasmlib.NewScImmutableHname(s.id, ParamHname.KeyID())
+}
+
+type MutableRemoveFromACLParams struct {
+	id int32
+}
+
+func (s MutableRemoveFromACLParams) AclList() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s MutableRemoveFromACLParams) AgentID() wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(s.id, ParamAgentID.KeyID())
+}
+
+func (s MutableRemoveFromACLParams) Hname() wasmlib.ScMutableHname {
+	return wasmlib.NewScMutableHname(s.id, ParamHname.KeyID())
+}
+
 type ImmutableRotateStateControllerParams struct {
 	id int32
 }
@@ -193,6 +257,30 @@ func (s MutableSetDefaultFeeParams) ValidatorFee() wasmlib.ScMutableInt64 {
 	return wasmlib.NewScMutableInt64(s.id, ParamValidatorFee.KeyID())
 }
 
+type ImmutableGetACLParams struct {
+	id int32
+}
+
+func (s ImmutableGetACLParams) AclList() wasmlib.ScImmutableString {
+	return wasmlib.NewScImmutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s ImmutableGetACLParams) Hname() wasmlib.ScImmutableHname {
+	return wasmlib.NewScImmutableHname(s.id, ParamHname.KeyID())
+}
+
+type MutableGetACLParams struct {
+	id int32
+}
+
+func (s MutableGetACLParams) AclList() wasmlib.ScMutableString {
+	return wasmlib.NewScMutableString(s.id, ParamAclList.KeyID())
+}
+
+func (s MutableGetACLParams) Hname() wasmlib.ScMutableHname {
+	return wasmlib.NewScMutableHname(s.id, ParamHname.KeyID())
+}
+
 type ImmutableGetFeeInfoParams struct {
 	id int32
 }
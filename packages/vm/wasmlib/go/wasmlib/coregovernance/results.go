@@ -9,6 +9,52 @@ package coregovernance
 
 import "github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
 
+type ArrayOfImmutableAgentID struct {
+	objID int32
+}
+
+func (a ArrayOfImmutableAgentID) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfImmutableAgentID) GetAgentID(index int32) wasmlib.ScImmutableAgentID {
+	return wasmlib.NewScImmutableAgentID(a.objID, wasmlib.Key32(index))
+}
+
+type ImmutableGetACLResults struct {
+	id int32
+}
+
+func (s ImmutableGetACLResults) AclAgentIDs() ArrayOfImmutableAgentID {
+	arrID := wasmlib.GetObjectID(s.id, ResultAclAgentIDs.KeyID(), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_AGENT_ID)
+	return ArrayOfImmutableAgentID{objID: arrID}
+}
+
+type ArrayOfMutableAgentID struct {
+	objID int32
+}
+
+func (a ArrayOfMutableAgentID) Clear() {
+	wasmlib.Clear(a.objID)
+}
+
+func (a ArrayOfMutableAgentID) Length() int32 {
+	return wasmlib.GetLength(a.objID)
+}
+
+func (a ArrayOfMutableAgentID) GetAgentID(index int32) wasmlib.ScMutableAgentID {
+	return wasmlib.NewScMutableAgentID(a.objID, wasmlib.Key32(index))
+}
+
+type MutableGetACLResults struct {
+	id int32
+}
+
+func (s MutableGetACLResults) AclAgentIDs() ArrayOfMutableAgentID {
+	arrID := wasmlib.GetObjectID(s.id, ResultAclAgentIDs.KeyID(), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_AGENT_ID)
+	return ArrayOfMutableAgentID{objID: arrID}
+}
+
 type ArrayOfImmutableBytes struct {
 	objID int32
 }
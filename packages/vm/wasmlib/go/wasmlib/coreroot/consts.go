@@ -16,9 +16,11 @@ const (
 )
 
 const (
+	ParamCursor      = wasmlib.Key("cursor")
 	ParamDeployer    = wasmlib.Key("dp")
 	ParamDescription = wasmlib.Key("ds")
 	ParamHname       = wasmlib.Key("hn")
+	ParamLimit       = wasmlib.Key("limit")
 	ParamName        = wasmlib.Key("nm")
 	ParamProgramHash = wasmlib.Key("ph")
 )
@@ -27,6 +29,8 @@ const (
 	ResultContractFound    = wasmlib.Key("cf")
 	ResultContractRecData  = wasmlib.Key("dt")
 	ResultContractRegistry = wasmlib.Key("r")
+	ResultNextCursor       = wasmlib.Key("nextCursor")
+	ResultTruncated        = wasmlib.Key("truncated")
 )
 
 const (
@@ -32,6 +32,7 @@ type FindContractCall struct {
 
 type GetContractRecordsCall struct {
 	Func    *wasmlib.ScView
+	Params  MutableGetContractRecordsParams
 	Results ImmutableGetContractRecordsResults
 }
 
@@ -65,7 +66,7 @@ func (sc Funcs) FindContract(ctx wasmlib.ScViewCallContext) *FindContractCall {
 
 func (sc Funcs) GetContractRecords(ctx wasmlib.ScViewCallContext) *GetContractRecordsCall {
 	f := &GetContractRecordsCall{Func: wasmlib.NewScView(ctx, HScName, HViewGetContractRecords)}
-	f.Func.SetPtrs(nil, &f.Results.id)
+	f.Func.SetPtrs(&f.Params.id, &f.Results.id)
 	return f
 }
 
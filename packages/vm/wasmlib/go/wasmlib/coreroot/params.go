@@ -88,3 +88,27 @@ type MutableFindContractParams struct {
 func (s MutableFindContractParams) Hname() wasmlib.ScMutableHname {
 	return wasmlib.NewScMutableHname(s.id, ParamHname.KeyID())
 }
+
+type ImmutableGetContractRecordsParams struct {
+	id int32
+}
+
+func (s ImmutableGetContractRecordsParams) Cursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s ImmutableGetContractRecordsParams) Limit() wasmlib.ScImmutableInt32 {
+	return wasmlib.NewScImmutableInt32(s.id, ParamLimit.KeyID())
+}
+
+type MutableGetContractRecordsParams struct {
+	id int32
+}
+
+func (s MutableGetContractRecordsParams) Cursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ParamCursor.KeyID())
+}
+
+func (s MutableGetContractRecordsParams) Limit() wasmlib.ScMutableInt32 {
+	return wasmlib.NewScMutableInt32(s.id, ParamLimit.KeyID())
+}
@@ -50,6 +50,14 @@ func (s ImmutableGetContractRecordsResults) ContractRegistry() MapHnameToImmutab
 	return MapHnameToImmutableBytes{objID: mapID}
 }
 
+func (s ImmutableGetContractRecordsResults) NextCursor() wasmlib.ScImmutableBytes {
+	return wasmlib.NewScImmutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s ImmutableGetContractRecordsResults) Truncated() wasmlib.ScImmutableBool {
+	return wasmlib.NewScImmutableBool(s.id, ResultTruncated.KeyID())
+}
+
 type MapHnameToMutableBytes struct {
 	objID int32
 }
@@ -70,3 +78,11 @@ func (s MutableGetContractRecordsResults) ContractRegistry() MapHnameToMutableBy
 	mapID := wasmlib.GetObjectID(s.id, ResultContractRegistry.KeyID(), wasmlib.TYPE_MAP)
 	return MapHnameToMutableBytes{objID: mapID}
 }
+
+func (s MutableGetContractRecordsResults) NextCursor() wasmlib.ScMutableBytes {
+	return wasmlib.NewScMutableBytes(s.id, ResultNextCursor.KeyID())
+}
+
+func (s MutableGetContractRecordsResults) Truncated() wasmlib.ScMutableBool {
+	return wasmlib.NewScMutableBool(s.id, ResultTruncated.KeyID())
+}
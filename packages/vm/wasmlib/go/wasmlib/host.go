@@ -25,6 +25,15 @@ const (
 	TYPE_MAP        int32 = 11
 	TYPE_REQUEST_ID int32 = 12
 	TYPE_STRING     int32 = 13
+	TYPE_BOOL       int32 = 14
+	TYPE_INT8       int32 = 15
+
+	// extended types have the 0x80 bit set, the other bits are taken
+	TYPE_UINT8   int32 = 0x80
+	TYPE_UINT16  int32 = 0x81
+	TYPE_UINT32  int32 = 0x82
+	TYPE_UINT64  int32 = 0x83
+	TYPE_BIG_INT int32 = 0x84
 
 	OBJ_ID_NULL    int32 = 0
 	OBJ_ID_ROOT    int32 = 1
@@ -33,7 +42,13 @@ const (
 	OBJ_ID_RESULTS int32 = 4
 )
 
-var TypeSizes = [...]uint8{0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0}
+// TypeSizes holds the size in bytes of the basic types followed by the extended types
+var TypeSizes = [...]uint8{0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0, 1, 1, 1, 2, 4, 8, 0}
+
+// TypeSize returns the size in bytes of the type, or zero for variable-sized types
+func TypeSize(typeID int32) uint8 {
+	return TypeSizes[(typeID&0x0f)|((typeID&0x80)>>3)]
+}
 
 type (
 	ScFuncContextFunction func(ScFuncContext)
@@ -85,7 +100,7 @@ func Exists(objID int32, keyID Key32, typeID int32) bool {
 func GetBytes(objID int32, keyID Key32, typeID int32) []byte {
 	bytes := host.GetBytes(objID, int32(keyID), typeID)
 	if len(bytes) == 0 {
-		return make([]byte, TypeSizes[typeID])
+		return make([]byte, TypeSize(typeID))
 	}
 	return bytes
 }
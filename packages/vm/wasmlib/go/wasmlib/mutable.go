@@ -110,6 +110,109 @@ func (o ScMutableAgentIDArray) Length() int32 {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+type ScMutableBigInt struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableBigInt(objID int32, keyID Key32) ScMutableBigInt {
+	return ScMutableBigInt{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableBigInt) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_BIG_INT)
+}
+
+func (o ScMutableBigInt) SetValue(value ScBigInt) {
+	SetBytes(o.objID, o.keyID, TYPE_BIG_INT, value.Bytes())
+}
+
+func (o ScMutableBigInt) String() string {
+	return o.Value().String()
+}
+
+func (o ScMutableBigInt) Value() ScBigInt {
+	return NewScBigIntFromBytes(GetBytes(o.objID, o.keyID, TYPE_BIG_INT))
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableBigIntArray struct {
+	objID int32
+}
+
+func (o ScMutableBigIntArray) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableBigIntArray) GetBigInt(index int32) ScMutableBigInt {
+	return ScMutableBigInt{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableBigIntArray) Immutable() ScImmutableBigIntArray {
+	return ScImmutableBigIntArray(o)
+}
+
+func (o ScMutableBigIntArray) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableBool struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableBool(objID int32, keyID Key32) ScMutableBool {
+	return ScMutableBool{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableBool) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_BOOL)
+}
+
+func (o ScMutableBool) SetValue(value bool) {
+	bytes := []byte{0}
+	if value {
+		bytes[0] = 1
+	}
+	SetBytes(o.objID, o.keyID, TYPE_BOOL, bytes)
+}
+
+func (o ScMutableBool) String() string {
+	return strconv.FormatBool(o.Value())
+}
+
+func (o ScMutableBool) Value() bool {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_BOOL)
+	return bytes[0] != 0
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableBoolArray struct {
+	objID int32
+}
+
+func (o ScMutableBoolArray) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableBoolArray) GetBool(index int32) ScMutableBool {
+	return ScMutableBool{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableBoolArray) Immutable() ScImmutableBoolArray {
+	return ScImmutableBoolArray(o)
+}
+
+func (o ScMutableBoolArray) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 type ScMutableBytes struct {
 	objID int32
 	keyID Key32
@@ -355,6 +458,56 @@ func (o ScMutableHnameArray) Length() int32 {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+type ScMutableInt8 struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableInt8(objID int32, keyID Key32) ScMutableInt8 {
+	return ScMutableInt8{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableInt8) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_INT8)
+}
+
+func (o ScMutableInt8) SetValue(value int8) {
+	SetBytes(o.objID, o.keyID, TYPE_INT8, []byte{byte(value)})
+}
+
+func (o ScMutableInt8) String() string {
+	return strconv.FormatInt(int64(o.Value()), 10)
+}
+
+func (o ScMutableInt8) Value() int8 {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_INT8)
+	return int8(bytes[0])
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableInt8Array struct {
+	objID int32
+}
+
+func (o ScMutableInt8Array) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableInt8Array) GetInt8(index int32) ScMutableInt8 {
+	return ScMutableInt8{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableInt8Array) Immutable() ScImmutableInt8Array {
+	return ScImmutableInt8Array(o)
+}
+
+func (o ScMutableInt8Array) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 type ScMutableInt16 struct {
 	objID int32
 	keyID Key32
@@ -546,6 +699,24 @@ func (o ScMutableMap) GetAgentIDArray(key MapKey) ScMutableAgentIDArray {
 	return ScMutableAgentIDArray{objID: arrID}
 }
 
+func (o ScMutableMap) GetBigInt(key MapKey) ScMutableBigInt {
+	return ScMutableBigInt{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetBigIntArray(key MapKey) ScMutableBigIntArray {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_BIG_INT|TYPE_ARRAY)
+	return ScMutableBigIntArray{objID: arrID}
+}
+
+func (o ScMutableMap) GetBool(key MapKey) ScMutableBool {
+	return ScMutableBool{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetBoolArray(key MapKey) ScMutableBoolArray {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_BOOL|TYPE_ARRAY)
+	return ScMutableBoolArray{objID: arrID}
+}
+
 func (o ScMutableMap) GetBytes(key MapKey) ScMutableBytes {
 	return ScMutableBytes{objID: o.objID, keyID: key.KeyID()}
 }
@@ -591,6 +762,15 @@ func (o ScMutableMap) GetHnameArray(key MapKey) ScMutableHnameArray {
 	return ScMutableHnameArray{objID: arrID}
 }
 
+func (o ScMutableMap) GetInt8(key MapKey) ScMutableInt8 {
+	return ScMutableInt8{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetInt8Array(key MapKey) ScMutableInt8Array {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_INT8|TYPE_ARRAY)
+	return ScMutableInt8Array{objID: arrID}
+}
+
 func (o ScMutableMap) GetInt16(key MapKey) ScMutableInt16 {
 	return ScMutableInt16{objID: o.objID, keyID: key.KeyID()}
 }
@@ -628,6 +808,10 @@ func (o ScMutableMap) GetMapArray(key MapKey) ScMutableMapArray {
 	return ScMutableMapArray{objID: arrID}
 }
 
+func (o ScMutableMap) GetOrderedMap(key MapKey) ScMutableOrderedMap {
+	return ScMutableOrderedMap{ScImmutableOrderedMap{objID: o.objID, keyID: key.KeyID()}}
+}
+
 func (o ScMutableMap) GetRequestID(key MapKey) ScMutableRequestID {
 	return ScMutableRequestID{objID: o.objID, keyID: key.KeyID()}
 }
@@ -646,6 +830,42 @@ func (o ScMutableMap) GetStringArray(key MapKey) ScMutableStringArray {
 	return ScMutableStringArray{objID: arrID}
 }
 
+func (o ScMutableMap) GetUint8(key MapKey) ScMutableUint8 {
+	return ScMutableUint8{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetUint8Array(key MapKey) ScMutableUint8Array {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_UINT8|TYPE_ARRAY)
+	return ScMutableUint8Array{objID: arrID}
+}
+
+func (o ScMutableMap) GetUint16(key MapKey) ScMutableUint16 {
+	return ScMutableUint16{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetUint16Array(key MapKey) ScMutableUint16Array {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_UINT16|TYPE_ARRAY)
+	return ScMutableUint16Array{objID: arrID}
+}
+
+func (o ScMutableMap) GetUint32(key MapKey) ScMutableUint32 {
+	return ScMutableUint32{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetUint32Array(key MapKey) ScMutableUint32Array {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_UINT32|TYPE_ARRAY)
+	return ScMutableUint32Array{objID: arrID}
+}
+
+func (o ScMutableMap) GetUint64(key MapKey) ScMutableUint64 {
+	return ScMutableUint64{objID: o.objID, keyID: key.KeyID()}
+}
+
+func (o ScMutableMap) GetUint64Array(key MapKey) ScMutableUint64Array {
+	arrID := GetObjectID(o.objID, key.KeyID(), TYPE_UINT64|TYPE_ARRAY)
+	return ScMutableUint64Array{objID: arrID}
+}
+
 func (o ScMutableMap) Immutable() ScImmutableMap {
 	return ScImmutableMap(o)
 }
@@ -778,3 +998,209 @@ func (o ScMutableStringArray) Immutable() ScImmutableStringArray {
 func (o ScMutableStringArray) Length() int32 {
 	return GetLength(o.objID)
 }
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint8 struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableUint8(objID int32, keyID Key32) ScMutableUint8 {
+	return ScMutableUint8{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableUint8) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_UINT8)
+}
+
+func (o ScMutableUint8) SetValue(value uint8) {
+	SetBytes(o.objID, o.keyID, TYPE_UINT8, []byte{value})
+}
+
+func (o ScMutableUint8) String() string {
+	return strconv.FormatUint(uint64(o.Value()), 10)
+}
+
+func (o ScMutableUint8) Value() uint8 {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_UINT8)
+	return bytes[0]
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint8Array struct {
+	objID int32
+}
+
+func (o ScMutableUint8Array) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableUint8Array) GetUint8(index int32) ScMutableUint8 {
+	return ScMutableUint8{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableUint8Array) Immutable() ScImmutableUint8Array {
+	return ScImmutableUint8Array(o)
+}
+
+func (o ScMutableUint8Array) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint16 struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableUint16(objID int32, keyID Key32) ScMutableUint16 {
+	return ScMutableUint16{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableUint16) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_UINT16)
+}
+
+func (o ScMutableUint16) SetValue(value uint16) {
+	bytes := make([]byte, 2)
+	binary.LittleEndian.PutUint16(bytes, value)
+	SetBytes(o.objID, o.keyID, TYPE_UINT16, bytes)
+}
+
+func (o ScMutableUint16) String() string {
+	return strconv.FormatUint(uint64(o.Value()), 10)
+}
+
+func (o ScMutableUint16) Value() uint16 {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_UINT16)
+	return binary.LittleEndian.Uint16(bytes)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint16Array struct {
+	objID int32
+}
+
+func (o ScMutableUint16Array) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableUint16Array) GetUint16(index int32) ScMutableUint16 {
+	return ScMutableUint16{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableUint16Array) Immutable() ScImmutableUint16Array {
+	return ScImmutableUint16Array(o)
+}
+
+func (o ScMutableUint16Array) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint32 struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableUint32(objID int32, keyID Key32) ScMutableUint32 {
+	return ScMutableUint32{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableUint32) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_UINT32)
+}
+
+func (o ScMutableUint32) SetValue(value uint32) {
+	bytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(bytes, value)
+	SetBytes(o.objID, o.keyID, TYPE_UINT32, bytes)
+}
+
+func (o ScMutableUint32) String() string {
+	return strconv.FormatUint(uint64(o.Value()), 10)
+}
+
+func (o ScMutableUint32) Value() uint32 {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_UINT32)
+	return binary.LittleEndian.Uint32(bytes)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint32Array struct {
+	objID int32
+}
+
+func (o ScMutableUint32Array) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableUint32Array) GetUint32(index int32) ScMutableUint32 {
+	return ScMutableUint32{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableUint32Array) Immutable() ScImmutableUint32Array {
+	return ScImmutableUint32Array(o)
+}
+
+func (o ScMutableUint32Array) Length() int32 {
+	return GetLength(o.objID)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint64 struct {
+	objID int32
+	keyID Key32
+}
+
+func NewScMutableUint64(objID int32, keyID Key32) ScMutableUint64 {
+	return ScMutableUint64{objID: objID, keyID: keyID}
+}
+
+func (o ScMutableUint64) Exists() bool {
+	return Exists(o.objID, o.keyID, TYPE_UINT64)
+}
+
+func (o ScMutableUint64) SetValue(value uint64) {
+	bytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bytes, value)
+	SetBytes(o.objID, o.keyID, TYPE_UINT64, bytes)
+}
+
+func (o ScMutableUint64) String() string {
+	return strconv.FormatUint(o.Value(), 10)
+}
+
+func (o ScMutableUint64) Value() uint64 {
+	bytes := GetBytes(o.objID, o.keyID, TYPE_UINT64)
+	return binary.LittleEndian.Uint64(bytes)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableUint64Array struct {
+	objID int32
+}
+
+func (o ScMutableUint64Array) Clear() {
+	Clear(o.objID)
+}
+
+func (o ScMutableUint64Array) GetUint64(index int32) ScMutableUint64 {
+	return ScMutableUint64{objID: o.objID, keyID: Key32(index)}
+}
+
+func (o ScMutableUint64Array) Immutable() ScImmutableUint64Array {
+	return ScImmutableUint64Array(o)
+}
+
+func (o ScMutableUint64Array) Length() int32 {
+	return GetLength(o.objID)
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmlib
+
+import "encoding/binary"
+
+// ordered map operations, should exactly match their counterpart values on the host
+const (
+	orderedOpDelete = int32(0)
+	orderedOpErase  = int32(1)
+	orderedOpExists = int32(2)
+	orderedOpGet    = int32(3)
+	orderedOpLength = int32(4)
+	orderedOpPage   = int32(5)
+	orderedOpSet    = int32(6)
+)
+
+// OrderedMaxPageSize is the maximum number of elements in a single page
+const OrderedMaxPageSize = 1000
+
+// EncodeSortableInt64 encodes an int64 so that the encodings sort
+// in the same order as the values, for use as ordered map key
+func EncodeSortableInt64(value int64) []byte {
+	return EncodeSortableUint64(uint64(value) ^ (1 << 63))
+}
+
+func DecodeSortableInt64(bytes []byte) int64 {
+	return int64(DecodeSortableUint64(bytes) ^ (1 << 63))
+}
+
+// EncodeSortableUint64 encodes a uint64 so that the encodings sort
+// in the same order as the values, for use as ordered map key
+func EncodeSortableUint64(value uint64) []byte {
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, value)
+	return bytes
+}
+
+func DecodeSortableUint64(bytes []byte) uint64 {
+	if len(bytes) != 8 {
+		panic("invalid sortable integer")
+	}
+	return binary.BigEndian.Uint64(bytes)
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// ScOrderedPage holds a page of ordered map elements, see ScImmutableOrderedMap.Page()
+type ScOrderedPage struct {
+	Keys   [][]byte
+	Values [][]byte
+	// cursor of the next page, nil when there are no more elements
+	Cursor []byte
+}
+
+// ScImmutableOrderedMap provides read-only access to a map that keeps its
+// byte slice keys in lexicographical order, which allows for range scans.
+// Integer keys need to be encoded with the EncodeSortable functions.
+type ScImmutableOrderedMap struct {
+	objID int32
+	keyID Key32
+}
+
+func (o ScImmutableOrderedMap) call(op int32, encode *BytesEncoder) []byte {
+	params := NewBytesEncoder().Int32(op).Data()
+	if encode != nil {
+		params = append(params, encode.Data()...)
+	}
+	return CallFunc(o.objID, o.keyID, params)
+}
+
+// Exists checks whether the map contains an element with the specified key
+func (o ScImmutableOrderedMap) Exists(key []byte) bool {
+	return len(o.call(orderedOpExists, NewBytesEncoder().Bytes(key))) != 0
+}
+
+// GetBytes returns the value of the element with the specified key
+func (o ScImmutableOrderedMap) GetBytes(key []byte) []byte {
+	return o.call(orderedOpGet, NewBytesEncoder().Bytes(key))
+}
+
+// Length returns the number of elements in the map
+func (o ScImmutableOrderedMap) Length() int32 {
+	return int32(binary.LittleEndian.Uint32(o.call(orderedOpLength, nil)))
+}
+
+// Page retrieves at most limit elements with keys in the range [from, to), in
+// ascending key order, or in descending key order when reverse is set. A nil
+// from or to leaves that end of the range unbounded. To retrieve the next page
+// pass the returned cursor as from, or as to when reverse is set.
+func (o ScImmutableOrderedMap) Page(from, to []byte, limit int32, reverse bool) *ScOrderedPage {
+	encode := NewBytesEncoder()
+	encodeOptionalBytes(encode, from)
+	encodeOptionalBytes(encode, to)
+	encode.Int32(limit)
+	if reverse {
+		encode.Int32(1)
+	} else {
+		encode.Int32(0)
+	}
+	decode := NewBytesDecoder(o.call(orderedOpPage, encode))
+	count := decode.Int32()
+	page := &ScOrderedPage{
+		Keys:   make([][]byte, count),
+		Values: make([][]byte, count),
+	}
+	for i := int32(0); i < count; i++ {
+		page.Keys[i] = decode.Bytes()
+		page.Values[i] = decode.Bytes()
+	}
+	page.Cursor = decodeOptionalBytes(decode)
+	decode.Close()
+	return page
+}
+
+// Seek returns the smallest key that is greater than or equal to key,
+// or nil when there is no such key
+func (o ScImmutableOrderedMap) Seek(key []byte) []byte {
+	page := o.Page(key, nil, 1, false)
+	if len(page.Keys) == 0 {
+		return nil
+	}
+	return page.Keys[0]
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+type ScMutableOrderedMap struct {
+	ScImmutableOrderedMap
+}
+
+// Clear deletes all elements of the map
+func (o ScMutableOrderedMap) Clear() {
+	o.call(orderedOpErase, nil)
+}
+
+// Delete deletes the element with the specified key
+func (o ScMutableOrderedMap) Delete(key []byte) {
+	o.call(orderedOpDelete, NewBytesEncoder().Bytes(key))
+}
+
+func (o ScMutableOrderedMap) Immutable() ScImmutableOrderedMap {
+	return o.ScImmutableOrderedMap
+}
+
+// SetBytes sets the value of the element with the specified key
+func (o ScMutableOrderedMap) SetBytes(key, value []byte) {
+	o.call(orderedOpSet, NewBytesEncoder().Bytes(key).Bytes(value))
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// optional byte slices are encoded with a presence flag,
+// because an empty slice is a valid key
+func decodeOptionalBytes(decode *BytesDecoder) []byte {
+	if decode.Int32() == 0 {
+		return nil
+	}
+	return decode.Bytes()
+}
+
+func encodeOptionalBytes(encode *BytesEncoder, value []byte) {
+	if value == nil {
+		encode.Int32(0)
+		return
+	}
+	encode.Int32(1).Bytes(value)
+}
@@ -0,0 +1,205 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// arbitrary-precision unsigned integer
+
+use std::cmp::Ordering;
+
+use crate::host::*;
+use crate::keys::*;
+
+// value object for arbitrary-precision unsigned integers
+// encoded as little-endian bytes without trailing zero bytes,
+// zero is encoded as a single zero byte
+#[derive(PartialEq, Clone)]
+pub struct ScBigInt {
+    // little-endian bytes without trailing zero bytes, empty for zero
+    bytes: Vec<u8>,
+}
+
+impl ScBigInt {
+    // construct from u64 value
+    pub fn new(value: u64) -> ScBigInt {
+        ScBigInt::normalize(value.to_le_bytes().to_vec())
+    }
+
+    // construct from byte array
+    pub fn from_bytes(bytes: &[u8]) -> ScBigInt {
+        ScBigInt::normalize(bytes.to_vec())
+    }
+
+    fn normalize(mut bytes: Vec<u8>) -> ScBigInt {
+        while let Some(0) = bytes.last() {
+            bytes.pop();
+        }
+        ScBigInt { bytes }
+    }
+
+    pub fn add(&self, rhs: &ScBigInt) -> ScBigInt {
+        let (lhs, rhs) = if self.bytes.len() < rhs.bytes.len() { (rhs, self) } else { (self, rhs) };
+        let mut res = vec![0_u8; lhs.bytes.len() + 1];
+        let mut carry = 0_u16;
+        for i in 0..lhs.bytes.len() {
+            carry += lhs.bytes[i] as u16;
+            if i < rhs.bytes.len() {
+                carry += rhs.bytes[i] as u16;
+            }
+            res[i] = carry as u8;
+            carry >>= 8;
+        }
+        res[lhs.bytes.len()] = carry as u8;
+        ScBigInt::normalize(res)
+    }
+
+    // compare with rhs
+    pub fn cmp(&self, rhs: &ScBigInt) -> Ordering {
+        if self.bytes.len() != rhs.bytes.len() {
+            return self.bytes.len().cmp(&rhs.bytes.len());
+        }
+        for i in (0..self.bytes.len()).rev() {
+            if self.bytes[i] != rhs.bytes[i] {
+                return self.bytes[i].cmp(&rhs.bytes[i]);
+            }
+        }
+        Ordering::Equal
+    }
+
+    pub fn div(&self, rhs: &ScBigInt) -> ScBigInt {
+        self.div_mod(rhs).0
+    }
+
+    // returns both the quotient and the remainder of self divided by rhs
+    pub fn div_mod(&self, rhs: &ScBigInt) -> (ScBigInt, ScBigInt) {
+        if rhs.is_zero() {
+            panic("division by zero");
+        }
+        if rhs.bytes.len() == 1 {
+            let (quo, rem) = self.div_mod_byte(rhs.bytes[0]);
+            return (quo, ScBigInt::new(rem as u64));
+        }
+
+        // binary long division, one bit at a time
+        let mut quo = vec![0_u8; self.bytes.len()];
+        let mut rem = ScBigInt::new(0);
+        for i in (0..self.bytes.len() * 8).rev() {
+            rem = rem.shl1((self.bytes[i >> 3] >> (i & 7)) & 1);
+            if rem.cmp(rhs) != Ordering::Less {
+                rem = rem.sub(rhs);
+                quo[i >> 3] |= 1 << (i & 7);
+            }
+        }
+        (ScBigInt::normalize(quo), rem)
+    }
+
+    fn div_mod_byte(&self, divisor: u8) -> (ScBigInt, u8) {
+        let mut quo = vec![0_u8; self.bytes.len()];
+        let mut rem = 0_u16;
+        for i in (0..self.bytes.len()).rev() {
+            rem = (rem << 8) | self.bytes[i] as u16;
+            quo[i] = (rem / divisor as u16) as u8;
+            rem %= divisor as u16;
+        }
+        (ScBigInt::normalize(quo), rem as u8)
+    }
+
+    pub fn is_u64(&self) -> bool {
+        self.bytes.len() <= 8
+    }
+
+    pub fn is_zero(&self) -> bool {
+        self.bytes.len() == 0
+    }
+
+    pub fn modulo(&self, rhs: &ScBigInt) -> ScBigInt {
+        self.div_mod(rhs).1
+    }
+
+    pub fn mul(&self, rhs: &ScBigInt) -> ScBigInt {
+        if self.is_zero() || rhs.is_zero() {
+            return ScBigInt::new(0);
+        }
+        let mut res = vec![0_u8; self.bytes.len() + rhs.bytes.len()];
+        for i in 0..self.bytes.len() {
+            let mut carry = 0_u16;
+            for j in 0..rhs.bytes.len() {
+                carry += self.bytes[i] as u16 * rhs.bytes[j] as u16 + res[i + j] as u16;
+                res[i + j] = carry as u8;
+                carry >>= 8;
+            }
+            res[i + rhs.bytes.len()] = carry as u8;
+        }
+        ScBigInt::normalize(res)
+    }
+
+    // shifts left by one bit and shifts in the lowest bit of bit
+    fn shl1(&self, mut bit: u8) -> ScBigInt {
+        let mut res = vec![0_u8; self.bytes.len() + 1];
+        for i in 0..self.bytes.len() {
+            res[i] = (self.bytes[i] << 1) | bit;
+            bit = self.bytes[i] >> 7;
+        }
+        res[self.bytes.len()] = bit;
+        ScBigInt::normalize(res)
+    }
+
+    pub fn sub(&self, rhs: &ScBigInt) -> ScBigInt {
+        if self.cmp(rhs) == Ordering::Less {
+            panic("subtraction underflow");
+        }
+        let mut res = vec![0_u8; self.bytes.len()];
+        let mut borrow = 0_i16;
+        for i in 0..self.bytes.len() {
+            let mut diff = self.bytes[i] as i16 - borrow;
+            if i < rhs.bytes.len() {
+                diff -= rhs.bytes[i] as i16;
+            }
+            borrow = 0;
+            if diff < 0 {
+                diff += 256;
+                borrow = 1;
+            }
+            res[i] = diff as u8;
+        }
+        ScBigInt::normalize(res)
+    }
+
+    // convert to byte array representation
+    pub fn to_bytes(&self) -> Vec<u8> {
+        if self.is_zero() {
+            return vec![0];
+        }
+        self.bytes.clone()
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        if self.is_zero() {
+            return "0".to_string();
+        }
+        let mut digits = Vec::new();
+        let mut value = self.clone();
+        while !value.is_zero() {
+            let (quo, digit) = value.div_mod_byte(10);
+            digits.push(b'0' + digit);
+            value = quo;
+        }
+        digits.reverse();
+        String::from_utf8(digits).unwrap()
+    }
+
+    pub fn to_u64(&self) -> u64 {
+        if !self.is_u64() {
+            panic("big int too large for u64");
+        }
+        let mut bytes = [0_u8; 8];
+        bytes[..self.bytes.len()].copy_from_slice(&self.bytes);
+        u64::from_le_bytes(bytes)
+    }
+}
+
+// can be used as key in maps
+impl MapKey for ScBigInt {
+    fn get_key_id(&self) -> Key32 {
+        get_key_id_from_bytes(&self.to_bytes())
+    }
+}
@@ -1,6 +1,7 @@
 // Copyright 2020 IOTA Stiftung
 // SPDX-License-Identifier: Apache-2.0
 
+use crate::bigint::*;
 use crate::hashtypes::*;
 use crate::host::*;
 
@@ -25,6 +26,16 @@ impl BytesDecoder<'_> {
         ScAgentID::from_bytes(self.bytes())
     }
 
+    // decodes an ScBigInt from the byte buffer
+    pub fn big_int(&mut self) -> ScBigInt {
+        ScBigInt::from_bytes(self.bytes())
+    }
+
+    // decodes a bool from the byte buffer
+    pub fn bool(&mut self) -> bool {
+        self.uint8() != 0
+    }
+
     // decodes the next substring of bytes from the byte buffer
     pub fn bytes(&mut self) -> &[u8] {
         let size = self.int32() as usize;
@@ -56,6 +67,11 @@ impl BytesDecoder<'_> {
         ScHname::from_bytes(self.bytes())
     }
 
+    // decodes an int8 from the byte buffer
+    pub fn int8(&mut self) -> i8 {
+        self.uint8() as i8
+    }
+
     // decodes an int16 from the byte buffer
     // note that these are encoded using leb128 encoding to conserve space
     pub fn int16(&mut self) -> i16 {
@@ -105,6 +121,32 @@ impl BytesDecoder<'_> {
         }
     }
 
+    // unsigned leb128 decoder
+    fn leb128_decode_unsigned(&mut self, bits: i32) -> u64 {
+        let mut val = 0_u64;
+        let mut s = 0;
+        loop {
+            if self.buf.len() == 0 {
+                panic("insufficient bytes");
+            }
+            let b = self.buf[0];
+            self.buf = &self.buf[1..];
+            val |= ((b & 0x7f) as u64) << s;
+
+            // termination bit set?
+            if (b & 0x80) == 0 {
+                if ((val >> s) as u8) & 0x7f != b & 0x7f || (bits < 64 && (val >> bits) != 0) {
+                    panic("integer too large");
+                }
+                return val;
+            }
+            s += 7;
+            if s >= bits {
+                panic("integer representation too long");
+            }
+        }
+    }
+
     // decodes an ScRequestID from the byte buffer
     pub fn request_id(&mut self) -> ScRequestID {
         ScRequestID::from_bytes(self.bytes())
@@ -114,6 +156,34 @@ impl BytesDecoder<'_> {
     pub fn string(&mut self) -> String {
         String::from_utf8_lossy(self.bytes()).to_string()
     }
+
+    // decodes an uint8 from the byte buffer
+    pub fn uint8(&mut self) -> u8 {
+        if self.buf.len() == 0 {
+            panic("insufficient bytes");
+        }
+        let value = self.buf[0];
+        self.buf = &self.buf[1..];
+        value
+    }
+
+    // decodes an uint16 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint16(&mut self) -> u16 {
+        self.leb128_decode_unsigned(16) as u16
+    }
+
+    // decodes an uint32 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint32(&mut self) -> u32 {
+        self.leb128_decode_unsigned(32) as u32
+    }
+
+    // decodes an uint64 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint64(&mut self) -> u64 {
+        self.leb128_decode_unsigned(64)
+    }
 }
 
 impl Drop for BytesDecoder<'_> {
@@ -149,6 +219,17 @@ impl BytesEncoder {
         self
     }
 
+    // encodes an ScBigInt into the byte buffer
+    pub fn big_int(&mut self, value: &ScBigInt) -> &BytesEncoder {
+        self.bytes(&value.to_bytes());
+        self
+    }
+
+    // encodes a bool into the byte buffer
+    pub fn bool(&mut self, val: bool) -> &BytesEncoder {
+        self.uint8(val as u8)
+    }
+
     // encodes a substring of bytes into the byte buffer
     pub fn bytes(&mut self, value: &[u8]) -> &BytesEncoder {
         self.int32(value.len() as i32);
@@ -185,6 +266,11 @@ impl BytesEncoder {
         self
     }
 
+    // encodes an int8 into the byte buffer
+    pub fn int8(&mut self, val: i8) -> &BytesEncoder {
+        self.uint8(val as u8)
+    }
+
     // encodes an int16 into the byte buffer
     // note that these are encoded using leb128 encoding to conserve space
     pub fn int16(&mut self, val: i16) -> &BytesEncoder {
@@ -217,6 +303,19 @@ impl BytesEncoder {
         }
     }
 
+    // unsigned leb128 encoder
+    fn leb128_encode_unsigned(&mut self, mut val: u64) -> &BytesEncoder {
+        loop {
+            let b = (val as u8) & 0x7f;
+            val >>= 7;
+            if val == 0 {
+                self.buf.push(b);
+                return self;
+            }
+            self.buf.push(b | 0x80);
+        }
+    }
+
     // encodes an ScRequestID into the byte buffer
     pub fn request_id(&mut self, value: &ScRequestID) -> &BytesEncoder {
         self.bytes(value.to_bytes());
@@ -228,4 +327,28 @@ impl BytesEncoder {
         self.bytes(value.as_bytes());
         self
     }
+
+    // encodes an uint8 into the byte buffer
+    pub fn uint8(&mut self, val: u8) -> &BytesEncoder {
+        self.buf.push(val);
+        self
+    }
+
+    // encodes an uint16 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint16(&mut self, val: u16) -> &BytesEncoder {
+        self.leb128_encode_unsigned(val as u64)
+    }
+
+    // encodes an uint32 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint32(&mut self, val: u32) -> &BytesEncoder {
+        self.leb128_encode_unsigned(val as u64)
+    }
+
+    // encodes an uint64 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    pub fn uint64(&mut self, val: u64) -> &BytesEncoder {
+        self.leb128_encode_unsigned(val)
+    }
 }
@@ -12,6 +12,7 @@ use crate::host::*;
 use crate::immutable::*;
 use crate::keys::*;
 use crate::mutable::*;
+use crate::ordered::*;
 
 // all access to the objects in host's object tree starts here
 pub(crate) static ROOT: ScMutableMap = ScMutableMap { obj_id: 1 };
@@ -221,6 +222,19 @@ pub trait ScBaseContext {
         panic(text);
     }
 
+    // panics with a typed error that ends up in the request receipt
+    // error codes below 1000 are reserved for the VM itself, at most 16 params can be passed
+    fn panic_with_error(&self, code: u16, text: &str, params: &[&str]) {
+        let mut encode = BytesEncoder::new();
+        encode.int32(code as i32);
+        encode.string(text);
+        encode.int32(params.len() as i32);
+        for param in params {
+            encode.string(param);
+        }
+        ROOT.get_bytes(&KEY_PANIC).set_value(&encode.data());
+    }
+
     // retrieve parameters that were passed to the smart contract function
     fn params(&self) -> ScImmutableMap {
         ROOT.get_map(&KEY_PARAMS).immutable()
@@ -257,6 +271,37 @@ pub trait ScBaseContext {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// reserved params of the cross-chain callback protocol, see post_with_callback()
+pub const PARAM_CALLBACK: &str = "$cb";
+pub const PARAM_CALLBACK_ID: &str = "$cb.id";
+pub const PARAM_CALLBACK_REQUEST_ID: &str = "$cb.req";
+pub const PARAM_CALLBACK_SUCCESS: &str = "$cb.ok";
+pub const PARAM_CALLBACK_ERROR: &str = "$cb.err";
+pub const PARAM_CALLBACK_DEADLINE: &str = "$cb.deadline";
+
+// state that tracks the calls that were posted with post_with_callback()
+const STATE_CALLBACK_NONCE: &str = "$callbacks.nonce";
+const STATE_CALLBACKS: &str = "$callbacks";
+const STATE_CALLBACK_TIMEOUTS: &str = "$callbacks.timeout";
+
+// outcome of a call that was posted with post_with_callback()
+pub struct ScCallResult {
+    // call ID returned by post_with_callback()
+    pub call_id: i64,
+    // ID of the request on the target chain, not set on timeout
+    pub request_id: ScRequestID,
+    // false when the request failed or timed out
+    pub success: bool,
+    // reason of the failure
+    pub error: String,
+    // the outcome arrived after the call already timed out
+    pub late: bool,
+    // results of the request
+    pub results: ScImmutableMap,
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // smart contract interface with mutable access to state
 #[derive(Clone, Copy)]
 pub struct ScFuncContext {}
@@ -360,6 +405,93 @@ impl ScFuncContext {
         self.post(&self.chain_id(), self.contract(), hfunction, params, transfer, delay);
     }
 
+    // asynchronously calls the specified smart contract function like post(), and has
+    // the target chain send back the outcome of the request to the hcallback function
+    // of this contract, see call_result()
+    // one iota of the transfer pays for sending back the outcome, the target function
+    // does not receive it
+    // when the outcome does not arrive within timeout seconds the callback receives a
+    // timeout failure instead, which costs another iota from the account of this contract
+    // the target chain does not run the request after the timeout, so the transfer stays
+    // in escrow until then, and is refunded with the failed outcome that arrives later
+    // a zero timeout waits indefinitely
+    // returns the call ID that identifies the outcome
+    pub fn post_with_callback(&self, chain_id: &ScChainID, hcontract: ScHname, hfunction: ScHname, params: Option<ScMutableMap>,
+                              transfer: ScTransfers, hcallback: ScHname, timeout: i32) -> i64 {
+        if timeout < 0 {
+            panic("invalid timeout");
+        }
+        let nonce = self.state().get_int64(STATE_CALLBACK_NONCE);
+        let call_id = nonce.value() + 1;
+        nonce.set_value(call_id);
+        let target = ScAgentID::new(&chain_id.address(), &hcontract);
+        self.state().get_ordered_map(STATE_CALLBACKS).set_bytes(&encode_sortable_int64(call_id), target.to_bytes());
+
+        let params = params.unwrap_or_else(ScMutableMap::new);
+        params.get_hname(PARAM_CALLBACK).set_value(hcallback);
+        params.get_int64(PARAM_CALLBACK_ID).set_value(call_id);
+        if timeout != 0 {
+            // same whole second as the time lock of the timeout failure
+            let deadline = (self.timestamp() / 1_000_000_000 + timeout as i64) * 1_000_000_000;
+            params.get_int64(PARAM_CALLBACK_DEADLINE).set_value(deadline);
+        }
+        self.post(chain_id, hcontract, hfunction, Some(params), transfer, 0);
+
+        if timeout != 0 {
+            let failure = ScMutableMap::new();
+            failure.get_int64(PARAM_CALLBACK_ID).set_value(call_id);
+            failure.get_bool(PARAM_CALLBACK_SUCCESS).set_value(false);
+            failure.get_string(PARAM_CALLBACK_ERROR).set_value("timeout");
+            self.post_self(hcallback, Some(failure), ScTransfers::iotas(1), timeout);
+        }
+        call_id
+    }
+
+    // retrieves the outcome of a call that was posted with post_with_callback()
+    // must be called from the callback function
+    // a call can deliver a timeout failure followed by its actual outcome, which then
+    // has late set, and the actual outcome is delivered only once
+    // the call is forgotten once its actual outcome is delivered
+    // the result is None when the request is not an outcome of a pending call of this contract
+    pub fn call_result(&self) -> Option<ScCallResult> {
+        let params = self.params();
+        let call_id = params.get_int64(PARAM_CALLBACK_ID);
+        if !call_id.exists() {
+            return None;
+        }
+        let key = encode_sortable_int64(call_id.value());
+        let callbacks = self.state().get_ordered_map(STATE_CALLBACKS);
+        if !callbacks.exists(&key) {
+            return None;
+        }
+        let timeouts = self.state().get_ordered_map(STATE_CALLBACK_TIMEOUTS);
+        let timed_out = timeouts.exists(&key);
+        let request_id = params.get_request_id(PARAM_CALLBACK_REQUEST_ID);
+        if !request_id.exists() {
+            // only this contract itself can signal a timeout, and only once
+            if self.caller() != self.account_id() || timed_out {
+                return None;
+            }
+            timeouts.set_bytes(&key, &[1]);
+        } else {
+            // only the target of the call can send its outcome
+            if self.caller() != ScAgentID::from_bytes(&callbacks.get_bytes(&key)) {
+                return None;
+            }
+            callbacks.delete(&key);
+            timeouts.delete(&key);
+        }
+
+        Some(ScCallResult {
+            call_id: call_id.value(),
+            request_id: if request_id.exists() { request_id.value() } else { ScRequestID::from_bytes(&[0; 34]) },
+            success: params.get_bool(PARAM_CALLBACK_SUCCESS).value(),
+            error: params.get_string(PARAM_CALLBACK_ERROR).value(),
+            late: request_id.exists() && timed_out,
+            results: params,
+        })
+    }
+
     // retrieve the request id of this transaction
     pub fn request_id(&self) -> ScRequestID {
         ROOT.get_request_id(&KEY_REQUEST_ID).value()
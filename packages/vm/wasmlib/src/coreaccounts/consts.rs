@@ -16,25 +16,45 @@ pub const SC_DESCRIPTION: &str = "Core chain account ledger contract";
 pub const HSC_NAME:       ScHname = ScHname(0x3c4b5e02);
 
 pub(crate) const PARAM_AGENT_ID:        &str = "a";
+pub(crate) const PARAM_CURSOR:          &str = "cursor";
+pub(crate) const PARAM_LIMIT:           &str = "limit";
+pub(crate) const PARAM_NFT_COLOR:       &str = "nc";
+pub(crate) const PARAM_NFT_METADATA:    &str = "nm";
 pub(crate) const PARAM_WITHDRAW_AMOUNT: &str = "m";
 pub(crate) const PARAM_WITHDRAW_COLOR:  &str = "c";
 
 pub(crate) const RESULT_ACCOUNT_NONCE: &str = "n";
+pub(crate) const RESULT_NEXT_CURSOR:   &str = "nextCursor";
+pub(crate) const RESULT_NFT_COLOR:     &str = "nc";
+pub(crate) const RESULT_NFT_ISSUER:    &str = "ni";
+pub(crate) const RESULT_NFT_METADATA:  &str = "nm";
+pub(crate) const RESULT_NFT_OWNER:     &str = "no";
+pub(crate) const RESULT_TRUNCATED:     &str = "truncated";
 
 pub(crate) const FUNC_DEPOSIT:           &str = "deposit";
 pub(crate) const FUNC_HARVEST:           &str = "harvest";
+pub(crate) const FUNC_MINT_NFT:          &str = "mintNFT";
+pub(crate) const FUNC_TRANSFER_NFT:      &str = "transferNFT";
 pub(crate) const FUNC_WITHDRAW:          &str = "withdraw";
+pub(crate) const FUNC_WITHDRAW_NFT:      &str = "withdrawNFT";
 pub(crate) const VIEW_ACCOUNTS:          &str = "accounts";
 pub(crate) const VIEW_BALANCE:           &str = "balance";
+pub(crate) const VIEW_GET_ACCOUNT_NF_TS: &str = "getAccountNFTs";
 pub(crate) const VIEW_GET_ACCOUNT_NONCE: &str = "getAccountNonce";
+pub(crate) const VIEW_GET_NFT_DATA:      &str = "getNFTData";
 pub(crate) const VIEW_TOTAL_ASSETS:      &str = "totalAssets";
 
 pub(crate) const HFUNC_DEPOSIT:           ScHname = ScHname(0xbdc9102d);
 pub(crate) const HFUNC_HARVEST:           ScHname = ScHname(0x7b40efbd);
+pub(crate) const HFUNC_MINT_NFT:          ScHname = ScHname(0x3b79d016);
+pub(crate) const HFUNC_TRANSFER_NFT:      ScHname = ScHname(0xdc3e3f0d);
 pub(crate) const HFUNC_WITHDRAW:          ScHname = ScHname(0x9dcc0f41);
+pub(crate) const HFUNC_WITHDRAW_NFT:      ScHname = ScHname(0x281b1980);
 pub(crate) const HVIEW_ACCOUNTS:          ScHname = ScHname(0x3c4b5e02);
 pub(crate) const HVIEW_BALANCE:           ScHname = ScHname(0x84168cb4);
+pub(crate) const HVIEW_GET_ACCOUNT_NF_TS: ScHname = ScHname(0x02010e79);
 pub(crate) const HVIEW_GET_ACCOUNT_NONCE: ScHname = ScHname(0x529d7df9);
+pub(crate) const HVIEW_GET_NFT_DATA:      ScHname = ScHname(0xa5c5513d);
 pub(crate) const HVIEW_TOTAL_ASSETS:      ScHname = ScHname(0xfab0f8d2);
 
 // @formatter:on
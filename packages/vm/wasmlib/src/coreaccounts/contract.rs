@@ -24,12 +24,29 @@ pub struct HarvestCall {
     pub params: MutableHarvestParams,
 }
 
+pub struct MintNFTCall {
+    pub func:    ScFunc,
+    pub params:  MutableMintNFTParams,
+    pub results: ImmutableMintNFTResults,
+}
+
+pub struct TransferNFTCall {
+    pub func:   ScFunc,
+    pub params: MutableTransferNFTParams,
+}
+
 pub struct WithdrawCall {
     pub func: ScFunc,
 }
 
+pub struct WithdrawNFTCall {
+    pub func:   ScFunc,
+    pub params: MutableWithdrawNFTParams,
+}
+
 pub struct AccountsCall {
     pub func:    ScView,
+    pub params:  MutableAccountsParams,
     pub results: ImmutableAccountsResults,
 }
 
@@ -39,12 +56,24 @@ pub struct BalanceCall {
     pub results: ImmutableBalanceResults,
 }
 
+pub struct GetAccountNFTsCall {
+    pub func:    ScView,
+    pub params:  MutableGetAccountNFTsParams,
+    pub results: ImmutableGetAccountNFTsResults,
+}
+
 pub struct GetAccountNonceCall {
     pub func:    ScView,
     pub params:  MutableGetAccountNonceParams,
     pub results: ImmutableGetAccountNonceResults,
 }
 
+pub struct GetNFTDataCall {
+    pub func:    ScView,
+    pub params:  MutableGetNFTDataParams,
+    pub results: ImmutableGetNFTDataResults,
+}
+
 pub struct TotalAssetsCall {
     pub func:    ScView,
     pub results: ImmutableTotalAssetsResults,
@@ -70,17 +99,43 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn mint_nft(_ctx: & dyn ScFuncCallContext) -> MintNFTCall {
+        let mut f = MintNFTCall {
+            func:    ScFunc::new(HSC_NAME, HFUNC_MINT_NFT),
+            params:  MutableMintNFTParams { id: 0 },
+            results: ImmutableMintNFTResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn transfer_nft(_ctx: & dyn ScFuncCallContext) -> TransferNFTCall {
+        let mut f = TransferNFTCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_TRANSFER_NFT),
+            params: MutableTransferNFTParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn withdraw(_ctx: & dyn ScFuncCallContext) -> WithdrawCall {
         WithdrawCall {
             func: ScFunc::new(HSC_NAME, HFUNC_WITHDRAW),
         }
     }
+    pub fn withdraw_nft(_ctx: & dyn ScFuncCallContext) -> WithdrawNFTCall {
+        let mut f = WithdrawNFTCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_WITHDRAW_NFT),
+            params: MutableWithdrawNFTParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn accounts(_ctx: & dyn ScViewCallContext) -> AccountsCall {
         let mut f = AccountsCall {
             func:    ScView::new(HSC_NAME, HVIEW_ACCOUNTS),
+            params:  MutableAccountsParams { id: 0 },
             results: ImmutableAccountsResults { id: 0 },
         };
-        f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
     pub fn balance(_ctx: & dyn ScViewCallContext) -> BalanceCall {
@@ -92,6 +147,15 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn get_account_nf_ts(_ctx: & dyn ScViewCallContext) -> GetAccountNFTsCall {
+        let mut f = GetAccountNFTsCall {
+            func:    ScView::new(HSC_NAME, HVIEW_GET_ACCOUNT_NF_TS),
+            params:  MutableGetAccountNFTsParams { id: 0 },
+            results: ImmutableGetAccountNFTsResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn get_account_nonce(_ctx: & dyn ScViewCallContext) -> GetAccountNonceCall {
         let mut f = GetAccountNonceCall {
             func:    ScView::new(HSC_NAME, HVIEW_GET_ACCOUNT_NONCE),
@@ -101,6 +165,15 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn get_nft_data(_ctx: & dyn ScViewCallContext) -> GetNFTDataCall {
+        let mut f = GetNFTDataCall {
+            func:    ScView::new(HSC_NAME, HVIEW_GET_NFT_DATA),
+            params:  MutableGetNFTDataParams { id: 0 },
+            results: ImmutableGetNFTDataResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn total_assets(_ctx: & dyn ScViewCallContext) -> TotalAssetsCall {
         let mut f = TotalAssetsCall {
             func:    ScView::new(HSC_NAME, HVIEW_TOTAL_ASSETS),
@@ -64,6 +64,118 @@ impl MutableHarvestParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableMintNFTParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableMintNFTParams {
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn nft_metadata(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_NFT_METADATA.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableMintNFTParams {
+    pub(crate) id: i32,
+}
+
+impl MutableMintNFTParams {
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn nft_metadata(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_NFT_METADATA.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableTransferNFTParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableTransferNFTParams {
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn nft_color(&self) -> ScImmutableColor {
+        ScImmutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableTransferNFTParams {
+    pub(crate) id: i32,
+}
+
+impl MutableTransferNFTParams {
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn nft_color(&self) -> ScMutableColor {
+        ScMutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableWithdrawNFTParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableWithdrawNFTParams {
+    pub fn nft_color(&self) -> ScImmutableColor {
+        ScImmutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableWithdrawNFTParams {
+    pub(crate) id: i32,
+}
+
+impl MutableWithdrawNFTParams {
+    pub fn nft_color(&self) -> ScMutableColor {
+        ScMutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableAccountsParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableAccountsParams {
+    pub fn cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableAccountsParams {
+    pub(crate) id: i32,
+}
+
+impl MutableAccountsParams {
+    pub fn cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableBalanceParams {
     pub(crate) id: i32,
@@ -86,6 +198,28 @@ impl MutableBalanceParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableGetAccountNFTsParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetAccountNFTsParams {
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetAccountNFTsParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetAccountNFTsParams {
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableGetAccountNonceParams {
     pub(crate) id: i32,
@@ -107,3 +241,25 @@ impl MutableGetAccountNonceParams {
         ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
     }
 }
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetNFTDataParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetNFTDataParams {
+    pub fn nft_color(&self) -> ScImmutableColor {
+        ScImmutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetNFTDataParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetNFTDataParams {
+    pub fn nft_color(&self) -> ScMutableColor {
+        ScMutableColor::new(self.id, PARAM_NFT_COLOR.get_key_id())
+    }
+}
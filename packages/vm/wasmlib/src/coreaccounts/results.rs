@@ -12,6 +12,28 @@ use crate::*;
 use crate::coreaccounts::*;
 use crate::host::*;
 
+#[derive(Clone, Copy)]
+pub struct ImmutableMintNFTResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableMintNFTResults {
+    pub fn nft_color(&self) -> ScImmutableColor {
+        ScImmutableColor::new(self.id, RESULT_NFT_COLOR.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableMintNFTResults {
+    pub(crate) id: i32,
+}
+
+impl MutableMintNFTResults {
+    pub fn nft_color(&self) -> ScMutableColor {
+        ScMutableColor::new(self.id, RESULT_NFT_COLOR.get_key_id())
+    }
+}
+
 pub struct MapAgentIDToImmutableBytes {
     pub(crate) obj_id: i32,
 }
@@ -31,6 +53,14 @@ impl ImmutableAccountsResults {
     pub fn agents(&self) -> MapAgentIDToImmutableBytes {
         MapAgentIDToImmutableBytes { obj_id: self.id }
     }
+
+    pub fn next_cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 pub struct MapAgentIDToMutableBytes {
@@ -56,6 +86,14 @@ impl MutableAccountsResults {
     pub fn agents(&self) -> MapAgentIDToMutableBytes {
         MapAgentIDToMutableBytes { obj_id: self.id }
     }
+
+    pub fn next_cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 pub struct MapColorToImmutableInt64 {
@@ -104,6 +142,52 @@ impl MutableBalanceResults {
     }
 }
 
+pub struct MapColorToImmutableBytes {
+    pub(crate) obj_id: i32,
+}
+
+impl MapColorToImmutableBytes {
+    pub fn get_bytes(&self, key: &ScColor) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.obj_id, key.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetAccountNFTsResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetAccountNFTsResults {
+    pub fn nft_colors(&self) -> MapColorToImmutableBytes {
+        MapColorToImmutableBytes { obj_id: self.id }
+    }
+}
+
+pub struct MapColorToMutableBytes {
+    pub(crate) obj_id: i32,
+}
+
+impl MapColorToMutableBytes {
+    pub fn clear(&self) {
+        clear(self.obj_id)
+    }
+
+    pub fn get_bytes(&self, key: &ScColor) -> ScMutableBytes {
+        ScMutableBytes::new(self.obj_id, key.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetAccountNFTsResults {
+    pub(crate) id: i32,
+}
+
+impl MutableGetAccountNFTsResults {
+    pub fn nft_colors(&self) -> MapColorToMutableBytes {
+        MapColorToMutableBytes { obj_id: self.id }
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableGetAccountNonceResults {
     pub(crate) id: i32,
@@ -126,6 +210,44 @@ impl MutableGetAccountNonceResults {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableGetNFTDataResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetNFTDataResults {
+    pub fn nft_issuer(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, RESULT_NFT_ISSUER.get_key_id())
+    }
+
+    pub fn nft_metadata(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, RESULT_NFT_METADATA.get_key_id())
+    }
+
+    pub fn nft_owner(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, RESULT_NFT_OWNER.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetNFTDataResults {
+    pub(crate) id: i32,
+}
+
+impl MutableGetNFTDataResults {
+    pub fn nft_issuer(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, RESULT_NFT_ISSUER.get_key_id())
+    }
+
+    pub fn nft_metadata(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, RESULT_NFT_METADATA.get_key_id())
+    }
+
+    pub fn nft_owner(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, RESULT_NFT_OWNER.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableTotalAssetsResults {
     pub(crate) id: i32,
@@ -15,20 +15,40 @@ pub const SC_NAME:        &str = "blob";
 pub const SC_DESCRIPTION: &str = "Core blob contract";
 pub const HSC_NAME:       ScHname = ScHname(0xfd91bc63);
 
-pub(crate) const PARAM_FIELD: &str = "field";
-pub(crate) const PARAM_HASH:  &str = "hash";
-
-pub(crate) const RESULT_BYTES: &str = "bytes";
-pub(crate) const RESULT_HASH:  &str = "hash";
-
-pub(crate) const FUNC_STORE_BLOB:     &str = "storeBlob";
-pub(crate) const VIEW_GET_BLOB_FIELD: &str = "getBlobField";
-pub(crate) const VIEW_GET_BLOB_INFO:  &str = "getBlobInfo";
-pub(crate) const VIEW_LIST_BLOBS:     &str = "listBlobs";
-
-pub(crate) const HFUNC_STORE_BLOB:     ScHname = ScHname(0xddd4c281);
-pub(crate) const HVIEW_GET_BLOB_FIELD: ScHname = ScHname(0x1f448130);
-pub(crate) const HVIEW_GET_BLOB_INFO:  ScHname = ScHname(0xfde4ab46);
-pub(crate) const HVIEW_LIST_BLOBS:     ScHname = ScHname(0x62ca7990);
+pub(crate) const PARAM_BYTES:  &str = "bytes";
+pub(crate) const PARAM_CURSOR: &str = "cursor";
+pub(crate) const PARAM_FIELD:  &str = "field";
+pub(crate) const PARAM_HASH:   &str = "hash";
+pub(crate) const PARAM_INDEX:  &str = "index";
+pub(crate) const PARAM_LIMIT:  &str = "limit";
+pub(crate) const PARAM_OWNER:  &str = "owner";
+
+pub(crate) const RESULT_BYTES:       &str = "bytes";
+pub(crate) const RESULT_HASH:        &str = "hash";
+pub(crate) const RESULT_NEXT_CURSOR: &str = "nextCursor";
+pub(crate) const RESULT_OWNER:       &str = "owner";
+pub(crate) const RESULT_TRUNCATED:   &str = "truncated";
+
+pub(crate) const FUNC_ABORT_BLOB_UPLOAD:      &str = "abortBlobUpload";
+pub(crate) const FUNC_DELETE_BLOB:            &str = "deleteBlob";
+pub(crate) const FUNC_FINISH_BLOB_UPLOAD:     &str = "finishBlobUpload";
+pub(crate) const FUNC_STORE_BLOB:             &str = "storeBlob";
+pub(crate) const FUNC_STORE_BLOB_CHUNK:       &str = "storeBlobChunk";
+pub(crate) const VIEW_GET_BLOB_FIELD:         &str = "getBlobField";
+pub(crate) const VIEW_GET_BLOB_INFO:          &str = "getBlobInfo";
+pub(crate) const VIEW_GET_BLOB_OWNER:         &str = "getBlobOwner";
+pub(crate) const VIEW_GET_BLOB_UPLOAD_STATUS: &str = "getBlobUploadStatus";
+pub(crate) const VIEW_LIST_BLOBS:             &str = "listBlobs";
+
+pub(crate) const HFUNC_ABORT_BLOB_UPLOAD:      ScHname = ScHname(0x551f4a0e);
+pub(crate) const HFUNC_DELETE_BLOB:            ScHname = ScHname(0xcae606c1);
+pub(crate) const HFUNC_FINISH_BLOB_UPLOAD:     ScHname = ScHname(0xcedd5ec2);
+pub(crate) const HFUNC_STORE_BLOB:             ScHname = ScHname(0xddd4c281);
+pub(crate) const HFUNC_STORE_BLOB_CHUNK:       ScHname = ScHname(0x62a6d849);
+pub(crate) const HVIEW_GET_BLOB_FIELD:         ScHname = ScHname(0x1f448130);
+pub(crate) const HVIEW_GET_BLOB_INFO:          ScHname = ScHname(0xfde4ab46);
+pub(crate) const HVIEW_GET_BLOB_OWNER:         ScHname = ScHname(0xefc4d2da);
+pub(crate) const HVIEW_GET_BLOB_UPLOAD_STATUS: ScHname = ScHname(0xa72cb02e);
+pub(crate) const HVIEW_LIST_BLOBS:             ScHname = ScHname(0x62ca7990);
 
 // @formatter:on
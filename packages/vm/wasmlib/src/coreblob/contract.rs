@@ -14,12 +14,33 @@ use std::ptr;
 use crate::*;
 use crate::coreblob::*;
 
+pub struct AbortBlobUploadCall {
+    pub func:   ScFunc,
+    pub params: MutableAbortBlobUploadParams,
+}
+
+pub struct DeleteBlobCall {
+    pub func:   ScFunc,
+    pub params: MutableDeleteBlobParams,
+}
+
+pub struct FinishBlobUploadCall {
+    pub func:    ScFunc,
+    pub params:  MutableFinishBlobUploadParams,
+    pub results: ImmutableFinishBlobUploadResults,
+}
+
 pub struct StoreBlobCall {
     pub func:    ScFunc,
     pub params:  MutableStoreBlobParams,
     pub results: ImmutableStoreBlobResults,
 }
 
+pub struct StoreBlobChunkCall {
+    pub func:   ScFunc,
+    pub params: MutableStoreBlobChunkParams,
+}
+
 pub struct GetBlobFieldCall {
     pub func:    ScView,
     pub params:  MutableGetBlobFieldParams,
@@ -32,8 +53,21 @@ pub struct GetBlobInfoCall {
     pub results: ImmutableGetBlobInfoResults,
 }
 
+pub struct GetBlobOwnerCall {
+    pub func:    ScView,
+    pub params:  MutableGetBlobOwnerParams,
+    pub results: ImmutableGetBlobOwnerResults,
+}
+
+pub struct GetBlobUploadStatusCall {
+    pub func:    ScView,
+    pub params:  MutableGetBlobUploadStatusParams,
+    pub results: ImmutableGetBlobUploadStatusResults,
+}
+
 pub struct ListBlobsCall {
     pub func:    ScView,
+    pub params:  MutableListBlobsParams,
     pub results: ImmutableListBlobsResults,
 }
 
@@ -41,6 +75,31 @@ pub struct ScFuncs {
 }
 
 impl ScFuncs {
+    pub fn abort_blob_upload(_ctx: & dyn ScFuncCallContext) -> AbortBlobUploadCall {
+        let mut f = AbortBlobUploadCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ABORT_BLOB_UPLOAD),
+            params: MutableAbortBlobUploadParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn delete_blob(_ctx: & dyn ScFuncCallContext) -> DeleteBlobCall {
+        let mut f = DeleteBlobCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_DELETE_BLOB),
+            params: MutableDeleteBlobParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
+    pub fn finish_blob_upload(_ctx: & dyn ScFuncCallContext) -> FinishBlobUploadCall {
+        let mut f = FinishBlobUploadCall {
+            func:    ScFunc::new(HSC_NAME, HFUNC_FINISH_BLOB_UPLOAD),
+            params:  MutableFinishBlobUploadParams { id: 0 },
+            results: ImmutableFinishBlobUploadResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn store_blob(_ctx: & dyn ScFuncCallContext) -> StoreBlobCall {
         let mut f = StoreBlobCall {
             func:    ScFunc::new(HSC_NAME, HFUNC_STORE_BLOB),
@@ -50,6 +109,14 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn store_blob_chunk(_ctx: & dyn ScFuncCallContext) -> StoreBlobChunkCall {
+        let mut f = StoreBlobChunkCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_STORE_BLOB_CHUNK),
+            params: MutableStoreBlobChunkParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn get_blob_field(_ctx: & dyn ScViewCallContext) -> GetBlobFieldCall {
         let mut f = GetBlobFieldCall {
             func:    ScView::new(HSC_NAME, HVIEW_GET_BLOB_FIELD),
@@ -68,12 +135,31 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
+    pub fn get_blob_owner(_ctx: & dyn ScViewCallContext) -> GetBlobOwnerCall {
+        let mut f = GetBlobOwnerCall {
+            func:    ScView::new(HSC_NAME, HVIEW_GET_BLOB_OWNER),
+            params:  MutableGetBlobOwnerParams { id: 0 },
+            results: ImmutableGetBlobOwnerResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
+    pub fn get_blob_upload_status(_ctx: & dyn ScViewCallContext) -> GetBlobUploadStatusCall {
+        let mut f = GetBlobUploadStatusCall {
+            func:    ScView::new(HSC_NAME, HVIEW_GET_BLOB_UPLOAD_STATUS),
+            params:  MutableGetBlobUploadStatusParams { id: 0 },
+            results: ImmutableGetBlobUploadStatusResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn list_blobs(_ctx: & dyn ScViewCallContext) -> ListBlobsCall {
         let mut f = ListBlobsCall {
             func:    ScView::new(HSC_NAME, HVIEW_LIST_BLOBS),
+            params:  MutableListBlobsParams { id: 0 },
             results: ImmutableListBlobsResults { id: 0 },
         };
-        f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
 }
@@ -12,6 +12,72 @@ use crate::*;
 use crate::coreblob::*;
 use crate::host::*;
 
+#[derive(Clone, Copy)]
+pub struct ImmutableAbortBlobUploadParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableAbortBlobUploadParams {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableAbortBlobUploadParams {
+    pub(crate) id: i32,
+}
+
+impl MutableAbortBlobUploadParams {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableDeleteBlobParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableDeleteBlobParams {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableDeleteBlobParams {
+    pub(crate) id: i32,
+}
+
+impl MutableDeleteBlobParams {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableFinishBlobUploadParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableFinishBlobUploadParams {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableFinishBlobUploadParams {
+    pub(crate) id: i32,
+}
+
+impl MutableFinishBlobUploadParams {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
 pub struct MapStringToImmutableBytes {
     pub(crate) obj_id: i32,
 }
@@ -58,6 +124,52 @@ impl MutableStoreBlobParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableStoreBlobChunkParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableStoreBlobChunkParams {
+    pub fn bytes(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_BYTES.get_key_id())
+    }
+
+    pub fn field(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, PARAM_FIELD.get_key_id())
+    }
+
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+
+    pub fn index(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, PARAM_INDEX.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableStoreBlobChunkParams {
+    pub(crate) id: i32,
+}
+
+impl MutableStoreBlobChunkParams {
+    pub fn bytes(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_BYTES.get_key_id())
+    }
+
+    pub fn field(&self) -> ScMutableString {
+        ScMutableString::new(self.id, PARAM_FIELD.get_key_id())
+    }
+
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+
+    pub fn index(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, PARAM_INDEX.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableGetBlobFieldParams {
     pub(crate) id: i32,
@@ -109,3 +221,85 @@ impl MutableGetBlobInfoParams {
         ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
     }
 }
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetBlobOwnerParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetBlobOwnerParams {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetBlobOwnerParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetBlobOwnerParams {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetBlobUploadStatusParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetBlobUploadStatusParams {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+
+    pub fn owner(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_OWNER.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetBlobUploadStatusParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetBlobUploadStatusParams {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, PARAM_HASH.get_key_id())
+    }
+
+    pub fn owner(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_OWNER.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableListBlobsParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableListBlobsParams {
+    pub fn cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableListBlobsParams {
+    pub(crate) id: i32,
+}
+
+impl MutableListBlobsParams {
+    pub fn cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
@@ -12,6 +12,28 @@ use crate::*;
 use crate::coreblob::*;
 use crate::host::*;
 
+#[derive(Clone, Copy)]
+pub struct ImmutableFinishBlobUploadResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableFinishBlobUploadResults {
+    pub fn hash(&self) -> ScImmutableHash {
+        ScImmutableHash::new(self.id, RESULT_HASH.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableFinishBlobUploadResults {
+    pub(crate) id: i32,
+}
+
+impl MutableFinishBlobUploadResults {
+    pub fn hash(&self) -> ScMutableHash {
+        ScMutableHash::new(self.id, RESULT_HASH.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableStoreBlobResults {
     pub(crate) id: i32,
@@ -102,6 +124,50 @@ impl MutableGetBlobInfoResults {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableGetBlobOwnerResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetBlobOwnerResults {
+    pub fn owner(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, RESULT_OWNER.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetBlobOwnerResults {
+    pub(crate) id: i32,
+}
+
+impl MutableGetBlobOwnerResults {
+    pub fn owner(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, RESULT_OWNER.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetBlobUploadStatusResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetBlobUploadStatusResults {
+    pub fn chunk_counts(&self) -> MapStringToImmutableInt32 {
+        MapStringToImmutableInt32 { obj_id: self.id }
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetBlobUploadStatusResults {
+    pub(crate) id: i32,
+}
+
+impl MutableGetBlobUploadStatusResults {
+    pub fn chunk_counts(&self) -> MapStringToMutableInt32 {
+        MapStringToMutableInt32 { obj_id: self.id }
+    }
+}
+
 pub struct MapHashToImmutableInt32 {
     pub(crate) obj_id: i32,
 }
@@ -121,6 +187,14 @@ impl ImmutableListBlobsResults {
     pub fn blob_sizes(&self) -> MapHashToImmutableInt32 {
         MapHashToImmutableInt32 { obj_id: self.id }
     }
+
+    pub fn next_cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 pub struct MapHashToMutableInt32 {
@@ -146,4 +220,12 @@ impl MutableListBlobsResults {
     pub fn blob_sizes(&self) -> MapHashToMutableInt32 {
         MapHashToMutableInt32 { obj_id: self.id }
     }
+
+    pub fn next_cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
@@ -17,7 +17,9 @@ pub const HSC_NAME:       ScHname = ScHname(0xf538ef2b);
 
 pub(crate) const PARAM_BLOCK_INDEX:    &str = "n";
 pub(crate) const PARAM_CONTRACT_HNAME: &str = "h";
+pub(crate) const PARAM_CURSOR:         &str = "cursor";
 pub(crate) const PARAM_FROM_BLOCK:     &str = "f";
+pub(crate) const PARAM_LIMIT:          &str = "limit";
 pub(crate) const PARAM_REQUEST_ID:     &str = "u";
 pub(crate) const PARAM_TO_BLOCK:       &str = "t";
 
@@ -25,11 +27,13 @@ pub(crate) const RESULT_BLOCK_INDEX:              &str = "n";
 pub(crate) const RESULT_BLOCK_INFO:               &str = "i";
 pub(crate) const RESULT_EVENT:                    &str = "e";
 pub(crate) const RESULT_GOVERNING_ADDRESS:        &str = "g";
+pub(crate) const RESULT_NEXT_CURSOR:              &str = "nextCursor";
 pub(crate) const RESULT_REQUEST_ID:               &str = "u";
 pub(crate) const RESULT_REQUEST_INDEX:            &str = "r";
 pub(crate) const RESULT_REQUEST_PROCESSED:        &str = "p";
 pub(crate) const RESULT_REQUEST_RECORD:           &str = "d";
 pub(crate) const RESULT_STATE_CONTROLLER_ADDRESS: &str = "s";
+pub(crate) const RESULT_TRUNCATED:                &str = "truncated";
 
 pub(crate) const VIEW_CONTROL_ADDRESSES:              &str = "controlAddresses";
 pub(crate) const VIEW_GET_BLOCK_INFO:                 &str = "getBlockInfo";
@@ -66,10 +66,18 @@ impl ImmutableGetEventsForContractParams {
         ScImmutableHname::new(self.id, PARAM_CONTRACT_HNAME.get_key_id())
     }
 
+    pub fn cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
     pub fn from_block(&self) -> ScImmutableInt32 {
         ScImmutableInt32::new(self.id, PARAM_FROM_BLOCK.get_key_id())
     }
 
+    pub fn limit(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+
     pub fn to_block(&self) -> ScImmutableInt32 {
         ScImmutableInt32::new(self.id, PARAM_TO_BLOCK.get_key_id())
     }
@@ -85,10 +93,18 @@ impl MutableGetEventsForContractParams {
         ScMutableHname::new(self.id, PARAM_CONTRACT_HNAME.get_key_id())
     }
 
+    pub fn cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
     pub fn from_block(&self) -> ScMutableInt32 {
         ScMutableInt32::new(self.id, PARAM_FROM_BLOCK.get_key_id())
     }
 
+    pub fn limit(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+
     pub fn to_block(&self) -> ScMutableInt32 {
         ScMutableInt32::new(self.id, PARAM_TO_BLOCK.get_key_id())
     }
@@ -138,6 +138,14 @@ impl ImmutableGetEventsForContractResults {
         let arr_id = get_object_id(self.id, RESULT_EVENT.get_key_id(), TYPE_ARRAY16 | TYPE_BYTES);
         ArrayOfImmutableBytes { obj_id: arr_id }
     }
+
+    pub fn next_cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 #[derive(Clone, Copy)]
@@ -150,6 +158,14 @@ impl MutableGetEventsForContractResults {
         let arr_id = get_object_id(self.id, RESULT_EVENT.get_key_id(), TYPE_ARRAY16 | TYPE_BYTES);
         ArrayOfMutableBytes { obj_id: arr_id }
     }
+
+    pub fn next_cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 #[derive(Clone, Copy)]
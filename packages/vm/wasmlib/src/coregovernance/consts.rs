@@ -15,6 +15,8 @@ pub const SC_NAME:        &str = "governance";
 pub const SC_DESCRIPTION: &str = "Core governance contract";
 pub const HSC_NAME:       ScHname = ScHname(0x17cf909f);
 
+pub(crate) const PARAM_ACL_LIST:                 &str = "al";
+pub(crate) const PARAM_AGENT_ID:                 &str = "ag";
 pub(crate) const PARAM_CHAIN_OWNER:              &str = "oi";
 pub(crate) const PARAM_FEE_COLOR:                &str = "fc";
 pub(crate) const PARAM_HNAME:                    &str = "hn";
@@ -25,6 +27,7 @@ pub(crate) const PARAM_OWNER_FEE:                &str = "of";
 pub(crate) const PARAM_STATE_CONTROLLER_ADDRESS: &str = "S";
 pub(crate) const PARAM_VALIDATOR_FEE:            &str = "vf";
 
+pub(crate) const RESULT_ACL_AGENT_I_DS:                     &str = "aa";
 pub(crate) const RESULT_ALLOWED_STATE_CONTROLLER_ADDRESSES: &str = "a";
 pub(crate) const RESULT_CHAIN_ID:                           &str = "c";
 pub(crate) const RESULT_CHAIN_OWNER_ID:                     &str = "o";
@@ -39,26 +42,32 @@ pub(crate) const RESULT_OWNER_FEE:                          &str = "of";
 pub(crate) const RESULT_VALIDATOR_FEE:                      &str = "vf";
 
 pub(crate) const FUNC_ADD_ALLOWED_STATE_CONTROLLER_ADDRESS:    &str = "addAllowedStateControllerAddress";
+pub(crate) const FUNC_ADD_TO_ACL:                              &str = "addToACL";
 pub(crate) const FUNC_CLAIM_CHAIN_OWNERSHIP:                   &str = "claimChainOwnership";
 pub(crate) const FUNC_DELEGATE_CHAIN_OWNERSHIP:                &str = "delegateChainOwnership";
 pub(crate) const FUNC_REMOVE_ALLOWED_STATE_CONTROLLER_ADDRESS: &str = "removeAllowedStateControllerAddress";
+pub(crate) const FUNC_REMOVE_FROM_ACL:                         &str = "removeFromACL";
 pub(crate) const FUNC_ROTATE_STATE_CONTROLLER:                 &str = "rotateStateController";
 pub(crate) const FUNC_SET_CHAIN_INFO:                          &str = "setChainInfo";
 pub(crate) const FUNC_SET_CONTRACT_FEE:                        &str = "setContractFee";
 pub(crate) const FUNC_SET_DEFAULT_FEE:                         &str = "setDefaultFee";
+pub(crate) const VIEW_GET_ACL:                                 &str = "getACL";
 pub(crate) const VIEW_GET_ALLOWED_STATE_CONTROLLER_ADDRESSES:  &str = "getAllowedStateControllerAddresses";
 pub(crate) const VIEW_GET_CHAIN_INFO:                          &str = "getChainInfo";
 pub(crate) const VIEW_GET_FEE_INFO:                            &str = "getFeeInfo";
 pub(crate) const VIEW_GET_MAX_BLOB_SIZE:                       &str = "getMaxBlobSize";
 
 pub(crate) const HFUNC_ADD_ALLOWED_STATE_CONTROLLER_ADDRESS:    ScHname = ScHname(0x9469d567);
+pub(crate) const HFUNC_ADD_TO_ACL:                              ScHname = ScHname(0xf9d77ccb);
 pub(crate) const HFUNC_CLAIM_CHAIN_OWNERSHIP:                   ScHname = ScHname(0x03ff0fc0);
 pub(crate) const HFUNC_DELEGATE_CHAIN_OWNERSHIP:                ScHname = ScHname(0x93ecb6ad);
 pub(crate) const HFUNC_REMOVE_ALLOWED_STATE_CONTROLLER_ADDRESS: ScHname = ScHname(0x31f69447);
+pub(crate) const HFUNC_REMOVE_FROM_ACL:                         ScHname = ScHname(0x67bc7a99);
 pub(crate) const HFUNC_ROTATE_STATE_CONTROLLER:                 ScHname = ScHname(0x244d1038);
 pub(crate) const HFUNC_SET_CHAIN_INFO:                          ScHname = ScHname(0x702f5d2b);
 pub(crate) const HFUNC_SET_CONTRACT_FEE:                        ScHname = ScHname(0x8421a42b);
 pub(crate) const HFUNC_SET_DEFAULT_FEE:                         ScHname = ScHname(0x3310ecd0);
+pub(crate) const HVIEW_GET_ACL:                                 ScHname = ScHname(0x8dd122e4);
 pub(crate) const HVIEW_GET_ALLOWED_STATE_CONTROLLER_ADDRESSES:  ScHname = ScHname(0xf3505183);
 pub(crate) const HVIEW_GET_CHAIN_INFO:                          ScHname = ScHname(0x434477e2);
 pub(crate) const HVIEW_GET_FEE_INFO:                            ScHname = ScHname(0x9fe54b48);
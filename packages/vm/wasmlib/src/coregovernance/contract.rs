@@ -19,6 +19,11 @@ pub struct AddAllowedStateControllerAddressCall {
     pub params: MutableAddAllowedStateControllerAddressParams,
 }
 
+pub struct AddToACLCall {
+    pub func:   ScFunc,
+    pub params: MutableAddToACLParams,
+}
+
 pub struct ClaimChainOwnershipCall {
     pub func: ScFunc,
 }
@@ -33,6 +38,11 @@ pub struct RemoveAllowedStateControllerAddressCall {
     pub params: MutableRemoveAllowedStateControllerAddressParams,
 }
 
+pub struct RemoveFromACLCall {
+    pub func:   ScFunc,
+    pub params: MutableRemoveFromACLParams,
+}
+
 pub struct RotateStateControllerCall {
     pub func:   ScFunc,
     pub params: MutableRotateStateControllerParams,
@@ -53,6 +63,12 @@ pub struct SetDefaultFeeCall {
     pub params: MutableSetDefaultFeeParams,
 }
 
+pub struct GetACLCall {
+    pub func:    ScView,
+    pub params:  MutableGetACLParams,
+    pub results: ImmutableGetACLResults,
+}
+
 pub struct GetAllowedStateControllerAddressesCall {
     pub func:    ScView,
     pub results: ImmutableGetAllowedStateControllerAddressesResults,
@@ -86,6 +102,14 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn add_to_acl(_ctx: & dyn ScFuncCallContext) -> AddToACLCall {
+        let mut f = AddToACLCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_ADD_TO_ACL),
+            params: MutableAddToACLParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn claim_chain_ownership(_ctx: & dyn ScFuncCallContext) -> ClaimChainOwnershipCall {
         ClaimChainOwnershipCall {
             func: ScFunc::new(HSC_NAME, HFUNC_CLAIM_CHAIN_OWNERSHIP),
@@ -107,6 +131,14 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn remove_from_acl(_ctx: & dyn ScFuncCallContext) -> RemoveFromACLCall {
+        let mut f = RemoveFromACLCall {
+            func:   ScFunc::new(HSC_NAME, HFUNC_REMOVE_FROM_ACL),
+            params: MutableRemoveFromACLParams { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
+        f
+    }
     pub fn rotate_state_controller(_ctx: & dyn ScFuncCallContext) -> RotateStateControllerCall {
         let mut f = RotateStateControllerCall {
             func:   ScFunc::new(HSC_NAME, HFUNC_ROTATE_STATE_CONTROLLER),
@@ -139,6 +171,15 @@ impl ScFuncs {
         f.func.set_ptrs(&mut f.params.id, ptr::null_mut());
         f
     }
+    pub fn get_acl(_ctx: & dyn ScViewCallContext) -> GetACLCall {
+        let mut f = GetACLCall {
+            func:    ScView::new(HSC_NAME, HVIEW_GET_ACL),
+            params:  MutableGetACLParams { id: 0 },
+            results: ImmutableGetACLResults { id: 0 },
+        };
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
+        f
+    }
     pub fn get_allowed_state_controller_addresses(_ctx: & dyn ScViewCallContext) -> GetAllowedStateControllerAddressesCall {
         let mut f = GetAllowedStateControllerAddressesCall {
             func:    ScView::new(HSC_NAME, HVIEW_GET_ALLOWED_STATE_CONTROLLER_ADDRESSES),
@@ -50,6 +50,44 @@ impl MutableAddAllowedStateControllerAddressParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableAddToACLParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableAddToACLParams {
+    pub fn acl_list(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScImmutableHname {
+        ScImmutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableAddToACLParams {
+    pub(crate) id: i32,
+}
+
+impl MutableAddToACLParams {
+    pub fn acl_list(&self) -> ScMutableString {
+        ScMutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScMutableHname {
+        ScMutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableDelegateChainOwnershipParams {
     pub(crate) id: i32,
@@ -94,6 +132,44 @@ impl MutableRemoveAllowedStateControllerAddressParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableRemoveFromACLParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableRemoveFromACLParams {
+    pub fn acl_list(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn agent_id(&self) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScImmutableHname {
+        ScImmutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableRemoveFromACLParams {
+    pub(crate) id: i32,
+}
+
+impl MutableRemoveFromACLParams {
+    pub fn acl_list(&self) -> ScMutableString {
+        ScMutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn agent_id(&self) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.id, PARAM_AGENT_ID.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScMutableHname {
+        ScMutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableRotateStateControllerParams {
     pub(crate) id: i32,
@@ -238,6 +314,36 @@ impl MutableSetDefaultFeeParams {
     }
 }
 
+#[derive(Clone, Copy)]
+pub struct ImmutableGetACLParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetACLParams {
+    pub fn acl_list(&self) -> ScImmutableString {
+        ScImmutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScImmutableHname {
+        ScImmutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetACLParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetACLParams {
+    pub fn acl_list(&self) -> ScMutableString {
+        ScMutableString::new(self.id, PARAM_ACL_LIST.get_key_id())
+    }
+
+    pub fn hname(&self) -> ScMutableHname {
+        ScMutableHname::new(self.id, PARAM_HNAME.get_key_id())
+    }
+}
+
 #[derive(Clone, Copy)]
 pub struct ImmutableGetFeeInfoParams {
     pub(crate) id: i32,
@@ -12,6 +12,62 @@ use crate::*;
 use crate::coregovernance::*;
 use crate::host::*;
 
+pub struct ArrayOfImmutableAgentID {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfImmutableAgentID {
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_agent_id(&self, index: i32) -> ScImmutableAgentID {
+        ScImmutableAgentID::new(self.obj_id, Key32(index))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetACLResults {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetACLResults {
+    pub fn acl_agent_i_ds(&self) -> ArrayOfImmutableAgentID {
+        let arr_id = get_object_id(self.id, RESULT_ACL_AGENT_I_DS.get_key_id(), TYPE_ARRAY16 | TYPE_AGENT_ID);
+        ArrayOfImmutableAgentID { obj_id: arr_id }
+    }
+}
+
+pub struct ArrayOfMutableAgentID {
+    pub(crate) obj_id: i32,
+}
+
+impl ArrayOfMutableAgentID {
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+
+    pub fn get_agent_id(&self, index: i32) -> ScMutableAgentID {
+        ScMutableAgentID::new(self.obj_id, Key32(index))
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetACLResults {
+    pub(crate) id: i32,
+}
+
+impl MutableGetACLResults {
+    pub fn acl_agent_i_ds(&self) -> ArrayOfMutableAgentID {
+        let arr_id = get_object_id(self.id, RESULT_ACL_AGENT_I_DS.get_key_id(), TYPE_ARRAY16 | TYPE_AGENT_ID);
+        ArrayOfMutableAgentID { obj_id: arr_id }
+    }
+}
+
 pub struct ArrayOfImmutableBytes {
     pub(crate) obj_id: i32,
 }
@@ -15,15 +15,19 @@ pub const SC_NAME:        &str = "root";
 pub const SC_DESCRIPTION: &str = "Core root contract";
 pub const HSC_NAME:       ScHname = ScHname(0xcebf5908);
 
+pub(crate) const PARAM_CURSOR:       &str = "cursor";
 pub(crate) const PARAM_DEPLOYER:     &str = "dp";
 pub(crate) const PARAM_DESCRIPTION:  &str = "ds";
 pub(crate) const PARAM_HNAME:        &str = "hn";
+pub(crate) const PARAM_LIMIT:        &str = "limit";
 pub(crate) const PARAM_NAME:         &str = "nm";
 pub(crate) const PARAM_PROGRAM_HASH: &str = "ph";
 
 pub(crate) const RESULT_CONTRACT_FOUND:    &str = "cf";
 pub(crate) const RESULT_CONTRACT_REC_DATA: &str = "dt";
 pub(crate) const RESULT_CONTRACT_REGISTRY: &str = "r";
+pub(crate) const RESULT_NEXT_CURSOR:       &str = "nextCursor";
+pub(crate) const RESULT_TRUNCATED:         &str = "truncated";
 
 pub(crate) const FUNC_DEPLOY_CONTRACT:          &str = "deployContract";
 pub(crate) const FUNC_GRANT_DEPLOY_PERMISSION:  &str = "grantDeployPermission";
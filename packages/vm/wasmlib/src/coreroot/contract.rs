@@ -37,6 +37,7 @@ pub struct FindContractCall {
 
 pub struct GetContractRecordsCall {
     pub func:    ScView,
+    pub params:  MutableGetContractRecordsParams,
     pub results: ImmutableGetContractRecordsResults,
 }
 
@@ -80,9 +81,10 @@ impl ScFuncs {
     pub fn get_contract_records(_ctx: & dyn ScViewCallContext) -> GetContractRecordsCall {
         let mut f = GetContractRecordsCall {
             func:    ScView::new(HSC_NAME, HVIEW_GET_CONTRACT_RECORDS),
+            params:  MutableGetContractRecordsParams { id: 0 },
             results: ImmutableGetContractRecordsResults { id: 0 },
         };
-        f.func.set_ptrs(ptr::null_mut(), &mut f.results.id);
+        f.func.set_ptrs(&mut f.params.id, &mut f.results.id);
         f
     }
 }
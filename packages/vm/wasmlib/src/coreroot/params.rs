@@ -115,3 +115,33 @@ impl MutableFindContractParams {
         ScMutableHname::new(self.id, PARAM_HNAME.get_key_id())
     }
 }
+
+#[derive(Clone, Copy)]
+pub struct ImmutableGetContractRecordsParams {
+    pub(crate) id: i32,
+}
+
+impl ImmutableGetContractRecordsParams {
+    pub fn cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScImmutableInt32 {
+        ScImmutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
+
+#[derive(Clone, Copy)]
+pub struct MutableGetContractRecordsParams {
+    pub(crate) id: i32,
+}
+
+impl MutableGetContractRecordsParams {
+    pub fn cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, PARAM_CURSOR.get_key_id())
+    }
+
+    pub fn limit(&self) -> ScMutableInt32 {
+        ScMutableInt32::new(self.id, PARAM_LIMIT.get_key_id())
+    }
+}
@@ -62,6 +62,14 @@ impl ImmutableGetContractRecordsResults {
         let map_id = get_object_id(self.id, RESULT_CONTRACT_REGISTRY.get_key_id(), TYPE_MAP);
         MapHnameToImmutableBytes { obj_id: map_id }
     }
+
+    pub fn next_cursor(&self) -> ScImmutableBytes {
+        ScImmutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScImmutableBool {
+        ScImmutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
 
 pub struct MapHnameToMutableBytes {
@@ -88,4 +96,12 @@ impl MutableGetContractRecordsResults {
         let map_id = get_object_id(self.id, RESULT_CONTRACT_REGISTRY.get_key_id(), TYPE_MAP);
         MapHnameToMutableBytes { obj_id: map_id }
     }
+
+    pub fn next_cursor(&self) -> ScMutableBytes {
+        ScMutableBytes::new(self.id, RESULT_NEXT_CURSOR.get_key_id())
+    }
+
+    pub fn truncated(&self) -> ScMutableBool {
+        ScMutableBool::new(self.id, RESULT_TRUNCATED.get_key_id())
+    }
 }
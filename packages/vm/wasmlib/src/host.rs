@@ -25,6 +25,15 @@ pub const TYPE_INT64: i32 = 10;
 pub const TYPE_MAP: i32 = 11;
 pub const TYPE_REQUEST_ID: i32 = 12;
 pub const TYPE_STRING: i32 = 13;
+pub const TYPE_BOOL: i32 = 14;
+pub const TYPE_INT8: i32 = 15;
+
+// extended types have the 0x80 bit set, the other bits are taken
+pub const TYPE_UINT8: i32 = 0x80;
+pub const TYPE_UINT16: i32 = 0x81;
+pub const TYPE_UINT32: i32 = 0x82;
+pub const TYPE_UINT64: i32 = 0x83;
+pub const TYPE_BIG_INT: i32 = 0x84;
 
 pub const OBJ_ID_NULL: i32 = 0;
 pub const OBJ_ID_ROOT: i32 = 1;
@@ -32,8 +41,12 @@ pub const OBJ_ID_STATE: i32 = 2;
 pub const OBJ_ID_PARAMS: i32 = 3;
 pub const OBJ_ID_RESULTS: i32 = 4;
 
-// size in bytes of predefined types, indexed by the TYPE_* consts
-const TYPE_SIZES: &[u8] = &[0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0];
+// size in bytes of predefined types, the basic types followed by the extended types
+const TYPE_SIZES: &[u8] = &[0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0, 1, 1, 1, 2, 4, 8, 0];
+
+fn type_size(type_id: i32) -> i32 {
+    TYPE_SIZES[((type_id & 0x0f) | ((type_id & 0x80) >> 3)) as usize] as i32
+}
 
 // These 4 external functions are funneling the entire WasmLib functionality
 // to their counterparts on the host.
@@ -105,7 +118,7 @@ pub fn exists(obj_id: i32, key_id: Key32, type_id: i32) -> bool {
 // return the default value for the specified type.
 pub fn get_bytes(obj_id: i32, key_id: Key32, type_id: i32) -> Vec<u8> {
     unsafe {
-        let mut size = TYPE_SIZES[type_id as usize] as i32;
+        let mut size = type_size(type_id);
         if size == 0 {
             // variable-sized type, first query expected length of bytes array
             // (pass zero-length buffer)
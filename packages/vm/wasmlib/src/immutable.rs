@@ -5,10 +5,12 @@
 
 use std::convert::TryInto;
 
+use crate::bigint::*;
 use crate::context::*;
 use crate::hashtypes::*;
 use crate::host::*;
 use crate::keys::*;
+use crate::ordered::*;
 
 // value proxy for immutable ScAddress in host container
 pub struct ScImmutableAddress {
@@ -106,6 +108,103 @@ impl ScImmutableAgentIDArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for immutable ScBigInt in host container
+pub struct ScImmutableBigInt {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableBigInt {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableBigInt {
+        ScImmutableBigInt { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BIG_INT)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> ScBigInt {
+        ScBigInt::from_bytes(&get_bytes(self.obj_id, self.key_id, TYPE_BIG_INT))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of ScBigInt
+pub struct ScImmutableBigIntArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableBigIntArray {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_big_int(&self, index: i32) -> ScImmutableBigInt {
+        ScImmutableBigInt { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable bool in host container
+pub struct ScImmutableBool {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableBool {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableBool {
+        ScImmutableBool { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BOOL)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> bool {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_BOOL);
+        bytes[0] != 0
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of bool
+pub struct ScImmutableBoolArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableBoolArray {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_bool(&self, index: i32) -> ScImmutableBool {
+        ScImmutableBool { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for immutable bytes array in host container
 pub struct ScImmutableBytes {
     obj_id: i32,
@@ -346,6 +445,55 @@ impl ScImmutableHnameArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for immutable int8 in host container
+pub struct ScImmutableInt8 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableInt8 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableInt8 {
+        ScImmutableInt8 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_INT8)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> i8 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_INT8);
+        bytes[0] as i8
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of int8
+pub struct ScImmutableInt8Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableInt8Array {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_int8(&self, index: i32) -> ScImmutableInt8 {
+        ScImmutableInt8 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for immutable int16 in host container
 pub struct ScImmutableInt16 {
     obj_id: i32,
@@ -525,6 +673,28 @@ impl ScImmutableMap {
         ScImmutableAgentIDArray { obj_id: arr_id }
     }
 
+    // get value proxy for immutable ScBigInt field specified by key
+    pub fn get_big_int<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableBigInt {
+        ScImmutableBigInt { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableBigIntArray specified by key
+    pub fn get_big_int_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableBigIntArray {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_BIG_INT | TYPE_ARRAY);
+        ScImmutableBigIntArray { obj_id: arr_id }
+    }
+
+    // get value proxy for immutable bool field specified by key
+    pub fn get_bool<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableBool {
+        ScImmutableBool { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableBoolArray specified by key
+    pub fn get_bool_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableBoolArray {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_BOOL | TYPE_ARRAY);
+        ScImmutableBoolArray { obj_id: arr_id }
+    }
+
     // get value proxy for immutable bytes array field specified by key
     pub fn get_bytes<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableBytes {
         ScImmutableBytes { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -580,6 +750,17 @@ impl ScImmutableMap {
         ScImmutableHnameArray { obj_id: arr_id }
     }
 
+    // get value proxy for immutable int8 field specified by key
+    pub fn get_int8<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableInt8 {
+        ScImmutableInt8 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableInt8Array specified by key
+    pub fn get_int8_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableInt8Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_INT8 | TYPE_ARRAY);
+        ScImmutableInt8Array { obj_id: arr_id }
+    }
+
     // get value proxy for immutable int16 field specified by key
     pub fn get_int16<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableInt16 {
         ScImmutableInt16 { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -625,6 +806,11 @@ impl ScImmutableMap {
         ScImmutableMapArray { obj_id: arr_id }
     }
 
+    // get proxy for ScImmutableOrderedMap specified by key
+    pub fn get_ordered_map<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableOrderedMap {
+        ScImmutableOrderedMap::new(self.obj_id, key.get_key_id())
+    }
+
     // get value proxy for immutable ScRequestID field specified by key
     pub fn get_request_id<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableRequestID {
         ScImmutableRequestID { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -646,6 +832,50 @@ impl ScImmutableMap {
         let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_STRING | TYPE_ARRAY);
         ScImmutableStringArray { obj_id: arr_id }
     }
+    // get value proxy for immutable uint8 field specified by key
+    pub fn get_uint8<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint8 {
+        ScImmutableUint8 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableUint8Array specified by key
+    pub fn get_uint8_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint8Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT8 | TYPE_ARRAY);
+        ScImmutableUint8Array { obj_id: arr_id }
+    }
+    // get value proxy for immutable uint16 field specified by key
+    pub fn get_uint16<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint16 {
+        ScImmutableUint16 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableUint16Array specified by key
+    pub fn get_uint16_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint16Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT16 | TYPE_ARRAY);
+        ScImmutableUint16Array { obj_id: arr_id }
+    }
+    // get value proxy for immutable uint32 field specified by key
+    pub fn get_uint32<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint32 {
+        ScImmutableUint32 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableUint32Array specified by key
+    pub fn get_uint32_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint32Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT32 | TYPE_ARRAY);
+        ScImmutableUint32Array { obj_id: arr_id }
+    }
+    // get value proxy for immutable uint64 field specified by key
+    pub fn get_uint64<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint64 {
+        ScImmutableUint64 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScImmutableUint64Array specified by key
+    pub fn get_uint64_array<T: MapKey + ?Sized>(&self, key: &T) -> ScImmutableUint64Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT64 | TYPE_ARRAY);
+        ScImmutableUint64Array { obj_id: arr_id }
+    }
+
+
+
+
 
     pub fn map_id(&self) -> i32 {
         self.obj_id
@@ -766,3 +996,199 @@ impl ScImmutableStringArray {
         get_length(self.obj_id)
     }
 }
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint8 in host container
+pub struct ScImmutableUint8 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableUint8 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableUint8 {
+        ScImmutableUint8 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT8)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> u8 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT8);
+        bytes[0]
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint8
+pub struct ScImmutableUint8Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableUint8Array {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_uint8(&self, index: i32) -> ScImmutableUint8 {
+        ScImmutableUint8 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint16 in host container
+pub struct ScImmutableUint16 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableUint16 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableUint16 {
+        ScImmutableUint16 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT16)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> u16 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT16);
+        u16::from_le_bytes(bytes.try_into().expect("invalid u16 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint16
+pub struct ScImmutableUint16Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableUint16Array {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_uint16(&self, index: i32) -> ScImmutableUint16 {
+        ScImmutableUint16 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint32 in host container
+pub struct ScImmutableUint32 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableUint32 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableUint32 {
+        ScImmutableUint32 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT32)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> u32 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT32);
+        u32::from_le_bytes(bytes.try_into().expect("invalid u32 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint32
+pub struct ScImmutableUint32Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableUint32Array {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_uint32(&self, index: i32) -> ScImmutableUint32 {
+        ScImmutableUint32 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint64 in host container
+pub struct ScImmutableUint64 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableUint64 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableUint64 {
+        ScImmutableUint64 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT64)
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // get value from host container
+    pub fn value(&self) -> u64 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT64);
+        u64::from_le_bytes(bytes.try_into().expect("invalid u64 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint64
+pub struct ScImmutableUint64Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScImmutableUint64Array {
+    // get value proxy for item at index, index can be 0..length()-1
+    pub fn get_uint64(&self, index: i32) -> ScImmutableUint64 {
+        ScImmutableUint64 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
@@ -3,6 +3,7 @@
 
 #![allow(dead_code)]
 
+pub use bigint::*;
 pub use bytes::*;
 pub use context::*;
 pub use contract::*;
@@ -11,7 +12,9 @@ pub use hashtypes::*;
 pub use immutable::*;
 pub use keys::*;
 pub use mutable::*;
+pub use ordered::*;
 
+mod bigint;
 mod bytes;
 mod context;
 mod contract;
@@ -26,6 +29,7 @@ pub mod host;
 mod immutable;
 pub mod keys;
 mod mutable;
+mod ordered;
 
 // When the `wee_alloc` feature is enabled,
 // use `wee_alloc` as the global allocator.
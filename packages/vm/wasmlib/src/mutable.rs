@@ -5,11 +5,13 @@
 
 use std::convert::TryInto;
 
+use crate::bigint::*;
 use crate::context::*;
 use crate::hashtypes::*;
 use crate::host::*;
 use crate::immutable::*;
 use crate::keys::*;
+use crate::ordered::*;
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
@@ -141,6 +143,135 @@ impl ScMutableAgentIDArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for mutable ScBigInt in host container
+pub struct ScMutableBigInt {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableBigInt {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableBigInt {
+        ScMutableBigInt { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BIG_INT)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: &ScBigInt) {
+        set_bytes(self.obj_id, self.key_id, TYPE_BIG_INT, &val.to_bytes());
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> ScBigInt {
+        ScBigInt::from_bytes(&get_bytes(self.obj_id, self.key_id, TYPE_BIG_INT))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of ScBigInt
+pub struct ScMutableBigIntArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableBigIntArray {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_big_int(&self, index: i32) -> ScMutableBigInt {
+        ScMutableBigInt { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableBigIntArray {
+        ScImmutableBigIntArray { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable bool in host container
+pub struct ScMutableBool {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableBool {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableBool {
+        ScMutableBool { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_BOOL)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: bool) {
+        set_bytes(self.obj_id, self.key_id, TYPE_BOOL, &[val as u8]);
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> bool {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_BOOL);
+        bytes[0] != 0
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of bool
+pub struct ScMutableBoolArray {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableBoolArray {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_bool(&self, index: i32) -> ScMutableBool {
+        ScMutableBool { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableBoolArray {
+        ScImmutableBoolArray { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for mutable bytes array in host container
 pub struct ScMutableBytes {
     obj_id: i32,
@@ -461,6 +592,71 @@ impl ScMutableHnameArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for mutable int8 in host container
+pub struct ScMutableInt8 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableInt8 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableInt8 {
+        ScMutableInt8 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_INT8)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: i8) {
+        set_bytes(self.obj_id, self.key_id, TYPE_INT8, &[val as u8]);
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> i8 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_INT8);
+        bytes[0] as i8
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of int8
+pub struct ScMutableInt8Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableInt8Array {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_int8(&self, index: i32) -> ScMutableInt8 {
+        ScMutableInt8 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableInt8Array {
+        ScImmutableInt8Array { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for mutable int16 in host container
 pub struct ScMutableInt16 {
     obj_id: i32,
@@ -700,6 +896,28 @@ impl ScMutableMap {
         ScMutableAgentIDArray { obj_id: arr_id }
     }
 
+    // get value proxy for mutable ScBigInt field specified by key
+    pub fn get_big_int<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableBigInt {
+        ScMutableBigInt { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableBigIntArray specified by key
+    pub fn get_big_int_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableBigIntArray {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_BIG_INT | TYPE_ARRAY);
+        ScMutableBigIntArray { obj_id: arr_id }
+    }
+
+    // get value proxy for mutable bool field specified by key
+    pub fn get_bool<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableBool {
+        ScMutableBool { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableBoolArray specified by key
+    pub fn get_bool_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableBoolArray {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_BOOL | TYPE_ARRAY);
+        ScMutableBoolArray { obj_id: arr_id }
+    }
+
     // get value proxy for mutable bytes array field specified by key
     pub fn get_bytes<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableBytes {
         ScMutableBytes { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -755,6 +973,17 @@ impl ScMutableMap {
         ScMutableHnameArray { obj_id: arr_id }
     }
 
+    // get value proxy for mutable int8 field specified by key
+    pub fn get_int8<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableInt8 {
+        ScMutableInt8 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableInt8Array specified by key
+    pub fn get_int8_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableInt8Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_INT8 | TYPE_ARRAY);
+        ScMutableInt8Array { obj_id: arr_id }
+    }
+
     // get value proxy for mutable int16 field specified by key
     pub fn get_int16<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableInt16 {
         ScMutableInt16 { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -800,6 +1029,11 @@ impl ScMutableMap {
         ScMutableMapArray { obj_id: arr_id }
     }
 
+    // get proxy for ScMutableOrderedMap specified by key
+    pub fn get_ordered_map<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableOrderedMap {
+        ScMutableOrderedMap::new(self.obj_id, key.get_key_id())
+    }
+
     // get value proxy for mutable ScRequestID field specified by key
     pub fn get_request_id<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableRequestID {
         ScMutableRequestID { obj_id: self.obj_id, key_id: key.get_key_id() }
@@ -821,6 +1055,50 @@ impl ScMutableMap {
         let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_STRING | TYPE_ARRAY);
         ScMutableStringArray { obj_id: arr_id }
     }
+    // get value proxy for mutable uint8 field specified by key
+    pub fn get_uint8<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint8 {
+        ScMutableUint8 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableUint8Array specified by key
+    pub fn get_uint8_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint8Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT8 | TYPE_ARRAY);
+        ScMutableUint8Array { obj_id: arr_id }
+    }
+    // get value proxy for mutable uint16 field specified by key
+    pub fn get_uint16<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint16 {
+        ScMutableUint16 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableUint16Array specified by key
+    pub fn get_uint16_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint16Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT16 | TYPE_ARRAY);
+        ScMutableUint16Array { obj_id: arr_id }
+    }
+    // get value proxy for mutable uint32 field specified by key
+    pub fn get_uint32<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint32 {
+        ScMutableUint32 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableUint32Array specified by key
+    pub fn get_uint32_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint32Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT32 | TYPE_ARRAY);
+        ScMutableUint32Array { obj_id: arr_id }
+    }
+    // get value proxy for mutable uint64 field specified by key
+    pub fn get_uint64<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint64 {
+        ScMutableUint64 { obj_id: self.obj_id, key_id: key.get_key_id() }
+    }
+
+    // get array proxy for ScMutableUint64Array specified by key
+    pub fn get_uint64_array<T: MapKey + ?Sized>(&self, key: &T) -> ScMutableUint64Array {
+        let arr_id = get_object_id(self.obj_id, key.get_key_id(), TYPE_UINT64 | TYPE_ARRAY);
+        ScMutableUint64Array { obj_id: arr_id }
+    }
+
+
+
+
 
     // get immutable version of map proxy
     pub fn immutable(&self) -> ScImmutableMap {
@@ -991,3 +1269,263 @@ impl ScMutableStringArray {
         get_length(self.obj_id)
     }
 }
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint8 in host container
+pub struct ScMutableUint8 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableUint8 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableUint8 {
+        ScMutableUint8 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT8)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: u8) {
+        set_bytes(self.obj_id, self.key_id, TYPE_UINT8, &[val]);
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> u8 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT8);
+        bytes[0]
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint8
+pub struct ScMutableUint8Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableUint8Array {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_uint8(&self, index: i32) -> ScMutableUint8 {
+        ScMutableUint8 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableUint8Array {
+        ScImmutableUint8Array { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint16 in host container
+pub struct ScMutableUint16 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableUint16 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableUint16 {
+        ScMutableUint16 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT16)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: u16) {
+        set_bytes(self.obj_id, self.key_id, TYPE_UINT16, &val.to_le_bytes());
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> u16 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT16);
+        u16::from_le_bytes(bytes.try_into().expect("invalid u16 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint16
+pub struct ScMutableUint16Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableUint16Array {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_uint16(&self, index: i32) -> ScMutableUint16 {
+        ScMutableUint16 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableUint16Array {
+        ScImmutableUint16Array { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint32 in host container
+pub struct ScMutableUint32 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableUint32 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableUint32 {
+        ScMutableUint32 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT32)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: u32) {
+        set_bytes(self.obj_id, self.key_id, TYPE_UINT32, &val.to_le_bytes());
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> u32 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT32);
+        u32::from_le_bytes(bytes.try_into().expect("invalid u32 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint32
+pub struct ScMutableUint32Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableUint32Array {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_uint32(&self, index: i32) -> ScMutableUint32 {
+        ScMutableUint32 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableUint32Array {
+        ScImmutableUint32Array { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint64 in host container
+pub struct ScMutableUint64 {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableUint64 {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableUint64 {
+        ScMutableUint64 { obj_id, key_id }
+    }
+
+    // check if value exists in host container
+    pub fn exists(&self) -> bool {
+        exists(self.obj_id, self.key_id, TYPE_UINT64)
+    }
+
+    // set value in host container
+    pub fn set_value(&self, val: u64) {
+        set_bytes(self.obj_id, self.key_id, TYPE_UINT64, &val.to_le_bytes());
+    }
+
+    // human-readable string representation
+    pub fn to_string(&self) -> String {
+        self.value().to_string()
+    }
+
+    // retrieve value from host container
+    pub fn value(&self) -> u64 {
+        let bytes = get_bytes(self.obj_id, self.key_id, TYPE_UINT64);
+        u64::from_le_bytes(bytes.try_into().expect("invalid u64 length"))
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint64
+pub struct ScMutableUint64Array {
+    pub(crate) obj_id: i32,
+}
+
+impl ScMutableUint64Array {
+    // empty the array
+    pub fn clear(&self) {
+        clear(self.obj_id);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    pub fn get_uint64(&self, index: i32) -> ScMutableUint64 {
+        ScMutableUint64 { obj_id: self.obj_id, key_id: Key32(index) }
+    }
+
+    // get immutable version of array proxy
+    pub fn immutable(&self) -> ScImmutableUint64Array {
+        ScImmutableUint64Array { obj_id: self.obj_id }
+    }
+
+    // number of items in array
+    pub fn length(&self) -> i32 {
+        get_length(self.obj_id)
+    }
+}
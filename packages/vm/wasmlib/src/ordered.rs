@@ -0,0 +1,204 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// maps that keep their keys in lexicographical order
+
+use std::convert::TryInto;
+
+use crate::bytes::*;
+use crate::host::*;
+use crate::keys::*;
+
+// ordered map operations, should exactly match their counterpart values on the host
+const ORDERED_OP_DELETE: i32 = 0;
+const ORDERED_OP_ERASE: i32 = 1;
+const ORDERED_OP_EXISTS: i32 = 2;
+const ORDERED_OP_GET: i32 = 3;
+const ORDERED_OP_LENGTH: i32 = 4;
+const ORDERED_OP_PAGE: i32 = 5;
+const ORDERED_OP_SET: i32 = 6;
+
+// maximum number of elements in a single page
+pub const ORDERED_MAX_PAGE_SIZE: i32 = 1000;
+
+// encodes an i64 so that the encodings sort in the same order as the values,
+// for use as ordered map key
+pub fn encode_sortable_int64(value: i64) -> Vec<u8> {
+    encode_sortable_uint64((value as u64) ^ (1 << 63))
+}
+
+pub fn decode_sortable_int64(bytes: &[u8]) -> i64 {
+    (decode_sortable_uint64(bytes) ^ (1 << 63)) as i64
+}
+
+// encodes a u64 so that the encodings sort in the same order as the values,
+// for use as ordered map key
+pub fn encode_sortable_uint64(value: u64) -> Vec<u8> {
+    value.to_be_bytes().to_vec()
+}
+
+pub fn decode_sortable_uint64(bytes: &[u8]) -> u64 {
+    if bytes.len() != 8 {
+        panic("invalid sortable integer");
+    }
+    u64::from_be_bytes(bytes.try_into().expect("invalid u64 length"))
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// page of ordered map elements, see ScImmutableOrderedMap::page()
+pub struct ScOrderedPage {
+    pub keys: Vec<Vec<u8>>,
+    pub values: Vec<Vec<u8>>,
+    // cursor of the next page, None when there are no more elements
+    pub cursor: Option<Vec<u8>>,
+}
+
+// proxy for an immutable map that keeps its byte array keys in lexicographical
+// order, which allows for range scans
+// integer keys need to be encoded with the encode_sortable functions
+pub struct ScImmutableOrderedMap {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScImmutableOrderedMap {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScImmutableOrderedMap {
+        ScImmutableOrderedMap { obj_id, key_id }
+    }
+
+    fn call(&self, op: i32, args: &[u8]) -> Vec<u8> {
+        let mut params = BytesEncoder::new().int32(op).data();
+        params.extend_from_slice(args);
+        call_func(self.obj_id, self.key_id, &params)
+    }
+
+    // check if the map contains an element with the specified key
+    pub fn exists(&self, key: &[u8]) -> bool {
+        !self.call(ORDERED_OP_EXISTS, &BytesEncoder::new().bytes(key).data()).is_empty()
+    }
+
+    // get the value of the element with the specified key
+    pub fn get_bytes(&self, key: &[u8]) -> Vec<u8> {
+        self.call(ORDERED_OP_GET, &BytesEncoder::new().bytes(key).data())
+    }
+
+    // number of elements in the map
+    pub fn length(&self) -> i32 {
+        let bytes = self.call(ORDERED_OP_LENGTH, &[]);
+        i32::from_le_bytes(bytes.try_into().expect("invalid i32 length"))
+    }
+
+    // retrieve at most limit elements with keys in the range [from, to), in ascending
+    // key order, or in descending key order when reverse is set
+    // a None from or to leaves that end of the range unbounded
+    // to retrieve the next page pass the returned cursor as from, or as to when reverse is set
+    pub fn page(&self, from: Option<&[u8]>, to: Option<&[u8]>, limit: i32, reverse: bool) -> ScOrderedPage {
+        let mut encode = BytesEncoder::new();
+        encode_optional_bytes(&mut encode, from);
+        encode_optional_bytes(&mut encode, to);
+        encode.int32(limit);
+        encode.int32(reverse as i32);
+        let result = self.call(ORDERED_OP_PAGE, &encode.data());
+        let mut decode = BytesDecoder::new(&result);
+        let count = decode.int32();
+        let mut page = ScOrderedPage { keys: Vec::new(), values: Vec::new(), cursor: None };
+        for _ in 0..count {
+            page.keys.push(decode.bytes().to_vec());
+            page.values.push(decode.bytes().to_vec());
+        }
+        page.cursor = decode_optional_bytes(&mut decode);
+        page
+    }
+
+    // smallest key that is greater than or equal to key, None when there is no such key
+    pub fn seek(&self, key: &[u8]) -> Option<Vec<u8>> {
+        let page = self.page(Some(key), None, 1, false);
+        page.keys.into_iter().next()
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// proxy for a mutable map that keeps its byte array keys in lexicographical order
+pub struct ScMutableOrderedMap {
+    obj_id: i32,
+    key_id: Key32,
+}
+
+impl ScMutableOrderedMap {
+    pub fn new(obj_id: i32, key_id: Key32) -> ScMutableOrderedMap {
+        ScMutableOrderedMap { obj_id, key_id }
+    }
+
+    // delete all elements of the map
+    pub fn clear(&self) {
+        self.immutable().call(ORDERED_OP_ERASE, &[]);
+    }
+
+    // delete the element with the specified key
+    pub fn delete(&self, key: &[u8]) {
+        self.immutable().call(ORDERED_OP_DELETE, &BytesEncoder::new().bytes(key).data());
+    }
+
+    // check if the map contains an element with the specified key
+    pub fn exists(&self, key: &[u8]) -> bool {
+        self.immutable().exists(key)
+    }
+
+    // get the value of the element with the specified key
+    pub fn get_bytes(&self, key: &[u8]) -> Vec<u8> {
+        self.immutable().get_bytes(key)
+    }
+
+    // get immutable version of map proxy
+    pub fn immutable(&self) -> ScImmutableOrderedMap {
+        ScImmutableOrderedMap::new(self.obj_id, self.key_id)
+    }
+
+    // number of elements in the map
+    pub fn length(&self) -> i32 {
+        self.immutable().length()
+    }
+
+    // see ScImmutableOrderedMap::page()
+    pub fn page(&self, from: Option<&[u8]>, to: Option<&[u8]>, limit: i32, reverse: bool) -> ScOrderedPage {
+        self.immutable().page(from, to, limit, reverse)
+    }
+
+    // see ScImmutableOrderedMap::seek()
+    pub fn seek(&self, key: &[u8]) -> Option<Vec<u8>> {
+        self.immutable().seek(key)
+    }
+
+    // set the value of the element with the specified key
+    pub fn set_bytes(&self, key: &[u8], value: &[u8]) {
+        let mut encode = BytesEncoder::new();
+        encode.bytes(key);
+        encode.bytes(value);
+        self.immutable().call(ORDERED_OP_SET, &encode.data());
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// optional byte arrays are encoded with a presence flag,
+// because an empty array is a valid key
+fn decode_optional_bytes(decode: &mut BytesDecoder) -> Option<Vec<u8>> {
+    if decode.int32() == 0 {
+        return None;
+    }
+    Some(decode.bytes().to_vec())
+}
+
+fn encode_optional_bytes(encode: &mut BytesEncoder, value: Option<&[u8]>) {
+    match value {
+        None => {
+            encode.int32(0);
+        }
+        Some(bytes) => {
+            encode.int32(1);
+            encode.bytes(bytes);
+        }
+    }
+}
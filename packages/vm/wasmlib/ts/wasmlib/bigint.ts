@@ -0,0 +1,218 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// arbitrary-precision unsigned integer
+
+import {getKeyIDFromBytes, panic} from "./host";
+import {Key32, MapKey} from "./keys";
+
+function zeroes(count: i32): u8[] {
+    let buf: u8[] = new Array(count);
+    buf.fill(0);
+    return buf;
+}
+
+// value object for arbitrary-precision unsigned integers
+// encoded as little-endian bytes without trailing zero bytes,
+// zero is encoded as a single zero byte
+export class ScBigInt implements MapKey {
+    // little-endian bytes without trailing zero bytes, empty for zero
+    bytes: u8[] = [];
+
+    // construct from u64 value
+    static fromU64(value: u64): ScBigInt {
+        let bytes = zeroes(8);
+        for (let i = 0; i < 8; i++) {
+            bytes[i] = (value >> ((i * 8) as u64)) as u8;
+        }
+        return ScBigInt.normalize(bytes);
+    }
+
+    // construct from byte array
+    static fromBytes(bytes: u8[]): ScBigInt {
+        return ScBigInt.normalize(bytes);
+    }
+
+    static normalize(bytes: u8[]): ScBigInt {
+        let length = bytes.length;
+        while (length > 0 && bytes[length - 1] == 0) {
+            length--;
+        }
+        let o = new ScBigInt();
+        o.bytes = bytes.slice(0, length);
+        return o;
+    }
+
+    add(rhs: ScBigInt): ScBigInt {
+        let lhs: ScBigInt = this;
+        if (lhs.bytes.length < rhs.bytes.length) {
+            lhs = rhs;
+            rhs = this;
+        }
+        let res = zeroes(lhs.bytes.length + 1);
+        let carry: i32 = 0;
+        for (let i = 0; i < lhs.bytes.length; i++) {
+            carry += lhs.bytes[i] as i32;
+            if (i < rhs.bytes.length) {
+                carry += rhs.bytes[i] as i32;
+            }
+            res[i] = carry as u8;
+            carry >>= 8;
+        }
+        res[lhs.bytes.length] = carry as u8;
+        return ScBigInt.normalize(res);
+    }
+
+    // compare with rhs, returns -1, 0, or 1
+    cmp(rhs: ScBigInt): i32 {
+        if (this.bytes.length != rhs.bytes.length) {
+            return this.bytes.length < rhs.bytes.length ? -1 : 1;
+        }
+        for (let i = this.bytes.length - 1; i >= 0; i--) {
+            if (this.bytes[i] != rhs.bytes[i]) {
+                return this.bytes[i] < rhs.bytes[i] ? -1 : 1;
+            }
+        }
+        return 0;
+    }
+
+    div(rhs: ScBigInt): ScBigInt {
+        return this.divMod(rhs)[0];
+    }
+
+    // returns both the quotient and the remainder of this divided by rhs
+    divMod(rhs: ScBigInt): ScBigInt[] {
+        if (rhs.isZero()) {
+            panic("division by zero");
+        }
+        if (rhs.bytes.length == 1) {
+            return this.divModByte(rhs.bytes[0]);
+        }
+
+        // binary long division, one bit at a time
+        let quo = zeroes(this.bytes.length);
+        let rem = new ScBigInt();
+        for (let i = this.bytes.length * 8 - 1; i >= 0; i--) {
+            rem = rem.shl1((((this.bytes[i >> 3] as i32) >> (i & 7)) & 1) as u8);
+            if (rem.cmp(rhs) >= 0) {
+                rem = rem.sub(rhs);
+                quo[i >> 3] = ((quo[i >> 3] as i32) | (1 << (i & 7))) as u8;
+            }
+        }
+        return [ScBigInt.normalize(quo), rem];
+    }
+
+    private divModByte(divisor: u8): ScBigInt[] {
+        let quo = zeroes(this.bytes.length);
+        let rem: i32 = 0;
+        for (let i = this.bytes.length - 1; i >= 0; i--) {
+            rem = (rem << 8) | (this.bytes[i] as i32);
+            quo[i] = (rem / (divisor as i32)) as u8;
+            rem %= divisor as i32;
+        }
+        return [ScBigInt.normalize(quo), ScBigInt.fromU64(rem as u64)];
+    }
+
+    // can be used as key in maps
+    getKeyID(): Key32 {
+        return getKeyIDFromBytes(this.toBytes());
+    }
+
+    isU64(): boolean {
+        return this.bytes.length <= 8;
+    }
+
+    isZero(): boolean {
+        return this.bytes.length == 0;
+    }
+
+    modulo(rhs: ScBigInt): ScBigInt {
+        return this.divMod(rhs)[1];
+    }
+
+    mul(rhs: ScBigInt): ScBigInt {
+        if (this.isZero() || rhs.isZero()) {
+            return new ScBigInt();
+        }
+        let res = zeroes(this.bytes.length + rhs.bytes.length);
+        for (let i = 0; i < this.bytes.length; i++) {
+            let carry: i32 = 0;
+            for (let j = 0; j < rhs.bytes.length; j++) {
+                carry += (this.bytes[i] as i32) * (rhs.bytes[j] as i32) + (res[i + j] as i32);
+                res[i + j] = carry as u8;
+                carry >>= 8;
+            }
+            res[i + rhs.bytes.length] = carry as u8;
+        }
+        return ScBigInt.normalize(res);
+    }
+
+    // shifts left by one bit and shifts in the lowest bit of bit
+    private shl1(bit: u8): ScBigInt {
+        let res = zeroes(this.bytes.length + 1);
+        let carry = bit as i32;
+        for (let i = 0; i < this.bytes.length; i++) {
+            let b = this.bytes[i] as i32;
+            res[i] = ((b << 1) | carry) as u8;
+            carry = b >> 7;
+        }
+        res[this.bytes.length] = carry as u8;
+        return ScBigInt.normalize(res);
+    }
+
+    sub(rhs: ScBigInt): ScBigInt {
+        if (this.cmp(rhs) < 0) {
+            panic("subtraction underflow");
+        }
+        let res = zeroes(this.bytes.length);
+        let borrow: i32 = 0;
+        for (let i = 0; i < this.bytes.length; i++) {
+            let diff = (this.bytes[i] as i32) - borrow;
+            if (i < rhs.bytes.length) {
+                diff -= rhs.bytes[i] as i32;
+            }
+            borrow = 0;
+            if (diff < 0) {
+                diff += 256;
+                borrow = 1;
+            }
+            res[i] = diff as u8;
+        }
+        return ScBigInt.normalize(res);
+    }
+
+    // convert to byte array representation
+    toBytes(): u8[] {
+        if (this.isZero()) {
+            return [0];
+        }
+        return this.bytes.slice(0);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        if (this.isZero()) {
+            return "0";
+        }
+        let digits = "";
+        let value: ScBigInt = this;
+        while (!value.isZero()) {
+            let quoRem = value.divModByte(10);
+            let rem = quoRem[1];
+            digits = (rem.isZero() ? "0" : rem.bytes[0].toString()) + digits;
+            value = quoRem[0];
+        }
+        return digits;
+    }
+
+    toU64(): u64 {
+        if (!this.isU64()) {
+            panic("big int too large for u64");
+        }
+        let value: u64 = 0;
+        for (let i = this.bytes.length - 1; i >= 0; i--) {
+            value = (value << 8) | (this.bytes[i] as u64);
+        }
+        return value;
+    }
+}
@@ -1,6 +1,7 @@
 // Copyright 2020 IOTA Stiftung
 // SPDX-License-Identifier: Apache-2.0
 
+import {ScBigInt} from "./bigint";
 import {Convert} from "./convert";
 import {ScAddress, ScAgentID, ScChainID, ScColor, ScHash, ScHname, ScRequestID} from "./hashtypes";
 import {panic} from "./host";
@@ -24,6 +25,16 @@ export class BytesDecoder {
         return ScAgentID.fromBytes(this.bytes());
     }
 
+    // decodes an ScBigInt from the byte buffer
+    bigInt(): ScBigInt {
+        return ScBigInt.fromBytes(this.bytes());
+    }
+
+    // decodes a boolean from the byte buffer
+    bool(): boolean {
+        return this.uint8() != 0;
+    }
+
     // decodes the next substring of bytes from the byte buffer
     bytes(): u8[] {
         let size = this.int32();
@@ -55,6 +66,11 @@ export class BytesDecoder {
         return ScHname.fromBytes(this.bytes());
     }
 
+    // decodes an int8 from the byte buffer
+    int8(): i8 {
+        return this.uint8() as i8;
+    }
+
     // decodes an int16 from the byte buffer
     // note that these are encoded using leb128 encoding to conserve space
     int16(): i16 {
@@ -105,6 +121,32 @@ export class BytesDecoder {
         return val;
     }
 
+    // unsigned leb128 decoder
+    leb128DecodeUnsigned(bits: i32): u64 {
+        let val: u64 = 0;
+        let s: u64 = 0;
+        for (; ;) {
+            if (this.buf.length == 0) {
+                panic("leb128DecodeUnsigned: insufficient bytes");
+            }
+            let b = this.buf.shift();
+            val |= ((b & 0x7f) as u64) << s;
+
+            // termination bit set?
+            if ((b & 0x80) == 0) {
+                if ((((val >> s) as u8) & 0x7f) != (b & 0x7f) || (bits < 64 && (val >> (bits as u64)) != 0)) {
+                    panic("integer too large");
+                }
+                break;
+            }
+            s += 7;
+            if (s >= (bits as u64)) {
+                panic("integer representation too long");
+            }
+        }
+        return val;
+    }
+
     // decodes an ScRequestID from the byte buffer
     requestID(): ScRequestID {
         return ScRequestID.fromBytes(this.bytes());
@@ -115,6 +157,32 @@ export class BytesDecoder {
         return Convert.toString(this.bytes());
     }
 
+    // decodes an uint8 from the byte buffer
+    uint8(): u8 {
+        if (this.buf.length == 0) {
+            panic("insufficient bytes");
+        }
+        return this.buf.shift();
+    }
+
+    // decodes an uint16 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint16(): u16 {
+        return this.leb128DecodeUnsigned(16) as u16;
+    }
+
+    // decodes an uint32 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint32(): u32 {
+        return this.leb128DecodeUnsigned(32) as u32;
+    }
+
+    // decodes an uint64 from the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint64(): u64 {
+        return this.leb128DecodeUnsigned(64);
+    }
+
     close(): void {
         if (this.buf.length != 0) {
             panic("extra bytes");
@@ -145,6 +213,17 @@ export class BytesEncoder {
         return this;
     }
 
+    // encodes an ScBigInt into the byte buffer
+    bigInt(value: ScBigInt): BytesEncoder {
+        this.bytes(value.toBytes());
+        return this;
+    }
+
+    // encodes a boolean into the byte buffer
+    bool(value: boolean): BytesEncoder {
+        return this.uint8(value ? 1 : 0);
+    }
+
     // encodes a substring of bytes into the byte buffer
     bytes(value: u8[]): BytesEncoder {
         this.int32(value.length);
@@ -183,6 +262,11 @@ export class BytesEncoder {
         return this;
     }
 
+    // encodes an int8 into the byte buffer
+    int8(val: i8): BytesEncoder {
+        return this.uint8(val as u8);
+    }
+
     // encodes an int16 into the byte buffer
     // note that these are encoded using leb128 encoding to conserve space
     int16(val: i16): BytesEncoder {
@@ -216,6 +300,20 @@ export class BytesEncoder {
         return this;
     }
 
+    // unsigned leb128 encoder
+    leb128EncodeUnsigned(val: u64): BytesEncoder {
+        for (; ;) {
+            let b = (val as u8) & 0x7f;
+            val >>= 7;
+            if (val == 0) {
+                this.buf.push(b);
+                break;
+            }
+            this.buf.push(b | 0x80);
+        }
+        return this;
+    }
+
     // encodes an ScRequestID into the byte buffer
     requestID(value: ScRequestID): BytesEncoder {
         this.bytes(value.toBytes());
@@ -227,4 +325,28 @@ export class BytesEncoder {
         this.bytes(Convert.fromString(value));
         return this;
     }
+
+    // encodes an uint8 into the byte buffer
+    uint8(val: u8): BytesEncoder {
+        this.buf.push(val);
+        return this;
+    }
+
+    // encodes an uint16 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint16(val: u16): BytesEncoder {
+        return this.leb128EncodeUnsigned(val as u64);
+    }
+
+    // encodes an uint32 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint32(val: u32): BytesEncoder {
+        return this.leb128EncodeUnsigned(val as u64);
+    }
+
+    // encodes an uint64 into the byte buffer
+    // note that these are encoded using leb128 encoding to conserve space
+    uint64(val: u64): BytesEncoder {
+        return this.leb128EncodeUnsigned(val);
+    }
 }
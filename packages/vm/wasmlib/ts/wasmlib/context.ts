@@ -11,6 +11,7 @@ import {log, OBJ_ID_ROOT, panic} from "./host";
 import {ScImmutableColorArray, ScImmutableMap} from "./immutable";
 import * as keys from "./keys";
 import {ScMutableMap} from "./mutable";
+import {encodeSortableInt64} from "./ordered";
 
 // all access to the objects in host's object tree starts here
 export let ROOT = new ScMutableMap(OBJ_ID_ROOT);
@@ -221,6 +222,19 @@ export class ScBaseContext {
         panic(text);
     }
 
+    // panics with a typed error that ends up in the request receipt
+    // error codes below 1000 are reserved for the VM itself, at most 16 params can be passed
+    panicWithError(code: u16, text: string, params: string[]): void {
+        let encode = new BytesEncoder();
+        encode.int32(code as i32);
+        encode.string(text);
+        encode.int32(params.length);
+        for (let i = 0; i < params.length; i++) {
+            encode.string(params[i]);
+        }
+        ROOT.getBytes(keys.KEY_PANIC).setValue(encode.data());
+    }
+
     // retrieve parameters that were passed to the smart contract function
     params(): ScImmutableMap {
         return ROOT.getMap(keys.KEY_PARAMS).immutable();
@@ -257,6 +271,35 @@ export class ScBaseContext {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// reserved params of the cross-chain callback protocol, see postWithCallback()
+export const PARAM_CALLBACK = "$cb";
+export const PARAM_CALLBACK_ID = "$cb.id";
+export const PARAM_CALLBACK_REQUEST_ID = "$cb.req";
+export const PARAM_CALLBACK_SUCCESS = "$cb.ok";
+export const PARAM_CALLBACK_ERROR = "$cb.err";
+export const PARAM_CALLBACK_DEADLINE = "$cb.deadline";
+
+// state that tracks the calls that were posted with postWithCallback()
+const STATE_CALLBACK_NONCE = "$callbacks.nonce";
+const STATE_CALLBACKS = "$callbacks";
+const STATE_CALLBACK_TIMEOUTS = "$callbacks.timeout";
+
+// outcome of a call that was posted with postWithCallback()
+export class ScCallResult {
+    callID: i64 = 0;                             // call ID returned by postWithCallback()
+    requestID: ScRequestID = new ScRequestID(); // ID of the request on the target chain, not set on timeout
+    success: boolean = false;                    // false when the request failed or timed out
+    error: string = "";                          // reason of the failure
+    late: boolean = false;                       // the outcome arrived after the call already timed out
+    results: ScImmutableMap;                     // results of the request
+
+    constructor(results: ScImmutableMap) {
+        this.results = results;
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // smart contract interface with mutable access to state
 export class ScFuncContext extends ScBaseContext implements ScViewCallContext, ScFuncCallContext {
     canCallFunc(): void {
@@ -334,6 +377,95 @@ export class ScFuncContext extends ScBaseContext implements ScViewCallContext, S
         this.post(this.chainID(), this.contract(), hfunction, params, transfer, delay);
     }
 
+    // asynchronously calls the specified smart contract function like post(), and has
+    // the target chain send back the outcome of the request to the hcallback function
+    // of this contract, see callResult()
+    // one iota of the transfer pays for sending back the outcome, the target function
+    // does not receive it
+    // when the outcome does not arrive within timeout seconds the callback receives a
+    // timeout failure instead, which costs another iota from the account of this contract
+    // the target chain does not run the request after the timeout, so the transfer stays
+    // in escrow until then, and is refunded with the failed outcome that arrives later
+    // a zero timeout waits indefinitely
+    // returns the call ID that identifies the outcome
+    postWithCallback(chainID: ScChainID, hcontract: ScHname, hfunction: ScHname, params: ScMutableMap | null,
+                     transfer: ScTransfers, hcallback: ScHname, timeout: i32): i64 {
+        if (timeout < 0) {
+            panic("invalid timeout");
+        }
+        let nonce = this.state().getInt64(keys.Key32.fromString(STATE_CALLBACK_NONCE));
+        let callID = nonce.value() + 1;
+        nonce.setValue(callID);
+        let target = ScAgentID.fromParts(chainID.address(), hcontract);
+        this.state().getOrderedMap(keys.Key32.fromString(STATE_CALLBACKS)).setBytes(encodeSortableInt64(callID), target.toBytes());
+
+        let callParams = ScMutableMap.create();
+        if (params !== null) {
+            callParams = params!;
+        }
+        callParams.getHname(keys.Key32.fromString(PARAM_CALLBACK)).setValue(hcallback);
+        callParams.getInt64(keys.Key32.fromString(PARAM_CALLBACK_ID)).setValue(callID);
+        if (timeout != 0) {
+            // same whole second as the time lock of the timeout failure
+            let deadline = (this.timestamp() / 1000000000 + (timeout as i64)) * 1000000000;
+            callParams.getInt64(keys.Key32.fromString(PARAM_CALLBACK_DEADLINE)).setValue(deadline);
+        }
+        this.post(chainID, hcontract, hfunction, callParams, transfer, 0);
+
+        if (timeout != 0) {
+            let failure = ScMutableMap.create();
+            failure.getInt64(keys.Key32.fromString(PARAM_CALLBACK_ID)).setValue(callID);
+            failure.getBool(keys.Key32.fromString(PARAM_CALLBACK_SUCCESS)).setValue(false);
+            failure.getString(keys.Key32.fromString(PARAM_CALLBACK_ERROR)).setValue("timeout");
+            this.postSelf(hcallback, failure, ScTransfers.iotas(1), timeout);
+        }
+        return callID;
+    }
+
+    // retrieves the outcome of a call that was posted with postWithCallback()
+    // must be called from the callback function
+    // a call can deliver a timeout failure followed by its actual outcome, which then
+    // has late set, and the actual outcome is delivered only once
+    // the call is forgotten once its actual outcome is delivered
+    // the result is null when the request is not an outcome of a pending call of this contract
+    callResult(): ScCallResult | null {
+        let params = this.params();
+        let callID = params.getInt64(keys.Key32.fromString(PARAM_CALLBACK_ID));
+        if (!callID.exists()) {
+            return null;
+        }
+        let key = encodeSortableInt64(callID.value());
+        let callbacks = this.state().getOrderedMap(keys.Key32.fromString(STATE_CALLBACKS));
+        if (!callbacks.exists(key)) {
+            return null;
+        }
+        let result = new ScCallResult(params);
+        let timeouts = this.state().getOrderedMap(keys.Key32.fromString(STATE_CALLBACK_TIMEOUTS));
+        let timedOut = timeouts.exists(key);
+        let requestID = params.getRequestID(keys.Key32.fromString(PARAM_CALLBACK_REQUEST_ID));
+        if (!requestID.exists()) {
+            // only this contract itself can signal a timeout, and only once
+            if (!this.caller().equals(this.accountID()) || timedOut) {
+                return null;
+            }
+            timeouts.setBytes(key, [1]);
+        } else {
+            // only the target of the call can send its outcome
+            if (!this.caller().equals(ScAgentID.fromBytes(callbacks.getBytes(key)))) {
+                return null;
+            }
+            result.requestID = requestID.value();
+            result.late = timedOut;
+            callbacks.delete(key);
+            timeouts.delete(key);
+        }
+
+        result.callID = callID.value();
+        result.success = params.getBool(keys.Key32.fromString(PARAM_CALLBACK_SUCCESS)).value();
+        result.error = params.getString(keys.Key32.fromString(PARAM_CALLBACK_ERROR)).value();
+        return result;
+    }
+
     // retrieve the request id of this transaction
     requestID(): ScRequestID {
         return ROOT.getRequestID(keys.KEY_REQUEST_ID).value();
@@ -56,6 +56,18 @@ export class Convert {
         return ret;
     }
 
+    static fromU16(val: u16): u8[] {
+        return Convert.fromI16(val as i16);
+    }
+
+    static fromU32(val: u32): u8[] {
+        return Convert.fromI32(val as i32);
+    }
+
+    static fromU64(val: u64): u8[] {
+        return Convert.fromI64(val as i64);
+    }
+
     static toI16(bytes: u8[]): i16 {
         if (bytes.length != 2) {
             panic("expected i16 (2 bytes)")
@@ -94,4 +106,16 @@ export class Convert {
     static toString(bytes: u8[]): string {
         return String.UTF8.decodeUnsafe(bytes.dataStart, bytes.length);
     }
+
+    static toU16(bytes: u8[]): u16 {
+        return Convert.toI16(bytes) as u16;
+    }
+
+    static toU32(bytes: u8[]): u32 {
+        return Convert.toI32(bytes) as u32;
+    }
+
+    static toU64(bytes: u8[]): u64 {
+        return Convert.toI64(bytes) as u64;
+    }
 }
\ No newline at end of file
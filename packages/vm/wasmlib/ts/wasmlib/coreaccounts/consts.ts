@@ -12,23 +12,43 @@ export const ScDescription = "Core chain account ledger contract";
 export const HScName       = new wasmlib.ScHname(0x3c4b5e02);
 
 export const ParamAgentID        = "a";
+export const ParamCursor         = "cursor";
+export const ParamLimit          = "limit";
+export const ParamNftColor       = "nc";
+export const ParamNftMetadata    = "nm";
 export const ParamWithdrawAmount = "m";
 export const ParamWithdrawColor  = "c";
 
 export const ResultAccountNonce = "n";
+export const ResultNextCursor   = "nextCursor";
+export const ResultNftColor     = "nc";
+export const ResultNftIssuer    = "ni";
+export const ResultNftMetadata  = "nm";
+export const ResultNftOwner     = "no";
+export const ResultTruncated    = "truncated";
 
 export const FuncDeposit         = "deposit";
 export const FuncHarvest         = "harvest";
+export const FuncMintNFT         = "mintNFT";
+export const FuncTransferNFT     = "transferNFT";
 export const FuncWithdraw        = "withdraw";
+export const FuncWithdrawNFT     = "withdrawNFT";
 export const ViewAccounts        = "accounts";
 export const ViewBalance         = "balance";
+export const ViewGetAccountNFTs  = "getAccountNFTs";
 export const ViewGetAccountNonce = "getAccountNonce";
+export const ViewGetNFTData      = "getNFTData";
 export const ViewTotalAssets     = "totalAssets";
 
 export const HFuncDeposit         = new wasmlib.ScHname(0xbdc9102d);
 export const HFuncHarvest         = new wasmlib.ScHname(0x7b40efbd);
+export const HFuncMintNFT         = new wasmlib.ScHname(0x3b79d016);
+export const HFuncTransferNFT     = new wasmlib.ScHname(0xdc3e3f0d);
 export const HFuncWithdraw        = new wasmlib.ScHname(0x9dcc0f41);
+export const HFuncWithdrawNFT     = new wasmlib.ScHname(0x281b1980);
 export const HViewAccounts        = new wasmlib.ScHname(0x3c4b5e02);
 export const HViewBalance         = new wasmlib.ScHname(0x84168cb4);
+export const HViewGetAccountNFTs  = new wasmlib.ScHname(0x02010e79);
 export const HViewGetAccountNonce = new wasmlib.ScHname(0x529d7df9);
+export const HViewGetNFTData      = new wasmlib.ScHname(0xa5c5513d);
 export const HViewTotalAssets     = new wasmlib.ScHname(0xfab0f8d2);
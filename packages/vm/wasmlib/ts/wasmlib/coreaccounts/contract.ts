@@ -18,12 +18,29 @@ export class HarvestCall {
     params: sc.MutableHarvestParams = new sc.MutableHarvestParams();
 }
 
+export class MintNFTCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncMintNFT);
+    params: sc.MutableMintNFTParams = new sc.MutableMintNFTParams();
+    results: sc.ImmutableMintNFTResults = new sc.ImmutableMintNFTResults();
+}
+
+export class TransferNFTCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncTransferNFT);
+    params: sc.MutableTransferNFTParams = new sc.MutableTransferNFTParams();
+}
+
 export class WithdrawCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncWithdraw);
 }
 
+export class WithdrawNFTCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncWithdrawNFT);
+    params: sc.MutableWithdrawNFTParams = new sc.MutableWithdrawNFTParams();
+}
+
 export class AccountsCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewAccounts);
+    params: sc.MutableAccountsParams = new sc.MutableAccountsParams();
     results: sc.ImmutableAccountsResults = new sc.ImmutableAccountsResults();
 }
 
@@ -33,12 +50,24 @@ export class BalanceCall {
     results: sc.ImmutableBalanceResults = new sc.ImmutableBalanceResults();
 }
 
+export class GetAccountNFTsCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetAccountNFTs);
+    params: sc.MutableGetAccountNFTsParams = new sc.MutableGetAccountNFTsParams();
+    results: sc.ImmutableGetAccountNFTsResults = new sc.ImmutableGetAccountNFTsResults();
+}
+
 export class GetAccountNonceCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetAccountNonce);
     params: sc.MutableGetAccountNonceParams = new sc.MutableGetAccountNonceParams();
     results: sc.ImmutableGetAccountNonceResults = new sc.ImmutableGetAccountNonceResults();
 }
 
+export class GetNFTDataCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetNFTData);
+    params: sc.MutableGetNFTDataParams = new sc.MutableGetNFTDataParams();
+    results: sc.ImmutableGetNFTDataResults = new sc.ImmutableGetNFTDataResults();
+}
+
 export class TotalAssetsCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewTotalAssets);
     results: sc.ImmutableTotalAssetsResults = new sc.ImmutableTotalAssetsResults();
@@ -58,14 +87,32 @@ export class ScFuncs {
         return f;
     }
 
+    static mintNFT(ctx: wasmlib.ScFuncCallContext): MintNFTCall {
+        let f = new MintNFTCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static transferNFT(ctx: wasmlib.ScFuncCallContext): TransferNFTCall {
+        let f = new TransferNFTCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static withdraw(ctx: wasmlib.ScFuncCallContext): WithdrawCall {
         let f = new WithdrawCall();
         return f;
     }
 
+    static withdrawNFT(ctx: wasmlib.ScFuncCallContext): WithdrawNFTCall {
+        let f = new WithdrawNFTCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static accounts(ctx: wasmlib.ScViewCallContext): AccountsCall {
         let f = new AccountsCall();
-        f.func.setPtrs(null, f.results);
+        f.func.setPtrs(f.params, f.results);
         return f;
     }
 
@@ -75,12 +122,24 @@ export class ScFuncs {
         return f;
     }
 
+    static getAccountNFTs(ctx: wasmlib.ScViewCallContext): GetAccountNFTsCall {
+        let f = new GetAccountNFTsCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static getAccountNonce(ctx: wasmlib.ScViewCallContext): GetAccountNonceCall {
         let f = new GetAccountNonceCall();
         f.func.setPtrs(f.params, f.results);
         return f;
     }
 
+    static getNFTData(ctx: wasmlib.ScViewCallContext): GetNFTDataCall {
+        let f = new GetNFTDataCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static totalAssets(ctx: wasmlib.ScViewCallContext): TotalAssetsCall {
         let f = new TotalAssetsCall();
         f.func.setPtrs(null, f.results);
@@ -44,6 +44,86 @@ export class MutableHarvestParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableMintNFTParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    nftMetadata(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamNftMetadata));
+    }
+}
+
+export class MutableMintNFTParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    nftMetadata(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamNftMetadata));
+    }
+}
+
+export class ImmutableTransferNFTParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    nftColor(): wasmlib.ScImmutableColor {
+        return new wasmlib.ScImmutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
+
+export class MutableTransferNFTParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    nftColor(): wasmlib.ScMutableColor {
+        return new wasmlib.ScMutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
+
+export class ImmutableWithdrawNFTParams extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScImmutableColor {
+        return new wasmlib.ScImmutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
+
+export class MutableWithdrawNFTParams extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScMutableColor {
+        return new wasmlib.ScMutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
+
+export class ImmutableAccountsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
+
+export class MutableAccountsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
+
 export class ImmutableBalanceParams extends wasmlib.ScMapID {
 
     agentID(): wasmlib.ScImmutableAgentID {
@@ -58,6 +138,20 @@ export class MutableBalanceParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableGetAccountNFTsParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+}
+
+export class MutableGetAccountNFTsParams extends wasmlib.ScMapID {
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+}
+
 export class ImmutableGetAccountNonceParams extends wasmlib.ScMapID {
 
     agentID(): wasmlib.ScImmutableAgentID {
@@ -71,3 +165,17 @@ export class MutableGetAccountNonceParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
     }
 }
+
+export class ImmutableGetNFTDataParams extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScImmutableColor {
+        return new wasmlib.ScImmutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
+
+export class MutableGetNFTDataParams extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScMutableColor {
+        return new wasmlib.ScMutableColor(this.mapID, wasmlib.Key32.fromString(sc.ParamNftColor));
+    }
+}
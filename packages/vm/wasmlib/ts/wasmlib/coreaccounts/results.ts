@@ -8,6 +8,20 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ImmutableMintNFTResults extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScImmutableColor {
+        return new wasmlib.ScImmutableColor(this.mapID, wasmlib.Key32.fromString(sc.ResultNftColor));
+    }
+}
+
+export class MutableMintNFTResults extends wasmlib.ScMapID {
+
+    nftColor(): wasmlib.ScMutableColor {
+        return new wasmlib.ScMutableColor(this.mapID, wasmlib.Key32.fromString(sc.ResultNftColor));
+    }
+}
+
 export class MapAgentIDToImmutableBytes {
     objID: i32;
 
@@ -25,6 +39,14 @@ export class ImmutableAccountsResults extends wasmlib.ScMapID {
     agents(): sc.MapAgentIDToImmutableBytes {
         return new sc.MapAgentIDToImmutableBytes(this.mapID);
     }
+
+    nextCursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class MapAgentIDToMutableBytes {
@@ -48,6 +70,14 @@ export class MutableAccountsResults extends wasmlib.ScMapID {
     agents(): sc.MapAgentIDToMutableBytes {
         return new sc.MapAgentIDToMutableBytes(this.mapID);
     }
+
+    nextCursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class MapColorToImmutableInt64 {
@@ -92,6 +122,48 @@ export class MutableBalanceResults extends wasmlib.ScMapID {
     }
 }
 
+export class MapColorToImmutableBytes {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    getBytes(key: wasmlib.ScColor): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.objID, key.getKeyID());
+    }
+}
+
+export class ImmutableGetAccountNFTsResults extends wasmlib.ScMapID {
+
+    nftColors(): sc.MapColorToImmutableBytes {
+        return new sc.MapColorToImmutableBytes(this.mapID);
+    }
+}
+
+export class MapColorToMutableBytes {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID)
+    }
+
+    getBytes(key: wasmlib.ScColor): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.objID, key.getKeyID());
+    }
+}
+
+export class MutableGetAccountNFTsResults extends wasmlib.ScMapID {
+
+    nftColors(): sc.MapColorToMutableBytes {
+        return new sc.MapColorToMutableBytes(this.mapID);
+    }
+}
+
 export class ImmutableGetAccountNonceResults extends wasmlib.ScMapID {
 
     accountNonce(): wasmlib.ScImmutableInt64 {
@@ -106,6 +178,36 @@ export class MutableGetAccountNonceResults extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableGetNFTDataResults extends wasmlib.ScMapID {
+
+    nftIssuer(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultNftIssuer));
+    }
+
+    nftMetadata(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNftMetadata));
+    }
+
+    nftOwner(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultNftOwner));
+    }
+}
+
+export class MutableGetNFTDataResults extends wasmlib.ScMapID {
+
+    nftIssuer(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultNftIssuer));
+    }
+
+    nftMetadata(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNftMetadata));
+    }
+
+    nftOwner(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultNftOwner));
+    }
+}
+
 export class ImmutableTotalAssetsResults extends wasmlib.ScMapID {
 
     balances(): sc.MapColorToImmutableInt64 {
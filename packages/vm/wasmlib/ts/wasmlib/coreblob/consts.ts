@@ -11,18 +11,38 @@ export const ScName        = "blob";
 export const ScDescription = "Core blob contract";
 export const HScName       = new wasmlib.ScHname(0xfd91bc63);
 
-export const ParamField = "field";
-export const ParamHash  = "hash";
+export const ParamBytes  = "bytes";
+export const ParamCursor = "cursor";
+export const ParamField  = "field";
+export const ParamHash   = "hash";
+export const ParamIndex  = "index";
+export const ParamLimit  = "limit";
+export const ParamOwner  = "owner";
 
-export const ResultBytes = "bytes";
-export const ResultHash  = "hash";
+export const ResultBytes      = "bytes";
+export const ResultHash       = "hash";
+export const ResultNextCursor = "nextCursor";
+export const ResultOwner      = "owner";
+export const ResultTruncated  = "truncated";
 
-export const FuncStoreBlob    = "storeBlob";
-export const ViewGetBlobField = "getBlobField";
-export const ViewGetBlobInfo  = "getBlobInfo";
-export const ViewListBlobs    = "listBlobs";
+export const FuncAbortBlobUpload     = "abortBlobUpload";
+export const FuncDeleteBlob          = "deleteBlob";
+export const FuncFinishBlobUpload    = "finishBlobUpload";
+export const FuncStoreBlob           = "storeBlob";
+export const FuncStoreBlobChunk      = "storeBlobChunk";
+export const ViewGetBlobField        = "getBlobField";
+export const ViewGetBlobInfo         = "getBlobInfo";
+export const ViewGetBlobOwner        = "getBlobOwner";
+export const ViewGetBlobUploadStatus = "getBlobUploadStatus";
+export const ViewListBlobs           = "listBlobs";
 
-export const HFuncStoreBlob    = new wasmlib.ScHname(0xddd4c281);
-export const HViewGetBlobField = new wasmlib.ScHname(0x1f448130);
-export const HViewGetBlobInfo  = new wasmlib.ScHname(0xfde4ab46);
-export const HViewListBlobs    = new wasmlib.ScHname(0x62ca7990);
+export const HFuncAbortBlobUpload     = new wasmlib.ScHname(0x551f4a0e);
+export const HFuncDeleteBlob          = new wasmlib.ScHname(0xcae606c1);
+export const HFuncFinishBlobUpload    = new wasmlib.ScHname(0xcedd5ec2);
+export const HFuncStoreBlob           = new wasmlib.ScHname(0xddd4c281);
+export const HFuncStoreBlobChunk      = new wasmlib.ScHname(0x62a6d849);
+export const HViewGetBlobField        = new wasmlib.ScHname(0x1f448130);
+export const HViewGetBlobInfo         = new wasmlib.ScHname(0xfde4ab46);
+export const HViewGetBlobOwner        = new wasmlib.ScHname(0xefc4d2da);
+export const HViewGetBlobUploadStatus = new wasmlib.ScHname(0xa72cb02e);
+export const HViewListBlobs           = new wasmlib.ScHname(0x62ca7990);
@@ -8,12 +8,33 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class AbortBlobUploadCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncAbortBlobUpload);
+    params: sc.MutableAbortBlobUploadParams = new sc.MutableAbortBlobUploadParams();
+}
+
+export class DeleteBlobCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncDeleteBlob);
+    params: sc.MutableDeleteBlobParams = new sc.MutableDeleteBlobParams();
+}
+
+export class FinishBlobUploadCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncFinishBlobUpload);
+    params: sc.MutableFinishBlobUploadParams = new sc.MutableFinishBlobUploadParams();
+    results: sc.ImmutableFinishBlobUploadResults = new sc.ImmutableFinishBlobUploadResults();
+}
+
 export class StoreBlobCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncStoreBlob);
     params: sc.MutableStoreBlobParams = new sc.MutableStoreBlobParams();
     results: sc.ImmutableStoreBlobResults = new sc.ImmutableStoreBlobResults();
 }
 
+export class StoreBlobChunkCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncStoreBlobChunk);
+    params: sc.MutableStoreBlobChunkParams = new sc.MutableStoreBlobChunkParams();
+}
+
 export class GetBlobFieldCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetBlobField);
     params: sc.MutableGetBlobFieldParams = new sc.MutableGetBlobFieldParams();
@@ -26,19 +47,56 @@ export class GetBlobInfoCall {
     results: sc.ImmutableGetBlobInfoResults = new sc.ImmutableGetBlobInfoResults();
 }
 
+export class GetBlobOwnerCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetBlobOwner);
+    params: sc.MutableGetBlobOwnerParams = new sc.MutableGetBlobOwnerParams();
+    results: sc.ImmutableGetBlobOwnerResults = new sc.ImmutableGetBlobOwnerResults();
+}
+
+export class GetBlobUploadStatusCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetBlobUploadStatus);
+    params: sc.MutableGetBlobUploadStatusParams = new sc.MutableGetBlobUploadStatusParams();
+    results: sc.ImmutableGetBlobUploadStatusResults = new sc.ImmutableGetBlobUploadStatusResults();
+}
+
 export class ListBlobsCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewListBlobs);
+    params: sc.MutableListBlobsParams = new sc.MutableListBlobsParams();
     results: sc.ImmutableListBlobsResults = new sc.ImmutableListBlobsResults();
 }
 
 export class ScFuncs {
 
+    static abortBlobUpload(ctx: wasmlib.ScFuncCallContext): AbortBlobUploadCall {
+        let f = new AbortBlobUploadCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static deleteBlob(ctx: wasmlib.ScFuncCallContext): DeleteBlobCall {
+        let f = new DeleteBlobCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
+    static finishBlobUpload(ctx: wasmlib.ScFuncCallContext): FinishBlobUploadCall {
+        let f = new FinishBlobUploadCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static storeBlob(ctx: wasmlib.ScFuncCallContext): StoreBlobCall {
         let f = new StoreBlobCall();
         f.func.setPtrs(f.params, f.results);
         return f;
     }
 
+    static storeBlobChunk(ctx: wasmlib.ScFuncCallContext): StoreBlobChunkCall {
+        let f = new StoreBlobChunkCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static getBlobField(ctx: wasmlib.ScViewCallContext): GetBlobFieldCall {
         let f = new GetBlobFieldCall();
         f.func.setPtrs(f.params, f.results);
@@ -51,9 +109,21 @@ export class ScFuncs {
         return f;
     }
 
+    static getBlobOwner(ctx: wasmlib.ScViewCallContext): GetBlobOwnerCall {
+        let f = new GetBlobOwnerCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
+    static getBlobUploadStatus(ctx: wasmlib.ScViewCallContext): GetBlobUploadStatusCall {
+        let f = new GetBlobUploadStatusCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static listBlobs(ctx: wasmlib.ScViewCallContext): ListBlobsCall {
         let f = new ListBlobsCall();
-        f.func.setPtrs(null, f.results);
+        f.func.setPtrs(f.params, f.results);
         return f;
     }
 }
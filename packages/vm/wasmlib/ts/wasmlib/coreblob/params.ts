@@ -8,6 +8,48 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ImmutableAbortBlobUploadParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class MutableAbortBlobUploadParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class ImmutableDeleteBlobParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class MutableDeleteBlobParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class ImmutableFinishBlobUploadParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class MutableFinishBlobUploadParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
 export class MapStringToImmutableBytes {
     objID: i32;
 
@@ -50,6 +92,44 @@ export class MutableStoreBlobParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableStoreBlobChunkParams extends wasmlib.ScMapID {
+
+    bytes(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamBytes));
+    }
+
+    field(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamField));
+    }
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+
+    index(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamIndex));
+    }
+}
+
+export class MutableStoreBlobChunkParams extends wasmlib.ScMapID {
+
+    bytes(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamBytes));
+    }
+
+    field(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamField));
+    }
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+
+    index(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamIndex));
+    }
+}
+
 export class ImmutableGetBlobFieldParams extends wasmlib.ScMapID {
 
     field(): wasmlib.ScImmutableString {
@@ -85,3 +165,61 @@ export class MutableGetBlobInfoParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
     }
 }
+
+export class ImmutableGetBlobOwnerParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class MutableGetBlobOwnerParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+}
+
+export class ImmutableGetBlobUploadStatusParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+
+    owner(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamOwner));
+    }
+}
+
+export class MutableGetBlobUploadStatusParams extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ParamHash));
+    }
+
+    owner(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamOwner));
+    }
+}
+
+export class ImmutableListBlobsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
+
+export class MutableListBlobsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
@@ -8,6 +8,20 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ImmutableFinishBlobUploadResults extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScImmutableHash {
+        return new wasmlib.ScImmutableHash(this.mapID, wasmlib.Key32.fromString(sc.ResultHash));
+    }
+}
+
+export class MutableFinishBlobUploadResults extends wasmlib.ScMapID {
+
+    hash(): wasmlib.ScMutableHash {
+        return new wasmlib.ScMutableHash(this.mapID, wasmlib.Key32.fromString(sc.ResultHash));
+    }
+}
+
 export class ImmutableStoreBlobResults extends wasmlib.ScMapID {
 
     hash(): wasmlib.ScImmutableHash {
@@ -78,6 +92,34 @@ export class MutableGetBlobInfoResults extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableGetBlobOwnerResults extends wasmlib.ScMapID {
+
+    owner(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultOwner));
+    }
+}
+
+export class MutableGetBlobOwnerResults extends wasmlib.ScMapID {
+
+    owner(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ResultOwner));
+    }
+}
+
+export class ImmutableGetBlobUploadStatusResults extends wasmlib.ScMapID {
+
+    chunkCounts(): sc.MapStringToImmutableInt32 {
+        return new sc.MapStringToImmutableInt32(this.mapID);
+    }
+}
+
+export class MutableGetBlobUploadStatusResults extends wasmlib.ScMapID {
+
+    chunkCounts(): sc.MapStringToMutableInt32 {
+        return new sc.MapStringToMutableInt32(this.mapID);
+    }
+}
+
 export class MapHashToImmutableInt32 {
     objID: i32;
 
@@ -95,6 +137,14 @@ export class ImmutableListBlobsResults extends wasmlib.ScMapID {
     blobSizes(): sc.MapHashToImmutableInt32 {
         return new sc.MapHashToImmutableInt32(this.mapID);
     }
+
+    nextCursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class MapHashToMutableInt32 {
@@ -118,4 +168,12 @@ export class MutableListBlobsResults extends wasmlib.ScMapID {
     blobSizes(): sc.MapHashToMutableInt32 {
         return new sc.MapHashToMutableInt32(this.mapID);
     }
+
+    nextCursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
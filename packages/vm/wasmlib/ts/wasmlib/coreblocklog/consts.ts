@@ -13,7 +13,9 @@ export const HScName       = new wasmlib.ScHname(0xf538ef2b);
 
 export const ParamBlockIndex    = "n";
 export const ParamContractHname = "h";
+export const ParamCursor        = "cursor";
 export const ParamFromBlock     = "f";
+export const ParamLimit         = "limit";
 export const ParamRequestID     = "u";
 export const ParamToBlock       = "t";
 
@@ -21,11 +23,13 @@ export const ResultBlockIndex             = "n";
 export const ResultBlockInfo              = "i";
 export const ResultEvent                  = "e";
 export const ResultGoverningAddress       = "g";
+export const ResultNextCursor             = "nextCursor";
 export const ResultRequestID              = "u";
 export const ResultRequestIndex           = "r";
 export const ResultRequestProcessed       = "p";
 export const ResultRequestRecord          = "d";
 export const ResultStateControllerAddress = "s";
+export const ResultTruncated              = "truncated";
 
 export const ViewControlAddresses           = "controlAddresses";
 export const ViewGetBlockInfo               = "getBlockInfo";
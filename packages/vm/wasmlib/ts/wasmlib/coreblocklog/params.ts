@@ -42,10 +42,18 @@ export class ImmutableGetEventsForContractParams extends wasmlib.ScMapID {
         return new wasmlib.ScImmutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamContractHname));
     }
 
+    cursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
     fromBlock(): wasmlib.ScImmutableInt32 {
         return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamFromBlock));
     }
 
+    limit(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+
     toBlock(): wasmlib.ScImmutableInt32 {
         return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamToBlock));
     }
@@ -57,10 +65,18 @@ export class MutableGetEventsForContractParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamContractHname));
     }
 
+    cursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
     fromBlock(): wasmlib.ScMutableInt32 {
         return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamFromBlock));
     }
 
+    limit(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+
     toBlock(): wasmlib.ScMutableInt32 {
         return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamToBlock));
     }
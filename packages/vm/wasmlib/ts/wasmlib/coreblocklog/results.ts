@@ -110,6 +110,14 @@ export class ImmutableGetEventsForContractResults extends wasmlib.ScMapID {
         let arrID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultEvent), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_BYTES);
         return new sc.ArrayOfImmutableBytes(arrID)
     }
+
+    nextCursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class MutableGetEventsForContractResults extends wasmlib.ScMapID {
@@ -118,6 +126,14 @@ export class MutableGetEventsForContractResults extends wasmlib.ScMapID {
         let arrID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultEvent), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_BYTES);
         return new sc.ArrayOfMutableBytes(arrID)
     }
+
+    nextCursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class ImmutableGetEventsForRequestResults extends wasmlib.ScMapID {
@@ -11,6 +11,8 @@ export const ScName        = "governance";
 export const ScDescription = "Core governance contract";
 export const HScName       = new wasmlib.ScHname(0x17cf909f);
 
+export const ParamAclList                = "al";
+export const ParamAgentID                = "ag";
 export const ParamChainOwner             = "oi";
 export const ParamFeeColor               = "fc";
 export const ParamHname                  = "hn";
@@ -21,6 +23,7 @@ export const ParamOwnerFee               = "of";
 export const ParamStateControllerAddress = "S";
 export const ParamValidatorFee           = "vf";
 
+export const ResultAclAgentIDs                     = "aa";
 export const ResultAllowedStateControllerAddresses = "a";
 export const ResultChainID                         = "c";
 export const ResultChainOwnerID                    = "o";
@@ -35,26 +38,32 @@ export const ResultOwnerFee                        = "of";
 export const ResultValidatorFee                    = "vf";
 
 export const FuncAddAllowedStateControllerAddress    = "addAllowedStateControllerAddress";
+export const FuncAddToACL                            = "addToACL";
 export const FuncClaimChainOwnership                 = "claimChainOwnership";
 export const FuncDelegateChainOwnership              = "delegateChainOwnership";
 export const FuncRemoveAllowedStateControllerAddress = "removeAllowedStateControllerAddress";
+export const FuncRemoveFromACL                       = "removeFromACL";
 export const FuncRotateStateController               = "rotateStateController";
 export const FuncSetChainInfo                        = "setChainInfo";
 export const FuncSetContractFee                      = "setContractFee";
 export const FuncSetDefaultFee                       = "setDefaultFee";
+export const ViewGetACL                              = "getACL";
 export const ViewGetAllowedStateControllerAddresses  = "getAllowedStateControllerAddresses";
 export const ViewGetChainInfo                        = "getChainInfo";
 export const ViewGetFeeInfo                          = "getFeeInfo";
 export const ViewGetMaxBlobSize                      = "getMaxBlobSize";
 
 export const HFuncAddAllowedStateControllerAddress    = new wasmlib.ScHname(0x9469d567);
+export const HFuncAddToACL                            = new wasmlib.ScHname(0xf9d77ccb);
 export const HFuncClaimChainOwnership                 = new wasmlib.ScHname(0x03ff0fc0);
 export const HFuncDelegateChainOwnership              = new wasmlib.ScHname(0x93ecb6ad);
 export const HFuncRemoveAllowedStateControllerAddress = new wasmlib.ScHname(0x31f69447);
+export const HFuncRemoveFromACL                       = new wasmlib.ScHname(0x67bc7a99);
 export const HFuncRotateStateController               = new wasmlib.ScHname(0x244d1038);
 export const HFuncSetChainInfo                        = new wasmlib.ScHname(0x702f5d2b);
 export const HFuncSetContractFee                      = new wasmlib.ScHname(0x8421a42b);
 export const HFuncSetDefaultFee                       = new wasmlib.ScHname(0x3310ecd0);
+export const HViewGetACL                              = new wasmlib.ScHname(0x8dd122e4);
 export const HViewGetAllowedStateControllerAddresses  = new wasmlib.ScHname(0xf3505183);
 export const HViewGetChainInfo                        = new wasmlib.ScHname(0x434477e2);
 export const HViewGetFeeInfo                          = new wasmlib.ScHname(0x9fe54b48);
@@ -13,6 +13,11 @@ export class AddAllowedStateControllerAddressCall {
     params: sc.MutableAddAllowedStateControllerAddressParams = new sc.MutableAddAllowedStateControllerAddressParams();
 }
 
+export class AddToACLCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncAddToACL);
+    params: sc.MutableAddToACLParams = new sc.MutableAddToACLParams();
+}
+
 export class ClaimChainOwnershipCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncClaimChainOwnership);
 }
@@ -27,6 +32,11 @@ export class RemoveAllowedStateControllerAddressCall {
     params: sc.MutableRemoveAllowedStateControllerAddressParams = new sc.MutableRemoveAllowedStateControllerAddressParams();
 }
 
+export class RemoveFromACLCall {
+    func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRemoveFromACL);
+    params: sc.MutableRemoveFromACLParams = new sc.MutableRemoveFromACLParams();
+}
+
 export class RotateStateControllerCall {
     func: wasmlib.ScFunc = new wasmlib.ScFunc(sc.HScName, sc.HFuncRotateStateController);
     params: sc.MutableRotateStateControllerParams = new sc.MutableRotateStateControllerParams();
@@ -47,6 +57,12 @@ export class SetDefaultFeeCall {
     params: sc.MutableSetDefaultFeeParams = new sc.MutableSetDefaultFeeParams();
 }
 
+export class GetACLCall {
+    func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetACL);
+    params: sc.MutableGetACLParams = new sc.MutableGetACLParams();
+    results: sc.ImmutableGetACLResults = new sc.ImmutableGetACLResults();
+}
+
 export class GetAllowedStateControllerAddressesCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetAllowedStateControllerAddresses);
     results: sc.ImmutableGetAllowedStateControllerAddressesResults = new sc.ImmutableGetAllowedStateControllerAddressesResults();
@@ -76,6 +92,12 @@ export class ScFuncs {
         return f;
     }
 
+    static addToACL(ctx: wasmlib.ScFuncCallContext): AddToACLCall {
+        let f = new AddToACLCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static claimChainOwnership(ctx: wasmlib.ScFuncCallContext): ClaimChainOwnershipCall {
         let f = new ClaimChainOwnershipCall();
         return f;
@@ -93,6 +115,12 @@ export class ScFuncs {
         return f;
     }
 
+    static removeFromACL(ctx: wasmlib.ScFuncCallContext): RemoveFromACLCall {
+        let f = new RemoveFromACLCall();
+        f.func.setPtrs(f.params, null);
+        return f;
+    }
+
     static rotateStateController(ctx: wasmlib.ScFuncCallContext): RotateStateControllerCall {
         let f = new RotateStateControllerCall();
         f.func.setPtrs(f.params, null);
@@ -117,6 +145,12 @@ export class ScFuncs {
         return f;
     }
 
+    static getACL(ctx: wasmlib.ScViewCallContext): GetACLCall {
+        let f = new GetACLCall();
+        f.func.setPtrs(f.params, f.results);
+        return f;
+    }
+
     static getAllowedStateControllerAddresses(ctx: wasmlib.ScViewCallContext): GetAllowedStateControllerAddressesCall {
         let f = new GetAllowedStateControllerAddressesCall();
         f.func.setPtrs(null, f.results);
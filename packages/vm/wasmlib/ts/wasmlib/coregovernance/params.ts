@@ -38,6 +38,36 @@ export class MutableAddAllowedStateControllerAddressParams extends wasmlib.ScMap
     }
 }
 
+export class ImmutableAddToACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    hname(): wasmlib.ScImmutableHname {
+        return new wasmlib.ScImmutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
+export class MutableAddToACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    hname(): wasmlib.ScMutableHname {
+        return new wasmlib.ScMutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
 export class ImmutableDelegateChainOwnershipParams extends wasmlib.ScMapID {
 
     chainOwner(): wasmlib.ScImmutableAgentID {
@@ -66,6 +96,36 @@ export class MutableRemoveAllowedStateControllerAddressParams extends wasmlib.Sc
     }
 }
 
+export class ImmutableRemoveFromACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    agentID(): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    hname(): wasmlib.ScImmutableHname {
+        return new wasmlib.ScImmutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
+export class MutableRemoveFromACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    agentID(): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.mapID, wasmlib.Key32.fromString(sc.ParamAgentID));
+    }
+
+    hname(): wasmlib.ScMutableHname {
+        return new wasmlib.ScMutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
 export class ImmutableRotateStateControllerParams extends wasmlib.ScMapID {
 
     stateControllerAddress(): wasmlib.ScImmutableAddress {
@@ -178,6 +238,28 @@ export class MutableSetDefaultFeeParams extends wasmlib.ScMapID {
     }
 }
 
+export class ImmutableGetACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScImmutableString {
+        return new wasmlib.ScImmutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    hname(): wasmlib.ScImmutableHname {
+        return new wasmlib.ScImmutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
+export class MutableGetACLParams extends wasmlib.ScMapID {
+
+    aclList(): wasmlib.ScMutableString {
+        return new wasmlib.ScMutableString(this.mapID, wasmlib.Key32.fromString(sc.ParamAclList));
+    }
+
+    hname(): wasmlib.ScMutableHname {
+        return new wasmlib.ScMutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
+    }
+}
+
 export class ImmutableGetFeeInfoParams extends wasmlib.ScMapID {
 
     hname(): wasmlib.ScImmutableHname {
@@ -8,6 +8,58 @@
 import * as wasmlib from "wasmlib"
 import * as sc from "./index";
 
+export class ArrayOfImmutableAgentID {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getAgentID(index: i32): wasmlib.ScImmutableAgentID {
+        return new wasmlib.ScImmutableAgentID(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class ImmutableGetACLResults extends wasmlib.ScMapID {
+
+    aclAgentIDs(): sc.ArrayOfImmutableAgentID {
+        let arrID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultAclAgentIDs), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_AGENT_ID);
+        return new sc.ArrayOfImmutableAgentID(arrID)
+    }
+}
+
+export class ArrayOfMutableAgentID {
+    objID: i32;
+
+    constructor(objID: i32) {
+        this.objID = objID;
+    }
+
+    clear(): void {
+        wasmlib.clear(this.objID);
+    }
+
+    length(): i32 {
+        return wasmlib.getLength(this.objID);
+    }
+
+    getAgentID(index: i32): wasmlib.ScMutableAgentID {
+        return new wasmlib.ScMutableAgentID(this.objID, new wasmlib.Key32(index));
+    }
+}
+
+export class MutableGetACLResults extends wasmlib.ScMapID {
+
+    aclAgentIDs(): sc.ArrayOfMutableAgentID {
+        let arrID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultAclAgentIDs), wasmlib.TYPE_ARRAY16|wasmlib.TYPE_AGENT_ID);
+        return new sc.ArrayOfMutableAgentID(arrID)
+    }
+}
+
 export class ArrayOfImmutableBytes {
     objID: i32;
 
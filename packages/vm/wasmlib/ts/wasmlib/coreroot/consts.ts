@@ -11,15 +11,19 @@ export const ScName        = "root";
 export const ScDescription = "Core root contract";
 export const HScName       = new wasmlib.ScHname(0xcebf5908);
 
+export const ParamCursor      = "cursor";
 export const ParamDeployer    = "dp";
 export const ParamDescription = "ds";
 export const ParamHname       = "hn";
+export const ParamLimit       = "limit";
 export const ParamName        = "nm";
 export const ParamProgramHash = "ph";
 
 export const ResultContractFound    = "cf";
 export const ResultContractRecData  = "dt";
 export const ResultContractRegistry = "r";
+export const ResultNextCursor       = "nextCursor";
+export const ResultTruncated        = "truncated";
 
 export const FuncDeployContract         = "deployContract";
 export const FuncGrantDeployPermission  = "grantDeployPermission";
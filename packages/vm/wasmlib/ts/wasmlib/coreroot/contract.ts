@@ -31,6 +31,7 @@ export class FindContractCall {
 
 export class GetContractRecordsCall {
     func: wasmlib.ScView = new wasmlib.ScView(sc.HScName, sc.HViewGetContractRecords);
+    params: sc.MutableGetContractRecordsParams = new sc.MutableGetContractRecordsParams();
     results: sc.ImmutableGetContractRecordsResults = new sc.ImmutableGetContractRecordsResults();
 }
 
@@ -62,7 +63,7 @@ export class ScFuncs {
 
     static getContractRecords(ctx: wasmlib.ScViewCallContext): GetContractRecordsCall {
         let f = new GetContractRecordsCall();
-        f.func.setPtrs(null, f.results);
+        f.func.setPtrs(f.params, f.results);
         return f;
     }
 }
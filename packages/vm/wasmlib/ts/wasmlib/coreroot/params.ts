@@ -79,3 +79,25 @@ export class MutableFindContractParams extends wasmlib.ScMapID {
         return new wasmlib.ScMutableHname(this.mapID, wasmlib.Key32.fromString(sc.ParamHname));
     }
 }
+
+export class ImmutableGetContractRecordsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScImmutableInt32 {
+        return new wasmlib.ScImmutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
+
+export class MutableGetContractRecordsParams extends wasmlib.ScMapID {
+
+    cursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ParamCursor));
+    }
+
+    limit(): wasmlib.ScMutableInt32 {
+        return new wasmlib.ScMutableInt32(this.mapID, wasmlib.Key32.fromString(sc.ParamLimit));
+    }
+}
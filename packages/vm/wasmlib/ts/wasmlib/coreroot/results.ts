@@ -48,6 +48,14 @@ export class ImmutableGetContractRecordsResults extends wasmlib.ScMapID {
         let mapID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultContractRegistry), wasmlib.TYPE_MAP);
         return new sc.MapHnameToImmutableBytes(mapID);
     }
+
+    nextCursor(): wasmlib.ScImmutableBytes {
+        return new wasmlib.ScImmutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScImmutableBool {
+        return new wasmlib.ScImmutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
 
 export class MapHnameToMutableBytes {
@@ -72,4 +80,12 @@ export class MutableGetContractRecordsResults extends wasmlib.ScMapID {
         let mapID = wasmlib.getObjectID(this.mapID, wasmlib.Key32.fromString(sc.ResultContractRegistry), wasmlib.TYPE_MAP);
         return new sc.MapHnameToMutableBytes(mapID);
     }
+
+    nextCursor(): wasmlib.ScMutableBytes {
+        return new wasmlib.ScMutableBytes(this.mapID, wasmlib.Key32.fromString(sc.ResultNextCursor));
+    }
+
+    truncated(): wasmlib.ScMutableBool {
+        return new wasmlib.ScMutableBool(this.mapID, wasmlib.Key32.fromString(sc.ResultTruncated));
+    }
 }
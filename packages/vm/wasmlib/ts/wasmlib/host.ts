@@ -26,6 +26,15 @@ export const TYPE_INT64: i32 = 10;
 export const TYPE_MAP: i32 = 11;
 export const TYPE_REQUEST_ID: i32 = 12;
 export const TYPE_STRING: i32 = 13;
+export const TYPE_BOOL: i32 = 14;
+export const TYPE_INT8: i32 = 15;
+
+// extended types have the 0x80 bit set, the other bits are taken
+export const TYPE_UINT8: i32 = 0x80;
+export const TYPE_UINT16: i32 = 0x81;
+export const TYPE_UINT32: i32 = 0x82;
+export const TYPE_UINT64: i32 = 0x83;
+export const TYPE_BIG_INT: i32 = 0x84;
 
 export const OBJ_ID_NULL: i32 = 0;
 export const OBJ_ID_ROOT: i32 = 1;
@@ -33,8 +42,12 @@ export const OBJ_ID_STATE: i32 = 2;
 export const OBJ_ID_PARAMS: i32 = 3;
 export const OBJ_ID_RESULTS: i32 = 4;
 
-// size in bytes of predefined types, indexed by the TYPE_* consts
-const TYPE_SIZES: u8[] = [0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0];
+// size in bytes of predefined types, the basic types followed by the extended types
+const TYPE_SIZES: u8[] = [0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0, 1, 1, 1, 2, 4, 8, 0];
+
+function typeSize(typeID: i32): i32 {
+    return TYPE_SIZES[(typeID & 0x0f) | ((typeID & 0x80) >> 3)] as i32;
+}
 
 
 // These 4 external functions are funneling the entire WasmLib functionality
@@ -99,7 +112,7 @@ export function exists(objID: i32, keyID: Key32, typeID: i32): boolean {
 // and with specified type. Note that if the key does not exist this function will
 // return the default value for the specified type.
 export function getBytes(objID: i32, keyID: Key32, typeID: i32): u8[] {
-    let size = TYPE_SIZES[typeID] as i32;
+    let size = typeSize(typeID);
     if (size == 0) {
         // variable-sized type, first query expected length of bytes array
         // (pass zero-length buffer)
@@ -3,12 +3,14 @@
 
 // immutable proxies to host objects
 
+import {ScBigInt} from "./bigint";
 import { base58Encode } from "./context";
 import {Convert} from "./convert";
 import {ScAddress,ScAgentID,ScChainID,ScColor,ScHash,ScHname,ScRequestID} from "./hashtypes";
 import * as host from "./host";
 import {callFunc, exists, getBytes, getLength, getObjectID} from "./host";
 import {Key32,MapKey} from "./keys";
+import {ScImmutableOrderedMap} from "./ordered";
 
 // value proxy for immutable ScAddress in host container
 export class ScImmutableAddress {
@@ -108,6 +110,104 @@ export class ScImmutableAgentIDArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for immutable ScBigInt in host container
+export class ScImmutableBigInt {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_BIG_INT);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): ScBigInt {
+        return ScBigInt.fromBytes(getBytes(this.objID, this.keyID, host.TYPE_BIG_INT));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of ScBigInt
+export class ScImmutableBigIntArray {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getBigInt(index: i32): ScImmutableBigInt {
+        return new ScImmutableBigInt(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable boolean in host container
+export class ScImmutableBool {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_BOOL);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): boolean {
+        return getBytes(this.objID, this.keyID, host.TYPE_BOOL)[0] != 0;
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of boolean
+export class ScImmutableBoolArray {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getBool(index: i32): ScImmutableBool {
+        return new ScImmutableBool(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for immutable bytes array in host container
 export class ScImmutableBytes {
     objID: i32;
@@ -353,6 +453,55 @@ export class ScImmutableHnameArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for immutable int8 in host container
+export class ScImmutableInt8 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_INT8);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): i8 {
+        return getBytes(this.objID, this.keyID, host.TYPE_INT8)[0] as i8;
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of int8
+export class ScImmutableInt8Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getInt8(index: i32): ScImmutableInt8 {
+        return new ScImmutableInt8(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for immutable int16 in host container
 export class ScImmutableInt16 {
     objID: i32;
@@ -537,6 +686,28 @@ export class ScImmutableMap {
         return new ScImmutableAgentIDArray(arrID);
     }
 
+    // get value proxy for immutable ScBigInt field specified by key
+    getBigInt(key: MapKey): ScImmutableBigInt {
+        return new ScImmutableBigInt(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableBigIntArray specified by key
+    getBigIntArray(key: MapKey): ScImmutableBigIntArray {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_BIG_INT | host.TYPE_ARRAY);
+        return new ScImmutableBigIntArray(arrID);
+    }
+
+    // get value proxy for immutable boolean field specified by key
+    getBool(key: MapKey): ScImmutableBool {
+        return new ScImmutableBool(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableBoolArray specified by key
+    getBoolArray(key: MapKey): ScImmutableBoolArray {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_BOOL | host.TYPE_ARRAY);
+        return new ScImmutableBoolArray(arrID);
+    }
+
     // get value proxy for immutable bytes array field specified by key
     getBytes(key: MapKey): ScImmutableBytes {
         return new ScImmutableBytes(this.objID, key.getKeyID());
@@ -592,6 +763,17 @@ export class ScImmutableMap {
         return new ScImmutableHnameArray(arrID);
     }
 
+    // get value proxy for immutable int8 field specified by key
+    getInt8(key: MapKey): ScImmutableInt8 {
+        return new ScImmutableInt8(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableInt8Array specified by key
+    getInt8Array(key: MapKey): ScImmutableInt8Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_INT8 | host.TYPE_ARRAY);
+        return new ScImmutableInt8Array(arrID);
+    }
+
     // get value proxy for immutable int16 field specified by key
     getInt16(key: MapKey): ScImmutableInt16 {
         return new ScImmutableInt16(this.objID, key.getKeyID());
@@ -637,6 +819,11 @@ export class ScImmutableMap {
         return new ScImmutableMapArray(arrID);
     }
 
+    // get proxy for immutable ordered map specified by key
+    getOrderedMap(key: MapKey): ScImmutableOrderedMap {
+        return new ScImmutableOrderedMap(this.objID, key.getKeyID());
+    }
+
     // get value proxy for immutable ScRequestID field specified by key
     getRequestID(key: MapKey): ScImmutableRequestID {
         return new ScImmutableRequestID(this.objID, key.getKeyID());
@@ -659,6 +846,50 @@ export class ScImmutableMap {
         return new ScImmutableStringArray(arrID);
     }
 
+    // get value proxy for immutable uint8 field specified by key
+    getUint8(key: MapKey): ScImmutableUint8 {
+        return new ScImmutableUint8(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableUint8Array specified by key
+    getUint8Array(key: MapKey): ScImmutableUint8Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT8 | host.TYPE_ARRAY);
+        return new ScImmutableUint8Array(arrID);
+    }
+
+    // get value proxy for immutable uint16 field specified by key
+    getUint16(key: MapKey): ScImmutableUint16 {
+        return new ScImmutableUint16(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableUint16Array specified by key
+    getUint16Array(key: MapKey): ScImmutableUint16Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT16 | host.TYPE_ARRAY);
+        return new ScImmutableUint16Array(arrID);
+    }
+
+    // get value proxy for immutable uint32 field specified by key
+    getUint32(key: MapKey): ScImmutableUint32 {
+        return new ScImmutableUint32(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableUint32Array specified by key
+    getUint32Array(key: MapKey): ScImmutableUint32Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT32 | host.TYPE_ARRAY);
+        return new ScImmutableUint32Array(arrID);
+    }
+
+    // get value proxy for immutable uint64 field specified by key
+    getUint64(key: MapKey): ScImmutableUint64 {
+        return new ScImmutableUint64(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScImmutableUint64Array specified by key
+    getUint64Array(key: MapKey): ScImmutableUint64Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT64 | host.TYPE_ARRAY);
+        return new ScImmutableUint64Array(arrID);
+    }
+
     mapID(): i32 {
         return this.objID;
     }
@@ -782,3 +1013,199 @@ export class ScImmutableStringArray {
         return getLength(this.objID);
     }
 }
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint8 in host container
+export class ScImmutableUint8 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT8);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): u8 {
+        return getBytes(this.objID, this.keyID, host.TYPE_UINT8)[0];
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint8
+export class ScImmutableUint8Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getUint8(index: i32): ScImmutableUint8 {
+        return new ScImmutableUint8(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint16 in host container
+export class ScImmutableUint16 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT16);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): u16 {
+        return Convert.toU16(getBytes(this.objID, this.keyID, host.TYPE_UINT16));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint16
+export class ScImmutableUint16Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getUint16(index: i32): ScImmutableUint16 {
+        return new ScImmutableUint16(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint32 in host container
+export class ScImmutableUint32 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT32);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): u32 {
+        return Convert.toU32(getBytes(this.objID, this.keyID, host.TYPE_UINT32));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint32
+export class ScImmutableUint32Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getUint32(index: i32): ScImmutableUint32 {
+        return new ScImmutableUint32(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for immutable uint64 in host container
+export class ScImmutableUint64 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT64);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // get value from host container
+    value(): u64 {
+        return Convert.toU64(getBytes(this.objID, this.keyID, host.TYPE_UINT64));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for immutable array of uint64
+export class ScImmutableUint64Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // get value proxy for item at index, index can be 0..length()-1
+    getUint64(index: i32): ScImmutableUint64 {
+        return new ScImmutableUint64(this.objID, new Key32(index));
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
@@ -1,3 +1,4 @@
+export * from "./bigint"
 export * from "./bytes"
 export * from "./context"
 export * from "./contract"
@@ -8,3 +9,4 @@ export * from "./host"
 export * from "./immutable"
 export * from "./keys"
 export * from "./mutable"
+export * from "./ordered"
@@ -3,6 +3,7 @@
 
 // mutable proxies to host objects
 
+import {ScBigInt} from "./bigint";
 import {base58Encode, ROOT} from "./context";
 import {Convert} from "./convert";
 import {ScAddress, ScAgentID, ScChainID, ScColor, ScHash, ScHname, ScRequestID} from "./hashtypes";
@@ -11,20 +12,28 @@ import {callFunc, clear, exists, getBytes, getLength, getObjectID, setBytes} fro
 import {
     ScImmutableAddressArray,
     ScImmutableAgentIDArray,
+    ScImmutableBigIntArray,
+    ScImmutableBoolArray,
     ScImmutableBytesArray,
     ScImmutableChainIDArray,
     ScImmutableColorArray,
     ScImmutableHashArray,
     ScImmutableHnameArray,
+    ScImmutableInt8Array,
     ScImmutableInt16Array,
     ScImmutableInt32Array,
     ScImmutableInt64Array,
     ScImmutableMap,
     ScImmutableMapArray,
     ScImmutableRequestIDArray,
-    ScImmutableStringArray
+    ScImmutableStringArray,
+    ScImmutableUint8Array,
+    ScImmutableUint16Array,
+    ScImmutableUint32Array,
+    ScImmutableUint64Array
 } from "./immutable";
 import {Key32, KEY_MAPS, MapKey} from "./keys";
+import {ScMutableOrderedMap} from "./ordered";
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
@@ -158,6 +167,136 @@ export class ScMutableAgentIDArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for mutable ScBigInt in host container
+export class ScMutableBigInt {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_BIG_INT);
+    }
+
+    // set value in host container
+    setValue(val: ScBigInt): void {
+        setBytes(this.objID, this.keyID, host.TYPE_BIG_INT, val.toBytes());
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): ScBigInt {
+        return ScBigInt.fromBytes(getBytes(this.objID, this.keyID, host.TYPE_BIG_INT));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of ScBigInt
+export class ScMutableBigIntArray {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getBigInt(index: i32): ScMutableBigInt {
+        return new ScMutableBigInt(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableBigIntArray {
+        return new ScImmutableBigIntArray(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable boolean in host container
+export class ScMutableBool {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_BOOL);
+    }
+
+    // set value in host container
+    setValue(val: boolean): void {
+        setBytes(this.objID, this.keyID, host.TYPE_BOOL, [(val ? 1 : 0) as u8]);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): boolean {
+        return getBytes(this.objID, this.keyID, host.TYPE_BOOL)[0] != 0;
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of boolean
+export class ScMutableBoolArray {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getBool(index: i32): ScMutableBool {
+        return new ScMutableBool(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableBoolArray {
+        return new ScImmutableBoolArray(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for mutable bytes array in host container
 export class ScMutableBytes {
     objID: i32;
@@ -483,6 +622,71 @@ export class ScMutableHnameArray {
 
 // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
 
+// value proxy for mutable int8 in host container
+export class ScMutableInt8 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_INT8);
+    }
+
+    // set value in host container
+    setValue(val: i8): void {
+        setBytes(this.objID, this.keyID, host.TYPE_INT8, [val as u8]);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): i8 {
+        return getBytes(this.objID, this.keyID, host.TYPE_INT8)[0] as i8;
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of int8
+export class ScMutableInt8Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getInt8(index: i32): ScMutableInt8 {
+        return new ScMutableInt8(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableInt8Array {
+        return new ScImmutableInt8Array(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
 // value proxy for mutable int16 in host container
 export class ScMutableInt16 {
     objID: i32;
@@ -723,6 +927,28 @@ export class ScMutableMap {
         return new ScMutableAgentIDArray(arrID);
     }
 
+    // get value proxy for mutable ScBigInt field specified by key
+    getBigInt(key: MapKey): ScMutableBigInt {
+        return new ScMutableBigInt(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableBigIntArray specified by key
+    getBigIntArray(key: MapKey): ScMutableBigIntArray {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_BIG_INT | host.TYPE_ARRAY);
+        return new ScMutableBigIntArray(arrID);
+    }
+
+    // get value proxy for mutable boolean field specified by key
+    getBool(key: MapKey): ScMutableBool {
+        return new ScMutableBool(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableBoolArray specified by key
+    getBoolArray(key: MapKey): ScMutableBoolArray {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_BOOL | host.TYPE_ARRAY);
+        return new ScMutableBoolArray(arrID);
+    }
+
     // get value proxy for mutable bytes array field specified by key
     getBytes(key: MapKey): ScMutableBytes {
         return new ScMutableBytes(this.objID, key.getKeyID());
@@ -778,6 +1004,17 @@ export class ScMutableMap {
         return new ScMutableHnameArray(arrID);
     }
 
+    // get value proxy for mutable int8 field specified by key
+    getInt8(key: MapKey): ScMutableInt8 {
+        return new ScMutableInt8(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableInt8Array specified by key
+    getInt8Array(key: MapKey): ScMutableInt8Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_INT8 | host.TYPE_ARRAY);
+        return new ScMutableInt8Array(arrID);
+    }
+
     // get value proxy for mutable int16 field specified by key
     getInt16(key: MapKey): ScMutableInt16 {
         return new ScMutableInt16(this.objID, key.getKeyID());
@@ -823,6 +1060,11 @@ export class ScMutableMap {
         return new ScMutableMapArray(arrID);
     }
 
+    // get proxy for mutable ordered map specified by key
+    getOrderedMap(key: MapKey): ScMutableOrderedMap {
+        return new ScMutableOrderedMap(this.objID, key.getKeyID());
+    }
+
     // get value proxy for mutable ScRequestID field specified by key
     getRequestID(key: MapKey): ScMutableRequestID {
         return new ScMutableRequestID(this.objID, key.getKeyID());
@@ -845,6 +1087,50 @@ export class ScMutableMap {
         return new ScMutableStringArray(arrID);
     }
 
+    // get value proxy for mutable uint8 field specified by key
+    getUint8(key: MapKey): ScMutableUint8 {
+        return new ScMutableUint8(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableUint8Array specified by key
+    getUint8Array(key: MapKey): ScMutableUint8Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT8 | host.TYPE_ARRAY);
+        return new ScMutableUint8Array(arrID);
+    }
+
+    // get value proxy for mutable uint16 field specified by key
+    getUint16(key: MapKey): ScMutableUint16 {
+        return new ScMutableUint16(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableUint16Array specified by key
+    getUint16Array(key: MapKey): ScMutableUint16Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT16 | host.TYPE_ARRAY);
+        return new ScMutableUint16Array(arrID);
+    }
+
+    // get value proxy for mutable uint32 field specified by key
+    getUint32(key: MapKey): ScMutableUint32 {
+        return new ScMutableUint32(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableUint32Array specified by key
+    getUint32Array(key: MapKey): ScMutableUint32Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT32 | host.TYPE_ARRAY);
+        return new ScMutableUint32Array(arrID);
+    }
+
+    // get value proxy for mutable uint64 field specified by key
+    getUint64(key: MapKey): ScMutableUint64 {
+        return new ScMutableUint64(this.objID, key.getKeyID());
+    }
+
+    // get array proxy for ScMutableUint64Array specified by key
+    getUint64Array(key: MapKey): ScMutableUint64Array {
+        let arrID = getObjectID(this.objID, key.getKeyID(), host.TYPE_UINT64 | host.TYPE_ARRAY);
+        return new ScMutableUint64Array(arrID);
+    }
+
     // get immutable version of map proxy
     immutable(): ScImmutableMap {
         return new ScImmutableMap(this.objID);
@@ -1018,3 +1304,263 @@ export class ScMutableStringArray {
         return getLength(this.objID);
     }
 }
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint8 in host container
+export class ScMutableUint8 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT8);
+    }
+
+    // set value in host container
+    setValue(val: u8): void {
+        setBytes(this.objID, this.keyID, host.TYPE_UINT8, [val]);
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): u8 {
+        return getBytes(this.objID, this.keyID, host.TYPE_UINT8)[0];
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint8
+export class ScMutableUint8Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getUint8(index: i32): ScMutableUint8 {
+        return new ScMutableUint8(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableUint8Array {
+        return new ScImmutableUint8Array(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint16 in host container
+export class ScMutableUint16 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT16);
+    }
+
+    // set value in host container
+    setValue(val: u16): void {
+        setBytes(this.objID, this.keyID, host.TYPE_UINT16, Convert.fromU16(val));
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): u16 {
+        return Convert.toU16(getBytes(this.objID, this.keyID, host.TYPE_UINT16));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint16
+export class ScMutableUint16Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getUint16(index: i32): ScMutableUint16 {
+        return new ScMutableUint16(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableUint16Array {
+        return new ScImmutableUint16Array(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint32 in host container
+export class ScMutableUint32 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT32);
+    }
+
+    // set value in host container
+    setValue(val: u32): void {
+        setBytes(this.objID, this.keyID, host.TYPE_UINT32, Convert.fromU32(val));
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): u32 {
+        return Convert.toU32(getBytes(this.objID, this.keyID, host.TYPE_UINT32));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint32
+export class ScMutableUint32Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getUint32(index: i32): ScMutableUint32 {
+        return new ScMutableUint32(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableUint32Array {
+        return new ScImmutableUint32Array(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// value proxy for mutable uint64 in host container
+export class ScMutableUint64 {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    // check if value exists in host container
+    exists(): boolean {
+        return exists(this.objID, this.keyID, host.TYPE_UINT64);
+    }
+
+    // set value in host container
+    setValue(val: u64): void {
+        setBytes(this.objID, this.keyID, host.TYPE_UINT64, Convert.fromU64(val));
+    }
+
+    // human-readable string representation
+    toString(): string {
+        return this.value().toString();
+    }
+
+    // retrieve value from host container
+    value(): u64 {
+        return Convert.toU64(getBytes(this.objID, this.keyID, host.TYPE_UINT64));
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// array proxy for mutable array of uint64
+export class ScMutableUint64Array {
+    objID: i32;
+
+    constructor(id: i32) {
+        this.objID = id;
+    }
+
+    // empty the array
+    clear(): void {
+        clear(this.objID);
+    }
+
+    // get value proxy for item at index, index can be 0..length()
+    // when index equals length() a new item is appended
+    getUint64(index: i32): ScMutableUint64 {
+        return new ScMutableUint64(this.objID, new Key32(index));
+    }
+
+    // get immutable version of array proxy
+    immutable(): ScImmutableUint64Array {
+        return new ScImmutableUint64Array(this.objID);
+    }
+
+    // number of items in array
+    length(): i32 {
+        return getLength(this.objID);
+    }
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+// maps that keep their keys in lexicographical order
+
+import {BytesDecoder, BytesEncoder} from "./bytes";
+import {Convert} from "./convert";
+import {callFunc, panic} from "./host";
+import {Key32} from "./keys";
+
+// ordered map operations, should exactly match their counterpart values on the host
+const ORDERED_OP_DELETE: i32 = 0;
+const ORDERED_OP_ERASE: i32 = 1;
+const ORDERED_OP_EXISTS: i32 = 2;
+const ORDERED_OP_GET: i32 = 3;
+const ORDERED_OP_LENGTH: i32 = 4;
+const ORDERED_OP_PAGE: i32 = 5;
+const ORDERED_OP_SET: i32 = 6;
+
+// maximum number of elements in a single page
+export const ORDERED_MAX_PAGE_SIZE: i32 = 1000;
+
+// encodes an i64 so that the encodings sort in the same order as the values,
+// for use as ordered map key
+export function encodeSortableInt64(value: i64): u8[] {
+    return encodeSortableUint64((value as u64) ^ ((1 as u64) << 63));
+}
+
+export function decodeSortableInt64(bytes: u8[]): i64 {
+    return (decodeSortableUint64(bytes) ^ ((1 as u64) << 63)) as i64;
+}
+
+// encodes a u64 so that the encodings sort in the same order as the values,
+// for use as ordered map key
+export function encodeSortableUint64(value: u64): u8[] {
+    return Convert.fromU64(value).reverse();
+}
+
+export function decodeSortableUint64(bytes: u8[]): u64 {
+    if (bytes.length != 8) {
+        panic("invalid sortable integer");
+    }
+    return Convert.toU64(bytes.slice(0).reverse());
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// page of ordered map elements, see ScImmutableOrderedMap.page()
+export class ScOrderedPage {
+    keys: u8[][] = [];
+    values: u8[][] = [];
+    // cursor of the next page, null when there are no more elements
+    cursor: u8[] | null = null;
+}
+
+// proxy for an immutable map that keeps its byte array keys in lexicographical
+// order, which allows for range scans
+// integer keys need to be encoded with the encodeSortable functions
+export class ScImmutableOrderedMap {
+    objID: i32;
+    keyID: Key32;
+
+    constructor(objID: i32, keyID: Key32) {
+        this.objID = objID;
+        this.keyID = keyID;
+    }
+
+    call(op: i32, args: u8[]): u8[] {
+        let params = new BytesEncoder().int32(op).data().concat(args);
+        return callFunc(this.objID, this.keyID, params);
+    }
+
+    // check if the map contains an element with the specified key
+    exists(key: u8[]): boolean {
+        return this.call(ORDERED_OP_EXISTS, new BytesEncoder().bytes(key).data()).length != 0;
+    }
+
+    // get the value of the element with the specified key
+    getBytes(key: u8[]): u8[] {
+        return this.call(ORDERED_OP_GET, new BytesEncoder().bytes(key).data());
+    }
+
+    // number of elements in the map
+    length(): i32 {
+        return Convert.toI32(this.call(ORDERED_OP_LENGTH, []));
+    }
+
+    // retrieve at most limit elements with keys in the range [from, to), in ascending
+    // key order, or in descending key order when reverse is set
+    // a null from or to leaves that end of the range unbounded
+    // to retrieve the next page pass the returned cursor as from, or as to when reverse is set
+    page(from: u8[] | null, to: u8[] | null, limit: i32, reverse: boolean): ScOrderedPage {
+        let encode = new BytesEncoder();
+        encodeOptionalBytes(encode, from);
+        encodeOptionalBytes(encode, to);
+        encode.int32(limit);
+        encode.int32(reverse ? 1 : 0);
+        let decode = new BytesDecoder(this.call(ORDERED_OP_PAGE, encode.data()));
+        let count = decode.int32();
+        let page = new ScOrderedPage();
+        for (let i = 0; i < count; i++) {
+            page.keys.push(decode.bytes());
+            page.values.push(decode.bytes());
+        }
+        page.cursor = decodeOptionalBytes(decode);
+        decode.close();
+        return page;
+    }
+
+    // smallest key that is greater than or equal to key, null when there is no such key
+    seek(key: u8[]): u8[] | null {
+        let page = this.page(key, null, 1, false);
+        if (page.keys.length == 0) {
+            return null;
+        }
+        return page.keys[0];
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// proxy for a mutable map that keeps its byte array keys in lexicographical order
+export class ScMutableOrderedMap extends ScImmutableOrderedMap {
+    // delete all elements of the map
+    clear(): void {
+        this.call(ORDERED_OP_ERASE, []);
+    }
+
+    // delete the element with the specified key
+    delete(key: u8[]): void {
+        this.call(ORDERED_OP_DELETE, new BytesEncoder().bytes(key).data());
+    }
+
+    // get immutable version of map proxy
+    immutable(): ScImmutableOrderedMap {
+        return new ScImmutableOrderedMap(this.objID, this.keyID);
+    }
+
+    // set the value of the element with the specified key
+    setBytes(key: u8[], value: u8[]): void {
+        this.call(ORDERED_OP_SET, new BytesEncoder().bytes(key).bytes(value).data());
+    }
+}
+
+// \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\ // \\
+
+// optional byte arrays are encoded with a presence flag,
+// because an empty array is a valid key
+function decodeOptionalBytes(decode: BytesDecoder): u8[] | null {
+    if (decode.int32() == 0) {
+        return null;
+    }
+    return decode.bytes();
+}
+
+function encodeOptionalBytes(encode: BytesEncoder, value: u8[] | null): void {
+    if (value === null) {
+        encode.int32(0);
+        return;
+    }
+    encode.int32(1).bytes(value!);
+}
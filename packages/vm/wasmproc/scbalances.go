@@ -4,11 +4,10 @@
 package wasmproc
 
 import (
-	"strconv"
-
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/wasmhost"
 )
@@ -40,11 +39,11 @@ func NewScBalances(wc *WasmContext, keyID int32) *ScDict {
 }
 
 func loadBalances(o *ScDict, balances colored.Balances) *ScDict {
-	index := 0
+	index := uint32(0)
 	key := o.host.GetKeyStringFromID(wasmhost.KeyColor)
 	balances.ForEachRandomly(func(color colored.Color, balance uint64) bool {
 		o.kvStore.Set(kv.Key(color[:]), codec.EncodeUint64(balance))
-		o.kvStore.Set(kv.Key(key+"."+strconv.Itoa(index)), color[:])
+		o.kvStore.Set(collections.NestedArrayElemKey(key, index), color[:])
 		index++
 		return true
 	})
@@ -4,6 +4,7 @@
 package wasmproc
 
 import (
+	"math"
 	"time"
 
 	"github.com/iotaledger/wasp/packages/iscp/colored"
@@ -161,6 +162,9 @@ func (o *ScContext) SetBytes(keyID, typeID int32, bytes []byte) {
 	case wasmhost.KeyTrace:
 		o.wc.log().Debugf(string(bytes))
 	case wasmhost.KeyPanic:
+		if typeID == wasmhost.OBJTYPE_BYTES {
+			o.processPanic(bytes)
+		}
 		o.wc.log().Panicf(string(bytes))
 	case wasmhost.KeyPost:
 		o.processPost(bytes)
@@ -183,6 +187,11 @@ func (o *ScContext) processCall(bytes []byte) {
 	transfer := o.getTransfer(decode.Int32())
 
 	o.Tracef("CALL c'%s' f'%s'", contract.String(), function.String())
+	if tracer := wasmhost.GetHostTracer(); tracer != nil {
+		scope := o.wc.traceScope()
+		tracer.Call(scope, o.wc.TraceDepth(), contract.String(), function.String())
+		defer tracer.Return(scope)
+	}
 	results, err := o.processCallUnlocked(contract, function, params, transfer)
 	if err != nil {
 		o.Panic("failed to invoke call: %v", err)
@@ -224,6 +233,37 @@ func (o *ScContext) processDeployUnlocked(programHash hashing.HashValue, name, d
 	return o.wc.ctx.DeployContract(programHash, name, description, params)
 }
 
+// maxPanicParams is the maximum number of params of a typed error raised by a contract
+const maxPanicParams = 16
+
+// processPanic raises the typed error encoded by the client as an iscp.VMError
+func (o *ScContext) processPanic(bytes []byte) {
+	decode := NewBytesDecoder(bytes)
+	code := decode.Int32()
+	if code < int32(iscp.VMErrorCodeUser) || code > math.MaxUint16 {
+		// codes below VMErrorCodeUser are reserved for the VM itself
+		o.Panic("invalid error code: %d", code)
+	}
+	message := string(decode.Bytes())
+	count := decode.Int32()
+	// every param takes at least its size byte, so the count can never exceed the remaining bytes
+	if count < 0 || count > maxPanicParams || int(count) > len(decode.data) {
+		o.Panic("invalid error param count: %d", count)
+	}
+	params := make([]string, count)
+	for i := range params {
+		params[i] = string(decode.Bytes())
+	}
+	vmErr := iscp.NewVMError(uint16(code), message, params...)
+	if o.wc.ctx != nil {
+		vmErr.Contract = o.wc.ctx.Contract()
+	} else {
+		vmErr.Contract = o.wc.ctxView.Contract()
+	}
+	o.Tracef("PANIC %s", vmErr.String())
+	panic(vmErr)
+}
+
 func (o *ScContext) processPost(bytes []byte) {
 	decode := NewBytesDecoder(bytes)
 	chainID, err := iscp.ChainIDFromBytes(decode.Bytes())
@@ -0,0 +1,50 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmproc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePanic(code, count int32, params ...string) []byte {
+	encode := NewBytesEncoder().Int32(code).Bytes([]byte("failed")).Int32(count)
+	for _, param := range params {
+		encode.Bytes([]byte(param))
+	}
+	return encode.Data()
+}
+
+// invalid typed errors raised by a contract are rejected before anything is allocated
+func TestProcessPanicInvalid(t *testing.T) {
+	host, _ := newTestState(dict.New())
+	o := &ScContext{}
+	o.host = host
+	o.name = "root"
+
+	user := int32(iscp.VMErrorCodeUser)
+	require.PanicsWithValue(t, "root.invalid error code: 3", func() {
+		o.processPanic(encodePanic(int32(iscp.VMErrorCodeNotAllowed), 0))
+	})
+	require.PanicsWithValue(t, "root.invalid error code: 65536", func() {
+		o.processPanic(encodePanic(math.MaxUint16+1, 0))
+	})
+	require.PanicsWithValue(t, "root.invalid error param count: -1", func() {
+		o.processPanic(encodePanic(user, -1))
+	})
+	require.PanicsWithValue(t, "root.invalid error param count: 2147483647", func() {
+		o.processPanic(encodePanic(user, math.MaxInt32, "a"))
+	})
+	require.PanicsWithValue(t, "root.invalid error param count: 3", func() {
+		o.processPanic(encodePanic(user, 3, "a"))
+	})
+	params := make([]string, maxPanicParams+1)
+	require.PanicsWithValue(t, "root.invalid error param count: 17", func() {
+		o.processPanic(encodePanic(user, maxPanicParams+1, params...))
+	})
+}
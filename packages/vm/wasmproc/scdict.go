@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/vm/wasmhost"
 )
 
@@ -67,9 +68,12 @@ type ScDict struct {
 
 // TODO iterate over maps
 
-var _ WaspObject = &ScDict{}
+var (
+	_ WaspObject         = &ScDict{}
+	_ wasmhost.HostArray = &ScDict{}
+)
 
-var typeSizes = [...]int{0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0}
+var typeSizes = [...]int{0, 33, 37, 0, 33, 32, 32, 4, 2, 4, 8, 0, 34, 0, 1, 1, 1, 2, 4, 8, 0}
 
 func NewScDict(host *wasmhost.KvStoreHost, kvStore kv.KVStore) *ScDict {
 	return &ScDict{host: host, kvStore: kvStore}
@@ -112,9 +116,9 @@ func (o *ScDict) InitObj(id, keyID int32, owner *ScDict) {
 	o.types = make(map[int32]int32)
 }
 
+// CallFunc provides access to the ordered map stored under keyID
 func (o *ScDict) CallFunc(keyID int32, params []byte) []byte {
-	o.Panic("CallFunc: invalid call")
-	return nil
+	return o.orderedMapFunc(keyID, params)
 }
 
 func (o *ScDict) Exists(keyID, typeID int32) bool {
@@ -137,12 +141,9 @@ func (o *ScDict) FindOrMakeObjectID(keyID int32, factory ObjFactory) int32 {
 	objID = o.host.TrackObject(newObject)
 	newObject.InitObj(objID, keyID, o)
 	o.objects[keyID] = objID
-	if (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0 {
-		o.length++
-		if o.kvStore != nil {
-			key := o.NestedKey()[1:]
-			o.kvStore.Set(kv.Key(key), codec.EncodeInt32(o.length))
-		}
+	if (o.typeID&wasmhost.OBJTYPE_ARRAY) != 0 && keyID >= o.length {
+		// validate() did not extend the array yet
+		o.setArrayLength(keyID + 1)
 	}
 	return objID
 }
@@ -161,6 +162,20 @@ func (o *ScDict) getArrayLength() (err error) {
 	return err
 }
 
+// setArrayLength stores the array length in the same encoding that getArrayLength expects
+func (o *ScDict) setArrayLength(length int32) {
+	o.length = length
+	if o.kvStore == nil {
+		return
+	}
+	key := kv.Key(o.NestedKey()[1:])
+	if (o.typeID & wasmhost.OBJTYPE_ARRAY16) != wasmhost.OBJTYPE_ARRAY16 {
+		o.kvStore.Set(key, codec.EncodeInt32(length))
+		return
+	}
+	o.kvStore.Set(key, codec.EncodeUint16(uint16(length)))
+}
+
 func (o *ScDict) GetBytes(keyID, typeID int32) []byte {
 	if keyID == wasmhost.KeyLength && (o.typeID&wasmhost.OBJTYPE_ARRAY) != 0 {
 		return codec.EncodeInt32(o.length)
@@ -182,6 +197,11 @@ func (o *ScDict) GetObjectID(keyID, typeID int32) int32 {
 
 func (o *ScDict) GetTypeID(keyID int32) int32 {
 	if (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0 {
+		// arrays of containers register the container type per element
+		typeID, ok := o.types[keyID]
+		if ok {
+			return typeID
+		}
 		return o.typeID & wasmhost.OBJTYPE_TYPEMASK
 	}
 	// TODO incomplete, currently only contains used field types
@@ -196,6 +216,10 @@ func (o *ScDict) InvalidKey(keyID int32) {
 	o.Panic("invalid key: %d", keyID)
 }
 
+func (o *ScDict) IsArray() bool {
+	return (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0
+}
+
 func (o *ScDict) key(keyID, typeID int32) kv.Key {
 	o.validate(keyID, typeID)
 	suffix := o.Suffix(keyID)
@@ -205,6 +229,10 @@ func (o *ScDict) key(keyID, typeID int32) kv.Key {
 	return kv.Key(key[1:])
 }
 
+func (o *ScDict) isContainer(typeID int32) bool {
+	return (typeID&wasmhost.OBJTYPE_ARRAY) != 0 || typeID == wasmhost.OBJTYPE_MAP
+}
+
 func (o *ScDict) KvStore() kv.KVStore {
 	return o.kvStore
 }
@@ -230,13 +258,9 @@ func (o *ScDict) Panic(format string, args ...interface{}) {
 
 func (o *ScDict) SetBytes(keyID, typeID int32, bytes []byte) {
 	if keyID == wasmhost.KeyLength {
-		if o.kvStore != nil {
-			// TODO this goes wrong for state, should clear map tree instead
-			// o.kvStore = dict.New()
-			if (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0 {
-				key := o.NestedKey()[1:]
-				o.kvStore.Set(kv.Key(key), codec.EncodeInt32(0))
-			}
+		// TODO this goes wrong for state, should clear map tree instead
+		if (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0 {
+			o.setArrayLength(0)
 		}
 		o.objects = make(map[int32]int32)
 		o.length = 0
@@ -248,10 +272,14 @@ func (o *ScDict) SetBytes(keyID, typeID int32, bytes []byte) {
 	o.kvStore.Set(key, bytes)
 }
 
+// Suffix returns the key suffix of the element with the specified key ID.
+// Maps and arrays use the nested layout of collections.NestedMapElemKey and
+// collections.NestedArrayElemKey, which is used by the state of deployed contracts,
+// so it must not change. Array16 is the layout of the native core contracts.
 func (o *ScDict) Suffix(keyID int32) string {
 	if (o.typeID & wasmhost.OBJTYPE_ARRAY16) != 0 {
 		if (o.typeID & wasmhost.OBJTYPE_ARRAY16) != wasmhost.OBJTYPE_ARRAY16 {
-			return fmt.Sprintf(".%d", keyID)
+			return string(collections.NestedArrayElemKey("", uint32(keyID)))
 		}
 
 		buf := make([]byte, 3)
@@ -260,8 +288,7 @@ func (o *ScDict) Suffix(keyID int32) string {
 		return string(buf)
 	}
 
-	key := o.host.GetKeyFromID(keyID)
-	return "." + string(key)
+	return string(collections.NestedMapElemKey("", o.host.GetKeyFromID(keyID)))
 }
 
 func (o *ScDict) Tracef(format string, a ...interface{}) {
@@ -269,7 +296,7 @@ func (o *ScDict) Tracef(format string, a ...interface{}) {
 }
 
 func (o *ScDict) typeCheck(typeID int32, bytes []byte) {
-	typeSize := typeSizes[typeID]
+	typeSize := typeSizes[wasmhost.TypeIndex(typeID)]
 	if typeSize != 0 && typeSize != len(bytes) {
 		o.Panic("typeCheck: invalid type size")
 	}
@@ -289,6 +316,15 @@ func (o *ScDict) typeCheck(typeID int32, bytes []byte) {
 		if ledgerstate.AddressType(bytes[0]) != ledgerstate.AliasAddressType {
 			o.Panic("typeCheck: invalid chain id address type")
 		}
+	case wasmhost.OBJTYPE_BOOL:
+		if bytes[0] > 1 {
+			o.Panic("typeCheck: invalid bool value")
+		}
+	case wasmhost.OBJTYPE_BIG_INT:
+		// big int must be encoded without trailing zero bytes
+		if len(bytes) == 0 || (len(bytes) > 1 && bytes[len(bytes)-1] == 0) {
+			o.Panic("typeCheck: invalid big int encoding")
+		}
 	case wasmhost.OBJTYPE_REQUEST_ID:
 		outputIndex := binary.LittleEndian.Uint16(bytes[ledgerstate.TransactionIDLength:])
 		if outputIndex > ledgerstate.MaxOutputCount {
@@ -307,7 +343,16 @@ func (o *ScDict) validate(keyID, typeID int32) {
 	if (o.typeID & wasmhost.OBJTYPE_ARRAY) != 0 {
 		// actually array
 		arrayTypeID := o.typeID & wasmhost.OBJTYPE_TYPEMASK
-		if typeID == wasmhost.OBJTYPE_BYTES {
+		if arrayTypeID == wasmhost.OBJTYPE_MAP && o.isContainer(typeID) {
+			// array of nested containers, make sure that all
+			// accesses to an element use the same container type
+			elemTypeID, ok := o.types[keyID]
+			if !ok {
+				o.types[keyID] = typeID
+			} else if elemTypeID != typeID {
+				o.Panic("validate: Invalid access")
+			}
+		} else if typeID == wasmhost.OBJTYPE_BYTES {
 			switch arrayTypeID {
 			case wasmhost.OBJTYPE_ADDRESS:
 			case wasmhost.OBJTYPE_AGENT_ID:
@@ -325,9 +370,7 @@ func (o *ScDict) validate(keyID, typeID int32) {
 			case *ScViewState:
 				break
 			default:
-				o.length++
-				key := o.NestedKey()[1:]
-				o.kvStore.Set(kv.Key(key), codec.EncodeInt32(o.length))
+				o.setArrayLength(o.length + 1)
 				return
 			}
 		}
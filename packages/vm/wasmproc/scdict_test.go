@@ -0,0 +1,84 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmproc
+
+import (
+	"testing"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/vm/wasmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestState(state kv.KVStore) (*wasmhost.KvStoreHost, *ScDict) {
+	host := &wasmhost.KvStoreHost{}
+	host.Init(nil)
+	host.TrackObject(NewNullObject(host))
+	root := NewScDict(host, state)
+	root.id = host.TrackObject(root)
+	root.isRoot = true
+	root.name = "root"
+	root.typeID = wasmhost.OBJTYPE_MAP
+	root.objects = make(map[int32]int32)
+	root.types = make(map[int32]int32)
+	return host, root
+}
+
+// state of deployed contracts must remain readable
+func TestScDictOldStateLayout(t *testing.T) {
+	agent := []byte{0x02, 0x01, 0x02, 0x03}
+	state := dict.New()
+	state.Set("owner", []byte("Alice"))
+	state.Set("values", codec.EncodeInt32(2))
+	state.Set("values.0", []byte("zero"))
+	state.Set("values.1", []byte("one"))
+	state.Set(kv.Key("tagged."+string(agent)), codec.EncodeInt32(1))
+	state.Set(kv.Key("tagged."+string(agent)+".0"), []byte("tag"))
+
+	host, root := newTestState(state)
+	require.EqualValues(t, "Alice", host.GetBytes(root.id, host.GetKeyIDFromString("owner"), wasmhost.OBJTYPE_STRING))
+
+	arrayTypeID := wasmhost.OBJTYPE_ARRAY | wasmhost.OBJTYPE_STRING
+	values := host.GetObjectID(root.id, host.GetKeyIDFromString("values"), arrayTypeID)
+	require.EqualValues(t, codec.EncodeInt32(2), host.GetBytes(values, wasmhost.KeyLength, wasmhost.OBJTYPE_INT32))
+	require.EqualValues(t, "zero", host.GetBytes(values, 0, wasmhost.OBJTYPE_STRING))
+	require.EqualValues(t, "one", host.GetBytes(values, 1, wasmhost.OBJTYPE_STRING))
+
+	// appending keeps using the same layout
+	host.SetBytes(values, 2, wasmhost.OBJTYPE_STRING, []byte("two"))
+	require.EqualValues(t, codec.EncodeInt32(3), state.MustGet("values"))
+	require.EqualValues(t, "two", state.MustGet("values.2"))
+
+	tagged := host.GetObjectID(root.id, host.GetKeyIDFromString("tagged"), wasmhost.OBJTYPE_MAP)
+	tags := host.GetObjectID(tagged, host.GetKeyIDFromBytes(agent), arrayTypeID)
+	require.EqualValues(t, "tag", host.GetBytes(tags, 0, wasmhost.OBJTYPE_STRING))
+
+	// the same layout is read natively by the nested collections
+	array := collections.NewNestedArrayReadOnly(state, "values")
+	require.EqualValues(t, 3, array.MustLen())
+	require.EqualValues(t, "two", array.MustGetAt(2))
+	array = collections.NewNestedMapReadOnly(state, "tagged").Array(agent)
+	require.EqualValues(t, "tag", array.MustGetAt(0))
+}
+
+// core contract arrays keep the Array16 layout, including the length
+func TestScDictArray16Layout(t *testing.T) {
+	state := dict.New()
+	host, root := newTestState(state)
+
+	arrayTypeID := wasmhost.OBJTYPE_ARRAY16 | wasmhost.OBJTYPE_STRING
+	values := host.GetObjectID(root.id, host.GetKeyIDFromString("values"), arrayTypeID)
+	host.SetBytes(values, 0, wasmhost.OBJTYPE_STRING, []byte("zero"))
+	host.SetBytes(values, 1, wasmhost.OBJTYPE_STRING, []byte("one"))
+
+	array := collections.NewArray16ReadOnly(state, "values")
+	require.EqualValues(t, 2, array.MustLen())
+	require.EqualValues(t, "one", array.MustGetAt(1))
+
+	host.SetBytes(values, wasmhost.KeyLength, wasmhost.OBJTYPE_INT32, nil)
+	require.EqualValues(t, 0, array.MustLen())
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmproc
+
+import (
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+)
+
+// ordered map operations, should exactly match their counterpart values on the client
+const (
+	OrderedOpDelete = int32(0)
+	OrderedOpErase  = int32(1)
+	OrderedOpExists = int32(2)
+	OrderedOpGet    = int32(3)
+	OrderedOpLength = int32(4)
+	OrderedOpPage   = int32(5)
+	OrderedOpSet    = int32(6)
+)
+
+// maximum number of elements returned by a single page request
+const orderedMaxPageSize = 1000
+
+// orderedMapFunc executes an ordered map operation on the ordered map that is
+// stored under the specified key of the dictionary, see collections.OrderedMap.
+// The operation and its arguments are encoded in params.
+func (o *ScDict) orderedMapFunc(keyID int32, params []byte) []byte {
+	if o.kvStore == nil || o.IsArray() {
+		o.Panic("orderedMapFunc: not a map")
+	}
+	name := string(o.key(keyID, -1))
+	decode := NewBytesDecoder(params)
+	op := decode.Int32()
+	o.Tracef("ordered %s op %d", name, op)
+
+	switch op {
+	case OrderedOpExists:
+		m := collections.NewOrderedMapReadOnly(o.kvStore, name)
+		if m.MustHasAt(decode.Bytes()) {
+			var flag [1]byte
+			return flag[:]
+		}
+		return nil
+	case OrderedOpGet:
+		m := collections.NewOrderedMapReadOnly(o.kvStore, name)
+		return m.MustGetAt(decode.Bytes())
+	case OrderedOpLength:
+		m := collections.NewOrderedMapReadOnly(o.kvStore, name)
+		return codec.EncodeInt32(int32(m.MustLen()))
+	case OrderedOpPage:
+		m := collections.NewOrderedMapReadOnly(o.kvStore, name)
+		return o.orderedPage(m, decode)
+	}
+
+	m := collections.NewOrderedMap(o.kvStore, name)
+	switch op {
+	case OrderedOpDelete:
+		m.MustDelAt(decode.Bytes())
+	case OrderedOpErase:
+		m.MustErase()
+	case OrderedOpSet:
+		key := decode.Bytes()
+		m.MustSetAt(key, decode.Bytes())
+	default:
+		o.Panic("orderedMapFunc: invalid operation %d", op)
+	}
+	return nil
+}
+
+// orderedPage returns a page of elements as a count followed by the
+// key/value pairs, followed by the optional cursor of the next page
+func (o *ScDict) orderedPage(m *collections.ImmutableOrderedMap, decode *BytesDecoder) []byte {
+	from := decodeOptionalBytes(decode)
+	to := decodeOptionalBytes(decode)
+	limit := decode.Int32()
+	reverse := decode.Int32() != 0
+	if limit <= 0 || limit > orderedMaxPageSize {
+		o.Panic("orderedPage: invalid limit %d", limit)
+	}
+
+	elements := NewBytesEncoder()
+	count := int32(0)
+	cursor := m.MustPage(from, to, int(limit), reverse, func(key, value []byte) {
+		elements.Bytes(key).Bytes(value)
+		count++
+	})
+	encode := NewBytesEncoder().Int32(count)
+	encode.data = append(encode.data, elements.Data()...)
+	return encodeOptionalBytes(encode, cursor).Data()
+}
+
+// optional byte slices are encoded with a presence flag,
+// because an empty slice is a valid key
+func decodeOptionalBytes(decode *BytesDecoder) []byte {
+	if decode.Int32() == 0 {
+		return nil
+	}
+	return decode.Bytes()
+}
+
+func encodeOptionalBytes(encode *BytesEncoder, value []byte) *BytesEncoder {
+	if value == nil {
+		return encode.Int32(0)
+	}
+	return encode.Int32(1).Bytes(value)
+}
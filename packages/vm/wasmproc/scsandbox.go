@@ -24,3 +24,8 @@ func (o *ScSandboxObject) GetObjectID(keyID, typeID int32) int32 {
 func (o *ScSandboxObject) SetBytes(keyID, typeID int32, bytes []byte) {
 	o.InvalidKey(keyID)
 }
+
+func (o *ScSandboxObject) CallFunc(keyID int32, params []byte) []byte {
+	o.Panic("CallFunc: invalid call")
+	return nil
+}
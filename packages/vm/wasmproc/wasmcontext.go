@@ -1,6 +1,8 @@
 package wasmproc
 
 import (
+	"fmt"
+
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/dict"
@@ -101,6 +103,13 @@ func (wc *WasmContext) Call(ctx interface{}) (dict.Dict, error) {
 	//}
 
 	wc.Tracef("Calling " + wc.function)
+	if tracer := wasmhost.GetHostTracer(); tracer != nil {
+		return wc.callTraced(tracer)
+	}
+	return wc.callResults()
+}
+
+func (wc *WasmContext) callResults() (dict.Dict, error) {
 	err := wc.callFunction()
 	if err != nil {
 		wc.log().Infof("VM call %s(): error %v", wc.function, err)
@@ -111,11 +120,35 @@ func (wc *WasmContext) Call(ctx interface{}) (dict.Dict, error) {
 	return results, nil
 }
 
+// callTraced wraps the function call with structured trace events,
+// including any panic that occurs during the call
+func (wc *WasmContext) callTraced(tracer *wasmhost.WasmTracer) (results dict.Dict, err error) {
+	depth := tracer.Enter(wc.traceScope(), wc.function)
+	wc.SetTraceDepth(depth + 1)
+	defer func() {
+		r := recover()
+		if r != nil {
+			tracer.Panic(depth+1, wc.function, fmt.Sprint(r), nil)
+			tracer.Leave(depth, wc.function, nil)
+			panic(r)
+		}
+		tracer.Leave(depth, wc.function, err)
+	}()
+
+	return wc.callResults()
+}
+
 func (wc *WasmContext) callFunction() error {
 	wc.proc.instanceLock.Lock()
 	defer wc.proc.instanceLock.Unlock()
 
 	saveID := wc.proc.currentContextID
+	if saveID == 0 {
+		// not a nested call: start from a pristine instance
+		if err := wc.proc.ResetVM(); err != nil {
+			return err
+		}
+	}
 	wc.proc.currentContextID = wc.id
 	err := wc.proc.RunScFunction(wc.function)
 	wc.proc.currentContextID = saveID
@@ -147,6 +180,14 @@ func (wc *WasmContext) params() dict.Dict {
 	return wc.ctxView.Params()
 }
 
+// traceScope returns the chain ID, because nested calls always run on the same chain
+func (wc *WasmContext) traceScope() string {
+	if wc.ctx != nil {
+		return wc.ctx.ChainID().String()
+	}
+	return wc.ctxView.ChainID().String()
+}
+
 func (wc *WasmContext) state() kv.KVStore {
 	if wc.ctx != nil {
 		return wc.ctx.State()
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/vm/wasmhost"
 )
@@ -27,11 +28,11 @@ var _ iscp.VMProcessor = &WasmProcessor{}
 var GoWasmVM wasmhost.WasmVM
 
 // GetProcessor creates a new Wasm VM processor.
-func GetProcessor(binaryCode []byte, log *logger.Logger) (iscp.VMProcessor, error) {
+func GetProcessor(programHash hashing.HashValue, binaryCode []byte, log *logger.Logger) (iscp.VMProcessor, error) {
 	vm := GoWasmVM
 	GoWasmVM = nil
 	if vm == nil {
-		vm = wasmhost.NewWasmTimeVM()
+		vm = wasmhost.NewWasmTimeVM(programHash)
 	}
 
 	proc := &WasmProcessor{log: log, contexts: make(map[int32]*WasmContext)}
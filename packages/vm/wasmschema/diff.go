@@ -0,0 +1,333 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmschema
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeChanged = "changed"
+	ChangeRemoved = "removed"
+
+	ElementAccess   = "access"
+	ElementContract = "contract"
+	ElementField    = "field"
+	ElementParam    = "param"
+	ElementResult   = "result"
+	ElementState    = "state"
+	ElementStruct   = "struct"
+	ElementTypedef  = "typedef"
+)
+
+// SchemaChange describes a single difference between two schema versions.
+// A breaking change makes the new contract version incompatible with existing
+// state or with clients that were generated from the old schema.
+type SchemaChange struct {
+	Element  string `json:"element"`
+	Name     string `json:"name"`
+	Change   string `json:"change"`
+	Detail   string `json:"detail,omitempty"`
+	Breaking bool   `json:"breaking"`
+}
+
+type SchemaDiff struct {
+	Contract string          `json:"contract"`
+	Breaking bool            `json:"breaking"`
+	Changes  []*SchemaChange `json:"changes"`
+}
+
+// DiffSchemas compares two compiled versions of a schema and classifies
+// every difference as compatible or breaking. Comments are ignored.
+func DiffSchemas(oldSchema, newSchema *Schema) *SchemaDiff {
+	d := &SchemaDiff{Contract: newSchema.Name, Changes: make([]*SchemaChange, 0)}
+	if oldSchema.Name != newSchema.Name {
+		// the contract hname is derived from its name
+		d.add(ElementContract, newSchema.Name, ChangeChanged, "renamed from "+oldSchema.Name, true)
+	}
+	d.diffStructs(oldSchema.Structs, newSchema.Structs)
+	d.diffTypedefs(oldSchema.Typedefs, newSchema.Typedefs)
+	d.diffFields(ElementState, "", oldSchema.StateVars, newSchema.StateVars)
+	d.diffFuncs(oldSchema.Funcs, newSchema.Funcs)
+	return d
+}
+
+func (d *SchemaDiff) add(element, name, change, detail string, breaking bool) {
+	d.Changes = append(d.Changes, &SchemaChange{
+		Element:  element,
+		Name:     name,
+		Change:   change,
+		Detail:   detail,
+		Breaking: breaking,
+	})
+	if breaking {
+		d.Breaking = true
+	}
+}
+
+// diffAccess treats lifting restrictions as compatible,
+// and any additional restriction as breaking
+func (d *SchemaDiff) diffAccess(oldFunc, newFunc *Func) {
+	oldGrants, _ := oldFunc.Grants()
+	newGrants, _ := newFunc.Grants()
+	sort.Strings(oldGrants)
+	sort.Strings(newGrants)
+	detail := fmt.Sprintf("'%s' -> '%s'", strings.Join(oldGrants, "|"), strings.Join(newGrants, "|"))
+	switch {
+	case strings.Join(oldGrants, "|") == strings.Join(newGrants, "|"):
+		return
+	case len(newGrants) == 0:
+		d.add(ElementAccess, newFunc.String, ChangeRemoved, detail, false)
+	case len(oldGrants) == 0:
+		d.add(ElementAccess, newFunc.String, ChangeAdded, detail, true)
+	default:
+		d.add(ElementAccess, newFunc.String, ChangeChanged, detail, !containsAll(newGrants, oldGrants))
+	}
+}
+
+func (d *SchemaDiff) diffField(element, name string, oldField, newField *Field) {
+	if oldField.Alias != newField.Alias {
+		d.add(element, name, ChangeChanged, fmt.Sprintf("key '%s' -> '%s'", oldField.Alias, newField.Alias), true)
+	}
+	oldType, newType := oldField.typeString(), newField.typeString()
+	if oldType != newType {
+		d.add(element, name, ChangeChanged, fmt.Sprintf("type %s -> %s", oldType, newType), true)
+		return
+	}
+	if element == ElementParam && oldField.Optional != newField.Optional {
+		// a param that became mandatory breaks callers that omit it
+		d.add(element, name, ChangeChanged, fmt.Sprintf("optional %v -> %v", oldField.Optional, newField.Optional), oldField.Optional)
+	}
+	narrower, wider := oldField.compareRange(newField)
+	if narrower || wider {
+		// a narrower param range rejects values that used to be valid,
+		// a narrower state invariant can be violated by existing state
+		detail := fmt.Sprintf("range %s -> %s", oldField.rangeString(), newField.rangeString())
+		d.add(element, name, ChangeChanged, detail, narrower)
+	}
+}
+
+// diffFields compares fields by name. Removing a field is always breaking,
+// and adding a mandatory param breaks existing callers.
+func (d *SchemaDiff) diffFields(element, prefix string, oldFields, newFields []*Field) {
+	for _, oldField := range oldFields {
+		newField := findField(newFields, oldField.Name)
+		if newField == nil {
+			d.add(element, prefix+oldField.Name, ChangeRemoved, oldField.typeString(), true)
+			continue
+		}
+		d.diffField(element, prefix+oldField.Name, oldField, newField)
+	}
+	for _, newField := range newFields {
+		if findField(oldFields, newField.Name) == nil {
+			breaking := element == ElementParam && !newField.Optional
+			d.add(element, prefix+newField.Name, ChangeAdded, newField.typeString(), breaking)
+		}
+	}
+}
+
+// diffFuncs compares funcs and views by name, because their hname is derived
+// from the name. Removing one, or turning a func into a view or vice versa,
+// breaks existing callers.
+func (d *SchemaDiff) diffFuncs(oldFuncs, newFuncs []*Func) {
+	for _, oldFunc := range oldFuncs {
+		newFunc := findFunc(newFuncs, oldFunc.String)
+		if newFunc == nil {
+			d.add(strings.ToLower(oldFunc.Kind), oldFunc.String, ChangeRemoved, "", true)
+			continue
+		}
+		if oldFunc.Kind != newFunc.Kind {
+			detail := fmt.Sprintf("kind %s -> %s", oldFunc.Kind, newFunc.Kind)
+			d.add(strings.ToLower(newFunc.Kind), newFunc.String, ChangeChanged, detail, true)
+		}
+		d.diffAccess(oldFunc, newFunc)
+		d.diffFields(ElementParam, newFunc.String+".", oldFunc.Params, newFunc.Params)
+		d.diffFields(ElementResult, newFunc.String+".", oldFunc.Results, newFunc.Results)
+	}
+	for _, newFunc := range newFuncs {
+		if findFunc(oldFuncs, newFunc.String) == nil {
+			d.add(strings.ToLower(newFunc.Kind), newFunc.String, ChangeAdded, "", false)
+		}
+	}
+}
+
+// diffStructFields compares struct fields by position, because that is how
+// they are encoded. A field that moved to another position is breaking.
+// A field that was renamed without changing its position or type keeps
+// the encoding intact and is compatible.
+func (d *SchemaDiff) diffStructFields(prefix string, oldFields, newFields []*Field) {
+	for i, oldField := range oldFields {
+		j := fieldIndex(newFields, oldField.Name)
+		switch {
+		case j >= 0:
+			if i != j {
+				d.add(ElementField, prefix+oldField.Name, ChangeChanged, fmt.Sprintf("position %d -> %d", i, j), true)
+			}
+			d.diffField(ElementField, prefix+oldField.Name, oldField, newFields[j])
+		case isRenamedField(oldFields, newFields, i):
+			d.add(ElementField, prefix+newFields[i].Name, ChangeChanged, "renamed from "+oldField.Name, false)
+		default:
+			d.add(ElementField, prefix+oldField.Name, ChangeRemoved, oldField.typeString(), true)
+		}
+	}
+	for j, newField := range newFields {
+		if fieldIndex(oldFields, newField.Name) < 0 && !isRenamedField(oldFields, newFields, j) {
+			d.add(ElementField, prefix+newField.Name, ChangeAdded, newField.typeString(), true)
+		}
+	}
+}
+
+// diffStructs compares struct layouts. Structs are serialized field by field
+// in order, so any change to the field layout breaks decoding of existing data.
+func (d *SchemaDiff) diffStructs(oldStructs, newStructs []*Struct) {
+	for _, oldStruct := range oldStructs {
+		newStruct := findStruct(newStructs, oldStruct.Name)
+		if newStruct == nil {
+			d.add(ElementStruct, oldStruct.Name, ChangeRemoved, "", true)
+			continue
+		}
+		d.diffStructFields(newStruct.Name+".", oldStruct.Fields, newStruct.Fields)
+	}
+	for _, newStruct := range newStructs {
+		if findStruct(oldStructs, newStruct.Name) == nil {
+			d.add(ElementStruct, newStruct.Name, ChangeAdded, "", false)
+		}
+	}
+}
+
+func (d *SchemaDiff) diffTypedefs(oldTypedefs, newTypedefs []*Field) {
+	for _, oldTypedef := range oldTypedefs {
+		newTypedef := findField(newTypedefs, oldTypedef.Name)
+		if newTypedef == nil {
+			d.add(ElementTypedef, oldTypedef.Name, ChangeRemoved, oldTypedef.typeString(), true)
+			continue
+		}
+		oldType, newType := oldTypedef.typeString(), newTypedef.typeString()
+		if oldType != newType {
+			d.add(ElementTypedef, newTypedef.Name, ChangeChanged, fmt.Sprintf("type %s -> %s", oldType, newType), true)
+		}
+	}
+	for _, newTypedef := range newTypedefs {
+		if findField(oldTypedefs, newTypedef.Name) == nil {
+			d.add(ElementTypedef, newTypedef.Name, ChangeAdded, newTypedef.typeString(), false)
+		}
+	}
+}
+
+// compareRange determines whether the range constraint of other is narrower
+// and/or wider than the one of f. Both fields must be of the same type.
+func (f *Field) compareRange(other *Field) (narrower, wider bool) {
+	switch compareLimit(f.Min, other.Min, -1) {
+	case -1:
+		wider = true
+	case 1:
+		narrower = true
+	}
+	switch compareLimit(f.Max, other.Max, 1) {
+	case -1:
+		narrower = true
+	case 1:
+		wider = true
+	}
+	return narrower, wider
+}
+
+// compareLimit compares two limits, where a missing limit means unbounded
+// in the direction indicated by unbounded (-1 for minimum, 1 for maximum)
+func compareLimit(oldLimit, newLimit string, unbounded int) int {
+	switch {
+	case oldLimit == newLimit:
+		return 0
+	case oldLimit == "":
+		return -unbounded
+	case newLimit == "":
+		return unbounded
+	}
+	oldValue, _ := new(big.Int).SetString(oldLimit, 10)
+	newValue, _ := new(big.Int).SetString(newLimit, 10)
+	return newValue.Cmp(oldValue)
+}
+
+func (f *Field) rangeString() string {
+	return "(" + f.Min + ".." + f.Max + ")"
+}
+
+// typeString returns the field type as it would be written in the schema
+func (f *Field) typeString() string {
+	switch {
+	case f.Array:
+		return f.Type + "[]"
+	case f.MapKey != "":
+		return "map[" + f.MapKey + "]" + f.Type
+	}
+	return f.Type
+}
+
+func containsAll(list, items []string) bool {
+	for _, item := range items {
+		found := false
+		for _, entry := range list {
+			if entry == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldIndex(fields []*Field, name string) int {
+	for i, field := range fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// isRenamedField determines whether the struct field at position i was renamed,
+// which is the case when neither name survives and the type did not change
+func isRenamedField(oldFields, newFields []*Field, i int) bool {
+	if i >= len(oldFields) || i >= len(newFields) {
+		return false
+	}
+	oldField, newField := oldFields[i], newFields[i]
+	return fieldIndex(newFields, oldField.Name) < 0 &&
+		fieldIndex(oldFields, newField.Name) < 0 &&
+		oldField.typeString() == newField.typeString()
+}
+
+func findField(fields []*Field, name string) *Field {
+	for _, field := range fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func findFunc(funcs []*Func, name string) *Func {
+	for _, f := range funcs {
+		if f.String == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func findStruct(structs []*Struct, name string) *Struct {
+	for _, typeDef := range structs {
+		if typeDef.Name == name {
+			return typeDef
+		}
+	}
+	return nil
+}
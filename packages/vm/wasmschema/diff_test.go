@@ -0,0 +1,358 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSchemaDef() *SchemaDef {
+	return &SchemaDef{
+		Name: "Test",
+		Structs: StringMapMap{
+			"Point": StringMap{"x": "Int32", "y": "Int32"},
+		},
+		Typedefs: StringMap{},
+		State: StringMap{
+			"counter": "Int64(0..100)",
+			"owner":   "AgentID",
+			"points":  "Point[]",
+		},
+		Funcs: FuncDefMap{
+			"init": {Params: StringMap{"owner": "AgentID?"}},
+			"setCounter": {
+				Access: "owner",
+				Params: StringMap{"counter": "Int64(0..100)", "step": "Int64?"},
+			},
+		},
+		Views: FuncDefMap{
+			"getCounter": {Results: StringMap{"counter": "Int64"}},
+		},
+	}
+}
+
+func compileTestSchema(t *testing.T, schemaDef *SchemaDef) *Schema {
+	s := NewSchema()
+	require.NoError(t, s.Compile(schemaDef))
+	return s
+}
+
+func TestDiffSchemas(t *testing.T) {
+	type change struct {
+		element  string
+		name     string
+		change   string
+		breaking bool
+	}
+	tests := []struct {
+		name     string
+		update   func(def *SchemaDef)
+		changes  []change
+		breaking bool
+	}{
+		{
+			name:   "unchanged",
+			update: func(def *SchemaDef) {},
+		},
+		{
+			name: "comments are ignored",
+			update: func(def *SchemaDef) {
+				def.State["owner"] = "AgentID // contract owner"
+				def.Funcs["setCounter"].Access = "owner // owner only"
+			},
+		},
+		{
+			name: "added func",
+			update: func(def *SchemaDef) {
+				def.Funcs["reset"] = &FuncDef{}
+			},
+			changes: []change{{"func", "reset", ChangeAdded, false}},
+		},
+		{
+			name: "removed func",
+			update: func(def *SchemaDef) {
+				delete(def.Funcs, "setCounter")
+			},
+			changes:  []change{{"func", "setCounter", ChangeRemoved, true}},
+			breaking: true,
+		},
+		{
+			name: "removed view",
+			update: func(def *SchemaDef) {
+				delete(def.Views, "getCounter")
+			},
+			changes:  []change{{"view", "getCounter", ChangeRemoved, true}},
+			breaking: true,
+		},
+		{
+			name: "func turned into view",
+			update: func(def *SchemaDef) {
+				def.Views["setCounter"] = def.Funcs["setCounter"]
+				delete(def.Funcs, "setCounter")
+			},
+			changes:  []change{{"view", "setCounter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param type changed",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["step"] = "Int32?"
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param turned into array",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["step"] = "Int64[]?"
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param key changed",
+			update: func(def *SchemaDef) {
+				delete(def.Funcs["setCounter"].Params, "step")
+				def.Funcs["setCounter"].Params["step=s"] = "Int64?"
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "optional param added",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["delay"] = "Int32?"
+			},
+			changes: []change{{ElementParam, "setCounter.delay", ChangeAdded, false}},
+		},
+		{
+			name: "mandatory param added",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["delay"] = "Int32"
+			},
+			changes:  []change{{ElementParam, "setCounter.delay", ChangeAdded, true}},
+			breaking: true,
+		},
+		{
+			name: "param removed",
+			update: func(def *SchemaDef) {
+				delete(def.Funcs["setCounter"].Params, "step")
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeRemoved, true}},
+			breaking: true,
+		},
+		{
+			name: "param became mandatory",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["step"] = "Int64"
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param became optional",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["counter"] = "Int64(0..100)?"
+			},
+			changes: []change{{ElementParam, "setCounter.counter", ChangeChanged, false}},
+		},
+		{
+			name: "param range narrowed",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["counter"] = "Int64(10..100)"
+			},
+			changes:  []change{{ElementParam, "setCounter.counter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param range widened",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["counter"] = "Int64(-10..1000)"
+			},
+			changes: []change{{ElementParam, "setCounter.counter", ChangeChanged, false}},
+		},
+		{
+			name: "param range unbounded",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["counter"] = "Int64"
+			},
+			changes: []change{{ElementParam, "setCounter.counter", ChangeChanged, false}},
+		},
+		{
+			name: "param range shifted",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["counter"] = "Int64(50..150)"
+			},
+			changes:  []change{{ElementParam, "setCounter.counter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "param range added",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Params["step"] = "Int64(1..10)?"
+			},
+			changes:  []change{{ElementParam, "setCounter.step", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "access loosened",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Access = "creator|owner"
+			},
+			changes: []change{{ElementAccess, "setCounter", ChangeChanged, false}},
+		},
+		{
+			name: "access replaced",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Access = "creator"
+			},
+			changes:  []change{{ElementAccess, "setCounter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "access removed",
+			update: func(def *SchemaDef) {
+				def.Funcs["setCounter"].Access = ""
+			},
+			changes: []change{{ElementAccess, "setCounter", ChangeRemoved, false}},
+		},
+		{
+			name: "access added",
+			update: func(def *SchemaDef) {
+				def.Funcs["init"].Access = "creator"
+			},
+			changes:  []change{{ElementAccess, "init", ChangeAdded, true}},
+			breaking: true,
+		},
+		{
+			name: "state var added",
+			update: func(def *SchemaDef) {
+				def.State["total"] = "Int64"
+			},
+			changes: []change{{ElementState, "total", ChangeAdded, false}},
+		},
+		{
+			name: "state var removed",
+			update: func(def *SchemaDef) {
+				delete(def.State, "points")
+			},
+			changes:  []change{{ElementState, "points", ChangeRemoved, true}},
+			breaking: true,
+		},
+		{
+			name: "state var type changed",
+			update: func(def *SchemaDef) {
+				def.State["counter"] = "Int32(0..100)"
+			},
+			changes:  []change{{ElementState, "counter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "state var key changed",
+			update: func(def *SchemaDef) {
+				delete(def.State, "owner")
+				def.State["owner=o"] = "AgentID"
+			},
+			changes:  []change{{ElementState, "owner", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "state invariant narrowed",
+			update: func(def *SchemaDef) {
+				def.State["counter"] = "Int64(0..10)"
+			},
+			changes:  []change{{ElementState, "counter", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "state invariant widened",
+			update: func(def *SchemaDef) {
+				def.State["counter"] = "Int64(0..1000)"
+			},
+			changes: []change{{ElementState, "counter", ChangeChanged, false}},
+		},
+		{
+			name: "result added",
+			update: func(def *SchemaDef) {
+				def.Views["getCounter"].Results["max"] = "Int64"
+			},
+			changes: []change{{ElementResult, "getCounter.max", ChangeAdded, false}},
+		},
+		{
+			name: "struct field added",
+			update: func(def *SchemaDef) {
+				def.Structs["Point"]["z"] = "Int32"
+			},
+			changes:  []change{{ElementField, "Point.z", ChangeAdded, true}},
+			breaking: true,
+		},
+		{
+			name: "struct field inserted",
+			update: func(def *SchemaDef) {
+				def.Structs["Point"]["a"] = "Int32"
+			},
+			changes: []change{
+				{ElementField, "Point.a", ChangeAdded, true},
+				{ElementField, "Point.x", ChangeChanged, true},
+				{ElementField, "Point.y", ChangeChanged, true},
+			},
+			breaking: true,
+		},
+		{
+			name: "struct field renamed",
+			update: func(def *SchemaDef) {
+				delete(def.Structs["Point"], "x")
+				def.Structs["Point"]["w"] = "Int32"
+			},
+			changes: []change{{ElementField, "Point.w", ChangeChanged, false}},
+		},
+		{
+			name: "struct fields reordered",
+			update: func(def *SchemaDef) {
+				delete(def.Structs["Point"], "x")
+				def.Structs["Point"]["z"] = "Int32"
+			},
+			changes: []change{
+				{ElementField, "Point.x", ChangeRemoved, true},
+				{ElementField, "Point.y", ChangeChanged, true},
+				{ElementField, "Point.z", ChangeAdded, true},
+			},
+			breaking: true,
+		},
+		{
+			name: "struct field type changed",
+			update: func(def *SchemaDef) {
+				def.Structs["Point"]["y"] = "Int64"
+			},
+			changes:  []change{{ElementField, "Point.y", ChangeChanged, true}},
+			breaking: true,
+		},
+		{
+			name: "contract renamed",
+			update: func(def *SchemaDef) {
+				def.Name = "Other"
+			},
+			changes:  []change{{ElementContract, "other", ChangeChanged, true}},
+			breaking: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldSchema := compileTestSchema(t, newTestSchemaDef())
+			newDef := newTestSchemaDef()
+			test.update(newDef)
+			newSchema := compileTestSchema(t, newDef)
+
+			d := DiffSchemas(oldSchema, newSchema)
+			require.Equal(t, test.breaking, d.Breaking)
+			changes := make([]change, 0, len(d.Changes))
+			for _, c := range d.Changes {
+				changes = append(changes, change{c.Element, c.Name, c.Change, c.Breaking})
+			}
+			require.ElementsMatch(t, test.changes, changes)
+		})
+	}
+}
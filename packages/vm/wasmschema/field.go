@@ -0,0 +1,294 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmschema
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
+)
+
+var (
+	fldNameRegexp  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+	fldAliasRegexp = regexp.MustCompile(`^[a-zA-Z0-9_$#@*%\-]+$`)
+	fldTypeRegexp  = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]+$`)
+)
+
+var FieldTypes = map[string]int32{
+	"Address":   wasmlib.TYPE_ADDRESS,
+	"AgentID":   wasmlib.TYPE_AGENT_ID,
+	"BigInt":    wasmlib.TYPE_BIG_INT,
+	"Bool":      wasmlib.TYPE_BOOL,
+	"Bytes":     wasmlib.TYPE_BYTES,
+	"ChainID":   wasmlib.TYPE_CHAIN_ID,
+	"Color":     wasmlib.TYPE_COLOR,
+	"Hash":      wasmlib.TYPE_HASH,
+	"Hname":     wasmlib.TYPE_HNAME,
+	"Int8":      wasmlib.TYPE_INT8,
+	"Int16":     wasmlib.TYPE_INT16,
+	"Int32":     wasmlib.TYPE_INT32,
+	"Int64":     wasmlib.TYPE_INT64,
+	"RequestID": wasmlib.TYPE_REQUEST_ID,
+	"String":    wasmlib.TYPE_STRING,
+	"Uint8":     wasmlib.TYPE_UINT8,
+	"Uint16":    wasmlib.TYPE_UINT16,
+	"Uint32":    wasmlib.TYPE_UINT32,
+	"Uint64":    wasmlib.TYPE_UINT64,
+}
+
+// MapKeyTypes are the field types that can be used as map key,
+// the generators have no key conversion for the other types
+var MapKeyTypes = map[string]bool{
+	"Address":   true,
+	"AgentID":   true,
+	"BigInt":    true,
+	"ChainID":   true,
+	"Color":     true,
+	"Hash":      true,
+	"Hname":     true,
+	"Int32":     true,
+	"RequestID": true,
+	"String":    true,
+}
+
+// integer field types with their bit size, negative for signed types
+var fieldIntBits = map[string]int{
+	"Int8":   -8,
+	"Int16":  -16,
+	"Int32":  -32,
+	"Int64":  -64,
+	"Uint8":  8,
+	"Uint16": 16,
+	"Uint32": 32,
+	"Uint64": 64,
+}
+
+type Field struct {
+	Name     string // external name for this field
+	Alias    string // internal name alias, can be different from Name
+	Array    bool
+	Comment  string
+	KeyID    int
+	MapKey   string
+	Max      string // maximum value, or maximum length for String/Bytes
+	Min      string // minimum value, or minimum length for String/Bytes
+	Optional bool
+	Type     string
+	TypeID   int32
+}
+
+func (f *Field) Compile(s *Schema, fldName, fldType string) error {
+	fldName = strings.TrimSpace(fldName)
+	f.Name = fldName
+	f.Alias = fldName
+	index := strings.Index(fldName, "=")
+	if index >= 0 {
+		f.Name = strings.TrimSpace(fldName[:index])
+		f.Alias = strings.TrimSpace(fldName[index+1:])
+	}
+	if !fldNameRegexp.MatchString(f.Name) {
+		return fmt.Errorf("invalid field name: %s", f.Name)
+	}
+	if !fldAliasRegexp.MatchString(f.Alias) {
+		return fmt.Errorf("invalid field alias: %s", f.Alias)
+	}
+
+	fldType = strings.TrimSpace(fldType)
+
+	// remove // comment
+	index = strings.Index(fldType, "//")
+	if index >= 0 {
+		f.Comment = " " + fldType[index:]
+		fldType = strings.TrimSpace(fldType[:index])
+	}
+
+	// remove optional indicator
+	n := len(fldType)
+	if n > 1 && fldType[n-1:] == "?" {
+		f.Optional = true
+		fldType = strings.TrimSpace(fldType[:n-1])
+	}
+
+	// remove (min..max) constraint
+	n = len(fldType)
+	if n > 1 && fldType[n-1:] == ")" {
+		index = strings.LastIndex(fldType, "(")
+		if index < 0 {
+			return fmt.Errorf("invalid field constraint: %s", fldType)
+		}
+		limits := strings.Split(fldType[index+1:n-1], "..")
+		if len(limits) != 2 {
+			return fmt.Errorf("invalid field constraint: %s", fldType[index:])
+		}
+		f.Min = strings.TrimSpace(limits[0])
+		f.Max = strings.TrimSpace(limits[1])
+		fldType = strings.TrimSpace(fldType[:index])
+	}
+
+	err := f.compileType(s, fldType)
+	if err != nil {
+		return err
+	}
+	return f.compileConstraint()
+}
+
+// compileConstraint checks the min/max constraint against the field type.
+// Limits that equal the limits of the field type itself are dropped,
+// so that generators never emit checks that are always true.
+func (f *Field) compileConstraint() error {
+	if !f.HasConstraint() {
+		return nil
+	}
+	if f.Array || f.MapKey != "" {
+		return fmt.Errorf("constraint on container field: %s", f.Name)
+	}
+	bits, ok := fieldIntBits[f.Type]
+	if f.IsLength() {
+		bits, ok = 32, true
+	}
+	if !ok {
+		return fmt.Errorf("constraint on %s field: %s", f.Type, f.Name)
+	}
+
+	if bits < 0 {
+		return f.compileSignedConstraint(-bits)
+	}
+	return f.compileUnsignedConstraint(bits)
+}
+
+func (f *Field) compileSignedConstraint(bits int) error {
+	typeMin := int64(-1) << (bits - 1)
+	typeMax := -(typeMin + 1)
+	lo, hi := typeMin, typeMax
+	var err error
+	if f.Min != "" {
+		lo, err = strconv.ParseInt(f.Min, 10, bits)
+		if err != nil {
+			return fmt.Errorf("invalid constraint minimum: %s", f.Min)
+		}
+	}
+	if f.Max != "" {
+		hi, err = strconv.ParseInt(f.Max, 10, bits)
+		if err != nil {
+			return fmt.Errorf("invalid constraint maximum: %s", f.Max)
+		}
+	}
+	if lo > hi {
+		return fmt.Errorf("invalid constraint: minimum exceeds maximum")
+	}
+	f.Min, f.Max = "", ""
+	if lo != typeMin {
+		f.Min = strconv.FormatInt(lo, 10)
+	}
+	if hi != typeMax {
+		f.Max = strconv.FormatInt(hi, 10)
+	}
+	return nil
+}
+
+func (f *Field) compileUnsignedConstraint(bits int) error {
+	typeMax := uint64(1)<<(bits-1)<<1 - 1
+	lo, hi := uint64(0), typeMax
+	var err error
+	if f.Min != "" {
+		lo, err = strconv.ParseUint(f.Min, 10, bits)
+		if err != nil {
+			return fmt.Errorf("invalid constraint minimum: %s", f.Min)
+		}
+	}
+	if f.Max != "" {
+		hi, err = strconv.ParseUint(f.Max, 10, bits)
+		if err != nil {
+			return fmt.Errorf("invalid constraint maximum: %s", f.Max)
+		}
+	}
+	if lo > hi {
+		return fmt.Errorf("invalid constraint: minimum exceeds maximum")
+	}
+	f.Min, f.Max = "", ""
+	if lo != 0 {
+		f.Min = strconv.FormatUint(lo, 10)
+	}
+	if hi != typeMax {
+		f.Max = strconv.FormatUint(hi, 10)
+	}
+	return nil
+}
+
+// HasConstraint returns true when the field value is constrained to a range
+func (f *Field) HasConstraint() bool {
+	return f.Min != "" || f.Max != ""
+}
+
+// IsLength returns true when the constraint limits the length of the field
+func (f *Field) IsLength() bool {
+	return f.Type == "String" || f.Type == "Bytes"
+}
+
+// compileType parses the container part of the field type. A nested container
+// type like String[][] or map[String]map[String]Int64 is turned into an
+// implicit typedef for the inner container, so that each field is at most
+// one level deep.
+func (f *Field) compileType(s *Schema, fldType string) error {
+	// a map prefix takes precedence, so map[Key]Type[] is a map of arrays
+	n := len(fldType)
+	if n > 4 && fldType[:4] == "map[" {
+		// must be map
+		index := strings.Index(fldType, "]")
+		if index > 5 {
+			f.MapKey = strings.TrimSpace(fldType[4:index])
+			if !fldTypeRegexp.MatchString(f.MapKey) {
+				return fmt.Errorf("invalid key field type: %s", f.MapKey)
+			}
+			if !MapKeyTypes[f.MapKey] {
+				return fmt.Errorf("unsupported key field type: %s", f.MapKey)
+			}
+			fldType = strings.TrimSpace(fldType[index+1:])
+		}
+	} else if n > 2 && fldType[n-2:] == "[]" {
+		// must be array
+		f.Array = true
+		fldType = strings.TrimSpace(fldType[:n-2])
+	}
+	if f.Array || f.MapKey != "" {
+		n = len(fldType)
+		if (n > 2 && fldType[n-2:] == "[]") || (n > 4 && fldType[:4] == "map[") {
+			typedef, err := s.compileNestedType(fldType)
+			if err != nil {
+				return err
+			}
+			fldType = typedef.Name
+		}
+	}
+	f.Type = fldType
+	if !fldTypeRegexp.MatchString(f.Type) {
+		return fmt.Errorf("invalid field type: %s", f.Type)
+	}
+	typeID, ok := FieldTypes[f.Type]
+	if ok {
+		f.TypeID = typeID
+		return nil
+	}
+	for _, typeDef := range s.Structs {
+		if f.Type == typeDef.Name {
+			return nil
+		}
+	}
+	if s.Typedef(f.Type) != nil {
+		return nil
+	}
+	// typedefs can refer to typedefs that have not been compiled yet
+	if _, ok = s.pendingTypedefs[f.Type]; ok {
+		return s.compileTypeDef(f.Type)
+	}
+	return fmt.Errorf("invalid field type: %s", f.Type)
+}
+
+// sameType returns true when both fields describe the same container type
+func (f *Field) sameType(other *Field) bool {
+	return f.Array == other.Array && f.MapKey == other.MapKey && f.Type == other.Type
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldMapKeyTypes(t *testing.T) {
+	for fldType := range FieldTypes {
+		def := newTestSchemaDef()
+		def.State["tagged"] = "map[" + fldType + "]String"
+		err := NewSchema().Compile(def)
+		if MapKeyTypes[fldType] {
+			require.NoError(t, err, fldType)
+			continue
+		}
+		require.Error(t, err, fldType)
+		require.Contains(t, err.Error(), "unsupported key field type: "+fldType)
+	}
+}
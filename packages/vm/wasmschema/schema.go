@@ -1,7 +1,7 @@
 // Copyright 2020 IOTA Stiftung
 // SPDX-License-Identifier: Apache-2.0
 
-package generator
+package wasmschema
 
 import (
 	"fmt"
@@ -11,6 +11,14 @@ import (
 	"github.com/iotaledger/wasp/packages/iscp"
 )
 
+const (
+	AccessChain   = "chain"
+	AccessCreator = "creator"
+	AccessSelf    = "self"
+	KindFunc      = "Func"
+	KindView      = "View"
+)
+
 // TODO describe schema details in docs
 type (
 	FieldMap     map[string]*Field
@@ -47,14 +55,22 @@ type Func struct {
 	Type     string
 }
 
-func (f *Func) nameLen(smallest int) int {
-	if len(f.Results) != 0 {
-		return 7
+// Grants splits the access specification into the alternative grants
+// separated by '|' and the optional trailing comment
+func (f *Func) Grants() (grants []string, comment string) {
+	grant := f.Access
+	index := strings.Index(grant, "//")
+	if index >= 0 {
+		comment = grant[index:]
+		grant = grant[:index]
 	}
-	if len(f.Params) != 0 {
-		return 6
+	for _, alternative := range strings.Split(grant, "|") {
+		alternative = strings.TrimSpace(alternative)
+		if alternative != "" {
+			grants = append(grants, alternative)
+		}
 	}
-	return smallest
+	return grants, comment
 }
 
 type Struct struct {
@@ -63,36 +79,25 @@ type Struct struct {
 }
 
 type Schema struct {
-	Name          string
-	FullName      string
-	Description   string
-	KeyID         int
-	ConstLen      int
-	ConstNames    []string
-	ConstValues   []string
-	CoreContracts bool
-	SchemaTime    time.Time
-	Funcs         []*Func
-	Params        []*Field
-	Results       []*Field
-	StateVars     []*Field
-	Structs       []*Struct
-	Typedefs      []*Field
-	Views         []*Func
+	pendingTypedefs StringMap
+	Name            string
+	FullName        string
+	Description     string
+	CoreContracts   bool
+	SchemaTime      time.Time
+	Funcs           []*Func
+	Params          []*Field
+	Results         []*Field
+	StateVars       []*Field
+	Structs         []*Struct
+	Typedefs        []*Field
+	Views           []*Func
 }
 
 func NewSchema() *Schema {
 	return &Schema{}
 }
 
-func (s *Schema) appendConst(name, value string) {
-	if s.ConstLen < len(name) {
-		s.ConstLen = len(name)
-	}
-	s.ConstNames = append(s.ConstNames, name)
-	s.ConstValues = append(s.ConstValues, value)
-}
-
 func (s *Schema) Compile(schemaDef *SchemaDef) error {
 	s.FullName = strings.TrimSpace(schemaDef.Name)
 	if s.FullName == "" {
@@ -125,7 +130,37 @@ func (s *Schema) Compile(schemaDef *SchemaDef) error {
 	for _, name := range sortedFields(results) {
 		s.Results = append(s.Results, results[name])
 	}
-	return s.compileStateVars(schemaDef)
+	err = s.compileStateVars(schemaDef)
+	if err != nil {
+		return err
+	}
+	return s.compileAccess()
+}
+
+// compileAccess verifies that every access grant of a func is either one of
+// the predefined grants or the name of a state variable that holds the agent
+// ID of the role (AgentID) or the set of agent IDs of the role (map[AgentID]Bool)
+func (s *Schema) compileAccess() error {
+	for _, f := range s.Funcs {
+		grants, _ := f.Grants()
+		for _, grant := range grants {
+			switch grant {
+			case AccessChain, AccessCreator, AccessSelf:
+				continue
+			}
+			role := s.StateVar(grant)
+			if role == nil {
+				return fmt.Errorf("%s: unknown access role: %s", f.FuncName, grant)
+			}
+			switch {
+			case !role.Array && role.MapKey == "" && role.Type == "AgentID":
+			case role.MapKey == "AgentID" && role.Type == "Bool":
+			default:
+				return fmt.Errorf("%s: invalid access role type: %s", f.FuncName, grant)
+			}
+		}
+	}
+	return nil
 }
 
 func (s *Schema) compileField(fldName, fldType string) (*Field, error) {
@@ -186,6 +221,9 @@ func (s *Schema) compileFuncFields(fieldMap StringMap, allFieldMap *FieldMap, wh
 		if err != nil {
 			return nil, err
 		}
+		if field.HasConstraint() && what != "param" {
+			return nil, fmt.Errorf("%s cannot be constrained", what)
+		}
 		if _, ok := fieldNames[field.Name]; ok {
 			return nil, fmt.Errorf("duplicate %s name", what)
 		}
@@ -248,6 +286,15 @@ func (s *Schema) compileStructs(schemaDef *SchemaDef) error {
 			if field.Optional {
 				return fmt.Errorf("type field cannot be optional")
 			}
+			if field.HasConstraint() {
+				return fmt.Errorf("type field cannot be constrained")
+			}
+			if field.MapKey != "" {
+				return fmt.Errorf("type field cannot be a map")
+			}
+			if field.Array && field.TypeID == 0 {
+				return fmt.Errorf("type field array must be of a predefined type")
+			}
 			if _, ok := fieldNames[field.Name]; ok {
 				return fmt.Errorf("duplicate field name")
 			}
@@ -264,32 +311,94 @@ func (s *Schema) compileStructs(schemaDef *SchemaDef) error {
 }
 
 func (s *Schema) compileTypeDefs(schemaDef *SchemaDef) error {
-	varNames := make(StringMap)
-	varAliases := make(StringMap)
+	s.pendingTypedefs = make(StringMap)
+	for varName, varType := range schemaDef.Typedefs {
+		s.pendingTypedefs[varName] = varType
+	}
 	for _, varName := range sortedKeys(schemaDef.Typedefs) {
-		varType := schemaDef.Typedefs[varName]
-		varDef, err := s.compileField(varName, varType)
+		// skip typedefs that were already compiled because another typedef
+		// referred to them
+		if _, ok := s.pendingTypedefs[varName]; !ok {
+			continue
+		}
+		err := s.compileTypeDef(varName)
 		if err != nil {
 			return err
 		}
-		if _, ok := varNames[varDef.Name]; ok {
-			return fmt.Errorf("duplicate sybtype name")
+	}
+	return nil
+}
+
+func (s *Schema) compileTypeDef(varName string) error {
+	// remove from pending first, so that a typedef that refers
+	// to itself results in an invalid field type error
+	varType := s.pendingTypedefs[varName]
+	delete(s.pendingTypedefs, varName)
+	varDef, err := s.compileField(varName, varType)
+	if err != nil {
+		return err
+	}
+	if varDef.HasConstraint() {
+		return fmt.Errorf("subtype cannot be constrained")
+	}
+	for _, typedef := range s.Typedefs {
+		if typedef.Name == varDef.Name {
+			return fmt.Errorf("duplicate subtype name")
 		}
-		varNames[varDef.Name] = varDef.Name
-		if _, ok := varAliases[varDef.Alias]; ok {
+		if typedef.Alias == varDef.Alias {
 			return fmt.Errorf("duplicate subtype alias")
 		}
-		varAliases[varDef.Alias] = varDef.Alias
-		s.Typedefs = append(s.Typedefs, varDef)
 	}
+	s.Typedefs = append(s.Typedefs, varDef)
 	return nil
 }
 
-func (s *Schema) flushConsts(printer func(name string, value string, padLen int)) {
-	for i, name := range s.ConstNames {
-		printer(name, s.ConstValues[i], s.ConstLen)
+// compileNestedType returns the typedef for a nested container type.
+// An existing typedef with the same definition is reused, otherwise an
+// implicit typedef is added, named after the container it describes,
+// e.g. StringArray or MapStringToInt64.
+func (s *Schema) compileNestedType(fldType string) (*Field, error) {
+	varDef := &Field{}
+	err := varDef.compileType(s, fldType)
+	if err != nil {
+		return nil, err
+	}
+	for _, typedef := range s.Typedefs {
+		if typedef.sameType(varDef) {
+			return typedef, nil
+		}
 	}
-	s.ConstLen = 0
-	s.ConstNames = nil
-	s.ConstValues = nil
+	varDef.Name = varDef.Type + "Array"
+	if varDef.MapKey != "" {
+		varDef.Name = "Map" + varDef.MapKey + "To" + varDef.Type
+	}
+	varDef.Alias = varDef.Name
+	if s.Typedef(varDef.Name) != nil {
+		return nil, fmt.Errorf("conflicting subtype name: %s", varDef.Name)
+	}
+	if _, ok := s.pendingTypedefs[varDef.Name]; ok {
+		return nil, fmt.Errorf("conflicting subtype name: %s", varDef.Name)
+	}
+	s.Typedefs = append(s.Typedefs, varDef)
+	return varDef, nil
+}
+
+// StateVar returns the state variable with the specified name, or nil
+func (s *Schema) StateVar(name string) *Field {
+	for _, stateVar := range s.StateVars {
+		if stateVar.Name == name {
+			return stateVar
+		}
+	}
+	return nil
+}
+
+// Typedef returns the typedef with the specified name, or nil
+func (s *Schema) Typedef(name string) *Field {
+	for _, typedef := range s.Typedefs {
+		if typedef.Name == name {
+			return typedef
+		}
+	}
+	return nil
 }
@@ -5,6 +5,8 @@ package wasmsolo
 
 import (
 	"flag"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,9 +32,10 @@ const (
 )
 
 var (
-	GoDebug = flag.Bool("godebug", false, "debug go smart contract code")
-	GoWasm  = flag.Bool("gowasm", false, "prefer go wasm smart contract code")
-	TsWasm  = flag.Bool("tswasm", false, "prefer typescript wasm smart contract code")
+	GoDebug   = flag.Bool("godebug", false, "debug go smart contract code")
+	GoWasm    = flag.Bool("gowasm", false, "prefer go wasm smart contract code")
+	TsWasm    = flag.Bool("tswasm", false, "prefer typescript wasm smart contract code")
+	WasmTrace = flag.String("wasmtrace", "", "write structured Wasm host trace JSON files to this directory")
 )
 
 type SoloContext struct {
@@ -159,10 +162,34 @@ func StartChain(t *testing.T, chainName string, env ...*solo.Solo) *solo.Chain {
 	}
 	if soloEnv == nil {
 		soloEnv = solo.New(t, SoloDebug, SoloStackTracing)
+		if *WasmTrace != "" {
+			traceTest(t, soloEnv)
+		}
 	}
 	return soloEnv.NewChain(nil, chainName)
 }
 
+// traceTest records a Wasm trace for the duration of the test and saves it
+// to a file named after the test and the Wasm variant that was used, so that
+// traces of the Go, Rust, and TypeScript versions of a contract can be diffed.
+func traceTest(t *testing.T, env *solo.Solo) {
+	env.EnableWasmTracing()
+	t.Cleanup(func() {
+		variant := "rswasm"
+		switch {
+		case *GoDebug:
+			variant = "godebug"
+		case *GoWasm:
+			variant = "gowasm"
+		case *TsWasm:
+			variant = "tswasm"
+		}
+		testName := strings.ReplaceAll(t.Name(), "/", "_")
+		env.SaveWasmTrace(filepath.Join(*WasmTrace, testName+"."+variant+".json"))
+		env.DisableWasmTracing()
+	})
+}
+
 // Account returns a SoloAgent for the smart contract associated with ctx
 func (ctx *SoloContext) Account() *SoloAgent {
 	return &SoloAgent{
@@ -306,6 +333,17 @@ func (ctx *SoloContext) SoloContextForCore(t *testing.T, scName string, onLoad f
 	return ctxCore
 }
 
+// StartTrace starts recording a structured trace of all Wasm host calls.
+// Use StopTrace to retrieve the recorded trace.
+func (ctx *SoloContext) StartTrace() {
+	ctx.Chain.Env.EnableWasmTracing()
+}
+
+// StopTrace stops recording Wasm host calls and returns the trace recorded since StartTrace
+func (ctx *SoloContext) StopTrace() *wasmhost.WasmTracer {
+	return ctx.Chain.Env.DisableWasmTracing()
+}
+
 // Transfer creates a new ScTransfers proxy
 func (ctx *SoloContext) Transfer() wasmlib.ScTransfers {
 	return wasmlib.NewScTransfers()
@@ -0,0 +1,301 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmsolo
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/iotaledger/wasp/packages/kv"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/solo"
+	"github.com/iotaledger/wasp/packages/vm/wasmhost"
+	"github.com/iotaledger/wasp/packages/vm/wasmlib/go/wasmlib"
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+// integer field types with their byte size
+var fuzzIntSizes = map[string]int{
+	"Int8":   1,
+	"Int16":  2,
+	"Int32":  4,
+	"Int64":  8,
+	"Uint8":  1,
+	"Uint16": 2,
+	"Uint32": 4,
+	"Uint64": 8,
+}
+
+type soloFuzzer struct {
+	ctx *SoloContext
+	f   *wasmschema.Func
+	rnd *rand.Rand
+}
+
+// FuzzConstraints loads the schema definition file of the contract and calls
+// every function that has mandatory or constrained parameters with generated
+// parameter values. Leaving out a mandatory parameter or passing a value that
+// violates its declared constraint must be rejected with the corresponding
+// error. Values within the constraints must never be rejected that way, but
+// the call can still fail for other reasons. Requests are signed by the
+// contract creator, so functions that the creator has no access to are skipped.
+// For each function rounds sets of valid parameter values are tried.
+func (ctx *SoloContext) FuzzConstraints(schemaFile string, rounds int) {
+	t := ctx.Chain.Env.T
+	s, err := loadSchema(schemaFile)
+	require.NoError(t, err)
+
+	// fixed seed, so that failures are reproducible
+	rnd := rand.New(rand.NewSource(1))
+	for _, f := range s.Funcs {
+		z := &soloFuzzer{ctx: ctx, f: f, rnd: rnd}
+		z.run(rounds)
+	}
+}
+
+func loadSchema(schemaFile string) (*wasmschema.Schema, error) {
+	data, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return nil, err
+	}
+	schemaDef := &wasmschema.SchemaDef{}
+	switch filepath.Ext(schemaFile) {
+	case ".json":
+		err = json.Unmarshal(data, schemaDef)
+	case ".yaml":
+		err = yaml.Unmarshal(data, schemaDef)
+	default:
+		err = fmt.Errorf("unsupported schema file: %s", schemaFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := wasmschema.NewSchema()
+	err = s.Compile(schemaDef)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (z *soloFuzzer) run(rounds int) {
+	checks := false
+	for _, param := range z.f.Params {
+		if param.TypeID == 0 || param.Array || param.MapKey != "" {
+			// cannot generate values for structs and containers
+			return
+		}
+		if !param.Optional || param.HasConstraint() {
+			checks = true
+		}
+	}
+	if !checks {
+		return
+	}
+
+	t := z.ctx.Chain.Env.T
+	err := z.call(z.validParams())
+	if err != nil && strings.Contains(err.Error(), "no permission") {
+		t.Logf("fuzz: skipping %s: %v", z.f.String, err)
+		return
+	}
+
+	for _, param := range z.f.Params {
+		if param.Optional {
+			continue
+		}
+		params := z.validParams()
+		params.Del(kv.Key(param.Alias))
+		err = z.call(params)
+		require.Error(t, err, "%s: missing %s accepted", z.f.String, param.Name)
+		require.Contains(t, err.Error(), "missing mandatory "+param.Name)
+	}
+
+	for _, param := range z.f.Params {
+		for _, value := range z.invalidValues(param) {
+			params := z.validParams()
+			params.Set(kv.Key(param.Alias), value)
+			err = z.call(params)
+			require.Error(t, err, "%s: invalid %s accepted", z.f.String, param.Name)
+			require.Contains(t, err.Error(), "invalid "+param.Name+":")
+		}
+	}
+
+	for i := 0; i < rounds; i++ {
+		err = z.call(z.validParams())
+		if err == nil {
+			continue
+		}
+		require.NotContains(t, err.Error(), "missing mandatory ")
+		for _, param := range z.f.Params {
+			require.NotContains(t, err.Error(), "invalid "+param.Name+":")
+		}
+	}
+}
+
+func (z *soloFuzzer) call(params dict.Dict) error {
+	ctx := z.ctx
+	_ = wasmhost.Connect(ctx.wasmHostOld)
+	defer wasmhost.Connect(ctx.wc)
+	if z.f.Kind == wasmschema.KindView {
+		_, err := ctx.Chain.CallView(ctx.scName, z.f.String, params)
+		return err
+	}
+	req := solo.NewCallParamsFromDic(ctx.scName, z.f.String, params).WithIotas(1)
+	_, err := ctx.Chain.PostRequestSync(req, ctx.Creator().Pair)
+	return err
+}
+
+// invalidValues returns the values just outside the constraint limits of param
+func (z *soloFuzzer) invalidValues(param *wasmschema.Field) [][]byte {
+	values := make([][]byte, 0, 2)
+	if param.IsLength() {
+		if param.Min != "" {
+			minLen, _ := strconv.ParseUint(param.Min, 10, 32)
+			values = append(values, z.randomBytes(param.Type, int(minLen)-1))
+		}
+		if param.Max != "" {
+			maxLen, _ := strconv.ParseUint(param.Max, 10, 32)
+			values = append(values, z.randomBytes(param.Type, int(maxLen)+1))
+		}
+		return values
+	}
+
+	// the schema tool drops limits that equal the type limits,
+	// so stepping outside a remaining limit never overflows
+	size := fuzzIntSizes[param.Type]
+	if strings.HasPrefix(param.Type, "Int") {
+		if param.Min != "" {
+			lo, _ := strconv.ParseInt(param.Min, 10, 64)
+			values = append(values, encodeInt(uint64(lo-1), size))
+		}
+		if param.Max != "" {
+			hi, _ := strconv.ParseInt(param.Max, 10, 64)
+			values = append(values, encodeInt(uint64(hi+1), size))
+		}
+		return values
+	}
+	if param.Min != "" {
+		lo, _ := strconv.ParseUint(param.Min, 10, 64)
+		values = append(values, encodeInt(lo-1, size))
+	}
+	if param.Max != "" {
+		hi, _ := strconv.ParseUint(param.Max, 10, 64)
+		values = append(values, encodeInt(hi+1, size))
+	}
+	return values
+}
+
+// validParams returns a random set of params that satisfies all constraints.
+// Optional params are randomly left out.
+func (z *soloFuzzer) validParams() dict.Dict {
+	params := dict.New()
+	for _, param := range z.f.Params {
+		if param.Optional && z.rnd.Intn(2) == 0 {
+			continue
+		}
+		params.Set(kv.Key(param.Alias), z.validValue(param))
+	}
+	return params
+}
+
+func (z *soloFuzzer) validValue(param *wasmschema.Field) []byte {
+	switch param.Type {
+	case "Address":
+		return z.ctx.Creator().ScAddress().Bytes()
+	case "AgentID":
+		return z.ctx.Creator().ScAgentID().Bytes()
+	case "BigInt":
+		return []byte{byte(z.rnd.Intn(255) + 1)}
+	case "Bool":
+		return []byte{byte(z.rnd.Intn(2))}
+	case "ChainID":
+		return z.ctx.ChainID().Bytes()
+	case "Color":
+		return wasmlib.IOTA.Bytes()
+	case "Hash":
+		return z.randomBytes("Bytes", 32)
+	case "Hname":
+		return z.randomBytes("Bytes", 4)
+	case "RequestID":
+		// random transaction ID with output index 0
+		return append(z.randomBytes("Bytes", 32), 0, 0)
+	case "Bytes", "String":
+		lo, hi := uint64(0), uint64(32)
+		if param.Min != "" {
+			lo, _ = strconv.ParseUint(param.Min, 10, 32)
+			hi = lo + 32
+		}
+		if param.Max != "" {
+			hi, _ = strconv.ParseUint(param.Max, 10, 32)
+		}
+		return z.randomBytes(param.Type, int(z.randomRange(lo, hi)))
+	}
+
+	size := fuzzIntSizes[param.Type]
+	bits := uint(size * 8)
+	if strings.HasPrefix(param.Type, "Int") {
+		lo := int64(-1) << (bits - 1)
+		hi := -(lo + 1)
+		if param.Min != "" {
+			lo, _ = strconv.ParseInt(param.Min, 10, 64)
+		}
+		if param.Max != "" {
+			hi, _ = strconv.ParseInt(param.Max, 10, 64)
+		}
+		// two's complement arithmetic keeps the offset from lo correct
+		return encodeInt(z.randomRange(uint64(lo), uint64(hi)), size)
+	}
+	lo, hi := uint64(0), uint64(1)<<(bits-1)<<1-1
+	if param.Min != "" {
+		lo, _ = strconv.ParseUint(param.Min, 10, 64)
+	}
+	if param.Max != "" {
+		hi, _ = strconv.ParseUint(param.Max, 10, 64)
+	}
+	return encodeInt(z.randomRange(lo, hi), size)
+}
+
+// randomBytes returns length random bytes, or random letters for a String
+func (z *soloFuzzer) randomBytes(typeName string, length int) []byte {
+	data := make([]byte, length)
+	for i := range data {
+		if typeName == "String" {
+			data[i] = byte('a' + z.rnd.Intn(26))
+			continue
+		}
+		data[i] = byte(z.rnd.Intn(256))
+	}
+	return data
+}
+
+// randomRange returns a random value between lo and hi inclusive,
+// favoring the limits themselves
+func (z *soloFuzzer) randomRange(lo, hi uint64) uint64 {
+	switch z.rnd.Intn(4) {
+	case 0:
+		return lo
+	case 1:
+		return hi
+	}
+	span := hi - lo
+	if span == ^uint64(0) {
+		return z.rnd.Uint64()
+	}
+	return lo + z.rnd.Uint64()%(span+1)
+}
+
+func encodeInt(value uint64, size int) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, value)
+	return data[:size]
+}
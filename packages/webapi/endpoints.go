@@ -49,8 +49,11 @@ func Init(
 		pub,
 		chainsProvider.ChainProvider(),
 		webapiutil.GetAccountBalance,
+		webapiutil.GetAccountNonce,
 		webapiutil.HasRequestBeenProcessed,
+		webapiutil.CheckACL,
 		time.Duration(parameters.GetInt(parameters.OffledgerAPICacheTTL))*time.Second,
+		parameters.GetStringSlice(parameters.OffledgerStreamOrigins),
 		log,
 	)
 
@@ -20,6 +20,10 @@ func BadRequest(message string) *HTTPError {
 	return &HTTPError{Code: http.StatusBadRequest, Message: message}
 }
 
+func Forbidden(message string) *HTTPError {
+	return &HTTPError{Code: http.StatusForbidden, Message: message}
+}
+
 func NotFound(message string) *HTTPError {
 	return &HTTPError{Code: http.StatusNotFound, Message: message}
 }
@@ -32,6 +36,10 @@ func Timeout(message string) *HTTPError {
 	return &HTTPError{Code: http.StatusRequestTimeout, Message: message}
 }
 
+func PayloadTooLarge(message string) *HTTPError {
+	return &HTTPError{Code: http.StatusRequestEntityTooLarge, Message: message}
+}
+
 func ServerError(message string) *HTTPError {
 	return &HTTPError{Code: http.StatusInternalServerError, Message: message}
 }
@@ -3,23 +3,26 @@ package model
 import "github.com/iotaledger/wasp/packages/registry"
 
 type ChainRecord struct {
-	ChainID ChainID  `swagger:"desc(ChainID (base58-encoded))"`
-	Active  bool     `swagger:"desc(Whether or not the chain is active)"`
-	Peers   []string `swagger:"desc(List of peers/access nodes (network IDs))"`
+	ChainID  ChainID  `swagger:"desc(ChainID (base58-encoded))"`
+	Active   bool     `swagger:"desc(Whether or not the chain is active)"`
+	Peers    []string `swagger:"desc(List of peers/access nodes (network IDs))"`
+	Observer bool     `swagger:"desc(Whether or not the node only observes the chain without participating in consensus)"`
 }
 
 func NewChainRecord(rec *registry.ChainRecord) *ChainRecord {
 	return &ChainRecord{
-		ChainID: NewChainID(rec.ChainID),
-		Active:  rec.Active,
-		Peers:   rec.Peers,
+		ChainID:  NewChainID(rec.ChainID),
+		Active:   rec.Active,
+		Peers:    rec.Peers,
+		Observer: rec.Observer,
 	}
 }
 
 func (bd *ChainRecord) Record() *registry.ChainRecord {
 	return &registry.ChainRecord{
-		ChainID: bd.ChainID.ChainID(),
-		Active:  bd.Active,
-		Peers:   bd.Peers,
+		ChainID:  bd.ChainID.ChainID(),
+		Active:   bd.Active,
+		Peers:    bd.Peers,
+		Observer: bd.Observer,
 	}
 }
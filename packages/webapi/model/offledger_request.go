@@ -3,3 +3,17 @@ package model
 type OffLedgerRequestBody struct {
 	Request Bytes `swagger:"desc(Offledger Request (base64))"`
 }
+
+type OffLedgerRequestBatchBody struct {
+	Requests []Bytes `swagger:"desc(Offledger Requests (base64))"`
+}
+
+type OffLedgerRequestResult struct {
+	RequestID string `swagger:"desc(ID of the request (base58), empty if the request could not be parsed)"`
+	Accepted  bool   `swagger:"desc(True if the request was added to the mempool)"`
+	Error     string `swagger:"desc(Reason of the rejection)"`
+}
+
+type OffLedgerRequestBatchResponse struct {
+	Results []OffLedgerRequestResult `swagger:"desc(Results in the same order as the submitted requests)"`
+}
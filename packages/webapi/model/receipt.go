@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
+)
+
+type RequestError struct {
+	Code     uint16   `swagger:"desc(Error code, codes below 1000 are reserved for the VM)"`
+	Contract string   `swagger:"desc(Hname of the contract that raised the error (hex))"`
+	Message  string   `swagger:"desc(Error message)"`
+	Params   []string `swagger:"desc(Optional values that further describe the error)"`
+}
+
+func NewRequestError(vmErr *iscp.VMError) *RequestError {
+	if vmErr == nil {
+		return nil
+	}
+	return &RequestError{
+		Code:     vmErr.Code,
+		Contract: vmErr.Contract.String(),
+		Message:  vmErr.Message,
+		Params:   vmErr.Params,
+	}
+}
+
+type RequestReceipt struct {
+	BlockIndex     uint32        `swagger:"desc(Index of the block that contains the request)"`
+	RequestIndex   uint16        `swagger:"desc(Index of the request in the block)"`
+	Error          *RequestError `swagger:"desc(Error raised by the request, absent when the request succeeded)"`
+	Results        dict.JSONDict `swagger:"desc(Results of the call)"`
+	ResultsOmitted bool          `swagger:"desc(True if the results were too big to be kept in the receipt)"`
+}
+
+func NewRequestReceipt(rec *blocklog.RequestReceipt) *RequestReceipt {
+	if rec == nil {
+		return nil
+	}
+	return &RequestReceipt{
+		BlockIndex:     rec.BlockIndex,
+		RequestIndex:   rec.RequestIndex,
+		Error:          NewRequestError(rec.Error),
+		Results:        rec.Results.JSONDict(),
+		ResultsOmitted: rec.ResultsOmitted,
+	}
+}
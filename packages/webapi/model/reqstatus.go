@@ -7,7 +7,31 @@ type WaitRequestProcessedParams struct {
 }
 
 type RequestStatusResponse struct {
-	IsProcessed bool `swagger:"desc(True if the request has been processed)"`
+	IsProcessed bool            `swagger:"desc(True if the request has been processed)"`
+	State       string          `swagger:"desc(Propagation state of the request: unknown / received / in_mempools / in_batch / processed)"`
+	Mempools    int             `swagger:"desc(Number of mempools known to contain the request)"`
+	Quorum      int             `swagger:"desc(Number of mempools that must acknowledge the request for it to be confirmed)"`
+	Confirmed   bool            `swagger:"desc(True if a quorum of mempools acknowledged the request or it has been processed)"`
+	Receipt     *RequestReceipt `swagger:"desc(Receipt of the request, present when the request has been processed)"`
 }
 
 const WaitRequestProcessedDefaultTimeout = 30 * time.Second
+
+type RequestsStatusParams struct {
+	RequestIDs    []string      `swagger:"desc(IDs of the requests (base58), ignored when TransactionID is set)"`
+	TransactionID string        `swagger:"desc(ID of an L1 transaction (base58), selects the requests of the transaction; not found while the node has not received the transaction)"`
+	Wait          bool          `swagger:"desc(Wait until all requests have been processed)"`
+	Timeout       time.Duration `swagger:"desc(Timeout in nanoseconds when waiting),default(30 seconds)"`
+}
+
+type RequestStatusItem struct {
+	RequestID string                `swagger:"desc(Request ID (base58))"`
+	Status    RequestStatusResponse `swagger:"desc(Status of the request)"`
+}
+
+type RequestsStatusResponse struct {
+	Requests []RequestStatusItem `swagger:"desc(Status of each request, in the order of the request IDs)"`
+}
+
+// MaxRequestsStatusCount is the maximum number of requests queried at once
+const MaxRequestsStatusCount = 1000
@@ -3,8 +3,10 @@ package reqstatus
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/wasp/packages/chain"
 	"github.com/iotaledger/wasp/packages/chains"
@@ -36,6 +38,12 @@ func AddEndpoints(server echoswagger.ApiRouter, getChain chains.ChainProvider) {
 		AddParamPath("", "chainID", "ChainID (base58)").
 		AddParamPath("", "reqID", "Request ID (base58)").
 		AddParamBody(model.WaitRequestProcessedParams{}, "Params", "Optional parameters", false)
+
+	server.POST(routes.RequestsStatus(":chainID"), r.handleRequestsStatus).
+		SetSummary("Get the processing status of several requests, optionally waiting until all of them have been processed").
+		AddParamPath("", "chainID", "ChainID (base58)").
+		AddParamBody(model.RequestsStatusParams{}, "Params", "Requests to query", true).
+		AddResponse(http.StatusOK, "Request statuses", model.RequestsStatusResponse{}, nil)
 }
 
 func (r *reqstatusWebAPI) handleRequestStatus(c echo.Context) error {
@@ -43,6 +51,14 @@ func (r *reqstatusWebAPI) handleRequestStatus(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	status, err := requestStatus(ch, reqID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, status)
+}
+
+func requestStatus(ch chain.ChainRequests, reqID iscp.RequestID) (*model.RequestStatusResponse, error) {
 	var isProcessed bool
 	switch ch.GetRequestProcessingStatus(reqID) {
 	case chain.RequestProcessingStatusCompleted:
@@ -50,9 +66,23 @@ func (r *reqstatusWebAPI) handleRequestStatus(c echo.Context) error {
 	case chain.RequestProcessingStatusBacklog:
 		isProcessed = false
 	}
-	return c.JSON(http.StatusOK, model.RequestStatusResponse{
+	var receipt *model.RequestReceipt
+	if isProcessed {
+		rec, err := ch.GetRequestReceipt(reqID)
+		if err != nil {
+			return nil, httperrors.ServerError(fmt.Sprintf("Could not fetch receipt for request %s: %v", reqID.Base58(), err))
+		}
+		receipt = model.NewRequestReceipt(rec)
+	}
+	info := ch.GetRequestPropagationInfo(reqID)
+	return &model.RequestStatusResponse{
 		IsProcessed: isProcessed,
-	})
+		State:       info.State.String(),
+		Mempools:    info.Mempools,
+		Quorum:      info.Quorum,
+		Confirmed:   info.Confirmed(),
+		Receipt:     receipt,
+	}, nil
 }
 
 func (r *reqstatusWebAPI) handleWaitRequestProcessed(c echo.Context) error {
@@ -97,14 +127,129 @@ func (r *reqstatusWebAPI) handleWaitRequestProcessed(c echo.Context) error {
 	}
 }
 
-func (r *reqstatusWebAPI) parseParams(c echo.Context) (chain.ChainRequests, iscp.RequestID, error) {
+func (r *reqstatusWebAPI) handleRequestsStatus(c echo.Context) error {
+	ch, err := r.getChainParam(c)
+	if err != nil {
+		return err
+	}
+	params := model.RequestsStatusParams{
+		Timeout: model.WaitRequestProcessedDefaultTimeout,
+	}
+	if err = c.Bind(&params); err != nil {
+		return httperrors.BadRequest("Invalid request body")
+	}
+	reqIDs, err := requestIDsFromParams(ch, &params)
+	if err != nil {
+		return err
+	}
+
+	if params.Wait && !waitRequestsProcessed(ch, reqIDs, params.Timeout) {
+		return httperrors.Timeout("Timeout while waiting for requests to be processed")
+	}
+
+	res := model.RequestsStatusResponse{
+		Requests: make([]model.RequestStatusItem, len(reqIDs)),
+	}
+	for i, reqID := range reqIDs {
+		status, err := requestStatus(ch, reqID)
+		if err != nil {
+			return err
+		}
+		res.Requests[i] = model.RequestStatusItem{
+			RequestID: reqID.Base58(),
+			Status:    *status,
+		}
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// requestIDsFromParams returns the requested IDs. The requests of a transaction are resolved
+// from the L1 transactions received by the node, a transaction it has not seen yet is reported as not found
+func requestIDsFromParams(ch chain.ChainRequests, params *model.RequestsStatusParams) ([]iscp.RequestID, error) {
+	if params.TransactionID != "" {
+		txID, err := ledgerstate.TransactionIDFromBase58(params.TransactionID)
+		if err != nil {
+			return nil, httperrors.BadRequest(fmt.Sprintf("Invalid transaction id %+v: %s", params.TransactionID, err.Error()))
+		}
+		reqIDs, ok := ch.GetTransactionRequestIDs(txID)
+		if !ok {
+			return nil, httperrors.NotFound(fmt.Sprintf("Transaction not known to the node: %s", params.TransactionID))
+		}
+		return reqIDs, nil
+	}
+	if len(params.RequestIDs) > model.MaxRequestsStatusCount {
+		return nil, httperrors.BadRequest(fmt.Sprintf("Too many request ids, maximum is %d", model.MaxRequestsStatusCount))
+	}
+	reqIDs := make([]iscp.RequestID, len(params.RequestIDs))
+	for i, s := range params.RequestIDs {
+		reqID, err := iscp.RequestIDFromBase58(s)
+		if err != nil {
+			return nil, httperrors.BadRequest(fmt.Sprintf("Invalid request id %+v: %s", s, err.Error()))
+		}
+		reqIDs[i] = reqID
+	}
+	return reqIDs, nil
+}
+
+// waitRequestsProcessed waits until all requests have been processed, or the timeout expires.
+// Returns false in case of timeout.
+func waitRequestsProcessed(ch chain.ChainRequests, reqIDs []iscp.RequestID, timeout time.Duration) bool {
+	var mutex sync.Mutex
+	pending := make(map[iscp.RequestID]bool)
+	allProcessed := make(chan bool)
+	handler := events.NewClosure(func(rid iscp.RequestID) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !pending[rid] {
+			return
+		}
+		delete(pending, rid)
+		if len(pending) == 0 {
+			close(allProcessed)
+		}
+	})
+	// subscribe before checking the status, so that no event is missed
+	ch.EventRequestProcessed().Attach(handler)
+	defer ch.EventRequestProcessed().Detach(handler)
+
+	mutex.Lock()
+	for _, reqID := range reqIDs {
+		if ch.GetRequestProcessingStatus(reqID) != chain.RequestProcessingStatusCompleted {
+			pending[reqID] = true
+		}
+	}
+	done := len(pending) == 0
+	mutex.Unlock()
+	if done {
+		return true
+	}
+
+	select {
+	case <-allProcessed:
+		return true
+	case <-time.After(timeout):
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(pending) == 0
+	}
+}
+
+func (r *reqstatusWebAPI) getChainParam(c echo.Context) (chain.ChainRequests, error) {
 	chainID, err := iscp.ChainIDFromBase58(c.Param("chainID"))
 	if err != nil {
-		return nil, iscp.RequestID{}, httperrors.BadRequest(fmt.Sprintf("Invalid Chain ID %+v: %s", c.Param("chainID"), err.Error()))
+		return nil, httperrors.BadRequest(fmt.Sprintf("Invalid Chain ID %+v: %s", c.Param("chainID"), err.Error()))
 	}
 	theChain := r.getChain(chainID)
 	if theChain == nil {
-		return nil, iscp.RequestID{}, httperrors.NotFound(fmt.Sprintf("Chain not found: %s", chainID.String()))
+		return nil, httperrors.NotFound(fmt.Sprintf("Chain not found: %s", chainID.String()))
+	}
+	return theChain, nil
+}
+
+func (r *reqstatusWebAPI) parseParams(c echo.Context) (chain.ChainRequests, iscp.RequestID, error) {
+	theChain, err := r.getChainParam(c)
+	if err != nil {
+		return nil, iscp.RequestID{}, err
 	}
 	reqID, err := iscp.RequestIDFromBase58(c.Param("reqID"))
 	if err != nil {
@@ -2,12 +2,15 @@ package reqstatus
 
 import (
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/wasp/packages/chain"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
 	"github.com/iotaledger/wasp/packages/webapi/model"
 	"github.com/iotaledger/wasp/packages/webapi/routes"
 	"github.com/iotaledger/wasp/packages/webapi/testutil"
@@ -20,6 +23,22 @@ func (m *mockChain) GetRequestProcessingStatus(id iscp.RequestID) chain.RequestP
 	return chain.RequestProcessingStatusCompleted
 }
 
+func (m *mockChain) GetRequestPropagationInfo(id iscp.RequestID) *chain.RequestPropagationInfo {
+	return &chain.RequestPropagationInfo{State: chain.RequestPropagationProcessed}
+}
+
+func (m *mockChain) GetRequestReceipt(id iscp.RequestID) (*blocklog.RequestReceipt, error) {
+	return &blocklog.RequestReceipt{
+		Error:        iscp.NewVMError(iscp.VMErrorCodeUser, "failed", "param"),
+		BlockIndex:   3,
+		RequestIndex: 1,
+	}, nil
+}
+
+func (m *mockChain) GetTransactionRequestIDs(txID ledgerstate.TransactionID) ([]iscp.RequestID, bool) {
+	return nil, false
+}
+
 func (m *mockChain) EventRequestProcessed() *events.Event {
 	panic("not implemented")
 }
@@ -48,4 +67,174 @@ func TestRequestStatus(t *testing.T) {
 	)
 
 	require.True(t, res.IsProcessed)
+	require.EqualValues(t, "processed", res.State)
+	require.True(t, res.Confirmed)
+	require.NotNil(t, res.Receipt)
+	require.EqualValues(t, 3, res.Receipt.BlockIndex)
+	require.EqualValues(t, 1, res.Receipt.RequestIndex)
+	require.NotNil(t, res.Receipt.Error)
+	require.EqualValues(t, iscp.VMErrorCodeUser, res.Receipt.Error.Code)
+	require.EqualValues(t, "failed", res.Receipt.Error.Message)
+	require.EqualValues(t, []string{"param"}, res.Receipt.Error.Params)
+}
+
+// mockTxChain knows some of the requests; processed requests are mapped to true.
+// The transactions of the requests are known too
+type mockTxChain struct {
+	mockChain
+	mutex    sync.Mutex
+	requests map[iscp.RequestID]bool
+	txs      map[ledgerstate.TransactionID][]iscp.RequestID
+	event    *events.Event
+}
+
+func newMockTxChain(reqIDs ...iscp.RequestID) *mockTxChain {
+	m := &mockTxChain{
+		requests: make(map[iscp.RequestID]bool),
+		txs:      make(map[ledgerstate.TransactionID][]iscp.RequestID),
+		event: events.NewEvent(func(handler interface{}, params ...interface{}) {
+			handler.(func(_ iscp.RequestID))(params[0].(iscp.RequestID))
+		}),
+	}
+	for _, reqID := range reqIDs {
+		m.requests[reqID] = false
+		txID := reqID.OutputID().TransactionID()
+		m.txs[txID] = append(m.txs[txID], reqID)
+	}
+	return m
+}
+
+func (m *mockTxChain) GetRequestProcessingStatus(id iscp.RequestID) chain.RequestProcessingStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	processed, ok := m.requests[id]
+	switch {
+	case !ok:
+		return chain.RequestProcessingStatusUnknown
+	case processed:
+		return chain.RequestProcessingStatusCompleted
+	}
+	return chain.RequestProcessingStatusBacklog
+}
+
+func (m *mockTxChain) GetRequestPropagationInfo(id iscp.RequestID) *chain.RequestPropagationInfo {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	processed, ok := m.requests[id]
+	switch {
+	case !ok:
+		return &chain.RequestPropagationInfo{State: chain.RequestPropagationUnknown}
+	case processed:
+		return &chain.RequestPropagationInfo{State: chain.RequestPropagationProcessed}
+	}
+	return &chain.RequestPropagationInfo{State: chain.RequestPropagationReceived}
+}
+
+func (m *mockTxChain) GetTransactionRequestIDs(txID ledgerstate.TransactionID) ([]iscp.RequestID, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	reqIDs, ok := m.txs[txID]
+	return reqIDs, ok
+}
+
+func (m *mockTxChain) EventRequestProcessed() *events.Event {
+	return m.event
+}
+
+func (m *mockTxChain) process(id iscp.RequestID) {
+	m.mutex.Lock()
+	m.requests[id] = true
+	m.mutex.Unlock()
+	m.event.Trigger(id)
+}
+
+func callRequestsStatus(t *testing.T, ch chain.ChainRequests, params *model.RequestsStatusParams, expectedStatus int) *model.RequestsStatusResponse {
+	r := &reqstatusWebAPI{func(chainID *iscp.ChainID) chain.ChainRequests {
+		return ch
+	}}
+	res := &model.RequestsStatusResponse{}
+	var body interface{}
+	if expectedStatus == http.StatusOK {
+		body = res
+	}
+	testutil.CallWebAPIRequestHandler(
+		t,
+		r.handleRequestsStatus,
+		http.MethodPost,
+		routes.RequestsStatus(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		params,
+		body,
+		expectedStatus,
+	)
+	return res
+}
+
+func TestRequestsStatus(t *testing.T) {
+	txID := ledgerstate.TransactionID{1}
+	reqID0 := iscp.NewRequestID(txID, 0)
+	reqID2 := iscp.NewRequestID(txID, 2)
+	ch := newMockTxChain(reqID0, reqID2)
+	ch.process(reqID0)
+
+	res := callRequestsStatus(t, ch, &model.RequestsStatusParams{
+		RequestIDs: []string{reqID2.Base58(), reqID0.Base58()},
+	}, http.StatusOK)
+	require.Len(t, res.Requests, 2)
+	require.EqualValues(t, reqID2.Base58(), res.Requests[0].RequestID)
+	require.False(t, res.Requests[0].Status.IsProcessed)
+	require.Nil(t, res.Requests[0].Status.Receipt)
+	require.EqualValues(t, reqID0.Base58(), res.Requests[1].RequestID)
+	require.True(t, res.Requests[1].Status.IsProcessed)
+	require.NotNil(t, res.Requests[1].Status.Receipt)
+
+	res = callRequestsStatus(t, ch, &model.RequestsStatusParams{
+		TransactionID: txID.Base58(),
+	}, http.StatusOK)
+	require.Len(t, res.Requests, 2)
+	require.EqualValues(t, reqID0.Base58(), res.Requests[0].RequestID)
+	require.EqualValues(t, reqID2.Base58(), res.Requests[1].RequestID)
+
+	callRequestsStatus(t, ch, &model.RequestsStatusParams{
+		RequestIDs: make([]string, model.MaxRequestsStatusCount+1),
+	}, http.StatusBadRequest)
+}
+
+func TestRequestsStatusUnseenTransaction(t *testing.T) {
+	reqID := iscp.NewRequestID(ledgerstate.TransactionID{1}, 0)
+	ch := newMockTxChain(reqID)
+
+	callRequestsStatus(t, ch, &model.RequestsStatusParams{
+		TransactionID: ledgerstate.TransactionID{2}.Base58(),
+	}, http.StatusNotFound)
+	callRequestsStatus(t, ch, &model.RequestsStatusParams{
+		TransactionID: ledgerstate.TransactionID{2}.Base58(),
+		Wait:          true,
+		Timeout:       10 * time.Second,
+	}, http.StatusNotFound)
+}
+
+func TestRequestsStatusWait(t *testing.T) {
+	txID := ledgerstate.TransactionID{1}
+	reqID0 := iscp.NewRequestID(txID, 0)
+	reqID1 := iscp.NewRequestID(txID, 1)
+	ch := newMockTxChain(reqID0, reqID1)
+	params := &model.RequestsStatusParams{
+		RequestIDs: []string{reqID0.Base58(), reqID1.Base58()},
+		Wait:       true,
+		Timeout:    100 * time.Millisecond,
+	}
+
+	ch.process(reqID0)
+	callRequestsStatus(t, ch, params, http.StatusRequestTimeout)
+
+	params.Timeout = 10 * time.Second
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		ch.process(reqID1)
+	}()
+	res := callRequestsStatus(t, ch, params, http.StatusOK)
+	require.Len(t, res.Requests, 2)
+	require.True(t, res.Requests[0].Status.IsProcessed)
+	require.True(t, res.Requests[1].Status.IsProcessed)
 }
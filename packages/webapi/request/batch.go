@@ -0,0 +1,333 @@
+package request
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/vm/vmcontext"
+	"github.com/iotaledger/wasp/packages/webapi/httperrors"
+	"github.com/iotaledger/wasp/packages/webapi/model"
+	"github.com/labstack/echo/v4"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const (
+	// maxBatchSizeConst is the maximum number of requests submitted in one batch
+	maxBatchSizeConst = 1000
+	// maxRequestSizeConst is the maximum size of one binary encoded request in a batch or a stream
+	maxRequestSizeConst = 1024 * 1024
+	// maxBatchBodySizeConst is the maximum size of the body of a batch submission, in any encoding
+	maxBatchBodySizeConst = 16 * 1024 * 1024
+)
+
+// batchItem is a request of the batch together with the result of its validation
+type batchItem struct {
+	req    *request.OffLedger
+	result model.OffLedgerRequestResult
+}
+
+func newBatchItem(data []byte) *batchItem {
+	item := &batchItem{}
+	rGeneric, err := request.FromMarshalUtil(marshalutil.New(data))
+	if err != nil {
+		item.reject(httperrors.BadRequest("Error parsing request from payload"))
+		return item
+	}
+	req, ok := rGeneric.(*request.OffLedger)
+	if !ok {
+		item.reject(httperrors.BadRequest("Error parsing request: off-ledger request expected"))
+		return item
+	}
+	item.req = req
+	item.result.RequestID = req.ID().Base58()
+	return item
+}
+
+func (b *batchItem) reject(err error) {
+	b.result.Error = err.Error()
+}
+
+func (b *batchItem) isRejected() bool {
+	return b.result.Error != ""
+}
+
+// batchSender is the on-chain account state of a sender of the batch requests
+type batchSender struct {
+	agentID         *iscp.AgentID
+	maxAssumedNonce uint64
+	err             error
+}
+
+func (o *offLedgerReqAPI) handleNewRequestBatch(c echo.Context) error {
+	chainID, items, err := parseBatchParams(c)
+	if err != nil {
+		return err
+	}
+
+	ch := o.getChain(chainID)
+	if ch == nil {
+		return httperrors.NotFound(fmt.Sprintf("Unknown chain: %s", chainID.Base58()))
+	}
+
+	o.processBatch(ch, items)
+
+	ret := &model.OffLedgerRequestBatchResponse{
+		Results: make([]model.OffLedgerRequestResult, len(items)),
+	}
+	for i, item := range items {
+		ret.Results[i] = item.result
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// handleNewRequestStream accepts off-ledger requests over a websocket connection. Requests,
+// which arrive while the previous ones are being validated, are processed together as a batch.
+func (o *offLedgerReqAPI) handleNewRequestStream(c echo.Context) error {
+	chainID, err := iscp.ChainIDFromBase58(c.Param("chainID"))
+	if err != nil {
+		return httperrors.BadRequest(fmt.Sprintf("Invalid Chain ID %+v: %s", c.Param("chainID"), err.Error()))
+	}
+	ch := o.getChain(chainID)
+	if ch == nil {
+		return httperrors.NotFound(fmt.Sprintf("Unknown chain: %s", chainID.Base58()))
+	}
+
+	// without origin patterns only same origin connections are accepted
+	conn, err := websocket.Accept(c.Response(), c.Request(), &websocket.AcceptOptions{
+		OriginPatterns: o.streamOrigins,
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close(websocket.StatusInternalError, "something went wrong")
+	conn.SetReadLimit(maxRequestSizeConst)
+	ctx := c.Request().Context()
+
+	received := make(chan []byte, maxBatchSizeConst)
+	done := make(chan struct{})
+	defer close(done)
+	var readErr error
+	go func() {
+		defer close(received)
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				readErr = err
+				return
+			}
+			select {
+			case received <- data:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for data := range received {
+		items := []*batchItem{newBatchItem(data)}
+	drain:
+		for len(items) < maxBatchSizeConst {
+			select {
+			case data, ok := <-received:
+				if !ok {
+					break drain
+				}
+				items = append(items, newBatchItem(data))
+			default:
+				break drain
+			}
+		}
+		o.processBatch(ch, items)
+		for _, item := range items {
+			if err := wsjson.Write(ctx, conn, &item.result); err != nil {
+				o.log.Debugf("webapi.offledger - request stream from %s closed: %v", c.Request().RemoteAddr, err)
+				return nil
+			}
+		}
+	}
+
+	if websocket.CloseStatus(readErr) == websocket.StatusNormalClosure {
+		conn.Close(websocket.StatusNormalClosure, "")
+	}
+	return nil
+}
+
+// processBatch validates the requests of the batch in parallel and passes the accepted ones to the mempool in one call
+func (o *offLedgerReqAPI) processBatch(ch chain.Chain, items []*batchItem) {
+	runParallel(len(items), func(i int) {
+		item := items[i]
+		if item.isRejected() {
+			return
+		}
+		if !item.req.VerifySignature() {
+			item.reject(httperrors.BadRequest("Invalid signature."))
+			return
+		}
+		if err := o.checkNotProcessed(ch, item.req.ID()); err != nil {
+			item.reject(err)
+			return
+		}
+		if err := o.checkAllowed(ch, item.req); err != nil {
+			item.reject(err)
+		}
+	})
+
+	// balance and nonce are queried once per sender
+	senders := make(map[string]*batchSender)
+	senderList := make([]*batchSender, 0)
+	for _, item := range items {
+		if item.isRejected() {
+			continue
+		}
+		key := item.req.SenderAccount().Base58()
+		if _, ok := senders[key]; !ok {
+			sender := &batchSender{agentID: item.req.SenderAccount()}
+			senders[key] = sender
+			senderList = append(senderList, sender)
+		}
+	}
+	runParallel(len(senderList), func(i int) {
+		sender := senderList[i]
+		sender.maxAssumedNonce, sender.err = o.checkSender(ch, sender.agentID)
+	})
+
+	accepted := make([]*request.OffLedger, 0, len(items))
+	seen := make(map[iscp.RequestID]bool)
+	for _, item := range items {
+		if item.isRejected() {
+			continue
+		}
+		reqID := item.req.ID()
+		if seen[reqID] {
+			item.reject(httperrors.BadRequest("duplicate request in the batch"))
+			continue
+		}
+		seen[reqID] = true
+		sender := senders[item.req.SenderAccount().Base58()]
+		if sender.err != nil {
+			item.reject(sender.err)
+			continue
+		}
+		if !isNonceAcceptable(item.req.Nonce(), sender.maxAssumedNonce) {
+			item.reject(httperrors.BadRequest(fmt.Sprintf("Nonce %d is too old", item.req.Nonce())))
+			continue
+		}
+		o.requestsCache.Set(reqID, true)
+		item.result.Accepted = true
+		accepted = append(accepted, item.req)
+	}
+	if len(accepted) > 0 {
+		ch.ReceiveOffLedgerRequests(accepted)
+	}
+}
+
+// checkSender checks the sender has on-chain balance and returns the max nonce assumed by the chain for it
+func (o *offLedgerReqAPI) checkSender(ch chain.Chain, agentID *iscp.AgentID) (uint64, error) {
+	balances, err := o.getAccountBalance(ch, agentID)
+	if err != nil {
+		o.log.Errorf("webapi.offledger - account balance: %v", err)
+		return 0, httperrors.ServerError("Unable to get account balance")
+	}
+	if len(balances) == 0 {
+		return 0, httperrors.BadRequest(fmt.Sprintf("No balance on account %s", agentID.Base58()))
+	}
+	nonce, err := o.getAccountNonce(ch, agentID)
+	if err != nil {
+		o.log.Errorf("webapi.offledger - account nonce: %v", err)
+		return 0, httperrors.ServerError("Unable to get account nonce")
+	}
+	return nonce, nil
+}
+
+// isNonceAcceptable follows the replay protection rule of the VM, so requests which
+// would be rejected by the VM anyway don't reach the mempool
+func isNonceAcceptable(nonce, maxAssumed uint64) bool {
+	if maxAssumed < vmcontext.OffLedgerNonceStrictOrderTolerance {
+		return true
+	}
+	return nonce > maxAssumed-vmcontext.OffLedgerNonceStrictOrderTolerance
+}
+
+func runParallel(n int, f func(i int)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.NumCPU())
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			f(i)
+			<-sem
+		}(i)
+	}
+	wg.Wait()
+}
+
+func parseBatchParams(c echo.Context) (chainID *iscp.ChainID, items []*batchItem, err error) {
+	chainID, err = iscp.ChainIDFromBase58(c.Param("chainID"))
+	if err != nil {
+		return nil, nil, httperrors.BadRequest(fmt.Sprintf("Invalid Chain ID %+v: %s", c.Param("chainID"), err.Error()))
+	}
+
+	body, err := readBody(c, maxBatchBodySizeConst)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contentType := c.Request().Header.Get("Content-Type")
+	if strings.Contains(strings.ToLower(contentType), "json") {
+		r := new(model.OffLedgerRequestBatchBody)
+		if err = c.Bind(r); err != nil {
+			return nil, nil, httperrors.BadRequest("Error parsing requests from payload")
+		}
+		if len(r.Requests) > maxBatchSizeConst {
+			return nil, nil, httperrors.BadRequest(fmt.Sprintf("Too many requests in the batch, max %d", maxBatchSizeConst))
+		}
+		items = make([]*batchItem, len(r.Requests))
+		for i, reqBytes := range r.Requests {
+			items[i] = newBatchItem(reqBytes.Bytes())
+		}
+		return chainID, items, nil
+	}
+
+	// binary format
+	r := bytes.NewReader(body)
+	for r.Len() > 0 {
+		if len(items) == maxBatchSizeConst {
+			return nil, nil, httperrors.BadRequest(fmt.Sprintf("Too many requests in the batch, max %d", maxBatchSizeConst))
+		}
+		reqBytes, err := readRequestBytes(r)
+		if err != nil {
+			return nil, nil, httperrors.BadRequest(fmt.Sprintf("Error parsing requests from payload: %v", err))
+		}
+		items = append(items, newBatchItem(reqBytes))
+	}
+	return chainID, items, nil
+}
+
+// readRequestBytes reads one binary encoded request, prefixed by its length
+func readRequestBytes(r io.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, err
+	}
+	if size > maxRequestSizeConst {
+		return nil, fmt.Errorf("request too large: %d bytes", size)
+	}
+	ret := make([]byte, size)
+	if _, err := io.ReadFull(r, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
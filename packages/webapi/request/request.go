@@ -1,6 +1,7 @@
 package request
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,26 +21,35 @@ import (
 	"github.com/iotaledger/wasp/packages/webapi/routes"
 	"github.com/labstack/echo/v4"
 	"github.com/pangpanglabs/echoswagger/v2"
+	"golang.org/x/xerrors"
 )
 
 type (
 	getAccountBalanceFn       func(ch chain.Chain, agentID *iscp.AgentID) (colored.Balances, error)
+	getAccountNonceFn         func(ch chain.Chain, agentID *iscp.AgentID) (uint64, error)
 	hasRequestBeenProcessedFn func(ch chain.Chain, reqID iscp.RequestID) (bool, error)
+	checkACLFn                func(ch chain.Chain, agentID *iscp.AgentID, target iscp.Hname) error
 )
 
 func AddEndpoints(
 	server echoswagger.ApiRouter,
 	getChain chains.ChainProvider,
 	getChainBalance getAccountBalanceFn,
+	getAccountNonce getAccountNonceFn,
 	hasRequestBeenProcessed hasRequestBeenProcessedFn,
+	checkACL checkACLFn,
 	cacheTTL time.Duration,
+	streamOrigins []string,
 	log *logger.Logger,
 ) {
 	instance := &offLedgerReqAPI{
 		getChain:                getChain,
 		getAccountBalance:       getChainBalance,
+		getAccountNonce:         getAccountNonce,
 		hasRequestBeenProcessed: hasRequestBeenProcessed,
+		checkACL:                checkACL,
 		requestsCache:           expiringcache.New(cacheTTL),
+		streamOrigins:           streamOrigins,
 		log:                     log,
 	}
 	server.POST(routes.NewRequest(":chainID"), instance.handleNewRequest).
@@ -51,13 +61,31 @@ func AddEndpoints(
 			"Offledger Request encoded in base64. Optionally, the body can be the binary representation of the offledger request, but mime-type must be specified to \"application/octet-stream\"",
 			false).
 		AddResponse(http.StatusAccepted, "Request submitted", nil, nil)
+
+	server.POST(routes.NewRequestBatch(":chainID"), instance.handleNewRequestBatch).
+		SetSummary("New batch of off-ledger requests").
+		AddParamPath("", "chainID", "chainID represented in base58").
+		AddParamBody(
+			model.OffLedgerRequestBatchBody{Requests: []model.Bytes{"base64 string"}},
+			"Requests",
+			"Offledger Requests encoded in base64. Optionally, the body can be the concatenation of the binary representations of the offledger requests, each one prefixed by its length (uint32, little endian), but mime-type must be specified to \"application/octet-stream\"",
+			false).
+		AddResponse(http.StatusOK, "Result of each request in the batch", model.OffLedgerRequestBatchResponse{}, nil)
+
+	server.GET(routes.NewRequestStream(":chainID"), instance.handleNewRequestStream).
+		SetSummary("Stream of off-ledger requests (websocket)").
+		SetDescription("Each binary message sent by the client is an off-ledger request. A JSON encoded OffLedgerRequestResult is sent back for each of them.").
+		AddParamPath("", "chainID", "chainID represented in base58")
 }
 
 type offLedgerReqAPI struct {
 	getChain                chains.ChainProvider
 	getAccountBalance       getAccountBalanceFn
+	getAccountNonce         getAccountNonceFn
 	hasRequestBeenProcessed hasRequestBeenProcessedFn
+	checkACL                checkACLFn
 	requestsCache           *expiringcache.ExpiringCache
+	streamOrigins           []string
 	log                     *logger.Logger
 }
 
@@ -78,37 +106,75 @@ func (o *offLedgerReqAPI) handleNewRequest(c echo.Context) error {
 		return httperrors.NotFound(fmt.Sprintf("Unknown chain: %s", chainID.Base58()))
 	}
 
-	reqID := offLedgerReq.ID()
+	if err := o.checkNotProcessed(ch, offLedgerReq.ID()); err != nil {
+		return err
+	}
+
+	if err := o.checkAllowed(ch, offLedgerReq); err != nil {
+		return err
+	}
+
+	// check user has on-chain balance and the nonce is not too old
+	maxAssumedNonce, err := o.checkSender(ch, offLedgerReq.SenderAccount())
+	if err != nil {
+		return err
+	}
+	if !isNonceAcceptable(offLedgerReq.Nonce(), maxAssumedNonce) {
+		return httperrors.BadRequest(fmt.Sprintf("Nonce %d is too old", offLedgerReq.Nonce()))
+	}
+
+	o.requestsCache.Set(offLedgerReq.ID(), true)
+	ch.ReceiveOffLedgerRequest(offLedgerReq, "")
+
+	return c.NoContent(http.StatusAccepted)
+}
 
+// checkNotProcessed fails if the request was already submitted recently or was processed by the chain
+func (o *offLedgerReqAPI) checkNotProcessed(ch chain.Chain, reqID iscp.RequestID) error {
 	if o.requestsCache.Get(reqID) != nil {
 		return httperrors.BadRequest("request already processed")
 	}
 
 	alreadyProcessed, err := o.hasRequestBeenProcessed(ch, reqID)
 	if err != nil {
-		o.log.Errorf("webapi.offledger - check if already processed: %w", err)
+		o.log.Errorf("webapi.offledger - check if already processed: %v", err)
 		return httperrors.ServerError("internal error")
 	}
 
 	if alreadyProcessed {
 		return httperrors.BadRequest("request already processed")
 	}
+	return nil
+}
 
-	// check user has on-chain balance
-	balances, err := o.getAccountBalance(ch, offLedgerReq.SenderAccount())
-	if err != nil {
-		o.log.Errorf("webapi.offledger - account balance: %w", err)
-		return httperrors.ServerError("Unable to get account balance")
+// checkAllowed fails if the access control lists of the chain do not allow the sender to post the request,
+// the mempool would not admit it
+func (o *offLedgerReqAPI) checkAllowed(ch chain.Chain, req *request.OffLedger) error {
+	target, _ := req.Target()
+	err := o.checkACL(ch, req.SenderAccount(), target)
+	if err == nil {
+		return nil
 	}
-
-	o.requestsCache.Set(reqID, true)
-
-	if len(balances) == 0 {
-		return httperrors.BadRequest(fmt.Sprintf("No balance on account %s", offLedgerReq.SenderAccount().Base58()))
+	var vmErr *iscp.VMError
+	if xerrors.As(err, &vmErr) {
+		return httperrors.Forbidden(vmErr.Error())
 	}
-	ch.ReceiveOffLedgerRequest(offLedgerReq, "")
+	o.log.Errorf("webapi.offledger - check access control lists: %v", err)
+	return httperrors.ServerError("internal error")
+}
 
-	return c.NoContent(http.StatusAccepted)
+// readBody reads the whole request body, failing if it is larger than maxSize bytes.
+// The body is put back into the request, so it can be bound afterwards
+func readBody(c echo.Context, maxSize int64) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxSize+1))
+	if err != nil {
+		return nil, httperrors.BadRequest("Error reading request body")
+	}
+	if int64(len(body)) > maxSize {
+		return nil, httperrors.PayloadTooLarge(fmt.Sprintf("Request body too large, max %d bytes", maxSize))
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
 }
 
 func parseParams(c echo.Context) (chainID *iscp.ChainID, req *request.OffLedger, err error) {
@@ -117,6 +183,12 @@ func parseParams(c echo.Context) (chainID *iscp.ChainID, req *request.OffLedger,
 		return nil, nil, httperrors.BadRequest(fmt.Sprintf("Invalid Chain ID %+v: %s", c.Param("chainID"), err.Error()))
 	}
 
+	// base64 encoding in the JSON body takes 4/3 of the binary size
+	body, err := readBody(c, 2*maxRequestSizeConst)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	contentType := c.Request().Header.Get("Content-Type")
 	if strings.Contains(strings.ToLower(contentType), "json") {
 		r := new(model.OffLedgerRequestBody)
@@ -135,11 +207,7 @@ func parseParams(c echo.Context) (chainID *iscp.ChainID, req *request.OffLedger,
 	}
 
 	// binary format
-	reqBytes, err := io.ReadAll(c.Request().Body)
-	if err != nil {
-		return nil, nil, httperrors.BadRequest("Error parsing request from payload")
-	}
-	rGeneric, err := request.FromMarshalUtil(marshalutil.New(reqBytes))
+	rGeneric, err := request.FromMarshalUtil(marshalutil.New(body))
 	if err != nil {
 		return nil, nil, httperrors.BadRequest("Error parsing request from payload")
 	}
@@ -1,7 +1,11 @@
 package request
 
 import (
+	"context"
+	"encoding/binary"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,9 +22,14 @@ import (
 	"github.com/iotaledger/wasp/packages/testutil/testkey"
 	"github.com/iotaledger/wasp/packages/testutil/testlogger"
 	"github.com/iotaledger/wasp/packages/util/expiringcache"
+	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
+	"github.com/iotaledger/wasp/packages/vm/vmcontext"
 	"github.com/iotaledger/wasp/packages/webapi/model"
 	"github.com/iotaledger/wasp/packages/webapi/routes"
 	"github.com/iotaledger/wasp/packages/webapi/testutil"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
 )
 
 type mockedChain struct {
@@ -31,6 +40,18 @@ func (m *mockedChain) GetRequestProcessingStatus(_ iscp.RequestID) chain.Request
 	panic("implement me")
 }
 
+func (m *mockedChain) GetRequestPropagationInfo(_ iscp.RequestID) *chain.RequestPropagationInfo {
+	panic("implement me")
+}
+
+func (m *mockedChain) GetRequestReceipt(_ iscp.RequestID) (*blocklog.RequestReceipt, error) {
+	panic("implement me")
+}
+
+func (m *mockedChain) GetTransactionRequestIDs(_ ledgerstate.TransactionID) ([]iscp.RequestID, bool) {
+	panic("implement me")
+}
+
 func (m *mockedChain) EventRequestProcessed() *events.Event {
 	panic("implement me")
 }
@@ -77,6 +98,12 @@ func hasRequestBeenProcessedMocked(ret bool) hasRequestBeenProcessedFn {
 	}
 }
 
+func checkACLMocked(ret error) checkACLFn {
+	return func(_ chain.Chain, _ *iscp.AgentID, _ iscp.Hname) error {
+		return ret
+	}
+}
+
 func dummyOffledgerRequest() *request.OffLedger {
 	contract := iscp.Hn("somecontract")
 	entrypoint := iscp.Hn("someentrypoint")
@@ -91,7 +118,9 @@ func TestNewRequestBase64(t *testing.T) {
 	instance := &offLedgerReqAPI{
 		getChain:                createMockedGetChain(t),
 		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
 		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
 		requestsCache:           expiringcache.New(10 * time.Second),
 	}
 
@@ -111,7 +140,9 @@ func TestNewRequestBinary(t *testing.T) {
 	instance := &offLedgerReqAPI{
 		getChain:                createMockedGetChain(t),
 		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
 		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
 		requestsCache:           expiringcache.New(10 * time.Second),
 	}
 
@@ -131,7 +162,9 @@ func TestRequestAlreadyProcessed(t *testing.T) {
 	instance := &offLedgerReqAPI{
 		getChain:                createMockedGetChain(t),
 		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
 		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(true),
+		checkACL:                checkACLMocked(nil),
 		requestsCache:           expiringcache.New(10 * time.Second),
 	}
 
@@ -146,3 +179,247 @@ func TestRequestAlreadyProcessed(t *testing.T) {
 		http.StatusBadRequest,
 	)
 }
+
+func TestNewRequestNotAllowed(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(iscp.NewVMError(iscp.VMErrorCodeNotAllowed, "sender is not allowed to post requests")),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequest,
+		http.MethodPost,
+		routes.NewRequest(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		dummyOffledgerRequest().Bytes(),
+		nil,
+		http.StatusForbidden,
+	)
+
+	res := &model.OffLedgerRequestBatchResponse{}
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		model.OffLedgerRequestBatchBody{Requests: []model.Bytes{model.NewBytes(dummyOffledgerRequest().Bytes())}},
+		res,
+		http.StatusOK,
+	)
+	require.Len(t, res.Results, 1)
+	require.False(t, res.Results[0].Accepted)
+	require.NotEmpty(t, res.Results[0].Error)
+}
+
+func getAccountNonceMocked(ret uint64) getAccountNonceFn {
+	return func(_ chain.Chain, _ *iscp.AgentID) (uint64, error) {
+		return ret, nil
+	}
+}
+
+func TestNewRequestBatch(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	valid := dummyOffledgerRequest()
+	invalidSignature := dummyOffledgerRequest()
+	invalidSignature.WithNonce(invalidSignature.Nonce() + 1)
+
+	body := model.OffLedgerRequestBatchBody{Requests: []model.Bytes{
+		model.NewBytes(valid.Bytes()),
+		model.NewBytes(invalidSignature.Bytes()),
+		model.NewBytes(valid.Bytes()),
+		model.NewBytes([]byte{1, 2, 3}),
+	}}
+	res := &model.OffLedgerRequestBatchResponse{}
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		body,
+		res,
+		http.StatusOK,
+	)
+
+	require.Len(t, res.Results, 4)
+	require.True(t, res.Results[0].Accepted)
+	require.Equal(t, valid.ID().Base58(), res.Results[0].RequestID)
+	require.False(t, res.Results[1].Accepted)
+	require.Equal(t, invalidSignature.ID().Base58(), res.Results[1].RequestID)
+	require.False(t, res.Results[2].Accepted)
+	require.NotEmpty(t, res.Results[2].Error)
+	require.False(t, res.Results[3].Accepted)
+	require.Empty(t, res.Results[3].RequestID)
+}
+
+func TestNewRequestBatchBinary(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	var body []byte
+	for i := 0; i < 10; i++ {
+		reqBytes := dummyOffledgerRequest().Bytes()
+		size := make([]byte, 4)
+		binary.LittleEndian.PutUint32(size, uint32(len(reqBytes)))
+		body = append(body, size...)
+		body = append(body, reqBytes...)
+	}
+	res := &model.OffLedgerRequestBatchResponse{}
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		body,
+		res,
+		http.StatusOK,
+	)
+
+	require.Len(t, res.Results, 10)
+	for _, r := range res.Results {
+		require.True(t, r.Accepted, r.Error)
+	}
+
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		body[:len(body)-1],
+		nil,
+		http.StatusBadRequest,
+	)
+}
+
+func TestNewRequestBatchOldNonce(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(2 * vmcontext.OffLedgerNonceStrictOrderTolerance),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	oldNonce := request.NewOffLedger(iscp.Hn("somecontract"), iscp.Hn("someentrypoint"), requestargs.New(dict.Dict{}))
+	oldNonce.WithNonce(1)
+	keys, _ := testkey.GenKeyAddr()
+	oldNonce.Sign(keys)
+	res := &model.OffLedgerRequestBatchResponse{}
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		model.OffLedgerRequestBatchBody{Requests: []model.Bytes{model.NewBytes(oldNonce.Bytes())}},
+		res,
+		http.StatusOK,
+	)
+	require.Len(t, res.Results, 1)
+	require.False(t, res.Results[0].Accepted)
+}
+
+func TestNewRequestOldNonce(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(2 * vmcontext.OffLedgerNonceStrictOrderTolerance),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	oldNonce := request.NewOffLedger(iscp.Hn("somecontract"), iscp.Hn("someentrypoint"), requestargs.New(dict.Dict{}))
+	oldNonce.WithNonce(1)
+	keys, _ := testkey.GenKeyAddr()
+	oldNonce.Sign(keys)
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequest,
+		http.MethodPost,
+		routes.NewRequest(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		oldNonce.Bytes(),
+		nil,
+		http.StatusBadRequest,
+	)
+}
+
+func TestNewRequestBatchTooLarge(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+
+	testutil.CallWebAPIRequestHandler(
+		t,
+		instance.handleNewRequestBatch,
+		http.MethodPost,
+		routes.NewRequestBatch(":chainID"),
+		map[string]string{"chainID": iscp.RandomChainID().Base58()},
+		make([]byte, maxBatchBodySizeConst+1),
+		nil,
+		http.StatusRequestEntityTooLarge,
+	)
+}
+
+func TestNewRequestStreamOrigin(t *testing.T) {
+	instance := &offLedgerReqAPI{
+		getChain:                createMockedGetChain(t),
+		getAccountBalance:       getAccountBalanceMocked,
+		getAccountNonce:         getAccountNonceMocked(0),
+		hasRequestBeenProcessed: hasRequestBeenProcessedMocked(false),
+		checkACL:                checkACLMocked(nil),
+		requestsCache:           expiringcache.New(10 * time.Second),
+	}
+	e := echo.New()
+	e.GET(routes.NewRequestStream(":chainID"), instance.handleNewRequestStream)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + routes.NewRequestStream(iscp.RandomChainID().Base58())
+
+	dial := func(origin string) error {
+		conn, _, err := websocket.Dial(context.Background(), url, &websocket.DialOptions{
+			HTTPHeader: http.Header{"Origin": []string{origin}},
+		})
+		if err != nil {
+			return err
+		}
+		return conn.Close(websocket.StatusNormalClosure, "")
+	}
+
+	// same origin only by default
+	require.NoError(t, dial(srv.URL))
+	require.Error(t, dial("http://example.com"))
+
+	instance.streamOrigins = []string{"example.com"}
+	require.NoError(t, dial("http://example.com"))
+	require.Error(t, dial("http://other.example.com"))
+}
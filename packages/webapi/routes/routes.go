@@ -11,6 +11,14 @@ func NewRequest(chainID string) string {
 	return "/request/" + chainID
 }
 
+func NewRequestBatch(chainID string) string {
+	return "/request/" + chainID + "/batch"
+}
+
+func NewRequestStream(chainID string) string {
+	return "/request/" + chainID + "/stream"
+}
+
 func CallView(chainID, contractHname, functionName string) string {
 	return "chain/" + chainID + "/contract/" + contractHname + "/callview/" + functionName
 }
@@ -23,6 +31,10 @@ func WaitRequestProcessed(chainID, reqID string) string {
 	return "/chain/" + chainID + "/request/" + reqID + "/wait"
 }
 
+func RequestsStatus(chainID string) string {
+	return "/chain/" + chainID + "/requests/status"
+}
+
 func StateGet(chainID, key string) string {
 	return "/chain/" + chainID + "/state/" + key
 }
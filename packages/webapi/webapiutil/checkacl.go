@@ -0,0 +1,18 @@
+package webapiutil
+
+import (
+	"github.com/iotaledger/wasp/packages/chain"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv/optimism"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+)
+
+// CheckACL checks the access control lists of the chain, like the mempool does.
+// A rejection by the lists is returned as an *iscp.VMError
+func CheckACL(ch chain.Chain, agentID *iscp.AgentID, target iscp.Hname) error {
+	return optimism.RetryOnStateInvalidated(func() error {
+		stateReader := ch.GetStateReader()
+		stateReader.SetBaseline()
+		return governance.CheckACLFromChainState(stateReader.KVStoreReader(), agentID, target)
+	})
+}
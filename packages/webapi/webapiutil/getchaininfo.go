@@ -18,3 +18,15 @@ func GetAccountBalance(ch chain.Chain, agentID *iscp.AgentID) (colored.Balances,
 	}
 	return accounts.DecodeBalances(ret)
 }
+
+// GetAccountNonce returns the max nonce of the off-ledger requests from the account, assumed by the chain
+func GetAccountNonce(ch chain.Chain, agentID *iscp.AgentID) (uint64, error) {
+	params := codec.MakeDict(map[string]interface{}{
+		accounts.ParamAgentID: codec.EncodeAgentID(agentID),
+	})
+	ret, err := CallView(ch, accounts.Contract.Hname(), accounts.FuncGetAccountNonce.Hname(), params)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeUint64(ret.MustGet(accounts.ParamAccountNonce), 0)
+}
@@ -6,12 +6,18 @@
 package wasmtimevm
 
 import (
+	"path/filepath"
+
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/wasp/packages/hashing"
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/parameters"
 	"github.com/iotaledger/wasp/packages/vm/vmtypes"
+	"github.com/iotaledger/wasp/packages/vm/wasmhost"
 	"github.com/iotaledger/wasp/packages/vm/wasmproc"
 	"github.com/iotaledger/wasp/plugins/processors"
+	"github.com/iotaledger/wasp/plugins/registry"
 )
 
 // pluginName is the name of the plugin.
@@ -26,11 +32,22 @@ func Init() *node.Plugin {
 func configure(_ *node.Plugin) {
 	log = logger.NewLogger(pluginName)
 
+	cacheDir := parameters.GetString(parameters.WasmModuleCacheDir)
+	if cacheDir == "" && !parameters.GetBool(parameters.DatabaseInMemory) {
+		cacheDir = filepath.Join(parameters.GetString(parameters.DatabaseDir), "wasm")
+	}
+	var secret []byte
+	if cacheDir != "" {
+		secret = moduleCacheSecret()
+		log.Infof("compiled Wasm modules are cached in %s", cacheDir)
+	}
+	wasmhost.DefaultModuleCache = wasmhost.NewModuleCache(cacheDir, parameters.GetInt(parameters.WasmModuleCacheSize), secret)
+
 	// register VM type(s)
-	err := processors.Config.RegisterVMType(vmtypes.WasmTime, func(binary []byte) (iscp.VMProcessor, error) {
+	err := processors.Config.RegisterVMType(vmtypes.WasmTime, func(programHash hashing.HashValue, binary []byte) (iscp.VMProcessor, error) {
 		// TODO (via config?) pass non-default timeout for WasmTime processor like this:
 		// WasmTimeout = 3 * time.Second
-		return wasmproc.GetProcessor(binary, log)
+		return wasmproc.GetProcessor(programHash, binary, log)
 	})
 	if err != nil {
 		log.Panicf("%v: %v", pluginName, err)
@@ -40,3 +57,14 @@ func configure(_ *node.Plugin) {
 
 func run(_ *node.Plugin) {
 }
+
+// moduleCacheSecret derives the key that authenticates the cached modules
+// from the node identity, so that it is never stored next to the cache
+func moduleCacheSecret() []byte {
+	nodeKeyPair, err := registry.DefaultRegistry().GetNodeIdentity()
+	if err != nil {
+		log.Panicf("%v: %v", pluginName, err)
+	}
+	secret := hashing.HashData([]byte("wasm module cache"), nodeKeyPair.PrivateKey.Bytes())
+	return secret[:]
+}
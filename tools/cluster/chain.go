@@ -236,11 +236,21 @@ func (ch *Chain) GetAllBlockInfoRecordsReverse(nodeIndex ...int) ([]*blocklog.Bl
 
 func (ch *Chain) ContractRegistry(nodeIndex ...int) (map[iscp.Hname]*root.ContractRecord, error) {
 	cl := ch.SCClient(root.Contract.Hname(), nil, nodeIndex...)
-	ret, err := cl.CallView(root.FuncGetContractRecords.Name, nil)
+	ret := make(map[iscp.Hname]*root.ContractRecord)
+	err := cl.CallViewPaged(root.FuncGetContractRecords.Name, nil, func(page dict.Dict) error {
+		recs, err := root.DecodeContractRegistry(collections.NewMapReadOnly(page, root.VarContractRegistry))
+		if err != nil {
+			return err
+		}
+		for hname, rec := range recs {
+			ret[hname] = rec
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return root.DecodeContractRegistry(collections.NewMapReadOnly(ret, root.VarContractRegistry))
+	return ret, nil
 }
 
 func (ch *Chain) GetCounterValue(inccounterSCHname iscp.Hname, nodeIndex ...int) (int64, error) {
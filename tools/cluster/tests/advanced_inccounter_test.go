@@ -439,7 +439,9 @@ func waitRequest(t *testing.T, chain *cluster.Chain, nodeIndex int, reqid iscp.R
 	succ := waitTrue(timeout, func() bool {
 		rec, err := callGetRequestRecord(t, chain, nodeIndex, reqid)
 		if err == nil && rec != nil {
-			ret = rec.Error
+			if rec.Error != nil {
+				ret = rec.Error.Error()
+			}
 			return true
 		}
 		return false
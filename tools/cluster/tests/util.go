@@ -9,9 +9,9 @@ import (
 	"github.com/iotaledger/wasp/contracts/native/inccounter"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
 	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
-	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/solo"
 	"github.com/iotaledger/wasp/packages/vm/core"
@@ -43,11 +43,7 @@ func (e *chainEnv) checkCoreContracts() {
 		require.NoError(e.t, err)
 		require.EqualValues(e.t, e.chain.Description, desc)
 
-		records, err := e.chain.SCClient(root.Contract.Hname(), nil, i).
-			CallView(root.FuncGetContractRecords.Name, nil)
-		require.NoError(e.t, err)
-
-		contractRegistry, err := root.DecodeContractRegistry(collections.NewMapReadOnly(records, root.VarContractRegistry))
+		contractRegistry, err := e.chain.ContractRegistry(i)
 		require.NoError(e.t, err)
 		for _, rec := range core.AllCoreContractsByHash {
 			cr := contractRegistry[rec.Contract.Hname()]
@@ -107,18 +103,21 @@ func (e *chainEnv) checkBalanceOnChain(agentID *iscp.AgentID, col colored.Color,
 }
 
 func (e *chainEnv) getAccountsOnChain() []*iscp.AgentID {
-	r, err := e.chain.Cluster.WaspClient(0).CallView(
-		e.chain.ChainID, accounts.Contract.Hname(), accounts.FuncViewAccounts.Name, nil,
-	)
-	require.NoError(e.t, err)
-
 	ret := make([]*iscp.AgentID, 0)
-	for key := range r {
-		aid, err := iscp.AgentIDFromBytes([]byte(key))
-		require.NoError(e.t, err)
-
-		ret = append(ret, aid)
-	}
+	err := coreutil.CallPaged(nil, func(args dict.Dict) (dict.Dict, error) {
+		return e.chain.Cluster.WaspClient(0).CallView(
+			e.chain.ChainID, accounts.Contract.Hname(), accounts.FuncViewAccounts.Name, args,
+		)
+	}, func(page dict.Dict) error {
+		for key := range page {
+			aid, err := iscp.AgentIDFromBytes([]byte(key))
+			if err != nil {
+				return err
+			}
+			ret = append(ret, aid)
+		}
+		return nil
+	})
 	require.NoError(e.t, err)
 
 	return ret
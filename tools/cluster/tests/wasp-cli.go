@@ -47,6 +47,7 @@ func (w *WaspCLITest) runCmd(args []string, f func(*exec.Cmd)) []string {
 	// -d: debug output
 	cmd := exec.Command("wasp-cli", append([]string{"-w", "-d"}, args...)...) //nolint:gosec
 	cmd.Dir = w.dir
+	cmd.Env = append(os.Environ(), "WASP_CLI_PASSPHRASE=wasp-cli-test")
 
 	stdout := &bytes.Buffer{}
 	cmd.Stdout = stdout
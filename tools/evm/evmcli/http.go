@@ -4,10 +4,18 @@
 package evmcli
 
 import (
+	"bytes"
+	"context"
 	"crypto/ecdsa"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -16,52 +24,112 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/spf13/cobra"
+	"golang.org/x/time/rate"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
 )
 
+// maxRequestBodySize is the maximum size of a JSON-RPC request over HTTP, the
+// same limit enforced by the go-ethereum RPC server
+const maxRequestBodySize = 5 * 1024 * 1024
+
 type JSONRPCServer struct {
 	listenAddr       string
 	corsAllowOrigins []string
 	unlockedAccount  string
+	unlockedKeys     []*ecdsa.PrivateKey
+
+	rateLimit          float64
+	rateLimitBurst     int
+	maxBatchSize       int
+	maxQueuedPerSender int
+	maxQueuedSenders   int
+	maxQueuedTotal     int
+	queueExpiry        time.Duration
+	receiptCacheSize   int
 }
 
 func (j *JSONRPCServer) InitFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&j.listenAddr, "listen", "l", ":8545", "JSON-RPC listen address")
 	cmd.Flags().StringSliceVarP(&j.corsAllowOrigins, "cors", "", []string{"*"}, "CORS allow origins")
+}
+
+// InitGatewayFlags adds the flags to configure the server as a public gateway
+func (j *JSONRPCServer) InitGatewayFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64VarP(&j.rateLimit, "rate-limit", "", 20, "maximum requests per second per IP address (0: unlimited)")
+	cmd.Flags().IntVarP(&j.rateLimitBurst, "rate-limit-burst", "", 100, "maximum requests per IP address in a burst")
+	cmd.Flags().IntVarP(&j.maxBatchSize, "max-batch-size", "", 100, "maximum amount of calls in a JSON-RPC batch request (0: unlimited)")
+	cmd.Flags().IntVarP(&j.maxQueuedPerSender, "max-queued", "", jsonrpc.DefaultTxQueueMaxPerSender, "maximum amount of queued transactions per sender (0: wait until each transaction is processed)")
+	cmd.Flags().IntVarP(&j.maxQueuedSenders, "max-queued-senders", "", jsonrpc.DefaultTxQueueMaxSenders, "maximum amount of senders with queued transactions (0: unlimited)")
+	cmd.Flags().IntVarP(&j.maxQueuedTotal, "max-queued-total", "", jsonrpc.DefaultTxQueueMaxTotal, "maximum amount of queued transactions of all senders (0: unlimited)")
+	cmd.Flags().DurationVarP(&j.queueExpiry, "queue-expiry", "", jsonrpc.DefaultTxQueueExpiry, "time after which a transaction held because of a nonce gap is evicted (0: never)")
+	cmd.Flags().IntVarP(&j.receiptCacheSize, "receipt-cache", "", 10000, "amount of transaction receipts to keep in memory (0: disabled)")
+}
+
+// InitAccountFlag adds the flag to unlock an account given as a hex-encoded private key
+func (j *JSONRPCServer) InitAccountFlag(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&j.unlockedAccount, "account", "", "", "unlocked account (hex-encoded private key)")
 }
 
+// UnlockAccount makes the account available to eth_sendTransaction
+func (j *JSONRPCServer) UnlockAccount(key *ecdsa.PrivateKey) {
+	j.unlockedKeys = append(j.unlockedKeys, key)
+}
+
 func (j *JSONRPCServer) getUnlockedAccount() []*ecdsa.PrivateKey {
 	if j.unlockedAccount == "" {
-		return nil
+		return j.unlockedKeys
 	}
 	account, err := crypto.HexToECDSA(j.unlockedAccount)
 	log.Check(err)
-	return []*ecdsa.PrivateKey{account}
+	return append([]*ecdsa.PrivateKey{account}, j.unlockedKeys...)
 }
 
 func (j *JSONRPCServer) ServeJSONRPC(backend jsonrpc.ChainBackend, chainID int, contractName string) {
 	evmChain := jsonrpc.NewEVMChain(backend, chainID, contractName)
+	if j.maxQueuedPerSender > 0 {
+		evmChain.EnableTxQueue(jsonrpc.TxQueueLimits{
+			MaxPerSender: j.maxQueuedPerSender,
+			MaxSenders:   j.maxQueuedSenders,
+			MaxTotal:     j.maxQueuedTotal,
+			Expiry:       j.queueExpiry,
+		})
+	}
+	if j.receiptCacheSize > 0 {
+		log.Check(evmChain.EnableReceiptCache(j.receiptCacheSize))
+	}
 
 	accountManager := jsonrpc.NewAccountManager(j.getUnlockedAccount())
 
 	rpcsrv := jsonrpc.NewServer(evmChain, accountManager)
 	defer rpcsrv.Stop()
 
-	j.serveHTTP(rpcsrv)
+	fmt.Printf("Starting JSON-RPC server on %s\n", j.listenAddr)
+	if err := j.newHTTPServer(rpcsrv).Start(j.listenAddr); err != nil {
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Check(err)
+		}
+	}
 }
 
-func (j *JSONRPCServer) serveHTTP(rpcsrv *rpc.Server) {
+// newHTTPServer serves JSON-RPC over HTTP and websocket on the same endpoint
+func (j *JSONRPCServer) newHTTPServer(rpcsrv *rpc.Server) *echo.Echo {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
+	// do not trust the X-Forwarded-For / X-Real-IP headers for rate limiting
+	e.IPExtractor = echo.ExtractIPDirect()
 	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 		Format: "[${time_rfc3339}] ${status} ${method} ${path} (${remote_ip}) ${latency_human}\n",
 		Output: e.Logger.Output(),
 	}))
 	if log.DebugFlag {
-		e.Use(middleware.BodyDump(func(c echo.Context, reqBody, resBody []byte) {
-			fmt.Printf("REQUEST:  %s\n", string(reqBody))
-			fmt.Printf("RESPONSE: %s\n", string(resBody))
+		e.Use(middleware.BodyDumpWithConfig(middleware.BodyDumpConfig{
+			Skipper: isWebsocket,
+			Handler: func(c echo.Context, reqBody, resBody []byte) {
+				fmt.Printf("REQUEST:  %s\n", string(reqBody))
+				fmt.Printf("RESPONSE: %s\n", string(resBody))
+			},
 		}))
 	}
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -69,12 +137,212 @@ func (j *JSONRPCServer) serveHTTP(rpcsrv *rpc.Server) {
 		AllowMethods: []string{http.MethodPost, http.MethodGet},
 		AllowHeaders: []string{"*"},
 	}))
-	e.Any("/", echo.WrapHandler(rpcsrv))
+	// the body size is limited regardless of the batch size limit
+	e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+		Skipper: isWebsocket,
+		Limit:   fmt.Sprint(maxRequestBodySize),
+	}))
+	var rateLimiter middleware.RateLimiterStore
+	if j.rateLimit > 0 {
+		rateLimiter = middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+			Rate:  rate.Limit(j.rateLimit),
+			Burst: j.rateLimitBurst,
+		})
+		e.Use(middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+			Store: rateLimiter,
+			IdentifierExtractor: func(c echo.Context) (string, error) {
+				return c.RealIP(), nil
+			},
+		}))
+	}
+	if j.maxBatchSize > 0 {
+		e.Use(limitBatchSize(j.maxBatchSize))
+	}
 
-	fmt.Printf("Starting JSON-RPC server on %s\n", j.listenAddr)
-	if err := e.Start(j.listenAddr); err != nil {
-		if !errors.Is(err, http.ErrServerClosed) {
-			log.Check(err)
+	e.Any("/", func(c echo.Context) error {
+		if isWebsocket(c) {
+			return j.serveWebsocket(c, rpcsrv, rateLimiter)
+		}
+		rpcsrv.ServeHTTP(c.Response(), c.Request())
+		return nil
+	})
+	return e
+}
+
+// serveWebsocket serves JSON-RPC over a websocket connection. The limits that
+// apply to HTTP requests are applied to every message of the connection.
+func (j *JSONRPCServer) serveWebsocket(c echo.Context, rpcsrv *rpc.Server, rateLimiter middleware.RateLimiterStore) error {
+	opts := &websocket.AcceptOptions{}
+	for _, origin := range j.corsAllowOrigins {
+		if origin == "*" {
+			opts.InsecureSkipVerify = true
+			break
+		}
+		// CORS origins are URLs, but origin patterns only match the host
+		if u, err := url.Parse(origin); err == nil && u.Host != "" {
+			origin = u.Host
+		}
+		opts.OriginPatterns = append(opts.OriginPatterns, origin)
+	}
+	conn, err := websocket.Accept(c.Response(), c.Request(), opts)
+	if err != nil {
+		// Accept has already written the response
+		return nil
+	}
+	conn.SetReadLimit(maxRequestBodySize)
+	ws := &wsConn{
+		conn:         conn,
+		ip:           c.RealIP(),
+		rateLimiter:  rateLimiter,
+		maxBatchSize: j.maxBatchSize,
+	}
+	rpcsrv.ServeCodec(rpc.NewFuncCodec(ws, ws.encode, ws.decode), 0)
+	return nil
+}
+
+func isWebsocket(c echo.Context) bool {
+	r := c.Request()
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// limitBatchSize rejects JSON-RPC batch requests with more than max calls
+func limitBatchSize(max int) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method != http.MethodPost || isWebsocket(c) {
+				return next(c)
+			}
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return err
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+			if size := batchSize(body); size > max {
+				return c.JSON(http.StatusOK, errorResponse(nil, errCodeInvalidRequest,
+					fmt.Sprintf("batch too large: %d calls, maximum is %d", size, max)))
+			}
+			return next(c)
 		}
 	}
 }
+
+const (
+	errCodeInvalidRequest = -32600
+	errCodeLimitExceeded  = -32005
+)
+
+// batchSize returns the amount of calls in a JSON-RPC batch request,
+// or 0 if the message is not a valid batch request
+func batchSize(msg []byte) int {
+	trimmed := bytes.TrimLeft(msg, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return 0
+	}
+	var batch []json.RawMessage
+	if err := json.Unmarshal(trimmed, &batch); err != nil {
+		// let the JSON-RPC server report the error
+		return 0
+	}
+	return len(batch)
+}
+
+func errorResponse(id json.RawMessage, code int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	}
+}
+
+// wsConn is the transport of a JSON-RPC websocket connection.
+// It answers messages that exceed the limits with an error itself, so
+// that they never reach the JSON-RPC server.
+type wsConn struct {
+	conn         *websocket.Conn
+	ip           string
+	rateLimiter  middleware.RateLimiterStore
+	maxBatchSize int
+
+	mutex         sync.Mutex
+	writeDeadline time.Time
+}
+
+func (ws *wsConn) Close() error {
+	return ws.conn.Close(websocket.StatusNormalClosure, "")
+}
+
+func (ws *wsConn) SetWriteDeadline(deadline time.Time) error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+	ws.writeDeadline = deadline
+	return nil
+}
+
+// decode reads the next message that is within the limits
+func (ws *wsConn) decode(v interface{}) error {
+	for {
+		_, msg, err := ws.conn.Read(context.Background())
+		if err != nil {
+			return err
+		}
+		if err = ws.checkLimits(msg); err != nil {
+			if err = ws.encode(limitResponse(msg, err)); err != nil {
+				return err
+			}
+			continue
+		}
+		return json.Unmarshal(msg, v)
+	}
+}
+
+// limitResponse answers every call of the rejected message with the error,
+// so that clients waiting for the responses of a batch are not left hanging
+func limitResponse(msg []byte, err error) interface{} {
+	type request struct {
+		ID json.RawMessage `json:"id"`
+	}
+	var batch []request
+	if batchSize(msg) == 0 || json.Unmarshal(msg, &batch) != nil {
+		var req request
+		_ = json.Unmarshal(msg, &req)
+		return errorResponse(req.ID, errCodeLimitExceeded, err.Error())
+	}
+	responses := make([]interface{}, 0, len(batch))
+	for _, req := range batch {
+		responses = append(responses, errorResponse(req.ID, errCodeLimitExceeded, err.Error()))
+	}
+	return responses
+}
+
+func (ws *wsConn) checkLimits(msg []byte) error {
+	if ws.rateLimiter != nil {
+		allow, err := ws.rateLimiter.Allow(ws.ip)
+		if err != nil {
+			return err
+		}
+		if !allow {
+			return errors.New("rate limit exceeded")
+		}
+	}
+	if size := batchSize(msg); ws.maxBatchSize > 0 && size > ws.maxBatchSize {
+		return fmt.Errorf("batch too large: %d calls, maximum is %d", size, ws.maxBatchSize)
+	}
+	return nil
+}
+
+func (ws *wsConn) encode(v interface{}) error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ctx := context.Background()
+	if !ws.writeDeadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, ws.writeDeadline)
+		defer cancel()
+	}
+	return wsjson.Write(ctx, ws.conn, v)
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package evmcli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (s *testService) Echo(x int) int { return x }
+
+func newTestServer(t *testing.T, j *JSONRPCServer) *httptest.Server {
+	rpcsrv := rpc.NewServer()
+	require.NoError(t, rpcsrv.RegisterName("test", &testService{}))
+	t.Cleanup(rpcsrv.Stop)
+	srv := httptest.NewServer(j.newHTTPServer(rpcsrv))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestJSONRPCServerBatch(t *testing.T) {
+	srv := newTestServer(t, &JSONRPCServer{corsAllowOrigins: []string{"*"}, maxBatchSize: 2})
+
+	client, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	newBatch := func(n int) []rpc.BatchElem {
+		batch := make([]rpc.BatchElem, n)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{Method: "test_echo", Args: []interface{}{i}, Result: new(int)}
+		}
+		return batch
+	}
+
+	batch := newBatch(2)
+	require.NoError(t, client.BatchCall(batch))
+	for i, elem := range batch {
+		require.NoError(t, elem.Error)
+		require.Equal(t, i, *elem.Result.(*int))
+	}
+
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(
+		`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[1]},`+
+			`{"jsonrpc":"2.0","id":2,"method":"test_echo","params":[2]},`+
+			`{"jsonrpc":"2.0","id":3,"method":"test_echo","params":[3]}]`,
+	))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var body struct {
+		Error struct {
+			Message string
+		}
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Contains(t, body.Error.Message, "batch too large")
+}
+
+func TestJSONRPCServerBodyTooLarge(t *testing.T) {
+	for _, maxBatchSize := range []int{0, 2} {
+		srv := newTestServer(t, &JSONRPCServer{corsAllowOrigins: []string{"*"}, maxBatchSize: maxBatchSize})
+
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(
+			"["+strings.Repeat(" ", maxRequestBodySize)+"]",
+		))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+}
+
+func TestJSONRPCServerWebsocket(t *testing.T) {
+	srv := newTestServer(t, &JSONRPCServer{corsAllowOrigins: []string{"*"}})
+
+	client, err := rpc.DialWebsocket(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http"), "")
+	require.NoError(t, err)
+	defer client.Close()
+
+	var ret int
+	require.NoError(t, client.Call(&ret, "test_echo", 42))
+	require.Equal(t, 42, ret)
+}
+
+func TestJSONRPCServerRateLimit(t *testing.T) {
+	srv := newTestServer(t, &JSONRPCServer{corsAllowOrigins: []string{"*"}, rateLimit: 1, rateLimitBurst: 2})
+
+	client, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var ret int
+	require.NoError(t, client.Call(&ret, "test_echo", 1))
+	require.NoError(t, client.Call(&ret, "test_echo", 2))
+	err = client.Call(&ret, "test_echo", 3)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Too Many Requests")
+}
+
+func TestJSONRPCServerWebsocketLimits(t *testing.T) {
+	srv := newTestServer(t, &JSONRPCServer{corsAllowOrigins: []string{"*"}, maxBatchSize: 2, rateLimit: 1, rateLimitBurst: 2})
+
+	// the websocket handshake counts as the first request
+	client, err := rpc.DialWebsocket(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http"), "")
+	require.NoError(t, err)
+	defer client.Close()
+
+	batch := make([]rpc.BatchElem, 3)
+	for i := range batch {
+		batch[i] = rpc.BatchElem{Method: "test_echo", Args: []interface{}{i}, Result: new(int)}
+	}
+	err = client.BatchCall(batch)
+	if err == nil {
+		err = batch[0].Error
+	}
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "batch too large")
+
+	var ret int
+	err = client.Call(&ret, "test_echo", 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rate limit exceeded")
+}
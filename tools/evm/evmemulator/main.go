@@ -60,6 +60,7 @@ By default the server has no unlocked accounts. To send transactions, either:
 
 	deployParams.InitFlags(cmd)
 	jsonRPCServer.InitFlags(cmd)
+	jsonRPCServer.InitAccountFlag(cmd)
 
 	err := cmd.Execute()
 	log.Check(err)
@@ -0,0 +1,103 @@
+// Copyright 2020 IOTA Stiftung
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/wasp/client"
+	"github.com/iotaledger/wasp/client/chainclient"
+	"github.com/iotaledger/wasp/client/goshimmer"
+	"github.com/iotaledger/wasp/contracts/native/evm"
+	"github.com/iotaledger/wasp/contracts/native/evm/evmchain"
+	"github.com/iotaledger/wasp/packages/evm/jsonrpc"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/tools/evm/evmcli"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+)
+
+const seedEnvVar = "EVMGATEWAY_SEED"
+
+var (
+	jsonRPCServer    evmcli.JSONRPCServer
+	waspAPI          string
+	goshimmerAPI     string
+	chainIDBase58    string
+	addressIndex     uint64
+	evmChainID       int
+	contractName     string
+	requestTimeout   time.Duration
+	resubmitInterval time.Duration
+)
+
+func main() {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Run:   start,
+		Use:   "evmgateway",
+		Short: "evmgateway is a public JSON-RPC gateway to an Ethereum blockchain running on an ISCP chain",
+		Long: `evmgateway is a public JSON-RPC gateway to an Ethereum blockchain running on an ISCP chain.
+
+The gateway connects to a Wasp node, and serves JSON-RPC over HTTP and
+websocket on the same endpoint, so that any Ethereum tool (eg Metamask) can
+interact with the evmchain/evmlight contract without running a node.
+
+The ISCP requests are signed with the key derived from the seed in the
+` + seedEnvVar + ` environment variable (base58-encoded). The fees for each
+Ethereum transaction are deposited from the address of this key, so it must
+hold enough funds.
+
+eth_sendRawTransaction returns as soon as the transaction is queued. The
+transactions of each sender are posted one at a time in nonce order, and
+off-ledger requests that are dropped by the node are posted again.
+
+The gateway has no unlocked accounts, so transactions must be sent with
+eth_sendRawTransaction.
+`,
+	}
+
+	log.Init(cmd)
+
+	jsonRPCServer.InitFlags(cmd)
+	jsonRPCServer.InitGatewayFlags(cmd)
+	cmd.Flags().StringVarP(&waspAPI, "wasp-api", "", "127.0.0.1:9090", "Wasp node web API address")
+	cmd.Flags().StringVarP(&goshimmerAPI, "goshimmer-api", "", "127.0.0.1:8080", "Goshimmer node web API address")
+	cmd.Flags().StringVarP(&chainIDBase58, "chain", "", "", "ISCP chain ID (base58)")
+	cmd.Flags().Uint64VarP(&addressIndex, "address-index", "", 0, "index of the address derived from the seed")
+	cmd.Flags().IntVarP(&evmChainID, "chainid", "", evm.DefaultChainID, "ChainID (used for signing transactions)")
+	cmd.Flags().StringVarP(&contractName, "name", "", evmchain.Contract.Name, "evmchain/evmlight contract name")
+	cmd.Flags().DurationVarP(&requestTimeout, "request-timeout", "", jsonrpc.DefaultRequestTimeout, "maximum time to wait for an ISCP request to be processed")
+	cmd.Flags().DurationVarP(&resubmitInterval, "resubmit-interval", "", jsonrpc.DefaultResubmitInterval, "time after which a dropped off-ledger request is posted again")
+	log.Check(cmd.MarkFlagRequired("chain"))
+
+	err := cmd.Execute()
+	log.Check(err)
+}
+
+func start(cmd *cobra.Command, args []string) {
+	chainID, err := iscp.ChainIDFromBase58(chainIDBase58)
+	log.Check(err)
+
+	seedb58 := os.Getenv(seedEnvVar)
+	if seedb58 == "" {
+		log.Fatalf("the %s environment variable is not set", seedEnvVar)
+	}
+	seedBytes, err := base58.Decode(seedb58)
+	log.Check(err)
+
+	backend := jsonrpc.NewWaspClientBackend(chainclient.New(
+		goshimmer.NewClient(goshimmerAPI, -1),
+		client.NewWaspClient(waspAPI),
+		chainID,
+		seed.NewSeed(seedBytes).KeyPair(addressIndex),
+	))
+	backend.RequestTimeout = requestTimeout
+	backend.ResubmitInterval = resubmitInterval
+
+	jsonRPCServer.ServeJSONRPC(backend, evmChainID, contractName)
+}
@@ -9,19 +9,21 @@ import (
 	"os"
 	"regexp"
 	"strings"
+
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 // TODO nested structs
 // TODO handle case where owner is type AgentID[]
 
 const (
-	AccessChain         = "chain"
-	AccessCreator       = "creator"
-	AccessSelf          = "self"
+	AccessChain         = wasmschema.AccessChain
+	AccessCreator       = wasmschema.AccessCreator
+	AccessSelf          = wasmschema.AccessSelf
 	AliasThis           = "this"
 	InitFunc            = "Init"
-	KindFunc            = "Func"
-	KindView            = "View"
+	KindFunc            = wasmschema.KindFunc
+	KindView            = wasmschema.KindView
 	PropImmutable       = "Immutable"
 	PropMutable         = "Mutable"
 	SpecialFuncInit     = "funcInit"
@@ -36,12 +38,12 @@ var (
 )
 
 type Generator interface {
-	funcName(f *Func) string
-	generateFuncSignature(f *Func)
+	funcName(f *wasmschema.Func) string
+	generateFuncSignature(f *wasmschema.Func)
 	generateLanguageSpecificFiles() error
-	generateProxyArray(field *Field, mutability, arrayType, proxyType string)
-	generateProxyMap(field *Field, mutability, mapType, proxyType string)
-	generateProxyReference(field *Field, mutability, typeName string)
+	generateProxyArray(field *wasmschema.Field, mutability, arrayType, proxyType string)
+	generateProxyMap(field *wasmschema.Field, mutability, mapType, proxyType string)
+	generateProxyReference(field *wasmschema.Field, mutability, typeName string)
 	writeConsts()
 	writeContract()
 	writeInitialFuncs()
@@ -55,18 +57,30 @@ type Generator interface {
 }
 
 type GenBase struct {
+	constLen       int
+	constNames     []string
+	constValues    []string
 	extension      string
 	file           *os.File
 	Folder         string
 	funcRegexp     *regexp.Regexp
 	gen            Generator
+	keyID          int
 	language       string
 	NewTypes       map[string]bool
 	rootFolder     string
-	s              *Schema
+	s              *wasmschema.Schema
 	skipDisclaimer bool
 }
 
+func (g *GenBase) appendConst(name, value string) {
+	if g.constLen < len(name) {
+		g.constLen = len(name)
+	}
+	g.constNames = append(g.constNames, name)
+	g.constValues = append(g.constValues, value)
+}
+
 func (g *GenBase) close() {
 	_ = g.file.Close()
 }
@@ -102,6 +116,16 @@ func (g *GenBase) createSourceFile(name string, generator func()) error {
 	return nil
 }
 
+// emitConsts passes the buffered consts to the printer and clears the buffer
+func (g *GenBase) emitConsts(printer func(name string, value string, padLen int)) {
+	for i, name := range g.constNames {
+		printer(name, g.constValues[i], g.constLen)
+	}
+	g.constLen = 0
+	g.constNames = nil
+	g.constValues = nil
+}
+
 func (g *GenBase) exists(path string) (err error) {
 	_, err = os.Stat(path)
 	return err
@@ -115,7 +139,7 @@ func (g *GenBase) formatter(on bool) {
 	g.printf("// @formatter:%s\n\n", "off")
 }
 
-func (g *GenBase) Generate(s *Schema) error {
+func (g *GenBase) Generate(s *wasmschema.Schema) error {
 	g.s = s
 	g.NewTypes = make(map[string]bool)
 
@@ -253,7 +277,7 @@ func (g *GenBase) generateFuncs() error {
 	return os.Remove(scOriginal)
 }
 
-func (g *GenBase) generateProxy(field *Field, mutability string) {
+func (g *GenBase) generateProxy(field *wasmschema.Field, mutability string) {
 	if field.Array {
 		proxyType := mutability + field.Type
 		arrayType := "ArrayOf" + proxyType
@@ -328,9 +352,9 @@ func (g *GenBase) println(a ...interface{}) {
 	_, _ = fmt.Fprintln(g.file, a...)
 }
 
-func (g *GenBase) scanExistingCode() ([]string, StringMap, error) {
+func (g *GenBase) scanExistingCode() ([]string, wasmschema.StringMap, error) {
 	defer g.close()
-	existing := make(StringMap)
+	existing := make(wasmschema.StringMap)
 	lines := make([]string, 0)
 	scanner := bufio.NewScanner(g.file)
 	for scanner.Scan() {
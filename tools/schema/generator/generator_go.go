@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 const (
@@ -17,46 +18,59 @@ const (
 	goImportWasmClient = "import \"github.com/iotaledger/wasp/packages/vm/wasmclient\""
 )
 
-var goTypes = StringMap{
+var goTypes = wasmschema.StringMap{
 	"Address":   "wasmlib.ScAddress",
 	"AgentID":   "wasmlib.ScAgentID",
+	"BigInt":    "wasmlib.ScBigInt",
+	"Bool":      "bool",
 	"ChainID":   "wasmlib.ScChainID",
 	"Color":     "wasmlib.ScColor",
 	"Hash":      "wasmlib.ScHash",
 	"Hname":     "wasmlib.ScHname",
+	"Int8":      "int8",
 	"Int16":     "int16",
 	"Int32":     "int32",
 	"Int64":     "int64",
 	"RequestID": "wasmlib.ScRequestID",
 	"String":    "string",
+	"Uint8":     "uint8",
+	"Uint16":    "uint16",
+	"Uint32":    "uint32",
+	"Uint64":    "uint64",
 }
 
-var goKeys = StringMap{
+var goKeys = wasmschema.StringMap{
 	"Address":   "key",
 	"AgentID":   "key",
+	"BigInt":    "key",
 	"ChainID":   "key",
 	"Color":     "key",
 	"Hash":      "key",
 	"Hname":     "key",
-	"Int16":     "??TODO",
 	"Int32":     "wasmlib.Key32(key)",
-	"Int64":     "??TODO",
 	"RequestID": "key",
 	"String":    "wasmlib.Key(key)",
 }
 
-var goTypeIds = StringMap{
+var goTypeIds = wasmschema.StringMap{
 	"Address":   "wasmlib.TYPE_ADDRESS",
 	"AgentID":   "wasmlib.TYPE_AGENT_ID",
+	"BigInt":    "wasmlib.TYPE_BIG_INT",
+	"Bool":      "wasmlib.TYPE_BOOL",
 	"ChainID":   "wasmlib.TYPE_CHAIN_ID",
 	"Color":     "wasmlib.TYPE_COLOR",
 	"Hash":      "wasmlib.TYPE_HASH",
 	"Hname":     "wasmlib.TYPE_HNAME",
+	"Int8":      "wasmlib.TYPE_INT8",
 	"Int16":     "wasmlib.TYPE_INT16",
 	"Int32":     "wasmlib.TYPE_INT32",
 	"Int64":     "wasmlib.TYPE_INT64",
 	"RequestID": "wasmlib.TYPE_REQUEST_ID",
 	"String":    "wasmlib.TYPE_STRING",
+	"Uint8":     "wasmlib.TYPE_UINT8",
+	"Uint16":    "wasmlib.TYPE_UINT16",
+	"Uint32":    "wasmlib.TYPE_UINT32",
+	"Uint64":    "wasmlib.TYPE_UINT64",
 }
 
 const (
@@ -79,26 +93,26 @@ func NewGoGenerator() *GoGenerator {
 }
 
 func (g *GoGenerator) flushConsts() {
-	if len(g.s.ConstNames) == 0 {
+	if len(g.constNames) == 0 {
 		return
 	}
 
-	if len(g.s.ConstNames) == 1 {
-		name := g.s.ConstNames[0]
-		value := g.s.ConstValues[0]
+	if len(g.constNames) == 1 {
+		name := g.constNames[0]
+		value := g.constValues[0]
 		g.printf("\nconst %s = %s\n", name, value)
-		g.s.flushConsts(func(name string, value string, padLen int) {})
+		g.emitConsts(func(name string, value string, padLen int) {})
 		return
 	}
 
 	g.printf("\nconst (\n")
-	g.s.flushConsts(func(name string, value string, padLen int) {
+	g.emitConsts(func(name string, value string, padLen int) {
 		g.printf("\t%s = %s\n", pad(name, padLen), value)
 	})
 	g.printf(")\n")
 }
 
-func (g *GoGenerator) funcName(f *Func) string {
+func (g *GoGenerator) funcName(f *wasmschema.Func) string {
 	return f.FuncName
 }
 
@@ -110,7 +124,7 @@ func (g *GoGenerator) generateArrayType(varType string) string {
 	return "wasmlib.TYPE_ARRAY|" + varType
 }
 
-func (g *GoGenerator) generateConstsFields(fields []*Field, prefix string) {
+func (g *GoGenerator) generateConstsFields(fields []*wasmschema.Field, prefix string) {
 	if len(fields) != 0 {
 		for _, field := range fields {
 			if field.Alias == AliasThis {
@@ -118,7 +132,7 @@ func (g *GoGenerator) generateConstsFields(fields []*Field, prefix string) {
 			}
 			name := prefix + capitalize(field.Name)
 			value := "wasmlib.Key(\"" + field.Alias + "\")"
-			g.s.appendConst(name, value)
+			g.appendConst(name, value)
 		}
 		g.flushConsts()
 	}
@@ -155,7 +169,7 @@ func (g *GoGenerator) generateContractFuncs() {
 	}
 }
 
-func (g *GoGenerator) generateFuncSignature(f *Func) {
+func (g *GoGenerator) generateFuncSignature(f *wasmschema.Func) {
 	g.printf("\nfunc %s(ctx wasmlib.Sc%sContext, f *%sContext) {\n", f.FuncName, f.Kind, f.Type)
 	switch f.FuncName {
 	case SpecialFuncInit:
@@ -173,27 +187,27 @@ func (g *GoGenerator) generateFuncSignature(f *Func) {
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateKeysArray(fields []*Field, prefix string) {
+func (g *GoGenerator) generateKeysArray(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := prefix + capitalize(field.Name)
 		g.printf("\t%s,\n", name)
-		g.s.KeyID++
+		g.keyID++
 	}
 }
 
-func (g *GoGenerator) generateKeysIndexes(fields []*Field, prefix string) {
+func (g *GoGenerator) generateKeysIndexes(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := "Idx" + prefix + capitalize(field.Name)
-		field.KeyID = g.s.KeyID
+		field.KeyID = g.keyID
 		value := strconv.Itoa(field.KeyID)
-		g.s.KeyID++
-		g.s.appendConst(name, value)
+		g.keyID++
+		g.appendConst(name, value)
 	}
 }
 
@@ -204,7 +218,7 @@ func (g *GoGenerator) generateLanguageSpecificFiles() error {
 	return g.createSourceFile("../main", g.writeSpecialMain)
 }
 
-func (g *GoGenerator) generateProxyArray(field *Field, mutability, arrayType, proxyType string) {
+func (g *GoGenerator) generateProxyArray(field *wasmschema.Field, mutability, arrayType, proxyType string) {
 	g.printf("\ntype %s struct {\n", arrayType)
 	g.printf("\tobjID int32\n")
 	g.printf("}\n")
@@ -230,18 +244,14 @@ func (g *GoGenerator) generateProxyArray(field *Field, mutability, arrayType, pr
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateProxyArrayNewType(field *Field, proxyType, arrayType string) {
+func (g *GoGenerator) generateProxyArrayNewType(field *wasmschema.Field, proxyType, arrayType string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := goTypeMap
 		if subtype.Array {
-			varType = goTypeIds[subtype.Type]
-			if varType == "" {
-				varType = goTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\nfunc (a %s) Get%s(index int32) %s {\n", arrayType, field.Type, proxyType)
 		g.printf("\tsubID := wasmlib.GetObjectID(a.objID, wasmlib.Key32(index), %s)\n", varType)
@@ -255,7 +265,20 @@ func (g *GoGenerator) generateProxyArrayNewType(field *Field, proxyType, arrayTy
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateProxyMap(field *Field, mutability, mapType, proxyType string) {
+// elementTypeID returns the type ID of the elements of an array of fldType,
+// nested containers are stored as map objects, structs as encoded bytes
+func (g *GoGenerator) elementTypeID(fldType string) string {
+	varType := goTypeIds[fldType]
+	if varType != "" {
+		return varType
+	}
+	if g.s.Typedef(fldType) != nil {
+		return goTypeMap
+	}
+	return goTypeBytes
+}
+
+func (g *GoGenerator) generateProxyMap(field *wasmschema.Field, mutability, mapType, proxyType string) {
 	keyType := goTypes[field.MapKey]
 	keyValue := goKeys[field.MapKey]
 
@@ -280,18 +303,14 @@ func (g *GoGenerator) generateProxyMap(field *Field, mutability, mapType, proxyT
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateProxyMapNewType(field *Field, proxyType, mapType, keyType, keyValue string) {
+func (g *GoGenerator) generateProxyMapNewType(field *wasmschema.Field, proxyType, mapType, keyType, keyValue string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := goTypeMap
 		if subtype.Array {
-			varType = goTypeIds[subtype.Type]
-			if varType == "" {
-				varType = goTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\nfunc (m %s) Get%s(key %s) %s {\n", mapType, field.Type, keyType, proxyType)
 		g.printf("\tsubID := wasmlib.GetObjectID(m.objID, %s.KeyID(), %s)\n", keyValue, varType)
@@ -305,13 +324,13 @@ func (g *GoGenerator) generateProxyMapNewType(field *Field, proxyType, mapType,
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateProxyReference(field *Field, mutability, typeName string) {
+func (g *GoGenerator) generateProxyReference(field *wasmschema.Field, mutability, typeName string) {
 	if field.Name[0] >= 'A' && field.Name[0] <= 'Z' {
 		g.printf("\ntype %s%s = %s\n", mutability, field.Name, typeName)
 	}
 }
 
-func (g *GoGenerator) generateProxyStruct(fields []*Field, mutability, typeName, kind string) {
+func (g *GoGenerator) generateProxyStruct(fields []*wasmschema.Field, mutability, typeName, kind string) {
 	typeName = mutability + typeName + kind
 	kind = strings.TrimSuffix(kind, "s")
 
@@ -335,7 +354,7 @@ func (g *GoGenerator) generateProxyStruct(fields []*Field, mutability, typeName,
 			varType = goTypeBytes
 		}
 		if field.Array {
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(field.Type))
 			arrayType := "ArrayOf" + mutability + field.Type
 			g.printf("\nfunc (s %s) %s() %s {\n", typeName, varName, arrayType)
 			g.printf("\tarrID := wasmlib.GetObjectID(s.id, %s, %s)\n", varID, varType)
@@ -371,13 +390,25 @@ func (g *GoGenerator) generateProxyStruct(fields []*Field, mutability, typeName,
 	}
 }
 
-func (g *GoGenerator) generateStruct(typeDef *Struct) {
+func (g *GoGenerator) generateStruct(typeDef *wasmschema.Struct) {
 	nameLen, typeLen := calculatePadding(typeDef.Fields, goTypes, false)
+	hasArrays := false
+	for _, field := range typeDef.Fields {
+		if field.Array {
+			hasArrays = true
+			if typeLen < len(goTypes[field.Type])+2 {
+				typeLen = len(goTypes[field.Type]) + 2
+			}
+		}
+	}
 
 	g.printf("\ntype %s struct {\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		fldName := pad(capitalize(field.Name), nameLen)
 		fldType := goTypes[field.Type]
+		if field.Array {
+			fldType = "[]" + fldType
+		}
 		if field.Comment != "" {
 			fldType = pad(fldType, typeLen)
 		}
@@ -391,24 +422,48 @@ func (g *GoGenerator) generateStruct(typeDef *Struct) {
 	g.printf("\tdata := &%s{}\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		name := capitalize(field.Name)
+		if field.Array {
+			g.printf("\tdata.%s = make(%s, decode.Uint32())\n", name, "[]"+goTypes[field.Type])
+			g.printf("\tfor i := range data.%s {\n", name)
+			g.printf("\t\tdata.%s[i] = decode.%s()\n", name, field.Type)
+			g.printf("\t}\n")
+			continue
+		}
 		g.printf("\tdata.%s = decode.%s()\n", name, field.Type)
 	}
 	g.printf("\tdecode.Close()\n")
 	g.printf("\treturn data\n}\n")
 
 	g.printf("\nfunc (o *%s) Bytes() []byte {\n", typeDef.Name)
-	g.printf("\treturn wasmlib.NewBytesEncoder().\n")
-	for _, field := range typeDef.Fields {
-		name := capitalize(field.Name)
-		g.printf("\t\t%s(o.%s).\n", field.Type, name)
+	if hasArrays {
+		// arrays are encoded as element count followed by the elements
+		g.printf("\tencode := wasmlib.NewBytesEncoder()\n")
+		for _, field := range typeDef.Fields {
+			name := capitalize(field.Name)
+			if field.Array {
+				g.printf("\tencode.Uint32(uint32(len(o.%s)))\n", name)
+				g.printf("\tfor _, item := range o.%s {\n", name)
+				g.printf("\t\tencode.%s(item)\n", field.Type)
+				g.printf("\t}\n")
+				continue
+			}
+			g.printf("\tencode.%s(o.%s)\n", field.Type, name)
+		}
+		g.printf("\treturn encode.Data()\n}\n")
+	} else {
+		g.printf("\treturn wasmlib.NewBytesEncoder().\n")
+		for _, field := range typeDef.Fields {
+			name := capitalize(field.Name)
+			g.printf("\t\t%s(o.%s).\n", field.Type, name)
+		}
+		g.printf("\t\tData()\n}\n")
 	}
-	g.printf("\t\tData()\n}\n")
 
 	g.generateStructProxy(typeDef, false)
 	g.generateStructProxy(typeDef, true)
 }
 
-func (g *GoGenerator) generateStructProxy(typeDef *Struct, mutable bool) {
+func (g *GoGenerator) generateStructProxy(typeDef *wasmschema.Struct, mutable bool) {
 	typeName := PropImmutable + typeDef.Name
 	if mutable {
 		typeName = PropMutable + typeDef.Name
@@ -434,8 +489,8 @@ func (g *GoGenerator) generateStructProxy(typeDef *Struct, mutable bool) {
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateThunk(f *Func) {
-	nameLen := f.nameLen(5)
+func (g *GoGenerator) generateThunk(f *wasmschema.Func) {
+	nameLen := funcNameLen(f, 5)
 	mutability := PropMutable
 	if f.Kind == KindView {
 		mutability = PropImmutable
@@ -483,32 +538,101 @@ func (g *GoGenerator) generateThunk(f *Func) {
 			g.printf("\tctx.Require(f.Params.%s().Exists(), \"missing mandatory %s\")\n", name, param.Name)
 		}
 	}
+	for _, param := range f.Params {
+		proxy := "f.Params." + capitalize(param.Name) + "()"
+		g.generateThunkConstraint(param, proxy, "invalid", param.Optional)
+	}
 
 	g.printf("\t%s(ctx, f)\n", f.FuncName)
+	if f.Kind != KindView {
+		// state var constraints are invariants that must hold after each func
+		for _, stateVar := range g.s.StateVars {
+			proxy := "f.State." + capitalize(stateVar.Name) + "()"
+			g.generateThunkConstraint(stateVar, proxy, "invariant violated:", true)
+		}
+	}
 	g.printf("\tctx.Log(\"%s.%s ok\")\n", g.s.Name, f.FuncName)
 	g.printf("}\n")
 }
 
-func (g *GoGenerator) generateThunkAccessCheck(f *Func) {
-	grant := f.Access
-	index := strings.Index(grant, "//")
-	if index >= 0 {
-		g.printf("\t%s\n", grant[index:])
-		grant = strings.TrimSpace(grant[:index])
+func (g *GoGenerator) generateThunkAccessCheck(f *wasmschema.Func) {
+	grants, comment := f.Grants()
+	if comment != "" {
+		g.printf("\t%s\n", comment)
+	}
+	if len(grants) == 1 {
+		grant := grants[0]
+		switch grant {
+		case AccessSelf:
+			grant = "ctx.AccountID()"
+		case AccessChain:
+			grant = "ctx.ChainOwnerID()"
+		case AccessCreator:
+			grant = "ctx.ContractCreator()"
+		default:
+			role := g.s.StateVar(grant)
+			if role.MapKey != "" {
+				g.printf("\tctx.Require(%s, \"no permission\")\n\n", g.accessCondition(grant))
+				return
+			}
+			g.printf("\taccess := ctx.State().GetAgentID(wasmlib.Key(\"%s\"))\n", role.Alias)
+			g.printf("\tctx.Require(access.Exists(), \"access not set: %s\")\n", grant)
+			grant = "access.Value()"
+		}
+		g.printf("\tctx.Require(ctx.Caller() == %s, \"no permission\")\n\n", grant)
+		return
+	}
+
+	// caller needs to be granted access by any of the alternatives
+	conditions := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		conditions = append(conditions, g.accessCondition(grant))
 	}
+	g.printf("\tctx.Require(%s,\n\t\t\"no permission\")\n\n", strings.Join(conditions, " ||\n\t\t"))
+}
+
+func (g *GoGenerator) accessCondition(grant string) string {
 	switch grant {
 	case AccessSelf:
-		grant = "ctx.AccountID()"
+		return "ctx.Caller() == ctx.AccountID()"
 	case AccessChain:
-		grant = "ctx.ChainOwnerID()"
+		return "ctx.Caller() == ctx.ChainOwnerID()"
 	case AccessCreator:
-		grant = "ctx.ContractCreator()"
-	default:
-		g.printf("\taccess := ctx.State().GetAgentID(wasmlib.Key(\"%s\"))\n", grant)
-		g.printf("\tctx.Require(access.Exists(), \"access not set: %s\")\n", grant)
-		grant = "access.Value()"
+		return "ctx.Caller() == ctx.ContractCreator()"
+	}
+	role := g.s.StateVar(grant)
+	if role.MapKey != "" {
+		return fmt.Sprintf("ctx.State().GetMap(wasmlib.Key(\"%s\")).GetBool(ctx.Caller()).Value()", role.Alias)
+	}
+	return fmt.Sprintf("ctx.Caller() == ctx.State().GetAgentID(wasmlib.Key(\"%s\")).Value()", role.Alias)
+}
+
+// generateThunkConstraint checks the value of a constrained param or state
+// var, skipping the check when an optional value is not present
+func (g *GoGenerator) generateThunkConstraint(field *wasmschema.Field, proxy, what string, optional bool) {
+	if !field.HasConstraint() {
+		return
+	}
+	indent := "\t"
+	if optional {
+		g.printf("\tif %s.Exists() {\n", proxy)
+		indent = "\t\t"
+	}
+	value := proxy + ".Value()"
+	tooSmall, tooLarge := "below minimum", "above maximum"
+	if field.IsLength() {
+		value = "len(" + value + ")"
+		tooSmall, tooLarge = "too short", "too long"
+	}
+	if field.Min != "" {
+		g.printf("%sctx.Require(%s >= %s, \"%s %s: %s\")\n", indent, value, field.Min, what, field.Name, tooSmall)
+	}
+	if field.Max != "" {
+		g.printf("%sctx.Require(%s <= %s, \"%s %s: %s\")\n", indent, value, field.Max, what, field.Name, tooLarge)
+	}
+	if optional {
+		g.printf("\t}\n")
 	}
-	g.printf("\tctx.Require(ctx.Caller() == %s, \"no permission\")\n\n", grant)
 }
 
 func (g *GoGenerator) packageName() string {
@@ -524,13 +648,13 @@ func (g *GoGenerator) writeConsts() {
 		// remove 'core' prefix
 		scName = scName[4:]
 	}
-	g.s.appendConst("ScName", "\""+scName+"\"")
+	g.appendConst("ScName", "\""+scName+"\"")
 	if g.s.Description != "" {
-		g.s.appendConst("ScDescription", "\""+g.s.Description+"\"")
+		g.appendConst("ScDescription", "\""+g.s.Description+"\"")
 	}
 	hName := iscp.Hn(scName)
 	hNameType := "wasmlib.ScHname"
-	g.s.appendConst("HScName", hNameType+"(0x"+hName.String()+")")
+	g.appendConst("HScName", hNameType+"(0x"+hName.String()+")")
 	g.flushConsts()
 
 	g.generateConstsFields(g.s.Params, "Param")
@@ -540,13 +664,13 @@ func (g *GoGenerator) writeConsts() {
 	if len(g.s.Funcs) != 0 {
 		for _, f := range g.s.Funcs {
 			constName := capitalize(f.FuncName)
-			g.s.appendConst(constName, "\""+f.String+"\"")
+			g.appendConst(constName, "\""+f.String+"\"")
 		}
 		g.flushConsts()
 
 		for _, f := range g.s.Funcs {
 			constHname := "H" + capitalize(f.FuncName)
-			g.s.appendConst(constHname, hNameType+"(0x"+f.Hname.String()+")")
+			g.appendConst(constHname, hNameType+"(0x"+f.Hname.String()+")")
 		}
 		g.flushConsts()
 	}
@@ -557,7 +681,7 @@ func (g *GoGenerator) writeContract() {
 	g.println(goImportWasmLib)
 
 	for _, f := range g.s.Funcs {
-		nameLen := f.nameLen(4)
+		nameLen := funcNameLen(f, 4)
 		kind := f.Kind
 		if f.Type == InitFunc {
 			kind = f.Type + f.Kind
@@ -599,13 +723,13 @@ func (g *GoGenerator) writeKeys() {
 	g.println(g.packageName())
 	g.println(goImportWasmLib)
 
-	g.s.KeyID = 0
+	g.keyID = 0
 	g.generateKeysIndexes(g.s.Params, "Param")
 	g.generateKeysIndexes(g.s.Results, "Result")
 	g.generateKeysIndexes(g.s.StateVars, "State")
 	g.flushConsts()
 
-	size := g.s.KeyID
+	size := g.keyID
 	g.printf("\nconst keyMapLen = %d\n", size)
 	g.printf("\nvar keyMap = [keyMapLen]wasmlib.Key{\n")
 	g.generateKeysArray(g.s.Params, "Param")
@@ -11,25 +11,78 @@ import (
 	"strings"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 var javaFuncRegexp = regexp.MustCompile(`public static void (\w+).+$`)
 
-var javaTypes = StringMap{
+// javaTypes maps the unsigned types on the next wider signed type, because Java
+// has no unsigned types. There is no wider type for Uint64, it is not supported.
+var javaTypes = wasmschema.StringMap{
 	"Address":   "ScAddress",
 	"AgentID":   "ScAgentID",
+	"BigInt":    "ScBigInt",
+	"Bool":      "boolean",
+	"Bytes":     "byte[]",
 	"ChainID":   "ScChainID",
 	"Color":     "ScColor",
 	"Hash":      "ScHash",
 	"Hname":     "Hname",
+	"Int8":      "byte",
 	"Int16":     "short",
 	"Int32":     "int",
 	"Int64":     "long",
 	"RequestID": "ScRequestID",
 	"String":    "String",
+	"Uint8":     "short",
+	"Uint16":    "int",
+	"Uint32":    "long",
 }
 
-func (s *Schema) GenerateJava() error {
+// JavaGenerator generates the Java interface code of a contract. It does not
+// support all field types, so schemas that need them are rejected.
+type JavaGenerator struct {
+	*wasmschema.Schema
+}
+
+func NewJavaGenerator() *JavaGenerator {
+	return &JavaGenerator{}
+}
+
+func (s *JavaGenerator) Generate(schema *wasmschema.Schema) error {
+	s.Schema = schema
+	err := s.checkSupported()
+	if err != nil {
+		return err
+	}
+	return s.GenerateJava()
+}
+
+// checkSupported rejects schemas that use features that the Java code cannot
+// represent, rather than generating code that silently misbehaves
+func (s *JavaGenerator) checkSupported() error {
+	return s.checkSupportedTypes()
+}
+
+// checkSupportedTypes rejects field types that have no Java type
+func (s *JavaGenerator) checkSupportedTypes() error {
+	fields := make([]*wasmschema.Field, 0)
+	fields = append(fields, s.Params...)
+	fields = append(fields, s.Results...)
+	fields = append(fields, s.StateVars...)
+	fields = append(fields, s.Typedefs...)
+	for _, td := range s.Structs {
+		fields = append(fields, td.Fields...)
+	}
+	for _, field := range fields {
+		if field.TypeID != 0 && javaTypes[field.Type] == "" {
+			return fmt.Errorf("java: field type is not supported: %s %s", field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+func (s *JavaGenerator) GenerateJava() error {
 	currentPath, err := os.Getwd()
 	if err != nil {
 		return err
@@ -79,7 +132,7 @@ func (s *Schema) GenerateJava() error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaFunc(file *os.File, f *Func) error {
+func (s *JavaGenerator) GenerateJavaFunc(file *os.File, f *wasmschema.Func) error {
 	funcName := f.FuncName
 	funcKind := capitalize(f.FuncName[:4])
 	fmt.Fprintf(file, "\npublic static void %s(Sc%sContext ctx, %sParams params) {\n", funcName, funcKind, capitalize(funcName))
@@ -87,7 +140,7 @@ func (s *Schema) GenerateJavaFunc(file *os.File, f *Func) error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaFuncs() error {
+func (s *JavaGenerator) GenerateJavaFuncs() error {
 	scFileName := s.Name + ".java"
 	file, err := os.Open(scFileName)
 	if err != nil {
@@ -128,9 +181,9 @@ func (s *Schema) GenerateJavaFuncs() error {
 	return os.Remove(scOriginal)
 }
 
-func (s *Schema) GenerateJavaFuncScanner(file *os.File) ([]string, StringMap, error) {
+func (s *JavaGenerator) GenerateJavaFuncScanner(file *os.File) ([]string, wasmschema.StringMap, error) {
 	defer file.Close()
-	existing := make(StringMap)
+	existing := make(wasmschema.StringMap)
 	lines := make([]string, 0)
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
@@ -148,7 +201,7 @@ func (s *Schema) GenerateJavaFuncScanner(file *os.File) ([]string, StringMap, er
 	return lines, existing, nil
 }
 
-func (s *Schema) GenerateJavaFuncsNew(scFileName string) error {
+func (s *JavaGenerator) GenerateJavaFuncsNew(scFileName string) error {
 	file, err := os.Create(scFileName)
 	if err != nil {
 		return err
@@ -175,7 +228,7 @@ func (s *Schema) GenerateJavaFuncsNew(scFileName string) error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaLib() error {
+func (s *JavaGenerator) GenerateJavaLib() error {
 	err := os.MkdirAll("lib", 0o755)
 	if err != nil {
 		return err
@@ -225,7 +278,7 @@ func (s *Schema) GenerateJavaLib() error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaConsts() error {
+func (s *JavaGenerator) GenerateJavaConsts() error {
 	file, err := os.Create("lib/Consts.java")
 	if err != nil {
 		return err
@@ -280,7 +333,7 @@ func (s *Schema) GenerateJavaConsts() error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaThunk(file, params *os.File, f *Func) {
+func (s *JavaGenerator) GenerateJavaThunk(file, params *os.File, f *wasmschema.Func) {
 	// calculate padding
 	nameLen, typeLen := calculatePadding(f.Params, javaTypes, false)
 
@@ -330,34 +383,111 @@ func (s *Schema) GenerateJavaThunk(file, params *os.File, f *Func) {
 			fmt.Fprintf(file, "        ctx.Require(params.%s.Exists(), \"missing mandatory %s\");\n", name, param.Name)
 		}
 	}
+	for _, param := range f.Params {
+		proxy := "params." + capitalize(param.Name)
+		s.generateJavaThunkConstraint(file, param, proxy, "invalid", param.Optional)
+	}
 	fmt.Fprintf(file, "        %s.%s(ctx, params);\n", s.FullName, f.FuncName)
+	if f.Kind != KindView {
+		// state var constraints are invariants that must hold after each func
+		for _, stateVar := range s.StateVars {
+			proxy := fmt.Sprintf("ctx.State().Get%s(Consts.Var%s)", stateVar.Type, capitalize(stateVar.Name))
+			s.generateJavaThunkConstraint(file, stateVar, proxy, "invariant violated:", true)
+		}
+	}
 	fmt.Fprintf(file, "        ctx.Log(\"%s.%s ok\");\n", s.Name, f.FuncName)
 	fmt.Fprintf(file, "    }\n")
 }
 
-func (s *Schema) generateJavaThunkAccessCheck(file *os.File, f *Func) {
-	grant := f.Access
-	index := strings.Index(grant, "//")
-	if index >= 0 {
-		fmt.Fprintf(file, "        %s\n", grant[index:])
-		grant = strings.TrimSpace(grant[:index])
+func (s *JavaGenerator) generateJavaThunkAccessCheck(file *os.File, f *wasmschema.Func) {
+	grants, comment := f.Grants()
+	if comment != "" {
+		fmt.Fprintf(file, "        %s\n", comment)
+	}
+	if len(grants) == 1 {
+		grant := grants[0]
+		switch grant {
+		case AccessSelf:
+			grant = "ctx.AccountID()"
+		case AccessChain:
+			grant = "ctx.ChainOwnerID()"
+		case AccessCreator:
+			grant = "ctx.ContractCreator()"
+		default:
+			role := s.StateVar(grant)
+			if role.MapKey != "" {
+				fmt.Fprintf(file, "        ctx.Require(%s, \"no permission\");\n\n", s.javaAccessCondition(grant))
+				return
+			}
+			fmt.Fprintf(file, "        var access = ctx.State().GetAgentID(new Key(\"%s\"));\n", role.Alias)
+			fmt.Fprintf(file, "        ctx.Require(access.Exists(), \"access not set: %s\");\n", grant)
+			grant = "access.Value()"
+		}
+		fmt.Fprintf(file, "        ctx.Require(ctx.Caller().equals(%s), \"no permission\");\n\n", grant)
+		return
 	}
+
+	// caller needs to be granted access by any of the alternatives
+	conditions := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		conditions = append(conditions, s.javaAccessCondition(grant))
+	}
+	fmt.Fprintf(file, "        ctx.Require(%s,\n                \"no permission\");\n\n", strings.Join(conditions, " ||\n                "))
+}
+
+func (s *JavaGenerator) javaAccessCondition(grant string) string {
 	switch grant {
 	case AccessSelf:
-		grant = "ctx.AccountID()"
+		return "ctx.Caller().equals(ctx.AccountID())"
 	case AccessChain:
-		grant = "ctx.ChainOwnerID()"
+		return "ctx.Caller().equals(ctx.ChainOwnerID())"
 	case AccessCreator:
-		grant = "ctx.ContractCreator()"
-	default:
-		fmt.Fprintf(file, "        var access = ctx.State().GetAgentID(new Key(\"%s\"));\n", grant)
-		fmt.Fprintf(file, "        ctx.Require(access.Exists(), \"access not set: %s\");\n", grant)
-		grant = "access.Value()"
+		return "ctx.Caller().equals(ctx.ContractCreator())"
+	}
+	role := s.StateVar(grant)
+	if role.MapKey != "" {
+		return fmt.Sprintf("ctx.State().GetMap(new Key(\"%s\")).GetBool(ctx.Caller()).Value()", role.Alias)
+	}
+	return fmt.Sprintf("ctx.Caller().equals(ctx.State().GetAgentID(new Key(\"%s\")).Value())", role.Alias)
+}
+
+// generateJavaThunkConstraint checks the value of a constrained param or state
+// var, skipping the check when an optional value is not present
+func (s *JavaGenerator) generateJavaThunkConstraint(file *os.File, field *wasmschema.Field, proxy, what string, optional bool) {
+	if !field.HasConstraint() {
+		return
+	}
+	indent := "        "
+	if optional {
+		fmt.Fprintf(file, "        if (%s.Exists()) {\n", proxy)
+		indent = "            "
+	}
+	value := proxy + ".Value()"
+	tooSmall, tooLarge := "below minimum", "above maximum"
+	switch field.Type {
+	case "String":
+		value += ".length()"
+		tooSmall, tooLarge = "too short", "too long"
+	case "Bytes":
+		value += ".length"
+		tooSmall, tooLarge = "too short", "too long"
+	}
+	suffix := ""
+	if !field.IsLength() && javaTypes[field.Type] == "long" {
+		suffix = "L"
+	}
+	if field.Min != "" {
+		fmt.Fprintf(file, "%sctx.Require(%s >= %s%s, \"%s %s: %s\");\n", indent, value, field.Min, suffix, what, field.Name, tooSmall)
+	}
+	if field.Max != "" {
+		fmt.Fprintf(file, "%sctx.Require(%s <= %s%s, \"%s %s: %s\");\n", indent, value, field.Max, suffix, what, field.Name, tooLarge)
+	}
+	if optional {
+		fmt.Fprintf(file, "        }\n")
 	}
-	fmt.Fprintf(file, "        ctx.Require(ctx.Caller().equals(%s), \"no permission\");\n\n", grant)
 }
 
-func (s *Schema) GenerateJavaTypes() error {
+func (s *JavaGenerator) GenerateJavaTypes() error {
 	if len(s.Structs) == 0 {
 		return nil
 	}
@@ -378,7 +508,7 @@ func (s *Schema) GenerateJavaTypes() error {
 	return nil
 }
 
-func (s *Schema) GenerateJavaType(td *Struct) error {
+func (s *JavaGenerator) GenerateJavaType(td *wasmschema.Struct) error {
 	file, err := os.Create("structs/" + td.Name + ".java")
 	if err != nil {
 		return err
@@ -4,11 +4,13 @@
 package generator
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 const (
@@ -27,60 +29,72 @@ const (
 	useWasmLibHost     = "use wasmlib::host::*;"
 )
 
-var rustTypes = StringMap{
+var rustTypes = wasmschema.StringMap{
 	"Address":   "ScAddress",
 	"AgentID":   "ScAgentID",
+	"BigInt":    "ScBigInt",
+	"Bool":      "bool",
 	"ChainID":   "ScChainID",
 	"Color":     "ScColor",
 	"Hash":      "ScHash",
 	"Hname":     "ScHname",
+	"Int8":      "i8",
 	"Int16":     "i16",
 	"Int32":     "i32",
 	"Int64":     "i64",
 	"RequestID": "ScRequestID",
 	"String":    "String",
+	"Uint8":     "u8",
+	"Uint16":    "u16",
+	"Uint32":    "u32",
+	"Uint64":    "u64",
 }
 
-var rustKeyTypes = StringMap{
+var rustKeyTypes = wasmschema.StringMap{
 	"Address":   "&ScAddress",
 	"AgentID":   "&ScAgentID",
+	"BigInt":    "&ScBigInt",
 	"ChainID":   "&ScChainID",
 	"Color":     "&ScColor",
 	"Hash":      "&ScHash",
 	"Hname":     "&ScHname",
-	"Int16":     "??TODO",
 	"Int32":     "i32",
-	"Int64":     "??TODO",
 	"RequestID": "&ScRequestID",
 	"String":    "&str",
 }
 
-var rustKeys = StringMap{
+var rustKeys = wasmschema.StringMap{
 	"Address":   "key",
 	"AgentID":   "key",
+	"BigInt":    "key",
 	"ChainID":   "key",
 	"Color":     "key",
 	"Hash":      "key",
 	"Hname":     "key",
-	"Int16":     "??TODO",
 	"Int32":     "Key32(int32)",
-	"Int64":     "??TODO",
 	"RequestID": "key",
 	"String":    "key",
 }
 
-var rustTypeIds = StringMap{
+var rustTypeIds = wasmschema.StringMap{
 	"Address":   "TYPE_ADDRESS",
 	"AgentID":   "TYPE_AGENT_ID",
+	"BigInt":    "TYPE_BIG_INT",
+	"Bool":      "TYPE_BOOL",
 	"ChainID":   "TYPE_CHAIN_ID",
 	"Color":     "TYPE_COLOR",
 	"Hash":      "TYPE_HASH",
 	"Hname":     "TYPE_HNAME",
+	"Int8":      "TYPE_INT8",
 	"Int16":     "TYPE_INT16",
 	"Int32":     "TYPE_INT32",
 	"Int64":     "TYPE_INT64",
 	"RequestID": "TYPE_REQUEST_ID",
 	"String":    "TYPE_STRING",
+	"Uint8":     "TYPE_UINT8",
+	"Uint16":    "TYPE_UINT16",
+	"Uint32":    "TYPE_UINT32",
+	"Uint64":    "TYPE_UINT64",
 }
 
 const (
@@ -121,7 +135,7 @@ func (g *RustGenerator) crateOrWasmLib(withContract, withHost bool) string {
 }
 
 func (g *RustGenerator) flushConsts(crateOnly bool) {
-	if len(g.s.ConstNames) == 0 {
+	if len(g.constNames) == 0 {
 		return
 	}
 
@@ -130,12 +144,12 @@ func (g *RustGenerator) flushConsts(crateOnly bool) {
 		crate = "(crate)"
 	}
 	g.println()
-	g.s.flushConsts(func(name string, value string, padLen int) {
+	g.emitConsts(func(name string, value string, padLen int) {
 		g.printf("pub%s const %s %s;\n", crate, pad(name+":", padLen+1), value)
 	})
 }
 
-func (g *RustGenerator) funcName(f *Func) string {
+func (g *RustGenerator) funcName(f *wasmschema.Func) string {
 	return snake(f.FuncName)
 }
 
@@ -147,7 +161,7 @@ func (g *RustGenerator) generateArrayType(varType string) string {
 	return "TYPE_ARRAY | " + varType
 }
 
-func (g *RustGenerator) generateConstsFields(fields []*Field, prefix string) {
+func (g *RustGenerator) generateConstsFields(fields []*wasmschema.Field, prefix string) {
 	if len(fields) != 0 {
 		for _, field := range fields {
 			if field.Alias == AliasThis {
@@ -155,7 +169,7 @@ func (g *RustGenerator) generateConstsFields(fields []*Field, prefix string) {
 			}
 			name := prefix + upper(snake(field.Name))
 			value := "&str = \"" + field.Alias + "\""
-			g.s.appendConst(name, value)
+			g.appendConst(name, value)
 		}
 		g.flushConsts(g.s.CoreContracts)
 	}
@@ -167,7 +181,7 @@ func (g *RustGenerator) generateContractFuncs() {
 	g.println("\nimpl ScFuncs {")
 
 	for _, f := range g.s.Funcs {
-		nameLen := f.nameLen(4) + 1
+		nameLen := funcNameLen(f, 4) + 1
 		funcName := g.funcName(f)
 		constName := upper(funcName)
 		letMut := ""
@@ -202,7 +216,7 @@ func (g *RustGenerator) generateContractFuncs() {
 	g.printf("}\n")
 }
 
-func (g *RustGenerator) generateFuncSignature(f *Func) {
+func (g *RustGenerator) generateFuncSignature(f *wasmschema.Func) {
 	switch f.FuncName {
 	case SpecialFuncInit:
 		g.printf("\npub fn %s(ctx: &Sc%sContext, f: &%sContext) {\n", g.funcName(f), f.Kind, capitalize(f.Type))
@@ -223,27 +237,27 @@ func (g *RustGenerator) generateFuncSignature(f *Func) {
 	g.printf("}\n")
 }
 
-func (g *RustGenerator) generateKeysArray(fields []*Field, prefix string) {
+func (g *RustGenerator) generateKeysArray(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := prefix + upper(snake(field.Name))
 		g.printf("    %s,\n", name)
-		g.s.KeyID++
+		g.keyID++
 	}
 }
 
-func (g *RustGenerator) generateKeysIndexes(fields []*Field, prefix string) {
+func (g *RustGenerator) generateKeysIndexes(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := "IDX_" + prefix + upper(snake(field.Name))
-		field.KeyID = g.s.KeyID
+		field.KeyID = g.keyID
 		value := "usize = " + strconv.Itoa(field.KeyID)
-		g.s.KeyID++
-		g.s.appendConst(name, value)
+		g.keyID++
+		g.appendConst(name, value)
 	}
 }
 
@@ -285,7 +299,7 @@ func (g *RustGenerator) generateModLines(format string) {
 	}
 }
 
-func (g *RustGenerator) generateProxyArray(field *Field, mutability, arrayType, proxyType string) {
+func (g *RustGenerator) generateProxyArray(field *wasmschema.Field, mutability, arrayType, proxyType string) {
 	g.printf("\npub struct %s {\n", arrayType)
 	g.printf("    pub(crate) obj_id: i32,\n")
 	g.printf("}\n")
@@ -314,21 +328,17 @@ func (g *RustGenerator) generateProxyArray(field *Field, mutability, arrayType,
 	g.printf("    }\n")
 }
 
-func (g *RustGenerator) generateProxyArrayNewType(field *Field, proxyType string) {
+func (g *RustGenerator) generateProxyArrayNewType(field *wasmschema.Field, proxyType string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := rustTypeMap
 		if subtype.Array {
-			varType = rustTypeIds[subtype.Type]
-			if varType == "" {
-				varType = rustTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\n    pub fn get_%s(&self, index: i32) -> %s {\n", snake(field.Type), proxyType)
-		g.printf("        let sub_id = get_object_id(self.obj_id, Key32(index), %s)\n", varType)
+		g.printf("        let sub_id = get_object_id(self.obj_id, Key32(index), %s);\n", varType)
 		g.printf("        %s { obj_id: sub_id }\n", proxyType)
 		g.printf("    }\n")
 		return
@@ -339,7 +349,20 @@ func (g *RustGenerator) generateProxyArrayNewType(field *Field, proxyType string
 	g.printf("    }\n")
 }
 
-func (g *RustGenerator) generateProxyMap(field *Field, mutability, mapType, proxyType string) {
+// elementTypeID returns the type ID of the elements of an array of fldType,
+// nested containers are stored as map objects, structs as encoded bytes
+func (g *RustGenerator) elementTypeID(fldType string) string {
+	varType := rustTypeIds[fldType]
+	if varType != "" {
+		return varType
+	}
+	if g.s.Typedef(fldType) != nil {
+		return rustTypeMap
+	}
+	return rustTypeBytes
+}
+
+func (g *RustGenerator) generateProxyMap(field *wasmschema.Field, mutability, mapType, proxyType string) {
 	keyType := rustKeyTypes[field.MapKey]
 	keyValue := rustKeys[field.MapKey]
 
@@ -367,18 +390,14 @@ func (g *RustGenerator) generateProxyMap(field *Field, mutability, mapType, prox
 	g.printf("    }\n")
 }
 
-func (g *RustGenerator) generateProxyMapNewType(field *Field, proxyType, keyType, keyValue string) {
+func (g *RustGenerator) generateProxyMapNewType(field *wasmschema.Field, proxyType, keyType, keyValue string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := rustTypeMap
 		if subtype.Array {
-			varType = rustTypeIds[subtype.Type]
-			if varType == "" {
-				varType = rustTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\n    pub fn get_%s(&self, key: %s) -> %s {\n", snake(field.Type), keyType, proxyType)
 		g.printf("        let sub_id = get_object_id(self.obj_id, %s.get_key_id(), %s);\n", keyValue, varType)
@@ -392,13 +411,13 @@ func (g *RustGenerator) generateProxyMapNewType(field *Field, proxyType, keyType
 	g.printf("    }\n")
 }
 
-func (g *RustGenerator) generateProxyReference(field *Field, mutability, typeName string) {
+func (g *RustGenerator) generateProxyReference(field *wasmschema.Field, mutability, typeName string) {
 	if field.Name[0] >= 'A' && field.Name[0] <= 'Z' {
 		g.printf("\npub type %s%s = %s;\n", mutability, field.Name, typeName)
 	}
 }
 
-func (g *RustGenerator) generateProxyStruct(fields []*Field, mutability, typeName, kind string) {
+func (g *RustGenerator) generateProxyStruct(fields []*wasmschema.Field, mutability, typeName, kind string) {
 	typeName = mutability + typeName + kind
 	kind = strings.TrimSuffix(kind, "s")
 	kind = upper(kind) + "_"
@@ -429,7 +448,7 @@ func (g *RustGenerator) generateProxyStruct(fields []*Field, mutability, typeNam
 			varType = rustTypeBytes
 		}
 		if field.Array {
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(field.Type))
 			arrayType := "ArrayOf" + mutability + field.Type
 			g.printf("\n    pub fn %s(&self) -> %s {\n", varName, arrayType)
 			g.printf("        let arr_id = get_object_id(self.id, %s, %s);\n", varID, varType)
@@ -465,13 +484,21 @@ func (g *RustGenerator) generateProxyStruct(fields []*Field, mutability, typeNam
 	}
 }
 
-func (g *RustGenerator) generateStruct(typeDef *Struct) {
+func (g *RustGenerator) generateStruct(typeDef *wasmschema.Struct) {
 	nameLen, typeLen := calculatePadding(typeDef.Fields, rustTypes, true)
+	for _, field := range typeDef.Fields {
+		if field.Array && typeLen < len(rustTypes[field.Type])+5 {
+			typeLen = len(rustTypes[field.Type]) + 5
+		}
+	}
 
 	g.printf("\npub struct %s {\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		fldName := pad(snake(field.Name)+":", nameLen+1)
 		fldType := rustTypes[field.Type] + ","
+		if field.Array {
+			fldType = "Vec<" + rustTypes[field.Type] + ">,"
+		}
 		if field.Comment != "" {
 			fldType = pad(fldType, typeLen+1)
 		}
@@ -487,6 +514,11 @@ func (g *RustGenerator) generateStruct(typeDef *Struct) {
 	g.printf("        %s {\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		name := snake(field.Name)
+		if field.Array {
+			// arrays are encoded as element count followed by the elements
+			g.printf("            %s: (0..decode.uint32()).map(|_| decode.%s()).collect(),\n", name, snake(field.Type))
+			continue
+		}
 		g.printf("            %s: decode.%s(),\n", name, snake(field.Type))
 	}
 	g.printf("        }\n")
@@ -497,9 +529,21 @@ func (g *RustGenerator) generateStruct(typeDef *Struct) {
 	for _, field := range typeDef.Fields {
 		name := snake(field.Name)
 		ref := "&"
-		if field.Type == "Hname" || field.Type == "Int64" || field.Type == "Int32" || field.Type == "Int16" {
+		switch field.Type {
+		case "Bool", "Hname", "Int8", "Int16", "Int32", "Int64", "Uint8", "Uint16", "Uint32", "Uint64":
 			ref = ""
 		}
+		if field.Array {
+			item := "item"
+			if ref == "" {
+				item = "*item"
+			}
+			g.printf("        encode.uint32(self.%s.len() as u32);\n", name)
+			g.printf("        for item in &self.%s {\n", name)
+			g.printf("            encode.%s(%s);\n", snake(field.Type), item)
+			g.printf("        }\n")
+			continue
+		}
 		g.printf("        encode.%s(%sself.%s);\n", snake(field.Type), ref, name)
 	}
 	g.printf("        return encode.data();\n")
@@ -510,7 +554,7 @@ func (g *RustGenerator) generateStruct(typeDef *Struct) {
 	g.generateStructProxy(typeDef, true)
 }
 
-func (g *RustGenerator) generateStructProxy(typeDef *Struct, mutable bool) {
+func (g *RustGenerator) generateStructProxy(typeDef *wasmschema.Struct, mutable bool) {
 	typeName := PropImmutable + typeDef.Name
 	if mutable {
 		typeName = PropMutable + typeDef.Name
@@ -540,8 +584,8 @@ func (g *RustGenerator) generateStructProxy(typeDef *Struct, mutable bool) {
 	g.printf("}\n")
 }
 
-func (g *RustGenerator) generateThunk(f *Func) {
-	nameLen := f.nameLen(5) + 1
+func (g *RustGenerator) generateThunk(f *wasmschema.Func) {
+	nameLen := funcNameLen(f, 5) + 1
 	mutability := PropMutable
 	if f.Kind == KindView {
 		mutability = PropImmutable
@@ -589,32 +633,101 @@ func (g *RustGenerator) generateThunk(f *Func) {
 			g.printf("    ctx.require(f.params.%s().exists(), \"missing mandatory %s\");\n", name, param.Name)
 		}
 	}
+	for _, param := range f.Params {
+		proxy := "f.params." + snake(param.Name) + "()"
+		g.generateThunkConstraint(param, proxy, "invalid", param.Optional)
+	}
 
 	g.printf("    %s(ctx, &f);\n", g.funcName(f))
+	if f.Kind != KindView {
+		// state var constraints are invariants that must hold after each func
+		for _, stateVar := range g.s.StateVars {
+			proxy := "f.state." + snake(stateVar.Name) + "()"
+			g.generateThunkConstraint(stateVar, proxy, "invariant violated:", true)
+		}
+	}
 	g.printf("    ctx.log(\"%s.%s ok\");\n", g.s.Name, f.FuncName)
 	g.printf("}\n")
 }
 
-func (g *RustGenerator) generateThunkAccessCheck(f *Func) {
-	grant := f.Access
-	index := strings.Index(grant, "//")
-	if index >= 0 {
-		g.printf("    %s\n", grant[index:])
-		grant = strings.TrimSpace(grant[:index])
+func (g *RustGenerator) generateThunkAccessCheck(f *wasmschema.Func) {
+	grants, comment := f.Grants()
+	if comment != "" {
+		g.printf("    %s\n", comment)
+	}
+	if len(grants) == 1 {
+		grant := grants[0]
+		switch grant {
+		case AccessSelf:
+			grant = "ctx.account_id()"
+		case AccessChain:
+			grant = "ctx.chain_owner_id()"
+		case AccessCreator:
+			grant = "ctx.contract_creator()"
+		default:
+			role := g.s.StateVar(grant)
+			if role.MapKey != "" {
+				g.printf("    ctx.require(%s, \"no permission\");\n\n", g.accessCondition(grant))
+				return
+			}
+			g.printf("    let access = ctx.state().get_agent_id(\"%s\");\n", role.Alias)
+			g.printf("    ctx.require(access.exists(), \"access not set: %s\");\n", grant)
+			grant = "access.value()"
+		}
+		g.printf("    ctx.require(ctx.caller() == %s, \"no permission\");\n\n", grant)
+		return
 	}
+
+	// caller needs to be granted access by any of the alternatives
+	conditions := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		conditions = append(conditions, g.accessCondition(grant))
+	}
+	g.printf("    ctx.require(%s,\n        \"no permission\");\n\n", strings.Join(conditions, " ||\n        "))
+}
+
+func (g *RustGenerator) accessCondition(grant string) string {
 	switch grant {
 	case AccessSelf:
-		grant = "ctx.account_id()"
+		return "ctx.caller() == ctx.account_id()"
 	case AccessChain:
-		grant = "ctx.chain_owner_id()"
+		return "ctx.caller() == ctx.chain_owner_id()"
 	case AccessCreator:
-		grant = "ctx.contract_creator()"
-	default:
-		g.printf("    let access = ctx.state().get_agent_id(\"%s\");\n", grant)
-		g.printf("    ctx.require(access.exists(), \"access not set: %s\");\n", grant)
-		grant = "access.value()"
+		return "ctx.caller() == ctx.contract_creator()"
+	}
+	role := g.s.StateVar(grant)
+	if role.MapKey != "" {
+		return fmt.Sprintf("ctx.state().get_map(\"%s\").get_bool(&ctx.caller()).value()", role.Alias)
+	}
+	return fmt.Sprintf("ctx.caller() == ctx.state().get_agent_id(\"%s\").value()", role.Alias)
+}
+
+// generateThunkConstraint checks the value of a constrained param or state
+// var, skipping the check when an optional value is not present
+func (g *RustGenerator) generateThunkConstraint(field *wasmschema.Field, proxy, what string, optional bool) {
+	if !field.HasConstraint() {
+		return
+	}
+	indent := "    "
+	if optional {
+		g.printf("    if %s.exists() {\n", proxy)
+		indent = "        "
+	}
+	value := proxy + ".value()"
+	tooSmall, tooLarge := "below minimum", "above maximum"
+	if field.IsLength() {
+		value += ".len()"
+		tooSmall, tooLarge = "too short", "too long"
+	}
+	if field.Min != "" {
+		g.printf("%sctx.require(%s >= %s, \"%s %s: %s\");\n", indent, value, field.Min, what, field.Name, tooSmall)
+	}
+	if field.Max != "" {
+		g.printf("%sctx.require(%s <= %s, \"%s %s: %s\");\n", indent, value, field.Max, what, field.Name, tooLarge)
+	}
+	if optional {
+		g.printf("    }\n")
 	}
-	g.printf("    ctx.require(ctx.caller() == %s, \"no permission\");\n\n", grant)
 }
 
 func (g *RustGenerator) writeConsts() {
@@ -628,12 +741,12 @@ func (g *RustGenerator) writeConsts() {
 		// remove 'core' prefix
 		scName = scName[4:]
 	}
-	g.s.appendConst("SC_NAME", "&str = \""+scName+"\"")
+	g.appendConst("SC_NAME", "&str = \""+scName+"\"")
 	if g.s.Description != "" {
-		g.s.appendConst("SC_DESCRIPTION", "&str = \""+g.s.Description+"\"")
+		g.appendConst("SC_DESCRIPTION", "&str = \""+g.s.Description+"\"")
 	}
 	hName := iscp.Hn(scName)
-	g.s.appendConst("HSC_NAME", "ScHname = ScHname(0x"+hName.String()+")")
+	g.appendConst("HSC_NAME", "ScHname = ScHname(0x"+hName.String()+")")
 	g.flushConsts(false)
 
 	g.generateConstsFields(g.s.Params, "PARAM_")
@@ -643,13 +756,13 @@ func (g *RustGenerator) writeConsts() {
 	if len(g.s.Funcs) != 0 {
 		for _, f := range g.s.Funcs {
 			constName := upper(g.funcName(f))
-			g.s.appendConst(constName, "&str = \""+f.String+"\"")
+			g.appendConst(constName, "&str = \""+f.String+"\"")
 		}
 		g.flushConsts(g.s.CoreContracts)
 
 		for _, f := range g.s.Funcs {
 			constHname := "H" + upper(g.funcName(f))
-			g.s.appendConst(constHname, "ScHname = ScHname(0x"+f.Hname.String()+")")
+			g.appendConst(constHname, "ScHname = ScHname(0x"+f.Hname.String()+")")
 		}
 		g.flushConsts(g.s.CoreContracts)
 	}
@@ -676,7 +789,7 @@ func (g *RustGenerator) writeContract() {
 	}
 
 	for _, f := range g.s.Funcs {
-		nameLen := f.nameLen(4) + 1
+		nameLen := funcNameLen(f, 4) + 1
 		kind := f.Kind
 		if f.Type == InitFunc {
 			kind = f.Type + f.Kind
@@ -720,13 +833,13 @@ func (g *RustGenerator) writeKeys() {
 	g.println()
 	g.println(useCrate)
 
-	g.s.KeyID = 0
+	g.keyID = 0
 	g.generateKeysIndexes(g.s.Params, "PARAM_")
 	g.generateKeysIndexes(g.s.Results, "RESULT_")
 	g.generateKeysIndexes(g.s.StateVars, "STATE_")
 	g.flushConsts(true)
 
-	size := g.s.KeyID
+	size := g.keyID
 	g.printf("\npub const KEY_MAP_LEN: usize = %d;\n", size)
 	g.printf("\npub const KEY_MAP: [&str; KEY_MAP_LEN] = [\n")
 	g.generateKeysArray(g.s.Params, "PARAM_")
@@ -841,6 +954,9 @@ func (g *RustGenerator) writeResults() {
 		if len(g.s.Structs) != 0 {
 			g.println(useStructs)
 		}
+		if len(g.s.Typedefs) != 0 {
+			g.println(useTypeDefs)
+		}
 	}
 
 	for _, f := range g.s.Funcs {
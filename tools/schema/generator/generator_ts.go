@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 const (
@@ -16,60 +17,80 @@ const (
 	tsImportWasmLib = "import * as wasmlib from \"wasmlib\""
 )
 
-var tsTypes = StringMap{
+var tsTypes = wasmschema.StringMap{
 	"Address":   "wasmlib.ScAddress",
 	"AgentID":   "wasmlib.ScAgentID",
+	"BigInt":    "wasmlib.ScBigInt",
+	"Bool":      "bool",
 	"ChainID":   "wasmlib.ScChainID",
 	"Color":     "wasmlib.ScColor",
 	"Hash":      "wasmlib.ScHash",
 	"Hname":     "wasmlib.ScHname",
+	"Int8":      "i8",
 	"Int16":     "i16",
 	"Int32":     "i32",
 	"Int64":     "i64",
 	"RequestID": "wasmlib.ScRequestID",
 	"String":    "string",
+	"Uint8":     "u8",
+	"Uint16":    "u16",
+	"Uint32":    "u32",
+	"Uint64":    "u64",
 }
 
-var tsInits = StringMap{
+var tsInits = wasmschema.StringMap{
 	"Address":   "new wasmlib.ScAddress()",
 	"AgentID":   "new wasmlib.ScAgentID()",
+	"BigInt":    "new wasmlib.ScBigInt()",
+	"Bool":      "false",
 	"ChainID":   "new wasmlib.ScChainID()",
 	"Color":     "new wasmlib.ScColor(0)",
 	"Hash":      "new wasmlib.ScHash()",
 	"Hname":     "new wasmlib.ScHname(0)",
+	"Int8":      "0",
 	"Int16":     "0",
 	"Int32":     "0",
 	"Int64":     "0",
 	"RequestID": "new wasmlib.ScRequestID()",
 	"String":    "\"\"",
+	"Uint8":     "0",
+	"Uint16":    "0",
+	"Uint32":    "0",
+	"Uint64":    "0",
 }
 
-var tsKeys = StringMap{
+var tsKeys = wasmschema.StringMap{
 	"Address":   "key",
 	"AgentID":   "key",
+	"BigInt":    "key",
 	"ChainID":   "key",
 	"Color":     "key",
 	"Hash":      "key",
 	"Hname":     "key",
-	"Int16":     "??TODO",
 	"Int32":     "new wasmlib.Key32(key)",
-	"Int64":     "??TODO",
 	"RequestID": "key",
 	"String":    "wasmlib.Key32.fromString(key)",
 }
 
-var tsTypeIds = StringMap{
+var tsTypeIds = wasmschema.StringMap{
 	"Address":   "wasmlib.TYPE_ADDRESS",
 	"AgentID":   "wasmlib.TYPE_AGENT_ID",
+	"BigInt":    "wasmlib.TYPE_BIG_INT",
+	"Bool":      "wasmlib.TYPE_BOOL",
 	"ChainID":   "wasmlib.TYPE_CHAIN_ID",
 	"Color":     "wasmlib.TYPE_COLOR",
 	"Hash":      "wasmlib.TYPE_HASH",
 	"Hname":     "wasmlib.TYPE_HNAME",
+	"Int8":      "wasmlib.TYPE_INT8",
 	"Int16":     "wasmlib.TYPE_INT16",
 	"Int32":     "wasmlib.TYPE_INT32",
 	"Int64":     "wasmlib.TYPE_INT64",
 	"RequestID": "wasmlib.TYPE_REQUEST_ID",
 	"String":    "wasmlib.TYPE_STRING",
+	"Uint8":     "wasmlib.TYPE_UINT8",
+	"Uint16":    "wasmlib.TYPE_UINT16",
+	"Uint32":    "wasmlib.TYPE_UINT32",
+	"Uint64":    "wasmlib.TYPE_UINT64",
 }
 
 const (
@@ -92,17 +113,17 @@ func NewTypeScriptGenerator() *TypeScriptGenerator {
 }
 
 func (g *TypeScriptGenerator) flushConsts() {
-	if len(g.s.ConstNames) == 0 {
+	if len(g.constNames) == 0 {
 		return
 	}
 
 	g.println()
-	g.s.flushConsts(func(name string, value string, padLen int) {
+	g.emitConsts(func(name string, value string, padLen int) {
 		g.printf("export const %s = %s;\n", pad(name, padLen), value)
 	})
 }
 
-func (g *TypeScriptGenerator) funcName(f *Func) string {
+func (g *TypeScriptGenerator) funcName(f *wasmschema.Func) string {
 	return f.FuncName
 }
 
@@ -114,7 +135,7 @@ func (g *TypeScriptGenerator) generateArrayType(varType string) string {
 	return "wasmlib.TYPE_ARRAY|" + varType
 }
 
-func (g *TypeScriptGenerator) generateConstsFields(fields []*Field, prefix string) {
+func (g *TypeScriptGenerator) generateConstsFields(fields []*wasmschema.Field, prefix string) {
 	if len(fields) != 0 {
 		for _, field := range fields {
 			if field.Alias == AliasThis {
@@ -122,7 +143,7 @@ func (g *TypeScriptGenerator) generateConstsFields(fields []*Field, prefix strin
 			}
 			name := prefix + capitalize(field.Name)
 			value := "\"" + field.Alias + "\""
-			g.s.appendConst(name, value)
+			g.appendConst(name, value)
 		}
 		g.flushConsts()
 	}
@@ -151,7 +172,7 @@ func (g *TypeScriptGenerator) generateContractFuncs() {
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateFuncSignature(f *Func) {
+func (g *TypeScriptGenerator) generateFuncSignature(f *wasmschema.Func) {
 	g.printf("\nexport function %s(ctx: wasmlib.Sc%sContext, f: sc.%sContext): void {\n", f.FuncName, f.Kind, f.Type)
 	switch f.FuncName {
 	case SpecialFuncInit:
@@ -169,27 +190,27 @@ func (g *TypeScriptGenerator) generateFuncSignature(f *Func) {
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateKeysArray(fields []*Field, prefix string) {
+func (g *TypeScriptGenerator) generateKeysArray(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := prefix + capitalize(field.Name)
 		g.printf("    sc.%s,\n", name)
-		g.s.KeyID++
+		g.keyID++
 	}
 }
 
-func (g *TypeScriptGenerator) generateKeysIndexes(fields []*Field, prefix string) {
+func (g *TypeScriptGenerator) generateKeysIndexes(fields []*wasmschema.Field, prefix string) {
 	for _, field := range fields {
 		if field.Alias == AliasThis {
 			continue
 		}
 		name := "Idx" + prefix + capitalize(field.Name)
-		field.KeyID = g.s.KeyID
+		field.KeyID = g.keyID
 		value := strconv.Itoa(field.KeyID)
-		g.s.KeyID++
-		g.s.appendConst(name, value)
+		g.keyID++
+		g.appendConst(name, value)
 	}
 }
 
@@ -201,7 +222,7 @@ func (g *TypeScriptGenerator) generateLanguageSpecificFiles() error {
 	return g.writeSpecialConfigJSON()
 }
 
-func (g *TypeScriptGenerator) generateProxyArray(field *Field, mutability, arrayType, proxyType string) {
+func (g *TypeScriptGenerator) generateProxyArray(field *wasmschema.Field, mutability, arrayType, proxyType string) {
 	g.printf("\nexport class %s {\n", arrayType)
 	g.printf("    objID: i32;\n")
 
@@ -233,18 +254,14 @@ func (g *TypeScriptGenerator) generateProxyArray(field *Field, mutability, array
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateProxyArrayNewType(field *Field, proxyType string) {
+func (g *TypeScriptGenerator) generateProxyArrayNewType(field *wasmschema.Field, proxyType string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := tsTypeMap
 		if subtype.Array {
-			varType = tsTypeIds[subtype.Type]
-			if varType == "" {
-				varType = tsTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\n    get%s(index: i32): sc.%s {\n", field.Type, proxyType)
 		g.printf("        let subID = wasmlib.getObjectID(this.objID, new wasmlib.Key32(index), %s);\n", varType)
@@ -258,7 +275,20 @@ func (g *TypeScriptGenerator) generateProxyArrayNewType(field *Field, proxyType
 	g.printf("    }\n")
 }
 
-func (g *TypeScriptGenerator) generateProxyMap(field *Field, mutability, mapType, proxyType string) {
+// elementTypeID returns the type ID of the elements of an array of fldType,
+// nested containers are stored as map objects, structs as encoded bytes
+func (g *TypeScriptGenerator) elementTypeID(fldType string) string {
+	varType := tsTypeIds[fldType]
+	if varType != "" {
+		return varType
+	}
+	if g.s.Typedef(fldType) != nil {
+		return tsTypeMap
+	}
+	return tsTypeBytes
+}
+
+func (g *TypeScriptGenerator) generateProxyMap(field *wasmschema.Field, mutability, mapType, proxyType string) {
 	keyType := tsTypes[field.MapKey]
 	keyValue := tsKeys[field.MapKey]
 
@@ -289,18 +319,14 @@ func (g *TypeScriptGenerator) generateProxyMap(field *Field, mutability, mapType
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateProxyMapNewType(field *Field, proxyType, keyType, keyValue string) {
+func (g *TypeScriptGenerator) generateProxyMapNewType(field *wasmschema.Field, proxyType, keyType, keyValue string) {
 	for _, subtype := range g.s.Typedefs {
 		if subtype.Name != field.Type {
 			continue
 		}
 		varType := tsTypeMap
 		if subtype.Array {
-			varType = tsTypeIds[subtype.Type]
-			if varType == "" {
-				varType = tsTypeBytes
-			}
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(subtype.Type))
 		}
 		g.printf("\n    get%s(key: %s): sc.%s {\n", field.Type, keyType, proxyType)
 		g.printf("        let subID = wasmlib.getObjectID(this.objID, %s.getKeyID(), %s);\n", keyValue, varType)
@@ -314,13 +340,13 @@ func (g *TypeScriptGenerator) generateProxyMapNewType(field *Field, proxyType, k
 	g.printf("    }\n")
 }
 
-func (g *TypeScriptGenerator) generateProxyReference(field *Field, mutability, typeName string) {
+func (g *TypeScriptGenerator) generateProxyReference(field *wasmschema.Field, mutability, typeName string) {
 	if field.Name[0] >= 'A' && field.Name[0] <= 'Z' {
 		g.printf("\nexport class %s%s extends %s {\n};\n", mutability, field.Name, typeName)
 	}
 }
 
-func (g *TypeScriptGenerator) generateProxyStruct(fields []*Field, mutability, typeName, kind string) {
+func (g *TypeScriptGenerator) generateProxyStruct(fields []*wasmschema.Field, mutability, typeName, kind string) {
 	typeName = mutability + typeName + kind
 	kind = strings.TrimSuffix(kind, "s")
 
@@ -342,7 +368,7 @@ func (g *TypeScriptGenerator) generateProxyStruct(fields []*Field, mutability, t
 			varType = tsTypeBytes
 		}
 		if field.Array {
-			varType = g.generateArrayType(varType)
+			varType = g.generateArrayType(g.elementTypeID(field.Type))
 			arrayType := "ArrayOf" + mutability + field.Type
 			g.printf("\n    %s(): sc.%s {\n", varName, arrayType)
 			g.printf("        let arrID = wasmlib.getObjectID(this.mapID, %s, %s);\n", varID, varType)
@@ -379,13 +405,22 @@ func (g *TypeScriptGenerator) generateProxyStruct(fields []*Field, mutability, t
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateStruct(typeDef *Struct) {
+func (g *TypeScriptGenerator) generateStruct(typeDef *wasmschema.Struct) {
 	nameLen, typeLen := calculatePadding(typeDef.Fields, tsTypes, false)
+	hasArrays := false
+	for _, field := range typeDef.Fields {
+		if field.Array {
+			hasArrays = true
+		}
+	}
 
 	g.printf("\nexport class %s {\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		fldName := pad(field.Name, nameLen)
 		fldType := tsTypes[field.Type] + " = " + tsInits[field.Type] + ";"
+		if field.Array {
+			fldType = tsTypes[field.Type] + "[] = [];"
+		}
 		if field.Comment != "" {
 			fldType = pad(fldType, typeLen)
 		}
@@ -398,18 +433,42 @@ func (g *TypeScriptGenerator) generateStruct(typeDef *Struct) {
 	g.printf("        let data = new %s();\n", typeDef.Name)
 	for _, field := range typeDef.Fields {
 		name := field.Name
+		if field.Array {
+			// arrays are encoded as element count followed by the elements
+			g.printf("        let %sLen = decode.uint32();\n", name)
+			g.printf("        for (let i: u32 = 0; i < %sLen; i++) {\n", name)
+			g.printf("            data.%s.push(decode.%s());\n", name, uncapitalize(field.Type))
+			g.printf("        }\n")
+			continue
+		}
 		g.printf("        data.%s = decode.%s();\n", name, uncapitalize(field.Type))
 	}
 	g.printf("        decode.close();\n")
 	g.printf("        return data;\n    }\n")
 
 	g.printf("\n    bytes(): u8[] {\n")
-	g.printf("        return new wasmlib.BytesEncoder().\n")
-	for _, field := range typeDef.Fields {
-		name := field.Name
-		g.printf("            %s(this.%s).\n", uncapitalize(field.Type), name)
+	if hasArrays {
+		g.printf("        let encode = new wasmlib.BytesEncoder();\n")
+		for _, field := range typeDef.Fields {
+			name := field.Name
+			if field.Array {
+				g.printf("        encode.uint32(this.%s.length as u32);\n", name)
+				g.printf("        for (let i = 0; i < this.%s.length; i++) {\n", name)
+				g.printf("            encode.%s(this.%s[i]);\n", uncapitalize(field.Type), name)
+				g.printf("        }\n")
+				continue
+			}
+			g.printf("        encode.%s(this.%s);\n", uncapitalize(field.Type), name)
+		}
+		g.printf("        return encode.data();\n    }\n")
+	} else {
+		g.printf("        return new wasmlib.BytesEncoder().\n")
+		for _, field := range typeDef.Fields {
+			name := field.Name
+			g.printf("            %s(this.%s).\n", uncapitalize(field.Type), name)
+		}
+		g.printf("            data();\n    }\n")
 	}
-	g.printf("            data();\n    }\n")
 
 	g.printf("}\n")
 
@@ -417,7 +476,7 @@ func (g *TypeScriptGenerator) generateStruct(typeDef *Struct) {
 	g.generateStructProxy(typeDef, true)
 }
 
-func (g *TypeScriptGenerator) generateStructProxy(typeDef *Struct, mutable bool) {
+func (g *TypeScriptGenerator) generateStructProxy(typeDef *wasmschema.Struct, mutable bool) {
 	typeName := PropImmutable + typeDef.Name
 	if mutable {
 		typeName = PropMutable + typeDef.Name
@@ -449,7 +508,7 @@ func (g *TypeScriptGenerator) generateStructProxy(typeDef *Struct, mutable bool)
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateThunk(f *Func) {
+func (g *TypeScriptGenerator) generateThunk(f *wasmschema.Func) {
 	g.printf("\nfunction %sThunk(ctx: wasmlib.Sc%sContext): void {\n", f.FuncName, f.Kind)
 	g.printf("    ctx.log(\"%s.%s\");\n", g.s.Name, f.FuncName)
 
@@ -475,32 +534,106 @@ func (g *TypeScriptGenerator) generateThunk(f *Func) {
 			g.printf("    ctx.require(f.params.%s().exists(), \"missing mandatory %s\")\n", name, param.Name)
 		}
 	}
+	for _, param := range f.Params {
+		proxy := "f.params." + param.Name + "()"
+		g.generateThunkConstraint(param, proxy, "invalid", param.Optional)
+	}
 
 	g.printf("    sc.%s(ctx, f);\n", f.FuncName)
+	if f.Kind != KindView {
+		// state var constraints are invariants that must hold after each func
+		for _, stateVar := range g.s.StateVars {
+			proxy := "f.state." + stateVar.Name + "()"
+			g.generateThunkConstraint(stateVar, proxy, "invariant violated:", true)
+		}
+	}
 	g.printf("    ctx.log(\"%s.%s ok\");\n", g.s.Name, f.FuncName)
 	g.printf("}\n")
 }
 
-func (g *TypeScriptGenerator) generateThunkAccessCheck(f *Func) {
-	grant := f.Access
-	index := strings.Index(grant, "//")
-	if index >= 0 {
-		g.printf("    %s\n", grant[index:])
-		grant = strings.TrimSpace(grant[:index])
+func (g *TypeScriptGenerator) generateThunkAccessCheck(f *wasmschema.Func) {
+	grants, comment := f.Grants()
+	if comment != "" {
+		g.printf("    %s\n", comment)
+	}
+	if len(grants) == 1 {
+		grant := grants[0]
+		switch grant {
+		case AccessSelf:
+			grant = "ctx.accountID()"
+		case AccessChain:
+			grant = "ctx.chainOwnerID()"
+		case AccessCreator:
+			grant = "ctx.contractCreator()"
+		default:
+			role := g.s.StateVar(grant)
+			if role.MapKey != "" {
+				g.printf("    ctx.require(%s, \"no permission\");\n\n", g.accessCondition(grant))
+				return
+			}
+			g.printf("    let access = ctx.state().getAgentID(wasmlib.Key32.fromString(\"%s\"));\n", role.Alias)
+			g.printf("    ctx.require(access.exists(), \"access not set: %s\");\n", grant)
+			grant = "access.value()"
+		}
+		g.printf("    ctx.require(ctx.caller().equals(%s), \"no permission\");\n\n", grant)
+		return
 	}
+
+	// caller needs to be granted access by any of the alternatives
+	conditions := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		conditions = append(conditions, g.accessCondition(grant))
+	}
+	g.printf("    ctx.require(%s,\n        \"no permission\");\n\n", strings.Join(conditions, " ||\n        "))
+}
+
+func (g *TypeScriptGenerator) accessCondition(grant string) string {
 	switch grant {
 	case AccessSelf:
-		grant = "ctx.accountID()"
+		return "ctx.caller().equals(ctx.accountID())"
 	case AccessChain:
-		grant = "ctx.chainOwnerID()"
+		return "ctx.caller().equals(ctx.chainOwnerID())"
 	case AccessCreator:
-		grant = "ctx.contractCreator()"
-	default:
-		g.printf("    let access = ctx.state().getAgentID(wasmlib.Key32.fromString(\"%s\"));\n", grant)
-		g.printf("    ctx.require(access.exists(), \"access not set: %s\");\n", grant)
-		grant = "access.value()"
+		return "ctx.caller().equals(ctx.contractCreator())"
+	}
+	role := g.s.StateVar(grant)
+	key := "wasmlib.Key32.fromString(\"" + role.Alias + "\")"
+	if role.MapKey != "" {
+		return "ctx.state().getMap(" + key + ").getBool(ctx.caller()).value()"
+	}
+	return "ctx.caller().equals(ctx.state().getAgentID(" + key + ").value())"
+}
+
+// generateThunkConstraint checks the value of a constrained param or state
+// var, skipping the check when an optional value is not present
+func (g *TypeScriptGenerator) generateThunkConstraint(field *wasmschema.Field, proxy, what string, optional bool) {
+	if !field.HasConstraint() {
+		return
+	}
+	indent := "    "
+	if optional {
+		g.printf("    if (%s.exists()) {\n", proxy)
+		indent = "        "
+	}
+	value := proxy + ".value()"
+	tooSmall, tooLarge := "below minimum", "above maximum"
+	if field.IsLength() {
+		// string length is checked in bytes, just like in the other languages
+		if field.Type == "String" {
+			value = "wasmlib.Convert.fromString(" + value + ")"
+		}
+		value += ".length"
+		tooSmall, tooLarge = "too short", "too long"
+	}
+	if field.Min != "" {
+		g.printf("%sctx.require(%s >= %s, \"%s %s: %s\");\n", indent, value, field.Min, what, field.Name, tooSmall)
+	}
+	if field.Max != "" {
+		g.printf("%sctx.require(%s <= %s, \"%s %s: %s\");\n", indent, value, field.Max, what, field.Name, tooLarge)
+	}
+	if optional {
+		g.printf("    }\n")
 	}
-	g.printf("    ctx.require(ctx.caller().equals(%s), \"no permission\");\n\n", grant)
 }
 
 func (g *TypeScriptGenerator) writeConsts() {
@@ -511,13 +644,13 @@ func (g *TypeScriptGenerator) writeConsts() {
 		// remove 'core' prefix
 		scName = scName[4:]
 	}
-	g.s.appendConst("ScName", "\""+scName+"\"")
+	g.appendConst("ScName", "\""+scName+"\"")
 	if g.s.Description != "" {
-		g.s.appendConst("ScDescription", "\""+g.s.Description+"\"")
+		g.appendConst("ScDescription", "\""+g.s.Description+"\"")
 	}
 	hName := iscp.Hn(scName)
 	hNameType := "new wasmlib.ScHname"
-	g.s.appendConst("HScName", hNameType+"(0x"+hName.String()+")")
+	g.appendConst("HScName", hNameType+"(0x"+hName.String()+")")
 	g.flushConsts()
 
 	g.generateConstsFields(g.s.Params, "Param")
@@ -527,13 +660,13 @@ func (g *TypeScriptGenerator) writeConsts() {
 	if len(g.s.Funcs) != 0 {
 		for _, f := range g.s.Funcs {
 			constName := capitalize(f.FuncName)
-			g.s.appendConst(constName, "\""+f.String+"\"")
+			g.appendConst(constName, "\""+f.String+"\"")
 		}
 		g.flushConsts()
 
 		for _, f := range g.s.Funcs {
 			constHname := "H" + capitalize(f.FuncName)
-			g.s.appendConst(constHname, hNameType+"(0x"+f.Hname.String()+")")
+			g.appendConst(constHname, hNameType+"(0x"+f.Hname.String()+")")
 		}
 		g.flushConsts()
 	}
@@ -591,7 +724,7 @@ func (g *TypeScriptGenerator) writeKeys() {
 	g.println(tsImportWasmLib)
 	g.println(tsImportSelf)
 
-	g.s.KeyID = 0
+	g.keyID = 0
 	g.generateKeysIndexes(g.s.Params, "Param")
 	g.generateKeysIndexes(g.s.Results, "Result")
 	g.generateKeysIndexes(g.s.StateVars, "State")
@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"os"
 	"regexp"
-	"sort"
 	"strings"
+
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 )
 
 var (
@@ -16,7 +17,7 @@ var (
 	camelPartWithID = regexp.MustCompile(`[A-Z][A-Z]+[a-z]`)
 )
 
-func calculatePadding(fields []*Field, types StringMap, snakeName bool) (nameLen, typeLen int) {
+func calculatePadding(fields []*wasmschema.Field, types wasmschema.StringMap, snakeName bool) (nameLen, typeLen int) {
 	for _, param := range fields {
 		fldName := param.Name
 		if snakeName {
@@ -50,6 +51,17 @@ func capitalize(name string) string {
 	return upper(name[:1]) + name[1:]
 }
 
+// funcNameLen returns the padding length for the members of the func structs
+func funcNameLen(f *wasmschema.Func, smallest int) int {
+	if len(f.Results) != 0 {
+		return 7
+	}
+	if len(f.Params) != 0 {
+		return 6
+	}
+	return smallest
+}
+
 // convert to lower case
 func lower(name string) string {
 	return strings.ToLower(name)
@@ -136,39 +148,3 @@ func uncapitalize(name string) string {
 func upper(name string) string {
 	return strings.ToUpper(name)
 }
-
-func sortedFields(dict FieldMap) []string {
-	keys := make([]string, 0)
-	for key := range dict {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-	return keys
-}
-
-func sortedFuncDescs(dict FuncDefMap) []string {
-	keys := make([]string, 0)
-	for key := range dict {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-	return keys
-}
-
-func sortedKeys(dict StringMap) []string {
-	keys := make([]string, 0)
-	for key := range dict {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-	return keys
-}
-
-func sortedMaps(dict StringMapMap) []string {
-	keys := make([]string, 0)
-	for key := range dict {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
-	return keys
-}
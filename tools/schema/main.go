@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/iotaledger/wasp/packages/vm/wasmschema"
 	"github.com/iotaledger/wasp/tools/schema/generator"
 	"gopkg.in/yaml.v2"
 )
@@ -35,6 +36,11 @@ func init() {
 }
 
 func main() {
+	if flag.Arg(0) == "diff" {
+		diffSchemas(flag.Args()[1:])
+		return
+	}
+
 	err := generator.FindModulePath()
 	if err != nil && *flagGo {
 		fmt.Println(err)
@@ -74,6 +80,39 @@ func main() {
 	flag.Usage()
 }
 
+// diffSchemas writes a JSON report of the changes between an old and a new
+// version of a schema file to stdout. The exit code is 1 when any change is
+// breaking, so that the report can be used to gate releases.
+func diffSchemas(args []string) {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: schema diff <old schema file> <new schema file>")
+		os.Exit(2)
+	}
+	oldSchema, err := loadSchemaFile(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	newSchema, err := loadSchemaFile(args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	diff := wasmschema.DiffSchemas(oldSchema, newSchema)
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "\t")
+	err = encoder.Encode(diff)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if diff.Breaking {
+		os.Exit(1)
+	}
+}
+
 func generateCoreInterfaces() {
 	err := filepath.WalkDir("interfaces", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -101,6 +140,7 @@ func generateSchema(file *os.File) error {
 		return err
 	}
 
+	fmt.Println("loading " + file.Name())
 	s, err := loadSchema(file)
 	if err != nil {
 		return err
@@ -153,29 +193,29 @@ func generateSchemaNew() error {
 		return err
 	}
 
-	schemaDef := &generator.SchemaDef{}
+	schemaDef := &wasmschema.SchemaDef{}
 	schemaDef.Name = name
 	schemaDef.Description = name + " description"
-	schemaDef.Structs = make(generator.StringMapMap)
-	schemaDef.Typedefs = make(generator.StringMap)
-	schemaDef.State = make(generator.StringMap)
+	schemaDef.Structs = make(wasmschema.StringMapMap)
+	schemaDef.Typedefs = make(wasmschema.StringMap)
+	schemaDef.State = make(wasmschema.StringMap)
 	schemaDef.State["owner"] = "AgentID // current owner of this smart contract"
-	schemaDef.Funcs = make(generator.FuncDefMap)
-	schemaDef.Views = make(generator.FuncDefMap)
+	schemaDef.Funcs = make(wasmschema.FuncDefMap)
+	schemaDef.Views = make(wasmschema.FuncDefMap)
 
-	funcInit := &generator.FuncDef{}
-	funcInit.Params = make(generator.StringMap)
+	funcInit := &wasmschema.FuncDef{}
+	funcInit.Params = make(wasmschema.StringMap)
 	funcInit.Params["owner"] = "AgentID? // optional owner of this smart contract"
 	schemaDef.Funcs["init"] = funcInit
 
-	funcSetOwner := &generator.FuncDef{}
+	funcSetOwner := &wasmschema.FuncDef{}
 	funcSetOwner.Access = "owner // current owner of this smart contract"
-	funcSetOwner.Params = make(generator.StringMap)
+	funcSetOwner.Params = make(wasmschema.StringMap)
 	funcSetOwner.Params["owner"] = "AgentID // new owner of this smart contract"
 	schemaDef.Funcs["setOwner"] = funcSetOwner
 
-	viewGetOwner := &generator.FuncDef{}
-	viewGetOwner.Results = make(generator.StringMap)
+	viewGetOwner := &wasmschema.FuncDef{}
+	viewGetOwner.Results = make(wasmschema.StringMap)
 	viewGetOwner.Results["owner"] = "AgentID // current owner of this smart contract"
 	schemaDef.Views["getOwner"] = viewGetOwner
 	switch *flagType {
@@ -187,9 +227,17 @@ func generateSchemaNew() error {
 	return errors.New("invalid schema type: " + *flagType)
 }
 
-func loadSchema(file *os.File) (s *generator.Schema, err error) {
-	fmt.Println("loading " + file.Name())
-	schemaDef := &generator.SchemaDef{}
+func loadSchemaFile(path string) (*wasmschema.Schema, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return loadSchema(file)
+}
+
+func loadSchema(file *os.File) (s *wasmschema.Schema, err error) {
+	schemaDef := &wasmschema.SchemaDef{}
 	switch filepath.Ext(file.Name()) {
 	case ".json":
 		err = json.NewDecoder(file).Decode(schemaDef)
@@ -209,7 +257,7 @@ func loadSchema(file *os.File) (s *generator.Schema, err error) {
 		return nil, err
 	}
 
-	s = generator.NewSchema()
+	s = wasmschema.NewSchema()
 	err = s.Compile(schemaDef)
 	if err != nil {
 		return nil, err
@@ -217,7 +265,7 @@ func loadSchema(file *os.File) (s *generator.Schema, err error) {
 	return s, nil
 }
 
-func WriteJSONSchema(schemaDef *generator.SchemaDef) error {
+func WriteJSONSchema(schemaDef *wasmschema.SchemaDef) error {
 	file, err := os.Create("schema.json")
 	if err != nil {
 		return err
@@ -239,7 +287,7 @@ func WriteJSONSchema(schemaDef *generator.SchemaDef) error {
 	return err
 }
 
-func WriteYAMLSchema(schemaDef *generator.SchemaDef) error {
+func WriteYAMLSchema(schemaDef *wasmschema.SchemaDef) error {
 	file, err := os.Create("schema.yaml")
 	if err != nil {
 		return err
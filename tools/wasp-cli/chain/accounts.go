@@ -12,7 +12,6 @@ import (
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/accounts"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
-	"github.com/iotaledger/wasp/tools/wasp-cli/util"
 )
 
 var listAccountsCmd = &cobra.Command{
@@ -20,20 +19,22 @@ var listAccountsCmd = &cobra.Command{
 	Short: "List accounts in chain",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		ret, err := SCClient(accounts.Contract.Hname()).CallView(accounts.FuncViewAccounts.Name, nil)
+		rows := make([][]string, 0)
+		err := SCViewClient(accounts.Contract.Hname()).CallViewPaged(accounts.FuncViewAccounts.Name, nil, func(page dict.Dict) error {
+			for k := range page {
+				agentID, err := codec.DecodeAgentID([]byte(k))
+				if err != nil {
+					return err
+				}
+				rows = append(rows, []string{agentID.String()})
+			}
+			return nil
+		})
 		log.Check(err)
 
-		log.Printf("Total %d account(s) in chain %s\n", len(ret), GetCurrentChainID().Base58())
+		log.Printf("Total %d account(s) in chain %s\n", len(rows), GetCurrentChainID().Base58())
 
 		header := []string{"agentid"}
-		rows := make([][]string, len(ret))
-		i := 0
-		for k := range ret {
-			agentID, err := codec.DecodeAgentID([]byte(k))
-			log.Check(err)
-			rows[i] = []string{agentID.String()}
-			i++
-		}
 		log.PrintTable(header, rows)
 	},
 }
@@ -46,7 +47,7 @@ var balanceCmd = &cobra.Command{
 		agentID, err := iscp.NewAgentIDFromString(args[0])
 		log.Check(err)
 
-		ret, err := SCClient(accounts.Contract.Hname()).CallView(accounts.FuncViewBalance.Name,
+		ret, err := SCViewClient(accounts.Contract.Hname()).CallView(accounts.FuncViewBalance.Name,
 			dict.Dict{
 				accounts.ParamAgentID: agentID.Bytes(),
 			})
@@ -73,13 +74,14 @@ var depositCmd = &cobra.Command{
 	Short: "Deposit funds into sender's on-chain account",
 	Args:  cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		util.WithSCTransaction(GetCurrentChainID(), func() (*ledgerstate.Transaction, error) {
-			return SCClient(accounts.Contract.Hname()).PostRequest(
-				accounts.FuncDeposit.Name,
-				chainclient.PostRequestParams{
-					Transfer: parseColoredBalances(args),
-				},
-			)
-		})
+		postRequest(
+			"deposit",
+			accounts.Contract.Hname(),
+			accounts.FuncDeposit.Hname(),
+			chainclient.PostRequestParams{
+				Transfer: parseColoredBalances(args),
+			},
+			false,
+		)
 	},
 }
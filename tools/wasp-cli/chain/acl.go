@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/client/chainclient"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/coreutil"
+	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/collections"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/spf13/cobra"
+)
+
+func aclCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acl <command>",
+		Short: "Manage the access control lists of the chain",
+		Long: "Manage the lists of agents allowed or denied to post requests, for the whole chain or for one contract.\n" +
+			"An address stands for all the agents of the address. The chain owner is never rejected.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Check(cmd.Help())
+		},
+	}
+	cmd.AddCommand(aclUpdateCmd("add", "Add an agent to an access control list", governance.FuncAddToACL))
+	cmd.AddCommand(aclUpdateCmd("remove", "Remove an agent from an access control list", governance.FuncRemoveFromACL))
+	cmd.AddCommand(aclListCmd())
+	return cmd
+}
+
+func aclUpdateCmd(name, short string, ep coreutil.EntryPointInfo) *cobra.Command {
+	var contract string
+	var offLedger bool
+
+	cmd := &cobra.Command{
+		Use:   name + " allow|deny <agentid|address>",
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			list := parseACLList(args[0])
+			agentID := parseACLAgentID(args[1])
+			postRequest(
+				name+" "+agentID.String()+" in "+list+" list",
+				governance.Contract.Hname(),
+				ep.Hname(),
+				chainclient.PostRequestParams{
+					Args: requestargs.New().AddEncodeSimpleMany(codec.MakeDict(map[string]interface{}{
+						governance.ParamACLList: list,
+						governance.ParamAgentID: agentID,
+						governance.ParamHname:   aclHname(contract),
+					})),
+				},
+				offLedger,
+			)
+		},
+	}
+
+	cmd.Flags().StringVarP(&contract, "contract", "", "", "name of the contract of the list (default: the global list)")
+	cmd.Flags().BoolVarP(&offLedger, "off-ledger", "o", false, "post an off-ledger request")
+	return cmd
+}
+
+func aclListCmd() *cobra.Command {
+	var contract string
+
+	cmd := &cobra.Command{
+		Use:   "list allow|deny",
+		Short: "Show the agents of an access control list",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			list := parseACLList(args[0])
+			ret, err := SCViewClient(governance.Contract.Hname()).CallView(governance.FuncGetACL.Name,
+				dict.Dict{
+					governance.ParamACLList: codec.EncodeString(list),
+					governance.ParamHname:   codec.EncodeHname(aclHname(contract)),
+				})
+			log.Check(err)
+
+			arr := collections.NewArray16ReadOnly(ret, governance.ParamACLAgentIDs)
+			rows := make([][]string, arr.MustLen())
+			for i := range rows {
+				agentID, err := codec.DecodeAgentID(arr.MustGetAt(uint16(i)))
+				log.Check(err)
+				rows[i] = []string{agentID.String()}
+			}
+			log.Printf("Total %d agent(s) in %s list\n", len(rows), list)
+			log.PrintTable([]string{"agentid"}, rows)
+		},
+	}
+
+	cmd.Flags().StringVarP(&contract, "contract", "", "", "name of the contract of the list (default: the global list)")
+	return cmd
+}
+
+func parseACLList(s string) string {
+	if !governance.IsValidACLList(s) {
+		log.Fatalf("invalid list %q, must be %s or %s", s, governance.ACLAllow, governance.ACLDeny)
+	}
+	return s
+}
+
+// parseACLAgentID accepts an agent ID, or an address which stands for all the agents of the address
+func parseACLAgentID(s string) *iscp.AgentID {
+	if agentID, err := iscp.NewAgentIDFromString(s); err == nil {
+		return agentID
+	}
+	addr, err := ledgerstate.AddressFromBase58EncodedString(s)
+	log.Check(err)
+	return iscp.NewAgentID(addr, 0)
+}
+
+func aclHname(contract string) iscp.Hname {
+	if contract == "" {
+		return 0
+	}
+	return iscp.Hn(contract)
+}
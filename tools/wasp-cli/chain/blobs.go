@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/kv"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/blob"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
 	"github.com/iotaledger/wasp/tools/wasp-cli/util"
 	"github.com/spf13/cobra"
@@ -28,19 +30,78 @@ var storeBlobCmd = &cobra.Command{
 }
 
 func uploadBlob(fieldValues dict.Dict) (hash hashing.HashValue) {
+	chunkSize := maxBlobSize()
+	for _, value := range fieldValues {
+		if len(value) > chunkSize {
+			// too big for a single request
+			return uploadBlobChunked(fieldValues, chunkSize)
+		}
+	}
 	hash, _, err := Client().UploadBlob(fieldValues)
 	log.Check(err)
 	log.Printf("uploaded blob to chain -- hash: %s", hash)
 	return hash
 }
 
+func uploadBlobChunked(fieldValues dict.Dict, chunkSize int) (hash hashing.HashValue) {
+	log.Printf("uploading blob in chunks of %d bytes\n", chunkSize)
+	hash, err := Client().UploadBlobChunked(fieldValues, chunkSize)
+	log.Check(err)
+	log.Printf("uploaded blob to chain -- hash: %s", hash)
+	return hash
+}
+
+func maxBlobSize() int {
+	ret, err := SCViewClient(governance.Contract.Hname()).CallView(governance.FuncGetMaxBlobSize.Name, nil)
+	log.Check(err)
+	size, err := codec.DecodeUint32(ret.MustGet(governance.ParamMaxBlobSize))
+	log.Check(err)
+	return int(size)
+}
+
+func uploadBlobCmd() *cobra.Command {
+	var chunkSize int
+	cmd := &cobra.Command{
+		Use:   "upload-blob <field> <filename> [<field> <filename> ...]",
+		Short: "Upload files as a blob to the chain, in multiple requests if needed",
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args)%2 != 0 {
+				log.Fatalf("field and filename must come in pairs")
+			}
+			fieldValues := dict.New()
+			for i := 0; i < len(args); i += 2 {
+				fieldValues.Set(kv.Key(args[i]), util.ReadFile(args[i+1]))
+			}
+			if chunkSize <= 0 {
+				chunkSize = maxBlobSize()
+			}
+			uploadBlobChunked(fieldValues, chunkSize)
+		},
+	}
+	cmd.Flags().IntVarP(&chunkSize, "chunk-size", "", 0, "maximum size of a chunk (default: max blob size of the chain)")
+	return cmd
+}
+
+var deleteBlobCmd = &cobra.Command{
+	Use:   "delete-blob <hash>",
+	Short: "Delete a blob from the chain",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		hash, err := hashing.HashValueFromBase58(args[0])
+		log.Check(err)
+		log.Check(Client().DeleteBlob(hash))
+		log.Printf("deleted blob %s", hash)
+	},
+}
+
 var showBlobCmd = &cobra.Command{
 	Use:   "show-blob <hash>",
 	Short: "Show a blob in chain",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		hash := util.ValueFromString("base58", args[0])
-		fields, err := SCClient(blob.Contract.Hname()).CallView(blob.FuncGetBlobInfo.Name,
+		fields, err := SCViewClient(blob.Contract.Hname()).CallView(blob.FuncGetBlobInfo.Name,
 			dict.Dict{
 				blob.ParamHash: hash,
 			})
@@ -48,7 +109,7 @@ var showBlobCmd = &cobra.Command{
 
 		values := dict.New()
 		for field := range fields {
-			value, err := SCClient(blob.Contract.Hname()).CallView(blob.FuncGetBlobField.Name,
+			value, err := SCViewClient(blob.Contract.Hname()).CallView(blob.FuncGetBlobField.Name,
 				dict.Dict{
 					blob.ParamHash:  hash,
 					blob.ParamField: []byte(field),
@@ -65,23 +126,26 @@ var listBlobsCmd = &cobra.Command{
 	Short: "List blobs in chain",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		ret, err := SCClient(blob.Contract.Hname()).CallView(blob.FuncListBlobs.Name, nil)
-		log.Check(err)
-
-		blobs, err := blob.DecodeSizesMap(ret)
+		rows := make([][]string, 0)
+		err := SCViewClient(blob.Contract.Hname()).CallViewPaged(blob.FuncListBlobs.Name, nil, func(page dict.Dict) error {
+			blobs, err := blob.DecodeSizesMap(page)
+			if err != nil {
+				return err
+			}
+			for k, size := range blobs {
+				hash, err := codec.DecodeHashValue([]byte(k))
+				if err != nil {
+					return err
+				}
+				rows = append(rows, []string{hash.String(), fmt.Sprintf("%d", size)})
+			}
+			return nil
+		})
 		log.Check(err)
 
-		log.Printf("Total %d blob(s) in chain %s\n", len(ret), GetCurrentChainID())
+		log.Printf("Total %d blob(s) in chain %s\n", len(rows), GetCurrentChainID())
 
 		header := []string{"hash", "size"}
-		rows := make([][]string, len(ret))
-		i := 0
-		for k, size := range blobs {
-			hash, err := codec.DecodeHashValue([]byte(k))
-			log.Check(err)
-			rows[i] = []string{hash.String(), fmt.Sprintf("%d", size)}
-			i++
-		}
 		log.PrintTable(header, rows)
 	},
 }
@@ -1,8 +1,10 @@
 package chain
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/iotaledger/wasp/packages/iscp"
@@ -36,7 +38,7 @@ func blockCmd() *cobra.Command {
 
 func fetchBlockInfo(args []string) *blocklog.BlockInfo {
 	if len(args) == 0 {
-		ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetLatestBlockInfo.Name, nil)
+		ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetLatestBlockInfo.Name, nil)
 		log.Check(err)
 		index, err := codec.DecodeUint32(ret.MustGet(blocklog.ParamBlockIndex))
 		log.Check(err)
@@ -46,7 +48,7 @@ func fetchBlockInfo(args []string) *blocklog.BlockInfo {
 	}
 	index, err := strconv.Atoi(args[0])
 	log.Check(err)
-	ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetBlockInfo.Name, dict.Dict{
+	ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetBlockInfo.Name, dict.Dict{
 		blocklog.ParamBlockIndex: codec.EncodeUint32(uint32(index)),
 	})
 	log.Check(err)
@@ -56,7 +58,7 @@ func fetchBlockInfo(args []string) *blocklog.BlockInfo {
 }
 
 func logRequestsInBlock(index uint32) {
-	ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetRequestReceiptsForBlock.Name, dict.Dict{
+	ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetRequestReceiptsForBlock.Name, dict.Dict{
 		blocklog.ParamBlockIndex: codec.EncodeUint32(index),
 	})
 	log.Check(err)
@@ -72,10 +74,14 @@ func logRequestsInBlock(index uint32) {
 			kind = "off-ledger"
 		}
 
+		errMsg := ""
+		if req.Error != nil {
+			errMsg = req.Error.Error()
+		}
 		rows[i] = []string{
 			req.Request.ID().Base58(),
 			kind,
-			fmt.Sprintf("%q", req.Error),
+			fmt.Sprintf("%q", errMsg),
 		}
 	}
 	log.Printf("Total %d requests\n", arr.MustLen())
@@ -83,7 +89,7 @@ func logRequestsInBlock(index uint32) {
 }
 
 func logEventsInBlock(index uint32) {
-	ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetEventsForBlock.Name, dict.Dict{
+	ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetEventsForBlock.Name, dict.Dict{
 		blocklog.ParamBlockIndex: codec.EncodeUint32(index),
 	})
 	log.Check(err)
@@ -98,26 +104,63 @@ func requestCmd() *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			reqID, err := iscp.RequestIDFromBase58(args[0])
 			log.Check(err)
-			ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetRequestReceipt.Name, dict.Dict{
+			ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetRequestReceipt.Name, dict.Dict{
 				blocklog.ParamRequestID: codec.EncodeRequestID(reqID),
 			})
 			log.Check(err)
 
+			if !ret.MustHas(blocklog.ParamRequestRecord) {
+				log.Fatalf("request %s not found in blocklog", reqID.Base58())
+			}
 			blockIndex, err := codec.DecodeUint32(ret.MustGet(blocklog.ParamBlockIndex))
 			log.Check(err)
 			receipt, err := blocklog.RequestReceiptFromBytes(ret.MustGet(blocklog.ParamRequestRecord))
 			log.Check(err)
 
-			log.Printf("request included in block %d\n, %s\n", blockIndex, receipt.String())
-
+			log.Printf("Request included in block %d\n", blockIndex)
+			log.Printf("%s\n", receipt.Request.String())
+			log.Printf("\n")
+			logReceiptError(receipt)
+			log.Printf("\n")
+			logReceiptResults(receipt)
 			log.Printf("\n")
 			logEventsInRequest(reqID)
 		},
 	}
 }
 
+func logReceiptError(receipt *blocklog.RequestReceipt) {
+	if receipt.Error == nil {
+		log.Printf("Request succeeded\n")
+		return
+	}
+	log.Printf("Request failed\n")
+	header := []string{"code", "contract", "message", "params"}
+	rows := [][]string{{
+		fmt.Sprintf("%d", receipt.Error.Code),
+		receipt.Error.Contract.String(),
+		receipt.Error.Message,
+		strings.Join(receipt.Error.Params, ", "),
+	}}
+	log.PrintTable(header, rows)
+}
+
+func logReceiptResults(receipt *blocklog.RequestReceipt) {
+	if receipt.ResultsOmitted {
+		log.Printf("Results omitted: larger than %d bytes\n", blocklog.MaxReceiptResultsSize)
+		return
+	}
+	header := []string{"key", "value"}
+	rows := make([][]string, 0, len(receipt.Results))
+	for _, key := range receipt.Results.KeysSorted() {
+		rows = append(rows, []string{string(key), hex.EncodeToString(receipt.Results[key])})
+	}
+	log.Printf("Total %d results\n", len(rows))
+	log.PrintTable(header, rows)
+}
+
 func logEventsInRequest(reqID iscp.RequestID) {
-	ret, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetEventsForRequest.Name, dict.Dict{
+	ret, err := SCViewClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetEventsForRequest.Name, dict.Dict{
 		blocklog.ParamRequestID: codec.EncodeRequestID(reqID),
 	})
 	log.Check(err)
@@ -126,11 +169,19 @@ func logEventsInRequest(reqID iscp.RequestID) {
 
 func logEvents(ret dict.Dict) {
 	arr := collections.NewArray16ReadOnly(ret, blocklog.ParamEvent)
+	events := make([][]byte, arr.MustLen())
+	for i := range events {
+		events[i] = arr.MustGetAt(uint16(i))
+	}
+	printEvents(events)
+}
+
+func printEvents(events [][]byte) {
 	header := []string{"event"}
-	rows := make([][]string, arr.MustLen())
-	for i := uint16(0); i < arr.MustLen(); i++ {
-		rows[i] = []string{string(arr.MustGetAt(i))}
+	rows := make([][]string, len(events))
+	for i, event := range events {
+		rows[i] = []string{string(event)}
 	}
-	log.Printf("Total %d events\n", arr.MustLen())
+	log.Printf("Total %d events\n", len(events))
 	log.PrintTable(header, rows)
 }
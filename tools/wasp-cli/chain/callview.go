@@ -13,7 +13,7 @@ var callViewCmd = &cobra.Command{
 	Long:  "Call contract <name>, view function <funcname> with given params.",
 	Args:  cobra.MinimumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
-		r, err := SCClient(iscp.Hn(args[0])).CallView(args[1], util.EncodeParams(args[2:]))
+		r, err := SCViewClient(iscp.Hn(args[0])).CallView(args[1], util.EncodeParams(args[2:]))
 		log.Check(err)
 		util.PrintDictAsJSON(r)
 	},
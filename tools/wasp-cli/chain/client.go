@@ -18,6 +18,16 @@ func Client() *chainclient.Client {
 	)
 }
 
+// viewClient is used by the commands which only call views, so the wallet does not need to be unlocked
+func viewClient() *chainclient.Client {
+	return chainclient.New(
+		config.GoshimmerClient(),
+		config.WaspClient(),
+		GetCurrentChainID(),
+		nil,
+	)
+}
+
 func MultiClient() *multiclient.MultiClient {
 	return multiclient.New(config.CommitteeAPI(chainCommittee()))
 }
@@ -25,3 +35,7 @@ func MultiClient() *multiclient.MultiClient {
 func SCClient(contractHname iscp.Hname) *scclient.SCClient {
 	return scclient.New(Client(), contractHname)
 }
+
+func SCViewClient(contractHname iscp.Hname) *scclient.SCClient {
+	return scclient.New(viewClient(), contractHname)
+}
@@ -21,6 +21,7 @@ func Init(rootCmd *cobra.Command) {
 
 	initAliasFlags(chainCmd)
 	initUploadFlags(chainCmd)
+	initOfflineFlags(chainCmd)
 
 	chainCmd.AddCommand(listCmd)
 	chainCmd.AddCommand(deployCmd())
@@ -33,6 +34,8 @@ func Init(rootCmd *cobra.Command) {
 	chainCmd.AddCommand(listBlobsCmd)
 	chainCmd.AddCommand(storeBlobCmd)
 	chainCmd.AddCommand(showBlobCmd)
+	chainCmd.AddCommand(uploadBlobCmd())
+	chainCmd.AddCommand(deleteBlobCmd)
 	chainCmd.AddCommand(eventsCmd)
 	chainCmd.AddCommand(blockCmd())
 	chainCmd.AddCommand(requestCmd())
@@ -40,6 +43,10 @@ func Init(rootCmd *cobra.Command) {
 	chainCmd.AddCommand(callViewCmd)
 	chainCmd.AddCommand(activateCmd)
 	chainCmd.AddCommand(deactivateCmd)
+	chainCmd.AddCommand(observeCmd())
+	chainCmd.AddCommand(setContractFeeCmd())
+	chainCmd.AddCommand(rotateStateControllerCmd())
+	chainCmd.AddCommand(aclCmd())
 
 	for _, p := range plugins {
 		p(chainCmd)
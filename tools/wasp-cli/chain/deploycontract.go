@@ -3,7 +3,6 @@ package chain
 import (
 	"github.com/iotaledger/wasp/client/chainclient"
 	"github.com/iotaledger/wasp/packages/hashing"
-	"github.com/iotaledger/wasp/packages/iscp/request"
 	"github.com/iotaledger/wasp/packages/iscp/requestargs"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/dict"
@@ -43,6 +42,10 @@ var deployContractCmd = &cobra.Command{
 				blob.VarFieldProgramDescription: description,
 				blob.VarFieldProgramBinary:      util.ReadFile(filename),
 			})
+			if unsignedOut != "" {
+				progHash = writeUnsignedBlobUpload(blobFieldValues)
+				break
+			}
 			progHash = uploadBlob(blobFieldValues)
 		}
 
@@ -51,19 +54,19 @@ var deployContractCmd = &cobra.Command{
 }
 
 func deployContract(name, description string, progHash hashing.HashValue, initParams dict.Dict) {
-	util.WithOffLedgerRequest(GetCurrentChainID(), func() (*request.OffLedger, error) {
-		return Client().PostOffLedgerRequest(
-			root.Contract.Hname(),
-			root.FuncDeployContract.Hname(),
-			chainclient.PostRequestParams{
-				Args: requestargs.New().
-					AddEncodeSimpleMany(codec.MakeDict(map[string]interface{}{
-						root.ParamName:        name,
-						root.ParamDescription: description,
-						root.ParamProgramHash: progHash,
-					})).
-					AddEncodeSimpleMany(initParams),
-			},
-		)
-	})
+	postRequest(
+		"deploy contract "+name,
+		root.Contract.Hname(),
+		root.FuncDeployContract.Hname(),
+		chainclient.PostRequestParams{
+			Args: requestargs.New().
+				AddEncodeSimpleMany(codec.MakeDict(map[string]interface{}{
+					root.ParamName:        name,
+					root.ParamDescription: description,
+					root.ParamProgramHash: progHash,
+				})).
+				AddEncodeSimpleMany(initParams),
+		},
+		true,
+	)
 }
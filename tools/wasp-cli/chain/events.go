@@ -2,6 +2,7 @@ package chain
 
 import (
 	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/packages/vm/core/blocklog"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
@@ -13,10 +14,17 @@ var eventsCmd = &cobra.Command{
 	Short: "Show events of contract <name>",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		r, err := SCClient(blocklog.Contract.Hname()).CallView(blocklog.FuncGetEventsForContract.Name, dict.Dict{
+		events := make([][]byte, 0)
+		err := SCViewClient(blocklog.Contract.Hname()).CallViewPaged(blocklog.FuncGetEventsForContract.Name, dict.Dict{
 			blocklog.ParamContractHname: iscp.Hn(args[0]).Bytes(),
+		}, func(page dict.Dict) error {
+			arr := collections.NewArray16ReadOnly(page, blocklog.ParamEvent)
+			for i := uint16(0); i < arr.MustLen(); i++ {
+				events = append(events, arr.MustGetAt(i))
+			}
+			return nil
 		})
 		log.Check(err)
-		logEvents(r)
+		printEvents(events)
 	},
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/iotaledger/wasp/packages/kv/dict"
 	"github.com/iotaledger/wasp/tools/evm/evmcli"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/iotaledger/wasp/tools/wasp-cli/wallet"
 	"github.com/spf13/cobra"
 )
 
@@ -54,6 +55,7 @@ func initJSONRPCCommand(evmCmd *cobra.Command) {
 	var jsonRPCServer evmcli.JSONRPCServer
 	var chainID int
 	var contractName string
+	var unlock bool
 
 	jsonRPCCmd := &cobra.Command{
 		Args:  cobra.NoArgs,
@@ -64,8 +66,12 @@ func initJSONRPCCommand(evmCmd *cobra.Command) {
 By default the server has no unlocked accounts. To send transactions, either:
 
 - use eth_sendRawTransaction
-- configure an unlocked account with --account, and use eth_sendTransaction`,
+- unlock the Ethereum key of the wallet account with --unlock (select the
+  account with --account), and use eth_sendTransaction`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if unlock {
+				jsonRPCServer.UnlockAccount(wallet.Load().EthKey())
+			}
 			backend := jsonrpc.NewWaspClientBackend(Client())
 			jsonRPCServer.ServeJSONRPC(backend, chainID, contractName)
 		},
@@ -73,6 +79,7 @@ By default the server has no unlocked accounts. To send transactions, either:
 
 	jsonRPCServer.InitFlags(jsonRPCCmd)
 	jsonRPCCmd.Flags().IntVarP(&chainID, "chainid", "", evm.DefaultChainID, "ChainID (used for signing transactions)")
+	jsonRPCCmd.Flags().BoolVarP(&unlock, "unlock", "", false, "unlock the Ethereum key of the wallet account")
 	jsonRPCCmd.Flags().StringVarP(&contractName, "name", "", evmchain.Contract.Name, "evmchain/evmlight contract name")
 	evmCmd.AddCommand(jsonRPCCmd)
 }
@@ -0,0 +1,79 @@
+package chain
+
+import (
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/client/chainclient"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/spf13/cobra"
+)
+
+func setContractFeeCmd() *cobra.Command {
+	var ownerFee, validatorFee int64
+	var offLedger bool
+
+	cmd := &cobra.Command{
+		Use:   "set-contract-fee <name>",
+		Short: "Set the owner fee and/or the validator fee of a contract",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if ownerFee < 0 && validatorFee < 0 {
+				log.Fatalf("at least one of --owner-fee and --validator-fee must be set")
+			}
+			params := map[string]interface{}{
+				governance.ParamHname: iscp.Hn(args[0]),
+			}
+			if ownerFee >= 0 {
+				params[governance.ParamOwnerFee] = ownerFee
+			}
+			if validatorFee >= 0 {
+				params[governance.ParamValidatorFee] = validatorFee
+			}
+			postRequest(
+				"set fee of contract "+args[0],
+				governance.Contract.Hname(),
+				governance.FuncSetContractFee.Hname(),
+				chainclient.PostRequestParams{
+					Args: requestargs.New().AddEncodeSimpleMany(codec.MakeDict(params)),
+				},
+				offLedger,
+			)
+		},
+	}
+
+	cmd.Flags().Int64VarP(&ownerFee, "owner-fee", "", -1, "owner fee, in iotas")
+	cmd.Flags().Int64VarP(&validatorFee, "validator-fee", "", -1, "validator fee, in iotas")
+	cmd.Flags().BoolVarP(&offLedger, "off-ledger", "o", false, "post an off-ledger request")
+	return cmd
+}
+
+func rotateStateControllerCmd() *cobra.Command {
+	var offLedger bool
+
+	cmd := &cobra.Command{
+		Use:   "rotate-state-controller <address>",
+		Short: "Rotate the state controller of the chain to an allowed address",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			addr, err := ledgerstate.AddressFromBase58EncodedString(args[0])
+			log.Check(err)
+			postRequest(
+				"rotate state controller to "+addr.Base58(),
+				governance.Contract.Hname(),
+				governance.FuncRotateStateController.Hname(),
+				chainclient.PostRequestParams{
+					Args: requestargs.New().AddEncodeSimpleMany(codec.MakeDict(map[string]interface{}{
+						governance.ParamStateControllerAddress: addr,
+					})),
+				},
+				offLedger,
+			)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&offLedger, "off-ledger", "o", false, "post an off-ledger request")
+	return cmd
+}
@@ -2,9 +2,7 @@ package chain
 
 import (
 	"github.com/iotaledger/wasp/packages/kv/codec"
-	"github.com/iotaledger/wasp/packages/kv/collections"
 	"github.com/iotaledger/wasp/packages/vm/core/governance"
-	"github.com/iotaledger/wasp/packages/vm/core/root"
 	"github.com/iotaledger/wasp/tools/wasp-cli/config"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
 	"github.com/spf13/cobra"
@@ -24,19 +22,17 @@ var infoCmd = &cobra.Command{
 		log.Printf("Chain ID: %s\n", chain.ChainID.Base58())
 		log.Printf("Committee nodes: %+v\n", committee.Nodes)
 		log.Printf("Active: %v\n", chain.Active)
+		log.Printf("Observer: %v\n", chain.Observer)
 
 		if chain.Active {
-			info, err := SCClient(governance.Contract.Hname()).CallView(governance.FuncGetChainInfo.Name, nil)
+			info, err := SCViewClient(governance.Contract.Hname()).CallView(governance.FuncGetChainInfo.Name, nil)
 			log.Check(err)
 
 			description, err := codec.DecodeString(info.MustGet(governance.VarDescription), "")
 			log.Check(err)
 			log.Printf("Description: %s\n", description)
 
-			recs, err := SCClient(root.Contract.Hname()).CallView(root.FuncGetContractRecords.Name, nil)
-			log.Check(err)
-			contracts, err := root.DecodeContractRegistry(collections.NewMapReadOnly(recs, root.VarContractRegistry))
-			log.Check(err)
+			contracts := getContractRecords()
 			log.Printf("#Contracts: %d\n", len(contracts))
 
 			ownerID, err := codec.DecodeAgentID(info.MustGet(governance.VarChainOwnerID))
@@ -23,12 +23,13 @@ var listCmd = &cobra.Command{
 }
 
 func showChainList(chains []*registry.ChainRecord) {
-	header := []string{"chainid", "active"}
+	header := []string{"chainid", "active", "observer"}
 	rows := make([][]string, len(chains))
 	for i, chain := range chains {
 		rows[i] = []string{
 			chain.ChainID.Base58(),
 			fmt.Sprintf("%v", chain.Active),
+			fmt.Sprintf("%v", chain.Observer),
 		}
 	}
 	log.PrintTable(header, rows)
@@ -3,6 +3,7 @@ package chain
 import (
 	"fmt"
 
+	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/packages/kv/codec"
 	"github.com/iotaledger/wasp/packages/kv/collections"
@@ -18,10 +19,7 @@ var listContractsCmd = &cobra.Command{
 	Short: "List deployed contracts in chain",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		records, err := SCClient(root.Contract.Hname()).CallView(root.FuncGetContractRecords.Name, nil)
-		log.Check(err)
-		contracts, err := root.DecodeContractRegistry(collections.NewMapReadOnly(records, root.VarContractRegistry))
-		log.Check(err)
+		contracts := getContractRecords()
 
 		log.Printf("Total %d contracts in chain %s\n", len(contracts), GetCurrentChainID())
 
@@ -42,7 +40,7 @@ var listContractsCmd = &cobra.Command{
 				creator = c.Creator.String()
 			}
 
-			fees, err := SCClient(governance.Contract.Hname()).CallView(governance.FuncGetFeeInfo.Name, dict.Dict{
+			fees, err := SCViewClient(governance.Contract.Hname()).CallView(governance.FuncGetFeeInfo.Name, dict.Dict{
 				governance.ParamHname: c.Hname().Bytes(),
 			})
 			log.Check(err)
@@ -70,3 +68,20 @@ var listContractsCmd = &cobra.Command{
 		log.PrintTable(header, rows)
 	},
 }
+
+// getContractRecords retrieves all pages of the contract registry
+func getContractRecords() map[iscp.Hname]*root.ContractRecord {
+	contracts := make(map[iscp.Hname]*root.ContractRecord)
+	err := SCViewClient(root.Contract.Hname()).CallViewPaged(root.FuncGetContractRecords.Name, nil, func(page dict.Dict) error {
+		recs, err := root.DecodeContractRegistry(collections.NewMapReadOnly(page, root.VarContractRegistry))
+		if err != nil {
+			return err
+		}
+		for hname, rec := range recs {
+			contracts[hname] = rec
+		}
+		return nil
+	})
+	log.Check(err)
+	return contracts
+}
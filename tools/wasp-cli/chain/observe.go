@@ -0,0 +1,40 @@
+package chain
+
+import (
+	"github.com/iotaledger/wasp/packages/apilib"
+	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/spf13/cobra"
+)
+
+func observeCmd() *cobra.Command {
+	var (
+		observers []int
+		committee []int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "observe",
+		Short: "Activate the chain in observer (read-only replica) mode",
+		Long: "Activate the chain on the observer nodes. Observers fetch blocks from the committee nodes,\n" +
+			"serve views and forward off-ledger requests, but never participate in consensus.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if observers == nil {
+				log.Fatalf("no observer nodes specified")
+			}
+			if committee == nil {
+				committee = []int{0, 1, 2, 3}
+			}
+			log.Check(apilib.ActivateChainOnObserverNodes(
+				config.CommitteeAPI(observers),
+				config.CommitteePeering(committee),
+				GetCurrentChainID(),
+			))
+		},
+	}
+
+	cmd.Flags().IntSliceVarP(&observers, "observers", "", nil, "indices of observer nodes")
+	cmd.Flags().IntSliceVarP(&committee, "committee", "", nil, "indices of committee nodes to follow (default: 0,1,2,3)")
+	return cmd
+}
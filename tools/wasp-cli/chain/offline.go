@@ -0,0 +1,139 @@
+package chain
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/client/chainclient"
+	"github.com/iotaledger/wasp/packages/hashing"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/kv/dict"
+	"github.com/iotaledger/wasp/packages/transaction"
+	"github.com/iotaledger/wasp/packages/vm/core/blob"
+	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/iotaledger/wasp/tools/wasp-cli/offline"
+	"github.com/iotaledger/wasp/tools/wasp-cli/util"
+	"github.com/iotaledger/wasp/tools/wasp-cli/wallet"
+	"github.com/spf13/cobra"
+)
+
+var (
+	unsignedOut string
+	signerFlag  string
+)
+
+func initOfflineFlags(chainCmd *cobra.Command) {
+	chainCmd.PersistentFlags().StringVarP(&unsignedOut, "unsigned-out", "", "",
+		"do not post the request: write it unsigned to the file, to be signed with `sign` and posted with `submit`")
+	chainCmd.PersistentFlags().StringVarP(&signerFlag, "signer", "", "",
+		"address signing the request written with --unsigned-out (default: address of the wallet account)")
+}
+
+func signerAddress() ledgerstate.Address {
+	if signerFlag == "" {
+		return wallet.PublicAddress()
+	}
+	addr, err := ledgerstate.AddressFromBase58EncodedString(signerFlag)
+	log.Check(err)
+	return addr
+}
+
+// postRequest posts the request to the chain, or writes it unsigned to the file given with --unsigned-out
+func postRequest(description string, contract, entryPoint iscp.Hname, params chainclient.PostRequestParams, offLedger bool) {
+	if params.Args == nil {
+		params.Args = requestargs.New()
+	}
+	if unsignedOut != "" {
+		writeUnsignedRequest(unsignedOut, description, contract, entryPoint, params, offLedger)
+		return
+	}
+	if offLedger {
+		util.WithOffLedgerRequest(GetCurrentChainID(), func() (*request.OffLedger, error) {
+			return Client().PostOffLedgerRequest(contract, entryPoint, params)
+		})
+	} else {
+		util.WithSCTransaction(GetCurrentChainID(), func() (*ledgerstate.Transaction, error) {
+			return Client().Post1Request(contract, entryPoint, params)
+		})
+	}
+}
+
+func writeUnsignedRequest(fname, description string, contract, entryPoint iscp.Hname, params chainclient.PostRequestParams, offLedger bool) {
+	chainID := GetCurrentChainID()
+	var artifact *offline.Artifact
+	if offLedger {
+		req := request.NewOffLedger(contract, entryPoint, params.Args).WithTransfer(params.Transfer)
+		if params.Nonce != 0 {
+			req.WithNonce(params.Nonce)
+		}
+		artifact = offline.NewOffLedgerRequestArtifact(chainID, description, req)
+	} else {
+		signer := signerAddress()
+		outputs, err := config.GoshimmerClient().GetConfirmedOutputs(signer)
+		log.Check(err)
+		essence, inputs, err := transaction.NewRequestTransactionEssence(transaction.NewRequestTransactionEssenceParams{
+			SenderAddress:  signer,
+			UnspentOutputs: outputs,
+			Requests: []transaction.RequestParams{{
+				ChainID:    chainID,
+				Contract:   contract,
+				EntryPoint: entryPoint,
+				Transfer:   params.Transfer,
+				Args:       params.Args,
+			}},
+		})
+		log.Check(err)
+		artifact = offline.NewTransactionArtifact(chainID, description, signer, essence, inputs)
+	}
+	log.Check(artifact.Save(fname))
+	log.Printf("Unsigned %s written to %s (sign with: %s sign %s)\n", artifact.Kind, fname, os.Args[0], fname)
+}
+
+// writeUnsignedBlobUpload writes the off-ledger requests that upload the blob
+// unsigned to files named after the one given with --unsigned-out. They must
+// be submitted in order, before the request that needs the blob.
+// Returns the hash of the blob
+func writeUnsignedBlobUpload(fieldValues dict.Dict) hashing.HashValue {
+	blobHash := blob.MustGetBlobHash(fieldValues)
+	chunkSize := maxBlobSize()
+	chunked := false
+	for _, value := range fieldValues {
+		chunked = chunked || len(value) > chunkSize
+	}
+	if !chunked {
+		writeUnsignedRequest(unsignedOut+".blob", "upload blob "+blobHash.String(),
+			blob.Contract.Hname(), blob.FuncStoreBlob.Hname(),
+			chainclient.PostRequestParams{Args: requestargs.New().AddEncodeSimpleMany(fieldValues)}, true)
+		return blobHash
+	}
+
+	n := 0
+	writeBlobRequest := func(description string, entryPoint iscp.Hname, params dict.Dict) {
+		n++
+		writeUnsignedRequest(fmt.Sprintf("%s.blob%d", unsignedOut, n), description,
+			blob.Contract.Hname(), entryPoint,
+			chainclient.PostRequestParams{Args: requestargs.New().AddEncodeSimpleMany(params)}, true)
+	}
+	for _, field := range fieldValues.KeysSorted() {
+		for i, chunk := range blob.SplitChunks(fieldValues.MustGet(field), chunkSize) {
+			writeBlobRequest(fmt.Sprintf("upload chunk %d of blob field '%s'", i, field),
+				blob.FuncStoreBlobChunk.Hname(), codec.MakeDict(map[string]interface{}{
+					blob.ParamHash:  blobHash,
+					blob.ParamField: []byte(field),
+					blob.ParamIndex: uint32(i),
+					blob.ParamBytes: chunk,
+				}))
+		}
+	}
+	writeBlobRequest("finish upload of blob "+blobHash.String(),
+		blob.FuncFinishBlobUpload.Hname(), codec.MakeDict(map[string]interface{}{
+			blob.ParamHash: blobHash,
+		}))
+	log.Printf("Submit the %d blob upload files in order\n", n)
+	return blobHash
+}
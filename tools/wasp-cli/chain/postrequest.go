@@ -4,11 +4,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/client/chainclient"
 	"github.com/iotaledger/wasp/packages/iscp"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
-	"github.com/iotaledger/wasp/packages/iscp/request"
 	"github.com/iotaledger/wasp/packages/iscp/requestargs"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
 	"github.com/iotaledger/wasp/tools/wasp-cli/util"
@@ -31,17 +29,7 @@ func postRequestCmd() *cobra.Command {
 				Transfer: parseColoredBalances(transfer),
 			}
 
-			scClient := SCClient(iscp.Hn(args[0]))
-
-			if offLedger {
-				util.WithOffLedgerRequest(GetCurrentChainID(), func() (*request.OffLedger, error) {
-					return scClient.PostOffLedgerRequest(fname, params)
-				})
-			} else {
-				util.WithSCTransaction(GetCurrentChainID(), func() (*ledgerstate.Transaction, error) {
-					return scClient.PostRequest(fname, params)
-				})
-			}
+			postRequest(args[0]+"."+fname, iscp.Hn(args[0]), iscp.Hn(fname), params, offLedger)
 		},
 	}
 
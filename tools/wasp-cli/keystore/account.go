@@ -0,0 +1,157 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+)
+
+const (
+	accountVersion  = 1
+	cipherAES256GCM = "aes-256-gcm"
+)
+
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// Account holds the secrets of a wallet account: the IOTA seed and the key used by the EVM commands
+type Account struct {
+	Seed   *seed.Seed
+	EthKey *ecdsa.PrivateKey
+}
+
+// accountSecrets is the plaintext, which is encrypted in the keystore
+type accountSecrets struct {
+	Seed   []byte `json:"seed"`
+	EthKey []byte `json:"ethKey,omitempty"`
+}
+
+// EncryptedAccount is the account as it is stored in the keystore. The addresses are
+// stored in clear, so accounts can be listed without the passphrase.
+type EncryptedAccount struct {
+	Version    int          `json:"version"`
+	Name       string       `json:"name"`
+	Address    string       `json:"address"`
+	EthAddress string       `json:"ethAddress,omitempty"`
+	Crypto     cryptoParams `json:"crypto"`
+}
+
+type cryptoParams struct {
+	KDF        *KDFParams `json:"kdf"`
+	Cipher     string     `json:"cipher"`
+	Nonce      []byte     `json:"nonce"`
+	Ciphertext []byte     `json:"ciphertext"`
+}
+
+// NewAccount generates an account with a random seed and a random Ethereum key
+func NewAccount() (*Account, error) {
+	ethKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		Seed:   seed.NewSeed(),
+		EthKey: ethKey,
+	}, nil
+}
+
+// Encrypt encrypts the account with a key derived from the passphrase. A new random salt is generated.
+func (a *Account) Encrypt(name, passphrase string, kdf *KDFParams) (*EncryptedAccount, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	kdfParams := *kdf
+	kdfParams.Salt = make([]byte, saltLen)
+	if _, err := io.ReadFull(rand.Reader, kdfParams.Salt); err != nil {
+		return nil, err
+	}
+	key, err := kdfParams.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	secrets := accountSecrets{Seed: a.Seed.Bytes()}
+	if a.EthKey != nil {
+		secrets.EthKey = crypto.FromECDSA(a.EthKey)
+	}
+	plaintext, err := json.Marshal(&secrets)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	ret := &EncryptedAccount{
+		Version: accountVersion,
+		Name:    name,
+		Address: a.Seed.Address(0).Address().Base58(),
+		Crypto: cryptoParams{
+			KDF:        &kdfParams,
+			Cipher:     cipherAES256GCM,
+			Nonce:      nonce,
+			Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+		},
+	}
+	if a.EthKey != nil {
+		ret.EthAddress = crypto.PubkeyToAddress(a.EthKey.PublicKey).Hex()
+	}
+	return ret, nil
+}
+
+// Decrypt returns the secrets of the account. ErrWrongPassphrase is returned if the passphrase does not match.
+func (e *EncryptedAccount) Decrypt(passphrase string) (*Account, error) {
+	if e.Version != accountVersion {
+		return nil, fmt.Errorf("unsupported keystore account version %d", e.Version)
+	}
+	if e.Crypto.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("unsupported cipher %q", e.Crypto.Cipher)
+	}
+	if e.Crypto.KDF == nil {
+		return nil, fmt.Errorf("missing key derivation parameters")
+	}
+	key, err := e.Crypto.KDF.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Crypto.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce size %d", len(e.Crypto.Nonce))
+	}
+	plaintext, err := aead.Open(nil, e.Crypto.Nonce, e.Crypto.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	var secrets accountSecrets
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, err
+	}
+	ret := &Account{Seed: seed.NewSeed(secrets.Seed)}
+	if len(secrets.EthKey) > 0 {
+		if ret.EthKey, err = crypto.ToECDSA(secrets.EthKey); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
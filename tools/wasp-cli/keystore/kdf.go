@@ -0,0 +1,65 @@
+package keystore
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
+
+	keyLen  = 32
+	saltLen = 32
+)
+
+// KDFParams are the parameters of the function deriving the encryption key from the passphrase
+type KDFParams struct {
+	Function string `json:"function"`
+	Salt     []byte `json:"salt"`
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// StandardKDFParams returns the recommended parameters of the KDF function. The salt is not set.
+func StandardKDFParams(function string) (*KDFParams, error) {
+	switch function {
+	case KDFScrypt:
+		return &KDFParams{Function: KDFScrypt, N: 1 << 18, R: 8, P: 1}, nil
+	case KDFArgon2id:
+		return &KDFParams{Function: KDFArgon2id, Time: 1, Memory: 64 * 1024, Threads: 4}, nil
+	}
+	return nil, fmt.Errorf("unknown key derivation function %q, expected %q or %q", function, KDFScrypt, KDFArgon2id)
+}
+
+// LightKDFParams are much faster to compute than the standard ones. To be used in tests only.
+func LightKDFParams(function string) *KDFParams {
+	switch function {
+	case KDFScrypt:
+		return &KDFParams{Function: KDFScrypt, N: 1 << 12, R: 8, P: 1}
+	case KDFArgon2id:
+		return &KDFParams{Function: KDFArgon2id, Time: 1, Memory: 1024, Threads: 1}
+	}
+	panic(fmt.Sprintf("unknown key derivation function %q", function))
+}
+
+func (p *KDFParams) deriveKey(passphrase string) ([]byte, error) {
+	switch p.Function {
+	case KDFScrypt:
+		return scrypt.Key([]byte(passphrase), p.Salt, p.N, p.R, p.P, keyLen)
+	case KDFArgon2id:
+		if p.Time == 0 || p.Memory == 0 || p.Threads == 0 {
+			return nil, fmt.Errorf("invalid argon2id parameters")
+		}
+		return argon2.IDKey([]byte(passphrase), p.Salt, p.Time, p.Memory, p.Threads, keyLen), nil
+	}
+	return nil, fmt.Errorf("unknown key derivation function %q", p.Function)
+}
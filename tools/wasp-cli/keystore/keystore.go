@@ -0,0 +1,138 @@
+// Package keystore stores the wasp-cli wallet accounts encrypted on disk, one file per account.
+package keystore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const accountFileExt = ".json"
+
+var accountNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9_.-]*$`)
+
+type Keystore struct {
+	dir string
+}
+
+func New(dir string) *Keystore {
+	return &Keystore{dir: dir}
+}
+
+func (k *Keystore) Dir() string {
+	return k.dir
+}
+
+func ValidateName(name string) error {
+	if !accountNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid account name %q: only letters, digits, '_', '-' and '.' are allowed", name)
+	}
+	return nil
+}
+
+func (k *Keystore) path(name string) string {
+	return filepath.Join(k.dir, name+accountFileExt)
+}
+
+func (k *Keystore) Exists(name string) bool {
+	if ValidateName(name) != nil {
+		return false
+	}
+	_, err := os.Stat(k.path(name))
+	return err == nil
+}
+
+func (k *Keystore) Load(name string) (*EncryptedAccount, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(k.path(name))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("account %q not found in keystore %s", name, k.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := &EncryptedAccount{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, fmt.Errorf("account %q: %w", name, err)
+	}
+	if ret.Name != name {
+		return nil, fmt.Errorf("account file %s contains account %q", k.path(name), ret.Name)
+	}
+	return ret, nil
+}
+
+// Store saves the account, overwriting the existing account with the same name.
+// The file is replaced atomically, so a failure never leaves a corrupted account behind.
+func (k *Keystore) Store(acc *EncryptedAccount) error {
+	if err := ValidateName(acc.Name); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(acc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(k.dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(k.dir, "."+acc.Name+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), k.path(acc.Name))
+}
+
+func (k *Keystore) Delete(name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	err := os.Remove(k.path(name))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("account %q not found in keystore %s", name, k.dir)
+	}
+	return err
+}
+
+// List returns the accounts of the keystore, sorted by name
+func (k *Keystore) List() ([]*EncryptedAccount, error) {
+	files, err := ioutil.ReadDir(k.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*EncryptedAccount, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), accountFileExt) || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		acc, err := k.Load(strings.TrimSuffix(f.Name(), accountFileExt))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, acc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
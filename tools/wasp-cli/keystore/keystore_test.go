@@ -0,0 +1,91 @@
+package keystore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	for _, kdf := range []string{KDFScrypt, KDFArgon2id} {
+		t.Run(kdf, func(t *testing.T) {
+			acc, err := NewAccount()
+			require.NoError(t, err)
+
+			enc, err := acc.Encrypt("owner", "secret", LightKDFParams(kdf))
+			require.NoError(t, err)
+			require.Equal(t, acc.Seed.Address(0).Address().Base58(), enc.Address)
+			require.NotContains(t, string(enc.Crypto.Ciphertext), string(acc.Seed.Bytes()))
+
+			dec, err := enc.Decrypt("secret")
+			require.NoError(t, err)
+			require.Equal(t, acc.Seed.Bytes(), dec.Seed.Bytes())
+			require.True(t, acc.EthKey.Equal(dec.EthKey))
+
+			_, err = enc.Decrypt("wrong")
+			require.ErrorIs(t, err, ErrWrongPassphrase)
+		})
+	}
+}
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasp-cli-keystore-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ks := New(filepath.Join(dir, "keystore"))
+
+	list, err := ks.List()
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	for _, name := range []string{"owner", "ci"} {
+		acc, err := NewAccount()
+		require.NoError(t, err)
+		enc, err := acc.Encrypt(name, name+"-secret", LightKDFParams(KDFScrypt))
+		require.NoError(t, err)
+		require.NoError(t, ks.Store(enc))
+	}
+	require.True(t, ks.Exists("owner"))
+	require.False(t, ks.Exists("../owner"))
+
+	list, err = ks.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "ci", list[0].Name)
+	require.Equal(t, "owner", list[1].Name)
+
+	info, err := os.Stat(filepath.Join(ks.Dir(), "owner.json"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	enc, err := ks.Load("owner")
+	require.NoError(t, err)
+	_, err = enc.Decrypt("owner-secret")
+	require.NoError(t, err)
+
+	require.NoError(t, ks.Delete("owner"))
+	require.False(t, ks.Exists("owner"))
+	require.Error(t, ks.Delete("owner"))
+
+	_, err = (&Account{}).Encrypt("../evil", "x", LightKDFParams(KDFScrypt))
+	require.Error(t, err)
+}
+
+func TestEthereumKeyExportImport(t *testing.T) {
+	acc, err := NewAccount()
+	require.NoError(t, err)
+
+	keyJSON, err := ExportEthereumKey(acc.EthKey, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	key, err := ImportEthereumKey(keyJSON, "secret")
+	require.NoError(t, err)
+	require.True(t, acc.EthKey.Equal(key))
+
+	_, err = ImportEthereumKey(keyJSON, "wrong")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+}
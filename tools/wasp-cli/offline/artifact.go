@@ -0,0 +1,252 @@
+// Package offline supports signing requests on a machine without network access.
+// The request is built on the online machine and written unsigned to a file (an artifact),
+// which is moved to the offline machine, signed there and moved back to be submitted.
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxoutil"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+)
+
+const (
+	KindOffLedgerRequest = "offledger-request"
+	KindTransaction      = "transaction"
+
+	artifactVersion = 1
+)
+
+// Artifact is an off-ledger request or an on-ledger transaction, unsigned or signed
+type Artifact struct {
+	Version     int    `json:"version"`
+	Kind        string `json:"kind"`
+	ChainID     string `json:"chainID"`
+	Description string `json:"description"`
+	Signed      bool   `json:"signed"`
+	// Request is the off-ledger request. Its public key and signature are empty until signed.
+	Request []byte `json:"request,omitempty"`
+	// Signer is the address expected to sign the transaction
+	Signer string `json:"signer,omitempty"`
+	// Essence and Inputs are the unsigned transaction and the outputs it consumes
+	Essence []byte   `json:"essence,omitempty"`
+	Inputs  [][]byte `json:"inputs,omitempty"`
+	// Transaction is the signed transaction
+	Transaction []byte `json:"transaction,omitempty"`
+}
+
+func NewOffLedgerRequestArtifact(chainID *iscp.ChainID, description string, req *request.OffLedger) *Artifact {
+	return &Artifact{
+		Version:     artifactVersion,
+		Kind:        KindOffLedgerRequest,
+		ChainID:     chainID.Base58(),
+		Description: description,
+		Request:     req.Bytes(),
+	}
+}
+
+func NewTransactionArtifact(
+	chainID *iscp.ChainID,
+	description string,
+	signer ledgerstate.Address,
+	essence *ledgerstate.TransactionEssence,
+	inputs []ledgerstate.Output,
+) *Artifact {
+	ret := &Artifact{
+		Version:     artifactVersion,
+		Kind:        KindTransaction,
+		ChainID:     chainID.Base58(),
+		Description: description,
+		Signer:      signer.Base58(),
+		Essence:     essence.Bytes(),
+		Inputs:      make([][]byte, len(inputs)),
+	}
+	for i, out := range inputs {
+		ret.Inputs[i] = marshalutil.New().Write(out.ID()).WriteBytes(out.Bytes()).Bytes()
+	}
+	return ret
+}
+
+func Load(fname string) (*Artifact, error) {
+	data, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	ret := &Artifact{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, fmt.Errorf("%s: %w", fname, err)
+	}
+	if ret.Version != artifactVersion {
+		return nil, fmt.Errorf("%s: unsupported version %d", fname, ret.Version)
+	}
+	return ret, nil
+}
+
+func (a *Artifact) Save(fname string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fname, data, 0o600)
+}
+
+func (a *Artifact) GetChainID() (*iscp.ChainID, error) {
+	return iscp.ChainIDFromBase58(a.ChainID)
+}
+
+func (a *Artifact) OffLedgerRequest() (*request.OffLedger, error) {
+	if a.Kind != KindOffLedgerRequest {
+		return nil, fmt.Errorf("expected an off-ledger request, found %s", a.Kind)
+	}
+	req, err := request.FromMarshalUtil(marshalutil.New(a.Request))
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := req.(*request.OffLedger)
+	if !ok {
+		return nil, fmt.Errorf("expected an off-ledger request")
+	}
+	return ret, nil
+}
+
+func (a *Artifact) essence() (*ledgerstate.TransactionEssence, []ledgerstate.Output, error) {
+	essence, _, err := ledgerstate.TransactionEssenceFromBytes(a.Essence)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(a.Inputs) != len(essence.Inputs()) {
+		return nil, nil, fmt.Errorf("the transaction has %d inputs, %d consumed outputs provided", len(essence.Inputs()), len(a.Inputs))
+	}
+	inputs := make([]ledgerstate.Output, len(a.Inputs))
+	for i, data := range a.Inputs {
+		mu := marshalutil.New(data)
+		id, err := ledgerstate.OutputIDFromMarshalUtil(mu)
+		if err != nil {
+			return nil, nil, err
+		}
+		out, err := ledgerstate.OutputFromMarshalUtil(mu)
+		if err != nil {
+			return nil, nil, err
+		}
+		utxoInput, ok := essence.Inputs()[i].(*ledgerstate.UTXOInput)
+		if !ok || utxoInput.ReferencedOutputID() != id {
+			return nil, nil, fmt.Errorf("consumed output #%d does not match the input of the transaction", i)
+		}
+		inputs[i] = out.SetID(id)
+	}
+	return essence, inputs, nil
+}
+
+// GetTransaction returns the signed transaction
+func (a *Artifact) GetTransaction() (*ledgerstate.Transaction, error) {
+	if a.Kind != KindTransaction {
+		return nil, fmt.Errorf("expected a transaction, found %s", a.Kind)
+	}
+	if !a.Signed {
+		return nil, fmt.Errorf("the transaction is not signed")
+	}
+	tx, _, err := ledgerstate.TransactionFromBytes(a.Transaction)
+	return tx, err
+}
+
+// Sign signs the request or the transaction with the key pair
+func (a *Artifact) Sign(keyPair *ed25519.KeyPair) error {
+	if a.Signed {
+		return fmt.Errorf("already signed")
+	}
+	switch a.Kind {
+	case KindOffLedgerRequest:
+		req, err := a.OffLedgerRequest()
+		if err != nil {
+			return err
+		}
+		req.Sign(keyPair)
+		a.Request = req.Bytes()
+
+	case KindTransaction:
+		addr := ledgerstate.NewED25519Address(keyPair.PublicKey)
+		if addr.Base58() != a.Signer {
+			return fmt.Errorf("the transaction must be signed by %s, the key is of address %s", a.Signer, addr.Base58())
+		}
+		essence, inputs, err := a.essence()
+		if err != nil {
+			return err
+		}
+		unlockBlocks, err := utxoutil.UnlockInputsWithED25519KeyPairs(inputs, essence, keyPair)
+		if err != nil {
+			return err
+		}
+		a.Transaction = ledgerstate.NewTransaction(essence, unlockBlocks).Bytes()
+
+	default:
+		return fmt.Errorf("unknown kind %q", a.Kind)
+	}
+	a.Signed = true
+	return nil
+}
+
+// Describe decodes the content of the artifact, to be reviewed before signing.
+// The description provided by the online machine is shown, but it is not trusted.
+func (a *Artifact) Describe() ([]string, error) {
+	ret := []string{
+		fmt.Sprintf("%s for chain %s", a.Kind, a.ChainID),
+		fmt.Sprintf("  description (unverified): %s", a.Description),
+	}
+	switch a.Kind {
+	case KindOffLedgerRequest:
+		req, err := a.OffLedgerRequest()
+		if err != nil {
+			return nil, err
+		}
+		contract, entryPoint := req.Target()
+		ret = append(ret,
+			fmt.Sprintf("  target: %s::%s", contract, entryPoint),
+			fmt.Sprintf("  nonce: %d", req.Nonce()),
+			fmt.Sprintf("  transfer: %s", balancesString(req.Tokens())),
+			fmt.Sprintf("  args: %s", req.Args()),
+		)
+
+	case KindTransaction:
+		essence, _, err := a.essence()
+		if err != nil {
+			return nil, err
+		}
+		chainID, err := a.GetChainID()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, fmt.Sprintf("  signer: %s", a.Signer))
+		for i, out := range essence.Outputs() {
+			balances := colored.BalancesFromL1Balances(out.Balances())
+			ext, ok := out.(*ledgerstate.ExtendedLockedOutput)
+			if !ok || !out.Address().Equals(chainID.AsAddress()) {
+				ret = append(ret, fmt.Sprintf("  output #%d to %s: %s", i, out.Address().Base58(), balancesString(balances)))
+				continue
+			}
+			md := request.MetadataFromBytes(ext.GetPayload())
+			ret = append(ret,
+				fmt.Sprintf("  output #%d, request to the chain: %s", i, balancesString(balances)),
+				fmt.Sprintf("    target: %s::%s", md.TargetContract(), md.EntryPoint()),
+				fmt.Sprintf("    args: %s", md.Args()),
+			)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown kind %q", a.Kind)
+	}
+	return ret, nil
+}
+
+func balancesString(b colored.Balances) string {
+	if len(b) == 0 {
+		return "none"
+	}
+	return b.String()
+}
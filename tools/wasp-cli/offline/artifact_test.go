@@ -0,0 +1,94 @@
+package offline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxodb"
+	"github.com/iotaledger/wasp/packages/iscp"
+	"github.com/iotaledger/wasp/packages/iscp/colored"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/packages/iscp/requestargs"
+	"github.com/iotaledger/wasp/packages/kv/codec"
+	"github.com/iotaledger/wasp/packages/transaction"
+	"github.com/iotaledger/wasp/packages/vm/core/governance"
+	"github.com/stretchr/testify/require"
+)
+
+func saveAndLoad(t *testing.T, a *Artifact) *Artifact {
+	dir, err := ioutil.TempDir("", "wasp-cli-offline-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fname := filepath.Join(dir, "artifact.json")
+	require.NoError(t, a.Save(fname))
+	ret, err := Load(fname)
+	require.NoError(t, err)
+	return ret
+}
+
+func TestOffLedgerRequestArtifact(t *testing.T) {
+	u := utxodb.New()
+	keyPair, addr := u.NewKeyPairByIndex(1)
+	chainID := iscp.RandomChainID()
+
+	req := request.NewOffLedger(governance.Contract.Hname(), governance.FuncSetContractFee.Hname(),
+		requestargs.New().AddEncodeSimpleMany(codec.MakeDict(map[string]interface{}{
+			governance.ParamHname:    iscp.Hn("somecontract"),
+			governance.ParamOwnerFee: int64(10),
+		})))
+	a := saveAndLoad(t, NewOffLedgerRequestArtifact(chainID, "set fee", req))
+	lines, err := a.Describe()
+	require.NoError(t, err)
+	require.NotEmpty(t, lines)
+
+	a = saveAndLoad(t, a)
+	require.NoError(t, a.Sign(keyPair))
+	require.Error(t, a.Sign(keyPair))
+
+	a = saveAndLoad(t, a)
+	signed, err := a.OffLedgerRequest()
+	require.NoError(t, err)
+	require.True(t, signed.VerifySignature())
+	require.Equal(t, req.Nonce(), signed.Nonce())
+	require.True(t, signed.SenderAccount().Equals(iscp.NewAgentID(addr, 0)))
+}
+
+func TestTransactionArtifact(t *testing.T) {
+	u := utxodb.New()
+	keyPair, addr := u.NewKeyPairByIndex(1)
+	_, err := u.RequestFunds(addr)
+	require.NoError(t, err)
+	otherKeyPair, _ := u.NewKeyPairByIndex(2)
+	chainID := iscp.RandomChainID()
+
+	essence, inputs, err := transaction.NewRequestTransactionEssence(transaction.NewRequestTransactionEssenceParams{
+		SenderAddress:  addr,
+		UnspentOutputs: u.GetAddressOutputs(addr),
+		Requests: []transaction.RequestParams{{
+			ChainID:    chainID,
+			Contract:   governance.Contract.Hname(),
+			EntryPoint: governance.FuncRotateStateController.Hname(),
+			Transfer:   colored.NewBalancesForIotas(1),
+			Args:       requestargs.New(),
+		}},
+	})
+	require.NoError(t, err)
+
+	a := saveAndLoad(t, NewTransactionArtifact(chainID, "rotate", addr, essence, inputs))
+	lines, err := a.Describe()
+	require.NoError(t, err)
+	require.NotEmpty(t, lines)
+	_, err = a.GetTransaction()
+	require.Error(t, err)
+
+	require.Error(t, a.Sign(otherKeyPair))
+	require.NoError(t, a.Sign(keyPair))
+
+	a = saveAndLoad(t, a)
+	tx, err := a.GetTransaction()
+	require.NoError(t, err)
+	require.NoError(t, u.AddTransaction(tx))
+	require.Len(t, request.RequestsInTransaction(chainID, tx), 1)
+}
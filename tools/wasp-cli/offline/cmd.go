@@ -0,0 +1,81 @@
+package offline
+
+import (
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/wasp/packages/iscp/request"
+	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/iotaledger/wasp/tools/wasp-cli/util"
+	"github.com/iotaledger/wasp/tools/wasp-cli/wallet"
+	"github.com/spf13/cobra"
+)
+
+func Init(rootCmd *cobra.Command) {
+	rootCmd.AddCommand(signCmd())
+	rootCmd.AddCommand(submitCmd)
+}
+
+func signCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign a transaction or an off-ledger request written with --unsigned-out",
+		Long: `Sign a transaction or an off-ledger request written with --unsigned-out.
+Does not need network access. The content is shown before the wallet is unlocked.
+Post the signed file with ` + "`submit`.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			a, err := Load(args[0])
+			log.Check(err)
+			lines, err := a.Describe()
+			log.Check(err)
+			for _, line := range lines {
+				log.Printf("%s\n", line)
+			}
+
+			log.Check(a.Sign(wallet.Load().KeyPair()))
+
+			if out == "" {
+				out = args[0] + ".signed"
+			}
+			log.Check(a.Save(out))
+			log.Printf("Signed %s written to %s\n", a.Kind, out)
+		},
+	}
+
+	cmd.Flags().StringVarP(&out, "out", "o", "", "output file (default: <file>.signed)")
+	return cmd
+}
+
+var submitCmd = &cobra.Command{
+	Use:   "submit <file>",
+	Short: "Post a transaction or an off-ledger request signed with `sign`",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		a, err := Load(args[0])
+		log.Check(err)
+		if !a.Signed {
+			log.Fatalf("%s is not signed", args[0])
+		}
+		chainID, err := a.GetChainID()
+		log.Check(err)
+
+		switch a.Kind {
+		case KindOffLedgerRequest:
+			req, err := a.OffLedgerRequest()
+			log.Check(err)
+			util.WithOffLedgerRequest(chainID, func() (*request.OffLedger, error) {
+				return req, config.WaspClient().PostOffLedgerRequest(chainID, req)
+			})
+		case KindTransaction:
+			tx, err := a.GetTransaction()
+			log.Check(err)
+			util.WithSCTransaction(chainID, func() (*ledgerstate.Transaction, error) {
+				return tx, config.GoshimmerClient().PostTransaction(tx)
+			})
+		default:
+			log.Fatalf("unknown kind %q", a.Kind)
+		}
+	},
+}
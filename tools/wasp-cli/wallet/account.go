@@ -0,0 +1,211 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	waspkeystore "github.com/iotaledger/wasp/tools/wasp-cli/keystore"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	formatSeed        = "seed"
+	formatEthKeystore = "eth-keystore"
+)
+
+var (
+	formatFlag     string
+	outputFileFlag string
+)
+
+var accountCmd = &cobra.Command{
+	Use:   "account <command>",
+	Short: "Manage the accounts of the encrypted keystore",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		log.Check(cmd.Help())
+	},
+}
+
+var accountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the accounts of the keystore",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		accounts, err := Keystore().List()
+		log.Check(err)
+		log.Printf("Total %d account(s) in keystore %s\n", len(accounts), Keystore().Dir())
+		current := AccountName()
+		rows := make([][]string, len(accounts))
+		for i, acc := range accounts {
+			selected := ""
+			if acc.Name == current {
+				selected = "*"
+			}
+			rows[i] = []string{selected, acc.Name, acc.Address, acc.EthAddress, acc.Crypto.KDF.Function}
+		}
+		log.PrintTable([]string{"", "name", "address (index 0)", "EVM address", "kdf"}, rows)
+	},
+}
+
+var accountUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Set the account used by default by the signing commands",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if !Keystore().Exists(args[0]) {
+			log.Fatalf("account %q not found in keystore %s", args[0], Keystore().Dir())
+		}
+		config.Set("wallet.account", args[0])
+	},
+}
+
+var accountDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete the account from the keystore. The passphrase is required.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := AccountName()
+		unlockAccount(name)
+		log.Check(Keystore().Delete(name))
+		log.Printf("Deleted account %q\n", name)
+	},
+}
+
+var accountPasswdCmd = &cobra.Command{
+	Use:   "passwd",
+	Short: "Change the passphrase of the account",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := AccountName()
+		acc := unlockAccount(name)
+		storeAccount(name, acc, readNewPassphrase(PassphraseEnvVar, fmt.Sprintf("account %q", name)))
+		log.Printf("Passphrase of account %q changed\n", name)
+	},
+}
+
+var accountExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the secrets of the account",
+	Long: fmt.Sprintf(`Export the secrets of the account in one of the formats:
+
+  %s          the wallet seed, base58 encoded
+  %s  the key of the EVM commands, in the Ethereum keystore JSON format,
+                encrypted with a new passphrase (%s)`, formatSeed, formatEthKeystore, FilePassphraseEnvVar),
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		acc := unlockAccount(AccountName())
+		switch formatFlag {
+		case formatSeed:
+			writeSecretFile(outputFileFlag, []byte(base58.Encode(acc.Seed.Bytes())))
+		case formatEthKeystore:
+			if acc.EthKey == nil {
+				log.Fatalf("the account has no Ethereum key")
+			}
+			passphrase := readNewPassphrase(FilePassphraseEnvVar, "the Ethereum keystore file")
+			data, err := waspkeystore.ExportEthereumKey(acc.EthKey, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+			log.Check(err)
+			writeSecretFile(outputFileFlag, data)
+		default:
+			log.Fatalf("unknown format %q", formatFlag)
+		}
+	},
+}
+
+var accountImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import secrets into the account. Use - to read from stdin.",
+	Long: fmt.Sprintf(`Import secrets into the account, from a file in one of the formats:
+
+  %s          a wallet seed, base58 encoded. A new account is created.
+  %s  a key in the Ethereum keystore JSON format, encrypted with the
+                passphrase %s. The key replaces the Ethereum key of
+                the account, which is created if it does not exist.`, formatSeed, formatEthKeystore, FilePassphraseEnvVar),
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := AccountName()
+		exists := Keystore().Exists(name)
+		data := readSecretFile(args[0])
+
+		switch formatFlag {
+		case formatSeed:
+			if exists {
+				log.Fatalf("account %q already exists", name)
+			}
+			seedBytes, err := base58.Decode(strings.TrimSpace(string(data)))
+			log.Check(err)
+			if len(seedBytes) != ed25519.SeedSize {
+				log.Fatalf("invalid seed: expected %d bytes, got %d", ed25519.SeedSize, len(seedBytes))
+			}
+			acc, err := waspkeystore.NewAccount()
+			log.Check(err)
+			acc.Seed = seed.NewSeed(seedBytes)
+			storeAccount(name, acc, readNewPassphrase(PassphraseEnvVar, fmt.Sprintf("account %q", name)))
+		case formatEthKeystore:
+			ethKey, err := waspkeystore.ImportEthereumKey(data, readPassphrase(FilePassphraseEnvVar, "Passphrase of the Ethereum keystore file: "))
+			log.Check(err)
+			if exists {
+				// the passphrase of the account is kept
+				acc, passphrase := unlockAccountWithPassphrase(name)
+				acc.EthKey = ethKey
+				storeAccount(name, acc, passphrase)
+			} else {
+				acc, err := waspkeystore.NewAccount()
+				log.Check(err)
+				acc.EthKey = ethKey
+				storeAccount(name, acc, readNewPassphrase(PassphraseEnvVar, fmt.Sprintf("account %q", name)))
+			}
+		default:
+			log.Fatalf("unknown format %q", formatFlag)
+		}
+		log.Printf("Imported into account %q\n", name)
+	},
+}
+
+var accountMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move the plain text seed of the config file into the encrypted keystore",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		seedb58 := viper.GetString("wallet.seed")
+		if seedb58 == "" {
+			log.Fatalf("there is no plain text seed in %s", config.ConfigPath)
+		}
+		name := AccountName()
+		if Keystore().Exists(name) {
+			log.Fatalf("account %q already exists", name)
+		}
+		seedBytes, err := base58.Decode(seedb58)
+		log.Check(err)
+		acc, err := waspkeystore.NewAccount()
+		log.Check(err)
+		acc.Seed = seed.NewSeed(seedBytes)
+		storeAccount(name, acc, readNewPassphrase(PassphraseEnvVar, fmt.Sprintf("account %q", name)))
+
+		config.Set("wallet.seed", "")
+		log.Printf("Seed moved to account %q in %s and removed from %s\n", name, Keystore().Dir(), config.ConfigPath)
+	},
+}
+
+func initAccountCmd(rootCmd *cobra.Command) {
+	rootCmd.AddCommand(accountCmd)
+	accountCmd.AddCommand(accountListCmd)
+	accountCmd.AddCommand(accountUseCmd)
+	accountCmd.AddCommand(accountDeleteCmd)
+	accountCmd.AddCommand(accountPasswdCmd)
+	accountCmd.AddCommand(accountExportCmd)
+	accountCmd.AddCommand(accountImportCmd)
+	accountCmd.AddCommand(accountMigrateCmd)
+
+	for _, cmd := range []*cobra.Command{accountExportCmd, accountImportCmd} {
+		cmd.Flags().StringVarP(&formatFlag, "format", "f", formatSeed, fmt.Sprintf("%s or %s", formatSeed, formatEthKeystore))
+	}
+	accountExportCmd.Flags().StringVarP(&outputFileFlag, "out", "o", "", "output file (default: stdout)")
+}
@@ -1,6 +1,9 @@
 package wallet
 
 import (
+	"fmt"
+
+	"github.com/iotaledger/wasp/tools/wasp-cli/keystore"
 	"github.com/spf13/cobra"
 )
 
@@ -11,6 +14,12 @@ func Init(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(mintCmd)
 	rootCmd.AddCommand(sendFundsCmd)
 	rootCmd.AddCommand(requestFundsCmd)
+	initAccountCmd(rootCmd)
+
+	for _, cmd := range []*cobra.Command{initCmd, accountPasswdCmd, accountImportCmd, accountMigrateCmd} {
+		cmd.Flags().StringVarP(&kdfFlag, "kdf", "", keystore.KDFScrypt, fmt.Sprintf("key derivation function: %s or %s", keystore.KDFScrypt, keystore.KDFArgon2id))
+	}
 
 	rootCmd.PersistentFlags().IntVarP(&addressIndex, "address-index", "i", 0, "address index")
+	rootCmd.PersistentFlags().StringVarP(&accountFlag, "account", "", "", "keystore account used to sign (default: config value wallet.account, or \"default\")")
 }
@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/wasp/packages/iscp/colored"
 	"github.com/iotaledger/wasp/tools/wasp-cli/config"
@@ -19,6 +20,9 @@ var addressCmd = &cobra.Command{
 		log.Verbosef("  Private key: %s\n", kp.PrivateKey)
 		log.Verbosef("  Public key:  %s\n", kp.PublicKey)
 		log.Printf("  Address:     %s\n", wallet.Address().Base58())
+		if wallet.ethKey != nil {
+			log.Printf("  EVM address: %s\n", crypto.PubkeyToAddress(wallet.ethKey.PublicKey).Hex())
+		}
 	},
 }
 
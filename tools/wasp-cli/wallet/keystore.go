@@ -0,0 +1,132 @@
+package wallet
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	"github.com/iotaledger/wasp/tools/wasp-cli/keystore"
+	"github.com/iotaledger/wasp/tools/wasp-cli/log"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const (
+	// PassphraseEnvVar allows to unlock the keystore account without a prompt, e.g. in CI
+	PassphraseEnvVar = "WASP_CLI_PASSPHRASE"
+	// FilePassphraseEnvVar is the passphrase of the imported or exported Ethereum keystore files
+	FilePassphraseEnvVar = "WASP_CLI_FILE_PASSPHRASE"
+
+	defaultAccountName = "default"
+)
+
+var (
+	accountFlag string
+	kdfFlag     string
+)
+
+// AccountName is the keystore account used by the signing commands
+func AccountName() string {
+	if accountFlag != "" {
+		return accountFlag
+	}
+	if name := viper.GetString("wallet.account"); name != "" {
+		return name
+	}
+	return defaultAccountName
+}
+
+func Keystore() *keystore.Keystore {
+	dir := viper.GetString("wallet.keystore")
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(config.ConfigPath), "wasp-cli-keystore")
+	}
+	return keystore.New(dir)
+}
+
+func kdfParams() *keystore.KDFParams {
+	ret, err := keystore.StandardKDFParams(kdfFlag)
+	log.Check(err)
+	return ret
+}
+
+func unlockAccount(name string) *keystore.Account {
+	acc, _ := unlockAccountWithPassphrase(name)
+	return acc
+}
+
+func unlockAccountWithPassphrase(name string) (*keystore.Account, string) {
+	enc, err := Keystore().Load(name)
+	log.Check(err)
+	passphrase := readPassphrase(PassphraseEnvVar, fmt.Sprintf("Passphrase for account %q: ", name))
+	acc, err := enc.Decrypt(passphrase)
+	log.Check(err)
+	return acc, passphrase
+}
+
+func storeAccount(name string, acc *keystore.Account, passphrase string) {
+	enc, err := acc.Encrypt(name, passphrase, kdfParams())
+	log.Check(err)
+	log.Check(Keystore().Store(enc))
+}
+
+// readPassphrase reads the passphrase from the environment variable, or prompts for it if the variable is not set
+func readPassphrase(envVar, prompt string) string {
+	if passphrase, ok := os.LookupEnv(envVar); ok {
+		return passphrase
+	}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		log.Fatalf("cannot prompt for the passphrase: stdin is not a terminal. Set %s instead", envVar)
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	log.Check(err)
+	return string(passphrase)
+}
+
+// readNewPassphrase prompts for the passphrase twice, unless it is set in the environment variable
+func readNewPassphrase(envVar, what string) string {
+	if passphrase, ok := os.LookupEnv(envVar); ok {
+		return passphrase
+	}
+	passphrase := readPassphrase(envVar, fmt.Sprintf("New passphrase for %s: ", what))
+	if passphrase == "" {
+		log.Fatalf("the passphrase must not be empty")
+	}
+	if readPassphrase(envVar, "Repeat the passphrase: ") != passphrase {
+		log.Fatalf("the passphrases do not match")
+	}
+	return passphrase
+}
+
+// readSecretFile reads the whole file, or stdin if the file name is "-"
+func readSecretFile(fname string) []byte {
+	if fname == "-" {
+		var lines []string
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		log.Check(scanner.Err())
+		return []byte(strings.Join(lines, "\n"))
+	}
+	data, err := ioutil.ReadFile(fname)
+	log.Check(err)
+	return data
+}
+
+// writeSecretFile writes the data to a file readable only by the user, or to stdout if the file name is empty
+func writeSecretFile(fname string, data []byte) {
+	if fname == "" {
+		log.Printf("%s\n", data)
+		return
+	}
+	log.Check(ioutil.WriteFile(fname, data, 0o600))
+	log.Printf("Written to %s\n", fname)
+}
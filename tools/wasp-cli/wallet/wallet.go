@@ -1,49 +1,69 @@
 package wallet
 
 import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/wasp/tools/wasp-cli/config"
+	"github.com/iotaledger/wasp/tools/wasp-cli/keystore"
 	"github.com/iotaledger/wasp/tools/wasp-cli/log"
 	"github.com/mr-tron/base58"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-type WalletConfig struct {
-	Seed []byte
-}
-
 type Wallet struct {
-	seed *seed.Seed
+	seed   *seed.Seed
+	ethKey *ecdsa.PrivateKey
 }
 
 var initCmd = &cobra.Command{
 	Use:   "init",
-	Short: "Initialize a new wallet",
+	Short: "Initialize a new wallet account in the encrypted keystore",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		seed := base58.Encode(seed.NewSeed().Bytes())
-		viper.Set("wallet.seed", seed)
-		log.Check(viper.WriteConfig())
-
-		log.Printf("Initialized wallet seed in %s\n", config.ConfigPath)
-		log.Printf("\nIMPORTANT: wasp-cli is alpha phase. The seed is currently being stored " +
-			"in a plain text file which is NOT secure. Do not use this seed to store funds " +
-			"in the mainnet!\n")
-		log.Verbosef("\nSeed: %s\n", seed)
+		name := AccountName()
+		ks := Keystore()
+		if ks.Exists(name) {
+			log.Fatalf("account %q already exists in keystore %s", name, ks.Dir())
+		}
+		acc, err := keystore.NewAccount()
+		log.Check(err)
+		storeAccount(name, acc, readNewPassphrase(PassphraseEnvVar, fmt.Sprintf("account %q", name)))
+
+		log.Printf("Initialized wallet account %q in %s\n", name, ks.Dir())
+		log.Verbosef("\nSeed: %s\n", base58.Encode(acc.Seed.Bytes()))
 	},
 }
 
+// Load unlocks the keystore account selected with --account.
+// Wallets initialized by older versions, with the seed stored in plain text in the
+// config file, are still loaded, until they are migrated with `account migrate`.
 func Load() *Wallet {
+	name := AccountName()
+	if !Keystore().Exists(name) {
+		if w := loadPlainTextSeed(); w != nil {
+			return w
+		}
+		log.Fatalf("account %q not found, call `init` first", name)
+	}
+	acc := unlockAccount(name)
+	return &Wallet{seed: acc.Seed, ethKey: acc.EthKey}
+}
+
+func loadPlainTextSeed() *Wallet {
 	seedb58 := viper.GetString("wallet.seed")
-	if seedb58 == "" {
-		log.Fatalf("call `init` first")
+	if seedb58 == "" || accountFlag != "" {
+		return nil
 	}
+	fmt.Fprintf(os.Stderr, "WARNING: the wallet seed is stored in plain text in the config file. "+
+		"Move it to the encrypted keystore with `wasp-cli account migrate`\n")
 	seedBytes, err := base58.Decode(seedb58)
 	log.Check(err)
-	return &Wallet{seed.NewSeed(seedBytes)}
+	return &Wallet{seed: seed.NewSeed(seedBytes)}
 }
 
 var addressIndex int
@@ -55,3 +75,31 @@ func (w *Wallet) KeyPair() *ed25519.KeyPair {
 func (w *Wallet) Address() ledgerstate.Address {
 	return w.seed.Address(uint64(addressIndex)).Address()
 }
+
+// PublicAddress returns the address of the account without unlocking it.
+// Only the address with index 0 is stored in clear in the keystore.
+func PublicAddress() ledgerstate.Address {
+	name := AccountName()
+	if !Keystore().Exists(name) {
+		if w := loadPlainTextSeed(); w != nil {
+			return w.Address()
+		}
+		log.Fatalf("account %q not found, call `init` first", name)
+	}
+	if addressIndex != 0 {
+		log.Fatalf("only the address with index 0 is known without unlocking the account")
+	}
+	enc, err := Keystore().Load(name)
+	log.Check(err)
+	addr, err := ledgerstate.AddressFromBase58EncodedString(enc.Address)
+	log.Check(err)
+	return addr
+}
+
+// EthKey is the private key used by the EVM commands
+func (w *Wallet) EthKey() *ecdsa.PrivateKey {
+	if w.ethKey == nil {
+		log.Fatalf("the account has no Ethereum key, import one with `account import --format %s`", formatEthKeystore)
+	}
+	return w.ethKey
+}